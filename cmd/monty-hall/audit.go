@@ -0,0 +1,64 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/ui"
+)
+
+const defaultAuditReport = "accessibility-report.txt"
+
+// runAudit renders every view with the active settings and writes an
+// accessibility report for maintainers
+func runAudit(args []string) int {
+	fs := flag.NewFlagSet("audit", flag.ContinueOnError)
+	output := fs.String("o", defaultAuditReport, "write the report to `file`")
+	width := fs.Int("width", minTerminalWidth, "terminal `columns` to render at")
+	height := fs.Int("height", minTerminalHeight, "terminal `rows` to render at")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall audit [-o file] [-width columns] [-height rows]")
+		fmt.Fprintln(fs.Output(), "\nRenders every view as plain text and checks contrast, selection cues and")
+		fmt.Fprintln(fs.Output(), "mouse hit targets, writing the results to an accessibility report.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	// Audit the saved settings without ever writing them back
+	cfg, configResult := checkConfigFile()
+	if configResult.Status == checkFail {
+		fmt.Printf("Warning: %s; auditing the default settings\n", configResult.Detail)
+	}
+	configManager := config.NewManagerWithDefaults("")
+	if err := configManager.Apply(cfg); err != nil {
+		fmt.Printf("Error applying configuration: %v\n", err)
+		return 1
+	}
+
+	report := ui.RunAccessibilityAudit(configManager, *width, *height)
+
+	file, err := os.Create(*output)
+	if err != nil {
+		fmt.Printf("Error creating report: %v\n", err)
+		return 1
+	}
+	defer file.Close()
+
+	if _, err := report.WriteTo(file); err != nil {
+		fmt.Printf("Error writing report: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Accessibility report written to %s: %d checks, %d failed\n",
+		*output, len(report.Checks), report.Failures())
+
+	if report.Failures() > 0 {
+		return 1
+	}
+	return 0
+}
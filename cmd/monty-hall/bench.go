@@ -0,0 +1,139 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/bench"
+)
+
+// runBench runs the benchmark suite and compares it against a saved baseline,
+// failing when a benchmark is slower than its budget allows
+func runBench(args []string) int {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	baselinePath := flags.String("baseline", bench.DefaultBaselineFile, "compare against the baseline in `file`")
+	save := flags.Bool("save", false, "save the results as the new baseline instead of comparing")
+	tolerance := flags.Float64("tolerance", bench.DefaultTolerance, "allowed slowdown over the baseline, as a `fraction`")
+	run := flags.String("run", "", "run only the benchmarks matching `regexp`")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: monty-hall bench [-baseline file] [-save] [-tolerance fraction] [-run regexp]")
+		fmt.Fprintln(flags.Output(), "\nTimes game creation, a million-game simulation, aggregating 100,000 game")
+		fmt.Fprintln(flags.Output(), "records and rendering the game view, then compares the times with a saved")
+		fmt.Fprintln(flags.Output(), "baseline. Exits non-zero if any benchmark is slower than the tolerance allows.")
+		fmt.Fprintln(flags.Output(), "Baselines depend on the machine, so save one on the machine that compares.")
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *tolerance < 0 {
+		fmt.Fprintln(os.Stderr, "Error: -tolerance cannot be negative")
+		return 2
+	}
+
+	benchmarks, err := bench.Select(*run)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	if len(benchmarks) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no benchmarks match %q\n", *run)
+		return 2
+	}
+
+	var baseline *bench.Baseline
+	if !*save {
+		baseline, err = bench.LoadBaseline(*baselinePath)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	results := bench.Run(benchmarks, func(benchmark bench.Benchmark) {
+		fmt.Printf("Running %s: %s...\n", benchmark.Name, benchmark.Description)
+	})
+	fmt.Println()
+
+	if *save {
+		printResults(results)
+		if err := bench.NewBaseline(results).Save(*baselinePath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		fmt.Printf("\nBaseline saved to %s\n", *baselinePath)
+		return 0
+	}
+
+	if baseline == nil {
+		printResults(results)
+		fmt.Printf("\nNo baseline at %s; run with -save to record one\n", *baselinePath)
+		return 0
+	}
+
+	comparisons := baseline.Compare(results, *tolerance)
+	regressions := printComparisons(comparisons)
+	fmt.Printf("\nCompared with the baseline from %s (%s, %s)\n",
+		baseline.Created.Format(time.DateOnly), baseline.GoVersion, baseline.Platform)
+	if regressions > 0 {
+		fmt.Printf("❌ %d of %d benchmarks are more than %.0f%% slower than the baseline\n",
+			regressions, len(comparisons), *tolerance*100)
+		return 1
+	}
+	fmt.Printf("✅ Every benchmark is within %.0f%% of the baseline\n", *tolerance*100)
+	return 0
+}
+
+// printResults prints benchmark results as a table
+func printResults(results []bench.Result) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BENCHMARK\tTIME/OP\tALLOCS/OP\tBYTES/OP")
+	for _, result := range results {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d%s\n", result.Name, formatNs(result.NsPerOp),
+			result.AllocsPerOp, result.BytesPerOp, formatExtra(result))
+	}
+	w.Flush()
+}
+
+// printComparisons prints results beside their baselines and returns how
+// many regressed
+func printComparisons(comparisons []bench.Comparison) int {
+	regressions := 0
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BENCHMARK\tBASELINE\tTIME/OP\tCHANGE\tSTATUS")
+	for _, c := range comparisons {
+		base, change, status := "-", "-", "new"
+		if c.Baseline != nil {
+			base = formatNs(c.Baseline.NsPerOp)
+			change = fmt.Sprintf("%+.1f%%", c.Change*100)
+			status = "ok"
+		}
+		if c.Regressed {
+			status = "SLOWER"
+			regressions++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s%s\n", c.Current.Name, base, formatNs(c.Current.NsPerOp),
+			change, status, formatExtra(c.Current))
+	}
+	w.Flush()
+	return regressions
+}
+
+// formatNs formats a time per operation
+func formatNs(ns int64) string {
+	return time.Duration(ns).String()
+}
+
+// formatExtra formats the custom metrics of a result
+func formatExtra(result bench.Result) string {
+	if games, ok := result.Extra["games/s"]; ok {
+		return fmt.Sprintf("\t%.0f games/s", games)
+	}
+	return ""
+}
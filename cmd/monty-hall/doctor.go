@@ -0,0 +1,298 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/term"
+	"github.com/mattn/go-runewidth"
+	"github.com/muesli/termenv"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+const (
+	minTerminalWidth   = 80
+	minTerminalHeight  = 24
+	largeTerminalWidth = 120 // Width at which the full ASCII banners are shown
+)
+
+// checkStatus is the outcome of a single doctor check
+type checkStatus int
+
+const (
+	checkPass checkStatus = iota
+	checkWarn
+	checkFail
+)
+
+// String returns the label printed for the status
+func (cs checkStatus) String() string {
+	switch cs {
+	case checkPass:
+		return "PASS"
+	case checkWarn:
+		return "WARN"
+	default:
+		return "FAIL"
+	}
+}
+
+// checkResult describes a doctor check and how to fix it if it did not pass
+type checkResult struct {
+	Name   string
+	Status checkStatus
+	Detail string
+	Fix    string
+}
+
+// runDoctor diagnoses the terminal and file system and prints pass/fail results
+func runDoctor(args []string) int {
+	fs := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall doctor")
+		fmt.Fprintln(fs.Output(), "\nChecks the terminal, file permissions and saved data for common problems.")
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	cfg, configResult := checkConfigFile()
+
+	results := []checkResult{
+		checkTerminalSize(),
+		checkColorSupport(),
+		checkUnicodeWidth(),
+		configResult,
+	}
+
+	if configDir, err := config.GetConfigDir(); err == nil {
+		results = append(results, checkWritableDir("Config directory", configDir))
+	}
+	statsPath := cfg.Stats.StatsPath()
+	results = append(results, checkWritableDir("Stats directory", filepath.Dir(statsPath)))
+	results = append(results, checkWritableDir("Export directory", cfg.Stats.ExportDirectory))
+	results = append(results, checkStatsFile(statsPath))
+
+	fmt.Println("Monty Hall doctor")
+	fmt.Println()
+
+	failed := false
+	for _, result := range results {
+		fmt.Printf("[%s] %s: %s\n", result.Status, result.Name, result.Detail)
+		if result.Status != checkPass && result.Fix != "" {
+			fmt.Printf("       → %s\n", result.Fix)
+		}
+		if result.Status == checkFail {
+			failed = true
+		}
+	}
+
+	printUnicodeSamples()
+
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// checkTerminalSize verifies the terminal is large enough for the game layout
+func checkTerminalSize() checkResult {
+	result := checkResult{Name: "Terminal size"}
+
+	fd := os.Stdout.Fd()
+	if !term.IsTerminal(fd) {
+		result.Status = checkWarn
+		result.Detail = "output is not a terminal"
+		result.Fix = "Run doctor directly in the terminal you play in, without redirecting output"
+		return result
+	}
+
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		result.Status = checkWarn
+		result.Detail = fmt.Sprintf("could not read size: %v", err)
+		return result
+	}
+
+	result.Detail = fmt.Sprintf("%dx%d", width, height)
+	switch {
+	case width < minTerminalWidth || height < minTerminalHeight:
+		result.Status = checkFail
+		result.Fix = fmt.Sprintf("Resize the terminal to at least %dx%d", minTerminalWidth, minTerminalHeight)
+	case width < largeTerminalWidth:
+		result.Status = checkPass
+		result.Detail += fmt.Sprintf(" (%d+ columns show the large banners)", largeTerminalWidth)
+	}
+	return result
+}
+
+// checkColorSupport reports the color profile detected for the terminal
+func checkColorSupport() checkResult {
+	result := checkResult{Name: "Color support"}
+
+	switch lipgloss.ColorProfile() {
+	case termenv.TrueColor:
+		result.Detail = "true color"
+	case termenv.ANSI256:
+		result.Detail = "256 colors"
+	case termenv.ANSI:
+		result.Status = checkWarn
+		result.Detail = "16 colors only"
+		result.Fix = "Colors will be approximated; set COLORTERM=truecolor if your terminal supports it"
+	default:
+		result.Status = checkWarn
+		result.Detail = "no color"
+		result.Fix = "Check that TERM is set correctly and NO_COLOR is not set"
+	}
+	return result
+}
+
+// checkUnicodeWidth warns when ambiguous-width characters are treated as wide
+func checkUnicodeWidth() checkResult {
+	result := checkResult{Name: "Unicode width", Detail: "standard widths (compare the samples below)"}
+
+	if runewidth.IsEastAsian() {
+		result.Status = checkWarn
+		result.Detail = "East Asian ambiguous widths are enabled"
+		result.Fix = "Borders may misalign; set RUNEWIDTH_EASTASIAN=0 or use --safe-mode for ASCII output"
+	}
+	return result
+}
+
+// checkConfigFile validates the saved configuration, returning it or the defaults
+func checkConfigFile() (*config.Config, checkResult) {
+	result := checkResult{Name: "Config file"}
+
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		result.Status = checkFail
+		result.Detail = err.Error()
+		return config.DefaultConfig(), result
+	}
+	profile := config.ActiveProfile(configDir)
+	configPath := config.ProfilePath(configDir, profile)
+
+	manager := config.NewManagerWithDefaults(configPath)
+	if !manager.Exists() {
+		result.Status = checkWarn
+		result.Detail = fmt.Sprintf("%s not found", configPath)
+		result.Fix = "It will be created with default settings on the next start"
+		return config.DefaultConfig(), result
+	}
+
+	cfg, _, err := manager.PreviewFile(configPath)
+	if err != nil {
+		result.Status = checkFail
+		result.Detail = err.Error()
+		result.Fix = "Fix or delete the file, or start with --safe-mode and change settings from inside the app"
+		return config.DefaultConfig(), result
+	}
+
+	result.Detail = configPath
+	if profile != config.DefaultProfile {
+		result.Detail += fmt.Sprintf(" (profile %s)", profile)
+	}
+	return cfg, result
+}
+
+// checkWritableDir verifies a directory, or the parent it would be created in, is writable
+func checkWritableDir(name, dir string) checkResult {
+	result := checkResult{Name: name}
+
+	// Walk up to the closest directory that already exists
+	existing := dir
+	for {
+		info, err := os.Stat(existing)
+		if err == nil {
+			if !info.IsDir() {
+				result.Status = checkFail
+				result.Detail = fmt.Sprintf("%s is not a directory", existing)
+				result.Fix = "Remove or rename the file so the directory can be created"
+				return result
+			}
+			break
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			break
+		}
+		existing = parent
+	}
+
+	probe, err := os.CreateTemp(existing, ".monty-hall-doctor-*")
+	if err != nil {
+		result.Status = checkFail
+		result.Detail = fmt.Sprintf("%s is not writable", existing)
+		result.Fix = fmt.Sprintf("Check the permissions of %s", existing)
+		return result
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	if existing == dir {
+		result.Detail = fmt.Sprintf("%s is writable", dir)
+	} else {
+		result.Detail = fmt.Sprintf("%s will be created when needed", dir)
+	}
+	return result
+}
+
+// checkStatsFile verifies the statistics file can be read and is consistent
+func checkStatsFile(path string) checkResult {
+	result := checkResult{Name: "Stats file"}
+
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		result.Detail = "no games recorded yet"
+		return result
+	}
+
+	persistence := stats.NewPersistenceManager(path)
+	gameStats, err := persistence.Load()
+	if err != nil {
+		result.Status = checkFail
+		result.Detail = err.Error()
+		result.Fix = fmt.Sprintf("Restore a backup or move %s aside to start fresh", path)
+		return result
+	}
+
+	if recovery := persistence.Recovery(); recovery != nil {
+		result.Status = checkWarn
+		result.Detail = fmt.Sprintf("damaged (%v); %d games load from the backup", recovery.Cause, gameStats.TotalGames)
+		result.Fix = fmt.Sprintf("The next game saves over it, keeping the damaged file as %s", path+stats.DamagedSuffix)
+		return result
+	}
+
+	if err := gameStats.Validate(); err != nil {
+		result.Status = checkWarn
+		result.Detail = fmt.Sprintf("inconsistent totals: %v", err)
+		result.Fix = "Statistics may be off; resetting them from the stats view starts fresh"
+		return result
+	}
+
+	result.Detail = fmt.Sprintf("%d games recorded", gameStats.TotalGames)
+	return result
+}
+
+// printUnicodeSamples prints glyphs used by the UI so their alignment can be checked
+func printUnicodeSamples() {
+	const sampleWidth = 10
+
+	samples := []string{
+		"██████████",
+		"╭────────╮",
+		"← → ↑ ↓ ▶",
+		"🚪🎉🏆✅❌",
+	}
+
+	fmt.Println()
+	fmt.Println("Unicode samples — the right-hand bars should line up:")
+	for _, sample := range samples {
+		fmt.Printf("  |%s|\n", runewidth.FillRight(sample, sampleWidth))
+	}
+	fmt.Println("If they do not, use --safe-mode for ASCII-only output.")
+}
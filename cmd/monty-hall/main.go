@@ -1,24 +1,172 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"os/user"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/chaos"
 	"github.com/westhuis/monty-hall/pkg/config"
 	"github.com/westhuis/monty-hall/pkg/ui"
 )
 
 func main() {
+	// Dispatch subcommands before starting the interactive UI
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "audit":
+			os.Exit(runAudit(os.Args[2:]))
+		case "schema":
+			os.Exit(runSchema(os.Args[2:]))
+		case "serve":
+			os.Exit(runServe(os.Args[2:]))
+		case "doctor":
+			os.Exit(runDoctor(os.Args[2:]))
+		case "verify":
+			os.Exit(runVerify(os.Args[2:]))
+		case "simulate":
+			os.Exit(runSimulate(os.Args[2:]))
+		case "tournament":
+			os.Exit(runTournament(os.Args[2:]))
+		case "play":
+			os.Exit(runPlay(os.Args[2:]))
+		case "stats":
+			os.Exit(runStats(os.Args[2:]))
+		case "status":
+			os.Exit(runStatus(os.Args[2:]))
+		case "bench":
+			os.Exit(runBench(os.Args[2:]))
+		}
+	}
+
+	importConfig := flag.String("import-config", "", "preview and apply settings from a config `file`")
+	restoreConfig := flag.Bool("restore-config", false, "preview and restore the most recent settings backup")
+	mergeStats := flag.String("merge-stats", "", "preview and merge the games in a stats `file`, such as one from another computer")
+	safeMode := flag.Bool("safe-mode", false, "start with default settings, no animations, ASCII-only output and read-only statistics")
+	configProfile := flag.String("config-profile", "", "use the named config `profile` for this session, creating it if needed, instead of the active one")
+	noPersist := flag.Bool("no-persist", false, "never save statistics, settings or exports, for public kiosks; games count for the session only")
+	seedDemoData := flag.Bool("seed-demo-data", false, "play with a temporary profile of made-up statistics, for screenshots and demos")
+	classroom := flag.String("classroom", "", "report each game to the instructor's monty-hall serve -classroom at `url`")
+	seed := flag.Int64("seed", 0, "play games from a fixed `seed`, for reproducible demos: each game uses the next seed and records it")
+	student := flag.String("student", "", "`name` to report games under in classroom mode (default: your user name)")
+	configFile := flag.String("config", "", "read and save settings in the config `file` instead of the active config profile")
+	noAnimations := flag.Bool("no-animations", false, "turn animations off for this session without changing the settings")
+	var actions oneShot
+	flag.BoolVar(&actions.stats, "stats", false, "print a summary of your statistics and exit")
+	flag.StringVar(&actions.export, "export", "", "export your statistics as `format` (json, csv, text or markdown) to the export directory and exit")
+	flag.BoolVar(&actions.resetStats, "reset-stats", false, "delete all statistics, backing up the stats file first, and exit; needs --yes")
+	flag.BoolVar(&actions.yes, "yes", false, "confirm --reset-stats")
+	flag.Parse()
+
+	if *noPersist && (*importConfig != "" || *restoreConfig || *mergeStats != "" || *seedDemoData || actions.requested()) {
+		fmt.Println("Error: --no-persist can't be combined with flags that change saved settings or statistics")
+		os.Exit(1)
+	}
+	if *safeMode && actions.requested() {
+		fmt.Println("Error: --safe-mode keeps statistics read-only, so it can't be combined with --stats, --export or --reset-stats")
+		os.Exit(1)
+	}
+	if *configFile != "" && *configProfile != "" {
+		fmt.Println("Error: --config and --config-profile both choose the settings; use one")
+		os.Exit(1)
+	}
+
+	// Hidden developer mode that injects storage and config faults
+	if _, err := chaos.EnableFromEnv(); err != nil {
+		fmt.Printf("Error enabling chaos mode: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Initialize configuration manager
-	configManager, err := config.NewManager()
+	var configManager *config.Manager
+	var err error
+	if *safeMode {
+		// Ignore the saved config, which may be what is causing problems
+		configPath := *configFile
+		if configPath == "" {
+			configPath, err = config.GetConfigPath()
+		}
+		if err == nil {
+			configManager = config.NewManagerWithDefaults(configPath)
+		}
+	} else if *configFile != "" {
+		configManager, err = config.NewManagerWithPath(*configFile)
+	} else {
+		configManager, err = config.NewManagerForProfile(*configProfile)
+	}
 	if err != nil {
 		fmt.Printf("Error initializing configuration: %v\n", err)
+		printChaosSummary()
 		os.Exit(1)
 	}
 
+	// One-shot actions work on the statistics without starting the UI
+	if actions.requested() {
+		code := runOneShot(configManager, actions)
+		printChaosSummary()
+		os.Exit(code)
+	}
+
 	// Create model with configuration
-	model := ui.NewModelWithConfig(configManager)
+	var model *ui.Model
+	if *safeMode {
+		model = ui.NewSafeModeModel(configManager)
+	} else if *noPersist {
+		model = ui.NewNoPersistModel(configManager)
+	} else {
+		model = ui.NewModelWithConfig(configManager)
+	}
+	if *noAnimations {
+		model.NoAnimations = true
+		model.ShowAnimations = false
+	}
+
+	// Only a seed given on the command line fixes the games
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			model.SeedGames(*seed)
+		}
+	})
+
+	// Games are reported to the class as well as recorded here
+	if *classroom != "" {
+		name := *student
+		if name == "" {
+			if current, err := user.Current(); err == nil {
+				name = current.Username
+			}
+		}
+		if err := model.JoinClassroom(*classroom, name); err != nil {
+			fmt.Printf("Error joining classroom: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Demo data goes into a temporary profile; games played on other devices
+	// are merged into real statistics before anything is shown
+	if *seedDemoData {
+		if err := model.UseDemoProfile(); err != nil {
+			fmt.Printf("Error seeding demo data: %v\n", err)
+			os.Exit(1)
+		}
+	} else {
+		model.SyncStats()
+		model.StartSession()
+	}
+
+	// Imported settings and merged statistics are previewed for confirmation
+	// before they apply
+	if *importConfig != "" {
+		model.PromptConfigImport(*importConfig)
+	} else if *mergeStats != "" {
+		model.PromptStatsMerge(*mergeStats)
+	} else if *restoreConfig {
+		model.PromptConfigRestore()
+	} else if !model.OfferProfiles() {
+		model.OfferTutorial()
+	}
 
 	// Configure tea program based on config
 	cfg := configManager.Get()
@@ -27,15 +175,47 @@ func main() {
 	// Always use alt screen for better experience
 	options = append(options, tea.WithAltScreen())
 
-	// Add mouse support if not in reduced motion mode
-	if !cfg.UI.ReducedMotion {
-		options = append(options, tea.WithMouseCellMotion())
+	// Add mouse support if not in reduced motion or safe mode; all motion is
+	// reported so the door under the pointer can be highlighted
+	if !cfg.UI.ReducedMotion && !*safeMode {
+		options = append(options, tea.WithMouseAllMotion())
 	}
 
 	p := tea.NewProgram(model, options...)
 
-	if _, err := p.Run(); err != nil {
+	_, err = p.Run()
+	if model.DemoDir != "" {
+		os.RemoveAll(model.DemoDir)
+	}
+	if err != nil {
 		fmt.Printf("Error running program: %v\n", err)
 		os.Exit(1)
 	}
+
+	// Show how the session moved the lifetime numbers
+	fmt.Print(model.SessionReport())
+
+	// Games played since the last automatic export are exported on the way out
+	if path, err := model.FinishAutoExport(); err != nil {
+		fmt.Printf("Error exporting statistics: %v\n", err)
+	} else if path != "" {
+		fmt.Printf("Statistics exported to: %s\n", path)
+	}
+
+	// Save any settings changes still waiting for the autosave delay
+	if !model.NoPersist {
+		err = configManager.Flush()
+	}
+	printChaosSummary()
+	if err != nil {
+		fmt.Printf("Error saving configuration: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// printChaosSummary reports the faults chaos mode injected, if it was on
+func printChaosSummary() {
+	if report, ok := chaos.Summary(); ok {
+		fmt.Print(report)
+	}
 }
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// oneShot is what the flags that act on the statistics and exit, without
+// starting the interactive UI, asked for
+type oneShot struct {
+	stats      bool   // Print a summary
+	export     string // Format to export in, or "" for none
+	resetStats bool   // Delete all statistics, after a backup
+	yes        bool   // The reset was confirmed on the command line
+}
+
+// requested reports whether any one-shot action was asked for
+func (o oneShot) requested() bool {
+	return o.stats || o.export != "" || o.resetStats
+}
+
+// runOneShot prints, exports and resets the statistics, in that order, so
+// --stats --export csv --reset-stats --yes archives a season before clearing it
+func runOneShot(configManager *config.Manager, o oneShot) int {
+	if configManager.Get().Stats.NoPersist && (o.export != "" || o.resetStats) {
+		fmt.Fprintln(os.Stderr, "Error: stats.no_persist is set in the config, so --export and --reset-stats are turned off")
+		return 1
+	}
+	if o.resetStats && !o.yes {
+		fmt.Fprintln(os.Stderr, "Error: --reset-stats deletes all statistics; add --yes to confirm")
+		return 1
+	}
+
+	var format stats.ExportFormat
+	if o.export != "" {
+		var err error
+		if format, err = stats.ParseExportFormat(o.export); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v: use json, csv, text or markdown\n", err)
+			return 1
+		}
+	}
+
+	store, err := configManager.OpenStatsStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening statistics: %v\n", err)
+		return 1
+	}
+	statsManager := stats.NewStatsManagerWithStore(store)
+
+	if o.stats {
+		printStatsSummary(statsManager)
+	}
+
+	if o.export != "" {
+		cfg := configManager.Get().Stats
+		options := stats.DefaultExportOptions()
+		options.Format = format
+		options.Locale = cfg.ExportLocale()
+		options.Filename = stats.AvailableFilename(filepath.Join(cfg.ExportDirectory, options.ResolvedFilename()))
+		if err := statsManager.ExportStats(options); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting statistics: %v\n", err)
+			return 1
+		}
+		fmt.Printf("Statistics exported to: %s\n", options.Filename)
+	}
+
+	if o.resetStats {
+		backup, err := statsManager.ResetWithBackup(stats.ResetAll)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error resetting statistics: %v\n", err)
+			return 1
+		}
+		fmt.Println("Statistics reset")
+		if backup != "" {
+			fmt.Printf("Previous statistics backed up to: %s\n", backup)
+		}
+	}
+	return 0
+}
+
+// printStatsSummary prints the totals, each strategy's record and the streaks
+func printStatsSummary(statsManager *stats.StatsManager) {
+	gameStats := statsManager.GetStats()
+	fmt.Println(aggregateLine(gameStats))
+	if gameStats.TotalGames > 0 {
+		summary := statsManager.GetSummary()
+		fmt.Printf("Won %.1f%% overall · best win streak %d · current streak %s\n",
+			summary.OverallWinRate*100, summary.BestStreak, currentStreak(gameStats.StreakStats))
+	}
+	fmt.Printf("Stats file: %s\n", statsManager.GetStatsFilePath())
+}
+
+// currentStreak describes the current run of wins or losses, e.g. "3 wins"
+func currentStreak(streaks stats.StreakStats) string {
+	switch {
+	case streaks.CurrentWinStreak == 1:
+		return "1 win"
+	case streaks.CurrentWinStreak > 1:
+		return fmt.Sprintf("%d wins", streaks.CurrentWinStreak)
+	case streaks.CurrentLossStreak == 1:
+		return "1 loss"
+	case streaks.CurrentLossStreak > 1:
+		return fmt.Sprintf("%d losses", streaks.CurrentLossStreak)
+	default:
+		return "none"
+	}
+}
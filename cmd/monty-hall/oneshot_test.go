@@ -0,0 +1,208 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// runMainEnv makes the test binary run main instead of the tests, so the
+// flags can be tested as they are parsed
+const runMainEnv = "MONTY_HALL_TEST_RUN_MAIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(runMainEnv) == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// oneShotHome holds the settings and statistics of one test run
+type oneShotHome struct {
+	dir        string
+	configPath string
+	statsPath  string
+}
+
+// newOneShotHome creates a config file and statistics with played games in a
+// temporary home, and the folder exports go to
+func newOneShotHome(t *testing.T, played int) *oneShotHome {
+	t.Helper()
+
+	dir := t.TempDir()
+	home := &oneShotHome{
+		dir:        dir,
+		configPath: filepath.Join(dir, "settings.json"),
+		statsPath:  filepath.Join(dir, "data", stats.DefaultStatsFileName),
+	}
+
+	configManager, err := config.NewManagerWithPath(home.configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := configManager.Get()
+	cfg.Stats.ExportDirectory = filepath.Join(dir, "exports")
+	if err := configManager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	statsManager := stats.NewStatsManager(home.statsPath)
+	for i := range played {
+		result := &game.GameResult{Strategy: game.Switch, Won: i%3 != 0, NumDoors: 3, InitialChoice: 1, FinalChoice: 2, CarPosition: 2, HostOpenedDoor: 3, Timestamp: time.Now()}
+		if err := statsManager.RecordGame(result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return home
+}
+
+// run runs monty-hall with args, returning its output and exit code
+func (h *oneShotHome) run(t *testing.T, args ...string) (string, int) {
+	t.Helper()
+
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Env = append(os.Environ(),
+		runMainEnv+"=1",
+		"HOME="+h.dir,
+		"XDG_CONFIG_HOME="+h.configHome(),
+		"MONTY_HALL_DATA="+filepath.Dir(h.statsPath),
+	)
+	output, err := cmd.CombinedOutput()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return string(output), exitErr.ExitCode()
+	}
+	if err != nil {
+		t.Fatalf("Failed to run monty-hall: %v", err)
+	}
+	return string(output), 0
+}
+
+// setNoPersist sets stats.no_persist in the config file
+func (h *oneShotHome) setNoPersist(t *testing.T) {
+	t.Helper()
+
+	configManager, err := config.NewManagerWithPath(h.configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := configManager.Get()
+	cfg.Stats.NoPersist = true
+	if err := configManager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// configHome returns the folder the config profiles are kept under
+func (h *oneShotHome) configHome() string {
+	return filepath.Join(h.dir, "config")
+}
+
+// games returns how many games the statistics hold now
+func (h *oneShotHome) games() int {
+	return stats.NewStatsManager(h.statsPath).GetStats().TotalGames
+}
+
+func TestStatsFlag(t *testing.T) {
+	home := newOneShotHome(t, 3)
+	output, code := home.run(t, "--config", home.configPath, "--stats")
+	if code != 0 {
+		t.Fatalf("Expected --stats to succeed, got %d:\n%s", code, output)
+	}
+	for _, want := range []string{"Stats: 3 games", "switch 2/3 won", "Stats file: " + home.statsPath} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected the summary to include %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestExportFlag(t *testing.T) {
+	home := newOneShotHome(t, 2)
+	output, code := home.run(t, "--config", home.configPath, "--export", "csv")
+	if code != 0 {
+		t.Fatalf("Expected --export to succeed, got %d:\n%s", code, output)
+	}
+	exported, _ := filepath.Glob(filepath.Join(home.dir, "exports", "*.csv"))
+	if len(exported) != 1 || !strings.Contains(output, exported[0]) {
+		t.Errorf("Expected one CSV in the configured export directory, got %v:\n%s", exported, output)
+	}
+
+	output, code = home.run(t, "--config", home.configPath, "--export", "xml")
+	if code != 1 || !strings.Contains(output, "use json, csv, text or markdown") {
+		t.Errorf("Expected an unknown format refused, got %d:\n%s", code, output)
+	}
+}
+
+func TestResetStatsFlag(t *testing.T) {
+	home := newOneShotHome(t, 2)
+	output, code := home.run(t, "--config", home.configPath, "--reset-stats")
+	if code != 1 || !strings.Contains(output, "--yes") {
+		t.Errorf("Expected --reset-stats to need --yes, got %d:\n%s", code, output)
+	}
+	if games := home.games(); games != 2 {
+		t.Fatalf("Expected the statistics kept without --yes, got %d games", games)
+	}
+
+	output, code = home.run(t, "--config", home.configPath, "--reset-stats", "--yes")
+	if code != 0 || !strings.Contains(output, "Statistics reset") {
+		t.Fatalf("Expected the statistics reset, got %d:\n%s", code, output)
+	}
+	if games := home.games(); games != 0 {
+		t.Errorf("Expected no games after the reset, got %d", games)
+	}
+	if !strings.Contains(output, "backed up to") {
+		t.Errorf("Expected the reset to name the backup, got:\n%s", output)
+	}
+}
+
+func TestOneShotFlagConflicts(t *testing.T) {
+	home := newOneShotHome(t, 1)
+	for _, args := range [][]string{
+		{"--no-persist", "--stats"},
+		{"--safe-mode", "--export", "csv"},
+		{"--config", home.configPath, "--config-profile", "work"},
+	} {
+		output, code := home.run(t, args...)
+		if code != 1 || !strings.HasPrefix(output, "Error:") {
+			t.Errorf("Expected %v refused, got %d:\n%s", args, code, output)
+		}
+	}
+	if games := home.games(); games != 1 {
+		t.Errorf("Expected refused flags to leave the statistics alone, got %d games", games)
+	}
+}
+
+func TestOneShotNoPersistConfig(t *testing.T) {
+	home := newOneShotHome(t, 1)
+	home.setNoPersist(t)
+
+	for _, args := range [][]string{
+		{"--export", "csv"},
+		{"--reset-stats", "--yes"},
+	} {
+		output, code := home.run(t, append([]string{"--config", home.configPath}, args...)...)
+		if code != 1 || !strings.Contains(output, "no_persist") {
+			t.Errorf("Expected %v refused by no_persist, got %d:\n%s", args, code, output)
+		}
+	}
+	if exported, _ := filepath.Glob(filepath.Join(home.dir, "exports", "*")); len(exported) != 0 {
+		t.Errorf("Expected nothing exported, got %v", exported)
+	}
+	if games := home.games(); games != 1 {
+		t.Errorf("Expected no_persist to leave the statistics alone, got %d games", games)
+	}
+
+	// A summary only reads the statistics
+	if output, code := home.run(t, "--config", home.configPath, "--stats"); code != 0 {
+		t.Errorf("Expected --stats allowed, got %d:\n%s", code, output)
+	}
+}
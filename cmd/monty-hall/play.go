@@ -0,0 +1,237 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	mathrand "math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// Exit codes of the play subcommand, so scripts can branch on the outcome
+const (
+	playExitWon   = 0
+	playExitLost  = 1
+	playExitError = 2
+)
+
+// runPlay plays a single game non-interactively: pick a door, then stay or
+// switch. The game is recorded in the statistics like one played in the app
+func runPlay(args []string) int {
+	fs := flag.NewFlagSet("play", flag.ContinueOnError)
+	door := fs.Int("door", 0, "`door` to pick first, from 1 (default: a random door)")
+	then := fs.String("then", "", "what to do after the host opens doors: switch, stay or random")
+	doors := fs.Int("doors", 0, "number of doors (default: from the config file)")
+	opens := fs.Int("opens", 0, "number of doors the host opens (default: from the config file, or all but one)")
+	seed := fs.Int64("seed", 0, "random seed for a reproducible game (default: based on the current time)")
+	noRecord := fs.Bool("no-record", false, "don't record the game in the statistics")
+	quiet := fs.Bool("q", false, "print nothing; only the exit status reports the outcome")
+	configFile := fs.String("config", "", "read settings and statistics from the config `file` instead of the active config profile")
+	configProfile := fs.String("config-profile", "", "use the named config `profile` instead of the active one")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall play -then switch|stay|random [-door n] [-doors n] [-opens n] [-seed n] [-no-record] [-q] [-config file | -config-profile name]")
+		fmt.Fprintln(fs.Output(), "\nPlays one game, prints the outcome and your updated statistics, and exits")
+		fmt.Fprintln(fs.Output(), "with status 0 if you won the car, 1 if you got a goat and 2 on errors.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return playExitError
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return playExitError
+	}
+
+	switch *then {
+	case "switch", "stay", "random":
+	case "":
+		fmt.Fprintln(os.Stderr, "Error: -then is required: switch, stay or random")
+		return playExitError
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown choice %q for -then: use switch, stay or random\n", *then)
+		return playExitError
+	}
+	if *configFile != "" && *configProfile != "" {
+		fmt.Fprintln(os.Stderr, "Error: -config and -config-profile both choose the settings; use one")
+		return playExitError
+	}
+
+	var configManager *config.Manager
+	var err error
+	if *configFile != "" {
+		configManager, err = config.NewManagerWithPath(*configFile)
+	} else {
+		configManager, err = config.NewManagerForProfile(*configProfile)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing configuration: %v\n", err)
+		return playExitError
+	}
+	cfg := configManager.Get()
+	if cfg.Stats.NoPersist && !*noRecord {
+		fmt.Fprintln(os.Stderr, "Error: stats.no_persist is set in the config, so games can't be recorded; add -no-record to play without recording")
+		return playExitError
+	}
+
+	numDoors := *doors
+	if numDoors == 0 {
+		numDoors = cfg.Game.NumDoors
+	}
+	behavior, err := game.ParseHostBehavior(cfg.Game.HostBehavior)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return playExitError
+	}
+
+	seedSet := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seedSet = true
+		}
+	})
+	if !seedSet {
+		*seed = time.Now().UnixNano()
+	}
+
+	g, err := game.NewSeededGameWithDoors(*seed, numDoors)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return playExitError
+	}
+	g.Host.Behavior = behavior
+
+	// The configured number to open may not fit a -doors override
+	hostOpens := *opens
+	if hostOpens == 0 && game.ValidateHostOpens(numDoors, cfg.Game.HostOpens) == nil {
+		hostOpens = cfg.Game.HostOpens
+	}
+	if err := game.ValidateHostOpens(numDoors, hostOpens); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return playExitError
+	}
+	g.Host.Opens = hostOpens
+
+	// Random picks use their own generator, so -seed also fixes them
+	rng := mathrand.New(mathrand.NewSource(*seed))
+	first := *door
+	if first == 0 {
+		first = rng.Intn(numDoors) + 1
+	}
+	if first < 1 || first > numDoors {
+		fmt.Fprintf(os.Stderr, "Error: -door must be between 1 and %d, got %d\n", numDoors, first)
+		return playExitError
+	}
+	if err := g.MakeInitialChoice(first - 1); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return playExitError
+	}
+
+	choice := *then
+	if choice == "random" {
+		choice = []string{"switch", "stay"}[rng.Intn(2)]
+	}
+	if choice == "switch" {
+		err = g.SwitchChoice()
+	} else {
+		err = g.StayWithChoice()
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return playExitError
+	}
+
+	var statsManager *stats.StatsManager
+	if !*noRecord {
+		store, err := configManager.OpenStatsStore()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening statistics: %v\n", err)
+			return playExitError
+		}
+		statsManager = stats.NewStatsManagerWithStore(store)
+		statsManager.SetRecordDraws(cfg.Stats.RecordDraws)
+		if err := statsManager.RecordGame(g.Result); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving statistics: %v\n", err)
+			return playExitError
+		}
+	}
+
+	if !*quiet {
+		fmt.Println(describeGame(g))
+		if statsManager != nil {
+			fmt.Println(aggregateLine(statsManager.GetStats()))
+		}
+	}
+
+	if g.Result.Won {
+		return playExitWon
+	}
+	return playExitLost
+}
+
+// describeGame narrates a finished game in a few lines
+func describeGame(g *game.Game) string {
+	result := g.Result
+
+	opened := make([]string, len(g.HostOpenedDoors))
+	for i, door := range g.HostOpenedDoors {
+		opened[i] = fmt.Sprintf("%d", door+1)
+	}
+	reveal := "revealing a goat"
+	if len(opened) > 1 {
+		reveal = "revealing goats"
+	}
+	if result.CarRevealed {
+		reveal = "revealing the car"
+	}
+	noun := "door"
+	if len(opened) > 1 {
+		noun = "doors"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "You picked door %d of %d. The host opened %s %s, %s.\n",
+		result.InitialChoice, result.NumDoors, noun, joinAnd(opened), reveal)
+	if result.Strategy == game.Switch {
+		fmt.Fprintf(&b, "You switched to door %d", result.FinalChoice)
+	} else {
+		fmt.Fprintf(&b, "You stayed with door %d", result.FinalChoice)
+	}
+	if result.Won {
+		b.WriteString(" and won the car! 🚗")
+	} else {
+		fmt.Fprintf(&b, " and got a goat. 🐐 The car was behind door %d.", result.CarPosition)
+	}
+	return b.String()
+}
+
+// joinAnd joins items as "1", "1 and 2" or "1, 2 and 3"
+func joinAnd(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+// aggregateLine summarizes the statistics after the game
+func aggregateLine(gameStats *stats.GameStats) string {
+	games := "games"
+	if gameStats.TotalGames == 1 {
+		games = "game"
+	}
+	return fmt.Sprintf("Stats: %d %s · switch %s · stay %s",
+		gameStats.TotalGames, games, strategyRecord(gameStats.SwitchStats), strategyRecord(gameStats.StayStats))
+}
+
+// strategyRecord formats a strategy's wins, e.g. "7/9 won (77.8%)"
+func strategyRecord(strategy stats.StrategyStats) string {
+	if strategy.GamesPlayed == 0 {
+		return "not played"
+	}
+	return fmt.Sprintf("%d/%d won (%.1f%%)", strategy.Wins, strategy.GamesPlayed, strategy.WinRate*100)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+)
+
+// setDoors saves the number of doors in the config file at path
+func setDoors(t *testing.T, path string, doors int) {
+	t.Helper()
+
+	configManager, err := config.NewManagerWithPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := configManager.Get()
+	cfg.Game.NumDoors = doors
+	if err := configManager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPlayConfigFlags(t *testing.T) {
+	home := newOneShotHome(t, 0)
+	setDoors(t, home.configPath, 5)
+
+	output, code := home.run(t, "play", "-then", "switch", "-config", home.configPath)
+	if code == playExitError || !strings.Contains(output, " of 5.") {
+		t.Errorf("Expected -config to choose 5 doors, got %d:\n%s", code, output)
+	}
+
+	t.Setenv("HOME", home.dir)
+	t.Setenv("XDG_CONFIG_HOME", home.configHome())
+	configDir, err := config.GetConfigDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	setDoors(t, config.ProfilePath(configDir, "classroom"), 4)
+
+	output, code = home.run(t, "play", "-then", "stay", "-config-profile", "classroom")
+	if code == playExitError || !strings.Contains(output, " of 4.") {
+		t.Errorf("Expected -config-profile to choose 4 doors, got %d:\n%s", code, output)
+	}
+
+	output, code = home.run(t, "play", "-then", "stay", "-config", home.configPath, "-config-profile", "classroom")
+	if code != playExitError || !strings.HasPrefix(output, "Error:") {
+		t.Errorf("Expected -config with -config-profile refused, got %d:\n%s", code, output)
+	}
+	if games := home.games(); games != 2 {
+		t.Errorf("Expected the 2 games played recorded, got %d", games)
+	}
+}
+
+func TestPlayNoPersistConfig(t *testing.T) {
+	home := newOneShotHome(t, 0)
+	home.setNoPersist(t)
+
+	output, code := home.run(t, "play", "-then", "switch", "-config", home.configPath)
+	if code != playExitError || !strings.Contains(output, "no_persist") {
+		t.Errorf("Expected recording refused by no_persist, got %d:\n%s", code, output)
+	}
+
+	output, code = home.run(t, "play", "-then", "switch", "-no-record", "-config", home.configPath)
+	if code == playExitError {
+		t.Errorf("Expected -no-record to play anyway, got %d:\n%s", code, output)
+	}
+	if games := home.games(); games != 0 {
+		t.Errorf("Expected no games recorded, got %d", games)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// runSchema prints the JSON Schema for the stats file or the JSON export format
+func runSchema(args []string) int {
+	fs := flag.NewFlagSet("schema", flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: monty-hall schema %s\n", strings.Join(stats.SchemaNames(), "|"))
+		fmt.Fprintln(fs.Output(), "\nPrints the JSON Schema for the statistics file or the JSON export format.")
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	schema, err := stats.Schema(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	os.Stdout.Write(schema)
+	return 0
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/server"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/ui"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+// runServe runs the HTTP API until interrupted, or in classroom mode until
+// the instructor closes the dashboard
+func runServe(args []string) int {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := fs.String("addr", server.DefaultAddr, "`address` to listen on")
+	classroom := fs.Bool("classroom", false, "show a live dashboard of the games students report from monty-hall --classroom")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall serve [-addr host:port] [-classroom]")
+		fmt.Fprintln(fs.Output(), "\nServes the HTTP API. Endpoints:")
+		fmt.Fprintln(fs.Output(), "  GET /api/stats           statistics (supports ETag/If-None-Match and gzip)")
+		fmt.Fprintln(fs.Output(), "  GET /api/schema          list the published JSON schemas")
+		fmt.Fprintln(fs.Output(), "  GET /api/schema/{name}   JSON Schema for the stats file or export format")
+		fmt.Fprintln(fs.Output(), "  POST /api/games          start a game ({\"doors\": n}, optional)")
+		fmt.Fprintln(fs.Output(), "  GET /api/games/{id}      state of an unfinished game")
+		fmt.Fprintln(fs.Output(), "  POST /api/games/{id}/choose  pick a door ({\"door\": n}): first pick, then final choice")
+		fmt.Fprintln(fs.Output(), "  GET /api/class           class-wide results reported by students")
+		fmt.Fprintln(fs.Output(), "  POST /api/class/results  report a student's game ({\"student\": name, \"game\": record})")
+		fmt.Fprintln(fs.Output(), "\nFor a class, listen on the network (e.g. -addr 0.0.0.0:8080) and have students")
+		fmt.Fprintln(fs.Output(), "start monty-hall --classroom http://HOST:8080 --student NAME.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	// Serve the statistics the game plays into: the active profile's, in
+	// its data directory or MONTY_HALL_DATA
+	configManager, err := config.NewManager()
+	if err != nil {
+		fmt.Printf("Error initializing configuration: %v\n", err)
+		return 1
+	}
+	store, err := configManager.OpenStatsStore()
+	if err != nil {
+		fmt.Printf("Error opening statistics: %v\n", err)
+		return 1
+	}
+
+	handler := server.New(stats.NewStatsManagerWithStore(store))
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Listen first, so an address in use is reported before the dashboard opens
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Printf("Error running server: %v\n", err)
+		return 1
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- httpServer.Serve(listener)
+	}()
+
+	if *classroom {
+		// The dashboard takes over the terminal; closing it stops serving
+		dashboard := tea.NewProgram(ui.NewDashboard(handler.Classroom(), "http://"+*addr), tea.WithAltScreen(), tea.WithContext(ctx))
+		if _, err := dashboard.Run(); err != nil && !errors.Is(err, tea.ErrProgramKilled) {
+			fmt.Printf("Error running dashboard: %v\n", err)
+		}
+		stop()
+	} else {
+		fmt.Printf("Serving on http://%s (Ctrl+C to stop)\n", *addr)
+	}
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, http.ErrServerClosed) {
+			fmt.Printf("Error running server: %v\n", err)
+			return 1
+		}
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			fmt.Printf("Error stopping server: %v\n", err)
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/simulation"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+const defaultSimulationGames = 10000
+
+// runSimulate plays games headlessly and prints aggregate win rates with
+// confidence intervals, so the 1/3 vs 2/3 result can be reproduced quickly
+func runSimulate(args []string) int {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	strategy := fs.String("strategy", "all", "strategy to simulate: switch, stay, random or all")
+	games := fs.Int("n", defaultSimulationGames, "number of `games` to play per strategy")
+	doors := fs.Int("doors", game.NumDoors, "number of doors per game")
+	seed := fs.Int64("seed", 0, "random seed for reproducible runs (default: based on the current time)")
+	workers := fs.Int("workers", 0, "number of worker goroutines (default: one per CPU)")
+	confidence := fs.Float64("confidence", stats.DefaultConfidence, "confidence `level` for win rate intervals")
+	format := fs.String("format", "text", "output format: text, json or csv")
+	output := fs.String("o", "", "write the results to `file` instead of stdout")
+	gamesCSV := fs.String("games-csv", "", "also write every game to CSV `file`, streamed as it is played")
+	shardRows := fs.Int("shard-rows", 0, "split -games-csv into numbered files of `n` rows each (0: one file)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall simulate [-strategy name] [-n games] [-doors n] [-seed n] [-workers n] [-format text|json|csv] [-o file]")
+		fmt.Fprintln(fs.Output(), "                         [-games-csv file [-shard-rows n]]")
+		fmt.Fprintln(fs.Output(), "\nPlays games without the interface and reports win rates with confidence intervals.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	policies, err := stats.ParseReplayPolicies(*strategy)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+	outputFormat, err := stats.ParseExportFormat(*format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	seedSet := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seedSet = true
+		}
+	})
+	if !seedSet {
+		*seed = time.Now().UnixNano()
+	}
+
+	options := simulation.Options{
+		Policies:   policies,
+		Games:      *games,
+		NumDoors:   *doors,
+		Seed:       *seed,
+		Workers:    *workers,
+		Confidence: *confidence,
+	}
+
+	var gameWriter *stats.GameCSVWriter
+	if *gamesCSV != "" {
+		total := int64(*games) * int64(len(policies))
+		gameWriter, err = stats.NewGameCSVWriter(*gamesCSV, stats.CSVStreamOptions{
+			ShardRows: *shardRows,
+			Progress: func(rows int64) {
+				fmt.Fprintf(os.Stderr, "\rWriting games: %d/%d (%.0f%%)", rows, total, float64(rows)/float64(total)*100)
+			},
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		options.OnGame = func(policy stats.ReplayPolicy, n int, result *game.GameResult) error {
+			return gameWriter.WriteGame(policy.String(), n, result)
+		}
+	}
+
+	report, err := simulation.Simulate(context.Background(), options)
+	if gameWriter != nil {
+		if closeErr := gameWriter.Close(); err == nil && closeErr != nil {
+			fmt.Fprintf(os.Stderr, "\nError writing games: %v\n", closeErr)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "\nWrote %d games to %s\n", gameWriter.Rows(), strings.Join(gameWriter.Files(), ", "))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := report.Write(w, outputFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing results: %v\n", err)
+		return 1
+	}
+	return 0
+}
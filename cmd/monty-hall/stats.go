@@ -0,0 +1,86 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// runStats dispatches the stats subcommands
+func runStats(args []string) int {
+	if len(args) == 0 || args[0] != "merge" {
+		fmt.Fprintln(os.Stderr, "Usage: monty-hall stats merge [-base file] [-dry-run] <file>")
+		return 2
+	}
+	return runStatsMerge(args[1:])
+}
+
+// runStatsMerge merges a stats file from another computer into the statistics
+func runStatsMerge(args []string) int {
+	fs := flag.NewFlagSet("stats merge", flag.ContinueOnError)
+	base := fs.String("base", "", "stats `file` both sides started from, such as the last merged copy; games deleted since stay deleted")
+	dryRun := fs.Bool("dry-run", false, "report what the merge would do without saving it")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall stats merge [-base file] [-dry-run] <file>")
+		fmt.Fprintln(fs.Output(), "\nMerges the games in a stats file, such as one copied from another computer,")
+		fmt.Fprintln(fs.Output(), "into your statistics. Games are matched by ID, and totals, streaks and daily")
+		fmt.Fprintln(fs.Output(), "statistics are recalculated from the merged history. Your stats file is")
+		fmt.Fprintln(fs.Output(), "backed up first.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+
+	configManager, err := config.NewManager()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error initializing configuration: %v\n", err)
+		return 1
+	}
+	store, err := configManager.OpenStatsStore()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error opening statistics: %v\n", err)
+		return 1
+	}
+	statsManager := stats.NewStatsManagerWithStore(store)
+
+	merge, err := statsManager.PrepareMerge(fs.Arg(0), *base)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if merge.Added == 0 && merge.Removed == 0 && merge.Dropped == 0 {
+		fmt.Printf("Your statistics already have every game in %s\n", fs.Arg(0))
+		return 0
+	}
+
+	fmt.Printf("%d new games, %d deleted games removed, %d games after merging\n", merge.Added, merge.Removed, merge.Games)
+	if merge.Dropped > 0 {
+		fmt.Printf("⚠️  %d games are only counted in totals, without their records, and are left out\n", merge.Dropped)
+	}
+	fmt.Printf("Before: %s\n", strings.TrimPrefix(aggregateLine(merge.Before), "Stats: "))
+	fmt.Printf("After:  %s\n", strings.TrimPrefix(aggregateLine(merge.After), "Stats: "))
+	if *dryRun {
+		return 0
+	}
+
+	backup, err := statsManager.ApplyMerge(merge)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error saving statistics: %v\n", err)
+		return 1
+	}
+	if backup != "" {
+		fmt.Printf("Previous statistics backed up to: %s\n", backup)
+	}
+	return 0
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/westhuis/monty-hall/pkg/client"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// defaultStatusInterval is how often status -watch checks for new games
+const defaultStatusInterval = 2 * time.Second
+
+// statusTimeout bounds each request to a server, so a stalled one doesn't
+// freeze the status line
+const statusTimeout = 5 * time.Second
+
+// statusSource reads the latest statistics for the status line
+type statusSource func(ctx context.Context) (*stats.GameStats, error)
+
+// runStatus prints a one-line summary of the statistics, for tmux or screen
+// status bars, once or every time it changes
+func runStatus(args []string) int {
+	fs := flag.NewFlagSet("status", flag.ContinueOnError)
+	watch := fs.Bool("watch", false, "keep running and print the summary again whenever it changes")
+	interval := fs.Duration("interval", defaultStatusInterval, "how often -watch checks for new games")
+	serverURL := fs.String("server", "", "read statistics from a monty-hall serve `URL`, such as http://localhost:8080, instead of the stats file")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall status [-watch] [-interval 2s] [-server URL]")
+		fmt.Fprintln(fs.Output(), "\nPrints a one-line summary of your statistics, such as")
+		fmt.Fprintln(fs.Output(), "  🚪 134 games · switch 62% · stay 33% · W3")
+		fmt.Fprintln(fs.Output(), "for status bars. In tmux, add it with:")
+		fmt.Fprintln(fs.Output(), "  set -g status-right '#(monty-hall status)'")
+		fmt.Fprintln(fs.Output(), "With -watch it keeps running, rewriting the line in place in a terminal,")
+		fmt.Fprintln(fs.Output(), "or printing a new line for each change when piped.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 0 || *interval <= 0 {
+		fs.Usage()
+		return 2
+	}
+
+	var source statusSource
+	if *serverURL != "" {
+		api := client.New(*serverURL)
+		api.HTTPClient = &http.Client{Timeout: statusTimeout}
+		source = api.Stats
+	} else {
+		configManager, err := config.NewManager()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error initializing configuration: %v\n", err)
+			return 1
+		}
+		store, err := configManager.OpenStatsStore()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error opening statistics: %v\n", err)
+			return 1
+		}
+		source = func(context.Context) (*stats.GameStats, error) { return store.Load() }
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if !*watch {
+		gameStats, err := source(ctx)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading statistics: %v\n", err)
+			return 1
+		}
+		fmt.Println(statusLine(gameStats))
+		return 0
+	}
+
+	// A terminal shows one line rewritten in place; pipes get a line per change
+	inPlace := term.IsTerminal(os.Stdout.Fd())
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	shown := ""
+	for {
+		line := "🚪 stats unavailable"
+		if gameStats, err := source(ctx); err == nil {
+			line = statusLine(gameStats)
+		}
+		if line != shown {
+			if inPlace {
+				fmt.Printf("\r\x1b[K%s", line)
+			} else {
+				fmt.Println(line)
+			}
+			shown = line
+		}
+
+		select {
+		case <-ctx.Done():
+			if inPlace {
+				fmt.Println()
+			}
+			return 0
+		case <-ticker.C:
+		}
+	}
+}
+
+// statusLine summarizes the statistics in one short line, such as
+// "🚪 134 games · switch 62% · stay 33% · W3"
+func statusLine(gameStats *stats.GameStats) string {
+	games := "games"
+	if gameStats.TotalGames == 1 {
+		games = "game"
+	}
+	parts := []string{fmt.Sprintf("🚪 %d %s", gameStats.TotalGames, games)}
+
+	for _, strategy := range []struct {
+		name  string
+		stats stats.StrategyStats
+	}{
+		{"switch", gameStats.SwitchStats},
+		{"stay", gameStats.StayStats},
+	} {
+		if strategy.stats.GamesPlayed > 0 {
+			rate := float64(strategy.stats.Wins) / float64(strategy.stats.GamesPlayed)
+			parts = append(parts, fmt.Sprintf("%s %.0f%%", strategy.name, rate*100))
+		}
+	}
+
+	switch streaks := gameStats.StreakStats; {
+	case streaks.CurrentWinStreak > 0:
+		parts = append(parts, fmt.Sprintf("W%d", streaks.CurrentWinStreak))
+	case streaks.CurrentLossStreak > 0:
+		parts = append(parts, fmt.Sprintf("L%d", streaks.CurrentLossStreak))
+	}
+
+	return strings.Join(parts, " · ")
+}
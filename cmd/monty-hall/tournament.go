@@ -0,0 +1,90 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/tournament"
+)
+
+// runTournament plays several bots on the same seeded deals and ranks them
+func runTournament(args []string) int {
+	fs := flag.NewFlagSet("tournament", flag.ContinueOnError)
+	bots := fs.String("bots", strings.Join(tournament.Names(), ","), "comma-separated `bots`: registered names or API bot URLs")
+	games := fs.Int("n", defaultSimulationGames, "number of `games` each bot plays")
+	doors := fs.Int("doors", game.NumDoors, "number of doors per game")
+	seed := fs.Int64("seed", 0, "random seed for the deals (default: based on the current time)")
+	confidence := fs.Float64("confidence", stats.DefaultConfidence, "confidence `level` for win rate intervals")
+	format := fs.String("format", "text", "output format: text, json or csv")
+	output := fs.String("o", "", "write the results to `file` instead of stdout")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall tournament [-bots a,b,...] [-n games] [-doors n] [-seed n] [-format text|json|csv] [-o file]")
+		fmt.Fprintln(fs.Output(), "\nPlays every bot on the same deals and ranks them by wins. Bots:", strings.Join(tournament.Names(), ", "))
+		fmt.Fprintln(fs.Output(), "An API bot is a URL that answers each turn, POSTed as game JSON, with {\"door\": n}.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	var strategies []tournament.Strategy
+	for _, name := range strings.Split(*bots, ",") {
+		strategy, err := tournament.Lookup(strings.TrimSpace(name))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 2
+		}
+		strategies = append(strategies, strategy)
+	}
+	outputFormat, err := stats.ParseExportFormat(*format)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	seedSet := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			seedSet = true
+		}
+	})
+	if !seedSet {
+		*seed = time.Now().UnixNano()
+	}
+
+	report, err := tournament.Run(tournament.Options{
+		Bots:       strategies,
+		Games:      *games,
+		NumDoors:   *doors,
+		Seed:       *seed,
+		Confidence: *confidence,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return 1
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if err := report.Write(w, outputFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing results: %v\n", err)
+		return 1
+	}
+	return 0
+}
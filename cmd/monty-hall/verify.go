@@ -0,0 +1,184 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// runVerify checks a daily challenge share code and reports whether it is
+// genuine, or replays the recorded draws of the games in a stats file
+func runVerify(args []string) int {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	games := fs.Bool("games", false, "replay the games in a stats file from their recorded draws")
+	fairness := fs.Bool("fairness", false, "play many games and test the car placements and the host's choices for bias")
+	n := fs.Int("n", stats.DefaultSelfTestGames, "`games` to play with -fairness")
+	doors := fs.Int("doors", game.NumDoors, "number of `doors` with -fairness")
+	seed := fs.Int64("seed", 0, "draw from a fixed `seed` with -fairness (default: the secure randomness of real games)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: monty-hall verify <share-code>")
+		fmt.Fprintln(fs.Output(), "       monty-hall verify -games <stats-file>")
+		fmt.Fprintln(fs.Output(), "       monty-hall verify -fairness [-n games] [-doors n] [-seed n]")
+		fmt.Fprintln(fs.Output(), "\nChecks the signature of a daily challenge share code and replays its games.")
+		fmt.Fprintln(fs.Output(), "With -games, replays each game in a stats file from the random numbers drawn")
+		fmt.Fprintln(fs.Output(), "in it, recorded when stats.record_draws is on, and checks that the car, the")
+		fmt.Fprintln(fs.Output(), "host's doors and the result follow from them.")
+		fmt.Fprintln(fs.Output(), "With -fairness, plays many games through the game's own code and runs")
+		fmt.Fprintln(fs.Output(), "chi-square tests on where the car was placed and which door the host left")
+		fmt.Fprintln(fs.Output(), "closed when it had a free choice, to show the game isn't rigged.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *fairness {
+		if fs.NArg() != 0 {
+			fs.Usage()
+			return 2
+		}
+		var src game.RandomSource
+		fs.Visit(func(f *flag.Flag) {
+			if f.Name == "seed" {
+				src = game.NewSeededSource(*seed)
+			}
+		})
+		return verifyFairness(src, *doors, *n)
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return 2
+	}
+	if *games {
+		return verifyGames(fs.Arg(0))
+	}
+
+	code, err := game.VerifyShareCode(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Share code rejected: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("✅ Genuine daily challenge result for %s\n", code.Date)
+	fmt.Printf("   Won %d of %d games\n", code.Wins, len(code.Rounds))
+
+	var moves []string
+	for i, round := range code.Rounds {
+		strategy := "stayed"
+		if round.Switched {
+			strategy = "switched"
+		}
+		outcome := "goat"
+		if round.Won {
+			outcome = "car"
+		}
+		moves = append(moves, fmt.Sprintf("   Round %d: door %d, %s → %s", i+1, round.InitialChoice+1, strategy, outcome))
+	}
+	fmt.Println(strings.Join(moves, "\n"))
+
+	return 0
+}
+
+// verifyGames replays the games in a stats file from their recorded draws
+func verifyGames(path string) int {
+	gameStats, err := stats.ReadStatsFile(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	verified, unrecorded, failed := 0, 0, 0
+	for i, record := range gameStats.GameHistory {
+		err := record.VerifyDraws()
+		switch {
+		case errors.Is(err, stats.ErrNoDraws):
+			unrecorded++
+		case err != nil:
+			failed++
+			fmt.Printf("❌ Game %d (%s, %s): %v\n", i+1, record.ID, record.Timestamp.Format("2006-01-02 15:04"), err)
+		default:
+			verified++
+		}
+	}
+
+	if verified+failed == 0 {
+		fmt.Fprintf(os.Stderr, "No games in %s were recorded with their draws; turn on stats.record_draws to record them\n", path)
+		return 1
+	}
+	if failed > 0 {
+		fmt.Printf("❌ %d of %d games do not follow from their draws\n", failed, verified+failed)
+	} else {
+		fmt.Printf("✅ All %d games follow from their draws\n", verified)
+	}
+	if unrecorded == 1 {
+		fmt.Println("   1 game was recorded without draws and could not be checked")
+	} else if unrecorded > 1 {
+		fmt.Printf("   %d games were recorded without draws and could not be checked\n", unrecorded)
+	}
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
+
+// verifyFairness runs the fairness self-test and reports whether the random
+// draws and the host's choices look unbiased
+func verifyFairness(src game.RandomSource, numDoors, games int) int {
+	report, err := stats.RunSelfTest(src, numDoors, games)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 2
+	}
+
+	fmt.Printf("Played %d games with %d doors\n\n", report.Games, report.NumDoors)
+
+	fmt.Println("Car placement, by door:")
+	printSpread(report.Cars, "Door")
+
+	fmt.Println("\nDoor the host left closed when the contestant picked the car,")
+	fmt.Println("counting the other doors from the left:")
+	printSpread(report.HostChoices, "Other door")
+
+	if report.RuleBreaks > 0 {
+		fmt.Printf("\n❌ The host opened the contestant's door or revealed the car in %d games\n", report.RuleBreaks)
+	} else {
+		fmt.Println("\n✅ The host never opened the contestant's door or revealed the car")
+	}
+	stay, switchDoors := game.HostClassic.ExpectedWinRates(report.NumDoors)
+	fmt.Printf("   Switching would have won %.1f%% of games (expected %.1f%%), staying %.1f%% (expected %.1f%%)\n\n",
+		report.SwitchWinRate()*100, switchDoors*100, (1-report.SwitchWinRate())*100, stay*100)
+
+	if !report.Fair() {
+		fmt.Println("❌ The game looks biased: see the checks marked ❌ above. A fair game spreads its draws")
+		fmt.Printf("   this unevenly less than %.0f%% of the time\n", stats.FairnessSignificance*100)
+		return 1
+	}
+	fmt.Println("✅ No sign of bias: the car placements and the host's choices are as even as chance allows")
+	return 0
+}
+
+// printSpread prints the counts of a spread test and its chi-square result
+func printSpread(test stats.SpreadTest, label string) {
+	if test.Total == 0 {
+		fmt.Println("   No games to test")
+		return
+	}
+	for i, count := range test.Counts {
+		fmt.Printf("   %s %d: %7d  (%.2f%%, expected %.2f%%)\n", label, i+1, count,
+			float64(count)/float64(test.Total)*100, test.Expected/float64(test.Total)*100)
+	}
+	mark := "✅"
+	if test.Suspicious() {
+		mark = "❌"
+	}
+	freedom := fmt.Sprintf("%d degrees", len(test.Counts)-1)
+	if len(test.Counts) == 2 {
+		freedom = "1 degree"
+	}
+	fmt.Printf("   %s χ² = %.2f with %s of freedom, p = %.3f\n", mark, test.ChiSquare, freedom, test.PValue)
+}
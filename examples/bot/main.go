@@ -0,0 +1,77 @@
+// Command bot plays Monty Hall games against "monty-hall serve" with a fixed
+// strategy and reports its win rate. Start the server, then run:
+//
+//	go run ./examples/bot -games 100 -strategy switch
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"github.com/westhuis/monty-hall/pkg/client"
+)
+
+func main() {
+	addr := flag.String("addr", "http://localhost:8080", "server `URL`")
+	games := flag.Int("games", 100, "number of games to play")
+	strategy := flag.String("strategy", "switch", "strategy: switch, stay or random")
+	doors := flag.Int("doors", 3, "doors per game")
+	flag.Parse()
+
+	if *strategy != "switch" && *strategy != "stay" && *strategy != "random" {
+		fmt.Fprintf(os.Stderr, "unknown strategy %q\n", *strategy)
+		os.Exit(2)
+	}
+
+	c := client.New(*addr)
+	ctx := context.Background()
+
+	wins := 0
+	for range *games {
+		won, err := play(ctx, c, *doors, *strategy)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(1)
+		}
+		if won {
+			wins++
+		}
+	}
+	fmt.Printf("%s won %d of %d games (%.1f%%)\n", *strategy, wins, *games, float64(wins)/float64(*games)*100)
+
+	stats, err := c.Stats(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("The server has recorded %d games\n", stats.TotalGames)
+}
+
+// play plays one game: pick a random door, then stay or switch
+func play(ctx context.Context, c *client.Client, doors int, strategy string) (bool, error) {
+	g, err := c.NewGame(ctx, doors)
+	if err != nil {
+		return false, err
+	}
+
+	g, err = c.Choose(ctx, g.ID, g.Available[rand.Intn(len(g.Available))])
+	if err != nil {
+		return false, err
+	}
+
+	if strategy == "random" {
+		strategy = []string{"switch", "stay"}[rand.Intn(2)]
+	}
+	if strategy == "switch" {
+		g, err = c.Switch(ctx, g)
+	} else {
+		g, err = c.Stay(ctx, g)
+	}
+	if err != nil {
+		return false, err
+	}
+	return g.Result.Won, nil
+}
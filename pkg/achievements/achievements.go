@@ -0,0 +1,270 @@
+// Package achievements tracks milestones reached while playing, derived from
+// the recorded games, and remembers when each was unlocked
+package achievements
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// DefaultFileName is the achievements file kept beside the statistics file
+const DefaultFileName = "monty_hall_achievements.json"
+
+// Achievement is a milestone and the rule that unlocks it
+type Achievement struct {
+	ID          string
+	Name        string
+	Description string
+	Icon        string
+
+	// reached returns the index of the game, oldest first, that reached the
+	// milestone, or -1
+	reached func(games []stats.GameRecord) int
+}
+
+// All returns every achievement in display order
+func All() []Achievement {
+	return []Achievement{
+		{
+			ID:          "first-win",
+			Name:        "First Win",
+			Description: "Win your first game",
+			Icon:        "🚗",
+			reached: func(games []stats.GameRecord) int {
+				return nth(games, 1, func(r stats.GameRecord) bool { return r.Won })
+			},
+		},
+		{
+			ID:          "switch-streak",
+			Name:        "Committed Switcher",
+			Description: "Switch doors in 10 games in a row",
+			Icon:        "🔁",
+			reached: func(games []stats.GameRecord) int {
+				return run(games, 10, func(r stats.GameRecord) bool { return r.Strategy == game.Switch })
+			},
+		},
+		{
+			ID:          "centurion",
+			Name:        "Centurion",
+			Description: "Play 100 games",
+			Icon:        "💯",
+			reached: func(games []stats.GameRecord) int {
+				return nth(games, 100, func(stats.GameRecord) bool { return true })
+			},
+		},
+		{
+			ID:          "beat-the-odds",
+			Name:        "Beat the Odds",
+			Description: "Win 3 games in a row by staying",
+			Icon:        "🍀",
+			reached: func(games []stats.GameRecord) int {
+				return run(games, 3, func(r stats.GameRecord) bool { return r.Won && r.Strategy == game.Stay })
+			},
+		},
+	}
+}
+
+// nth returns the index of the nth game that matches, or -1
+func nth(games []stats.GameRecord, n int, match func(stats.GameRecord) bool) int {
+	count := 0
+	for i, record := range games {
+		if match(record) {
+			count++
+			if count == n {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// run returns the index of the game that completes the first n consecutive
+// matching games, or -1
+func run(games []stats.GameRecord, n int, match func(stats.GameRecord) bool) int {
+	length := 0
+	for i, record := range games {
+		if !match(record) {
+			length = 0
+			continue
+		}
+		length++
+		if length == n {
+			return i
+		}
+	}
+	return -1
+}
+
+// Unlock records when an achievement was reached: the time of the game that reached it
+type Unlock struct {
+	ID         string    `json:"id"`
+	UnlockedAt time.Time `json:"unlocked_at"`
+}
+
+// Status is an achievement and when it was unlocked, nil while locked
+type Status struct {
+	Achievement
+	UnlockedAt *time.Time
+}
+
+// Tracker evaluates achievements as games are recorded and persists the
+// unlocked ones
+type Tracker struct {
+	filePath string
+	unlocks  []Unlock
+	pending  []Achievement // Unlocked but not yet announced
+	readOnly bool          // Keep unlocks in memory only
+}
+
+// NewTracker creates a tracker backed by the given file; an empty path keeps
+// unlocks for the session only
+func NewTracker(filePath string) *Tracker {
+	return &Tracker{
+		filePath: filePath,
+		readOnly: filePath == "",
+	}
+}
+
+// NewReadOnlyTracker creates a tracker that loads unlocks but never writes them
+func NewReadOnlyTracker(filePath string) *Tracker {
+	t := NewTracker(filePath)
+	t.readOnly = true
+	return t
+}
+
+// Load reads unlocked achievements; a missing file means none are unlocked
+func (t *Tracker) Load() error {
+	if t.filePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(t.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			t.unlocks = nil
+			return nil
+		}
+		return fmt.Errorf("failed to read achievements file: %w", err)
+	}
+
+	var unlocks []Unlock
+	if err := json.Unmarshal(data, &unlocks); err != nil {
+		return fmt.Errorf("failed to unmarshal achievements: %w", err)
+	}
+
+	t.unlocks = unlocks
+	return nil
+}
+
+// Save writes the unlocked achievements; read-only trackers keep them in memory
+func (t *Tracker) Save() error {
+	if t.readOnly {
+		return nil
+	}
+
+	dir := filepath.Dir(t.filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(t.unlocks, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal achievements: %w", err)
+	}
+
+	if err := chaos.WriteFile(t.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write achievements file: %w", err)
+	}
+
+	return nil
+}
+
+// unlockedAt returns when the achievement was unlocked, or nil
+func (t *Tracker) unlockedAt(id string) *time.Time {
+	for _, unlock := range t.unlocks {
+		if unlock.ID == id {
+			unlockedAt := unlock.UnlockedAt
+			return &unlockedAt
+		}
+	}
+	return nil
+}
+
+// IsUnlocked returns true if the achievement has been unlocked
+func (t *Tracker) IsUnlocked(id string) bool {
+	return t.unlockedAt(id) != nil
+}
+
+// Evaluate unlocks the achievements the games reach and returns the newly
+// unlocked ones, which are also queued for TakeUnlocked. Practice games
+// don't count
+func (t *Tracker) Evaluate(history []stats.GameRecord) ([]Achievement, error) {
+	games := make([]stats.GameRecord, 0, len(history))
+	for _, record := range history {
+		if !record.Practice {
+			games = append(games, record)
+		}
+	}
+
+	var unlocked []Achievement
+	for _, achievement := range All() {
+		if t.IsUnlocked(achievement.ID) {
+			continue
+		}
+		i := achievement.reached(games)
+		if i < 0 {
+			continue
+		}
+		t.unlocks = append(t.unlocks, Unlock{ID: achievement.ID, UnlockedAt: games[i].Timestamp})
+		unlocked = append(unlocked, achievement)
+	}
+
+	if len(unlocked) == 0 {
+		return nil, nil
+	}
+
+	t.pending = append(t.pending, unlocked...)
+	return unlocked, t.Save()
+}
+
+// Hook returns a record hook that evaluates achievements after every game
+func (t *Tracker) Hook() stats.RecordHook {
+	return func(record stats.GameRecord, gameStats *stats.GameStats) error {
+		_, err := t.Evaluate(gameStats.GameHistory)
+		return err
+	}
+}
+
+// TakeUnlocked returns achievements unlocked since the last call and clears the queue
+func (t *Tracker) TakeUnlocked() []Achievement {
+	unlocked := t.pending
+	t.pending = nil
+	return unlocked
+}
+
+// Statuses returns every achievement with when it was unlocked
+func (t *Tracker) Statuses() []Status {
+	all := All()
+	statuses := make([]Status, len(all))
+	for i, achievement := range all {
+		statuses[i] = Status{Achievement: achievement, UnlockedAt: t.unlockedAt(achievement.ID)}
+	}
+	return statuses
+}
+
+// UnlockedCount returns how many achievements have been unlocked
+func (t *Tracker) UnlockedCount() int {
+	return len(t.unlocks)
+}
+
+// GetFilePath returns the path of the achievements file
+func (t *Tracker) GetFilePath() string {
+	return t.filePath
+}
@@ -0,0 +1,121 @@
+package achievements
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+var start = time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+
+// games builds records from a pattern: 'W'/'L' switch wins and losses,
+// 'w'/'l' stay wins and losses, one minute apart
+func games(pattern string) []stats.GameRecord {
+	records := make([]stats.GameRecord, len(pattern))
+	for i, c := range pattern {
+		strategy := game.Switch
+		if c == 'w' || c == 'l' {
+			strategy = game.Stay
+		}
+		records[i] = stats.GameRecord{
+			Strategy:  strategy,
+			Won:       c == 'W' || c == 'w',
+			Timestamp: start.Add(time.Duration(i) * time.Minute),
+		}
+	}
+	return records
+}
+
+func TestAchievementRules(t *testing.T) {
+	tests := []struct {
+		id      string
+		pattern string
+		reached int
+	}{
+		{"first-win", "LLlW", 3},
+		{"first-win", "LLl", -1},
+		{"switch-streak", "LLLLLWWWWW", 9},
+		{"switch-streak", "LLLLwWWWWWWWWW", -1},
+		{"centurion", strings.Repeat("L", 99), -1},
+		{"centurion", strings.Repeat("l", 100), 99},
+		{"beat-the-odds", "wwLwww", 5},
+		{"beat-the-odds", "wwlww", -1},
+	}
+
+	for _, tt := range tests {
+		for _, achievement := range All() {
+			if achievement.ID == tt.id {
+				if got := achievement.reached(games(tt.pattern)); got != tt.reached {
+					t.Errorf("%s on %q: reached at %d, want %d", tt.id, tt.pattern, got, tt.reached)
+				}
+			}
+		}
+	}
+}
+
+func TestTrackerUnlocksAndPersists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	tracker := NewTracker(path)
+	if err := tracker.Load(); err != nil {
+		t.Fatalf("Failed to load a missing file: %v", err)
+	}
+
+	history := games("LLW")
+	history = append(history, stats.GameRecord{Strategy: game.Stay, Won: true, Practice: true})
+	history = append(history, stats.GameRecord{Strategy: game.Stay, Won: true, Practice: true})
+	history = append(history, stats.GameRecord{Strategy: game.Stay, Won: true, Practice: true})
+
+	unlocked, err := tracker.Evaluate(history)
+	if err != nil {
+		t.Fatalf("Evaluate failed: %v", err)
+	}
+	if len(unlocked) != 1 || unlocked[0].ID != "first-win" {
+		t.Fatalf("Expected only first-win, practice games don't count; got %v", unlocked)
+	}
+
+	// Unlocks are announced once and never repeat
+	if taken := tracker.TakeUnlocked(); len(taken) != 1 {
+		t.Errorf("Expected one pending unlock, got %d", len(taken))
+	}
+	if again, _ := tracker.Evaluate(history); len(again) != 0 || len(tracker.TakeUnlocked()) != 0 {
+		t.Error("Expected an unlocked achievement to stay unlocked quietly")
+	}
+
+	reloaded := NewTracker(path)
+	if err := reloaded.Load(); err != nil {
+		t.Fatalf("Failed to reload: %v", err)
+	}
+	for _, status := range reloaded.Statuses() {
+		switch {
+		case status.ID == "first-win" && (status.UnlockedAt == nil || !status.UnlockedAt.Equal(history[2].Timestamp)):
+			t.Errorf("Expected first-win unlocked at the winning game, got %v", status.UnlockedAt)
+		case status.ID != "first-win" && status.UnlockedAt != nil:
+			t.Errorf("Expected %s to be locked", status.ID)
+		}
+	}
+}
+
+func TestTrackerHook(t *testing.T) {
+	dir := t.TempDir()
+	sm := stats.NewStatsManager(filepath.Join(dir, "stats.json"))
+	tracker := NewReadOnlyTracker(filepath.Join(dir, DefaultFileName))
+	sm.AddRecordHook(tracker.Hook())
+
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(g.CarPosition)
+	g.StayWithChoice()
+	if err := sm.RecordGame(g.Result); err != nil {
+		t.Fatalf("Failed to record game: %v", err)
+	}
+
+	if !tracker.IsUnlocked("first-win") || tracker.UnlockedCount() != 1 {
+		t.Error("Expected the record hook to unlock first-win")
+	}
+	if matches, _ := filepath.Glob(filepath.Join(dir, DefaultFileName)); len(matches) != 0 {
+		t.Error("Expected a read-only tracker not to write its file")
+	}
+}
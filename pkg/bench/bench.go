@@ -0,0 +1,256 @@
+// Package bench is the public benchmark suite: the operations whose speed
+// players notice, timed with the testing package so the same code runs under
+// go test -bench and from monty-hall bench, and compared against a saved
+// baseline to catch regressions
+package bench
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	mathrand "math/rand"
+	"os"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/simulation"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/ui"
+)
+
+// DefaultBaselineFile is where monty-hall bench looks for a baseline
+const DefaultBaselineFile = "bench-baseline.json"
+
+// DefaultTolerance is how much slower than its baseline a benchmark may run
+// before it counts as a regression
+const DefaultTolerance = 0.20
+
+// Sizes of the workloads
+const (
+	SimulationGames = 1_000_000 // Games per simulation run
+	StatsRecords    = 100_000   // Records aggregated into statistics
+)
+
+// Benchmark is one entry in the suite
+type Benchmark struct {
+	Name        string
+	Description string
+	Run         func(b *testing.B)
+}
+
+// Result is the outcome of one benchmark
+type Result struct {
+	Name        string             `json:"name"`
+	NsPerOp     int64              `json:"ns_per_op"`
+	AllocsPerOp int64              `json:"allocs_per_op"`
+	BytesPerOp  int64              `json:"bytes_per_op"`
+	Extra       map[string]float64 `json:"extra,omitempty"` // Custom metrics, such as games/s
+}
+
+// Baseline is a saved set of results to compare later runs against
+type Baseline struct {
+	Created   time.Time `json:"created"`
+	GoVersion string    `json:"go_version"`
+	Platform  string    `json:"platform"`
+	Results   []Result  `json:"results"`
+}
+
+// Comparison is a result set against its baseline
+type Comparison struct {
+	Current   Result
+	Baseline  *Result // Nil for benchmarks missing from the baseline
+	Change    float64 // Fractional change in time per operation; positive is slower
+	Regressed bool
+}
+
+// Suite returns the benchmarks in the order they run
+func Suite() []Benchmark {
+	return []Benchmark{
+		{"game/new", "Create a game and place the car", benchmarkNewGame},
+		{"simulation/1m-games", "Simulate a million always-switch games", benchmarkSimulation},
+		{"stats/aggregate-100k", "Aggregate 100,000 game records into statistics", benchmarkStatsAggregate},
+		{"ui/render-game", "Render the game view at 120x40", benchmarkRenderGame},
+	}
+}
+
+// Select returns the benchmarks whose names match pattern; an empty pattern
+// selects them all
+func Select(pattern string) ([]Benchmark, error) {
+	if pattern == "" {
+		return Suite(), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid benchmark pattern: %w", err)
+	}
+
+	var selected []Benchmark
+	for _, benchmark := range Suite() {
+		if re.MatchString(benchmark.Name) {
+			selected = append(selected, benchmark)
+		}
+	}
+	return selected, nil
+}
+
+// Run runs each benchmark for about a second, calling progress, if set,
+// before each one
+func Run(benchmarks []Benchmark, progress func(Benchmark)) []Result {
+	results := make([]Result, 0, len(benchmarks))
+	for _, benchmark := range benchmarks {
+		if progress != nil {
+			progress(benchmark)
+		}
+		run := benchmark.Run
+		outcome := testing.Benchmark(func(b *testing.B) {
+			b.ReportAllocs()
+			run(b)
+		})
+		results = append(results, Result{
+			Name:        benchmark.Name,
+			NsPerOp:     outcome.NsPerOp(),
+			AllocsPerOp: outcome.AllocsPerOp(),
+			BytesPerOp:  outcome.AllocedBytesPerOp(),
+			Extra:       outcome.Extra,
+		})
+	}
+	return results
+}
+
+// NewBaseline records results as a baseline for this machine
+func NewBaseline(results []Result) *Baseline {
+	return &Baseline{
+		Created:   time.Now(),
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+		Results:   results,
+	}
+}
+
+// LoadBaseline reads a baseline file
+func LoadBaseline(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline: %w", err)
+	}
+	var baseline Baseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal baseline: %w", err)
+	}
+	return &baseline, nil
+}
+
+// Save writes the baseline to a file
+func (b *Baseline) Save(path string) error {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal baseline: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write baseline: %w", err)
+	}
+	return nil
+}
+
+// Compare sets results against the baseline. A benchmark regressed when it
+// takes more than tolerance longer per operation than its baseline
+func (b *Baseline) Compare(results []Result, tolerance float64) []Comparison {
+	byName := make(map[string]*Result, len(b.Results))
+	for i := range b.Results {
+		byName[b.Results[i].Name] = &b.Results[i]
+	}
+
+	comparisons := make([]Comparison, len(results))
+	for i, result := range results {
+		comparison := Comparison{Current: result, Baseline: byName[result.Name]}
+		if base := comparison.Baseline; base != nil && base.NsPerOp > 0 {
+			comparison.Change = float64(result.NsPerOp-base.NsPerOp) / float64(base.NsPerOp)
+			comparison.Regressed = comparison.Change > tolerance
+		}
+		comparisons[i] = comparison
+	}
+	return comparisons
+}
+
+func benchmarkNewGame(b *testing.B) {
+	for b.Loop() {
+		game.NewGame()
+	}
+}
+
+func benchmarkSimulation(b *testing.B) {
+	runner, err := simulation.NewRunner(simulation.Config{
+		Players:  []string{simulation.PolicyPlayer(stats.PolicyAlwaysSwitch)},
+		Games:    SimulationGames,
+		NumDoors: game.NumDoors,
+		Seed:     1,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for b.Loop() {
+		if _, err := runner.Run(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(SimulationGames)*float64(b.N)/b.Elapsed().Seconds(), "games/s")
+}
+
+func benchmarkStatsAggregate(b *testing.B) {
+	records := sampleRecords(StatsRecords)
+	for b.Loop() {
+		stats.StatsOf(records)
+	}
+}
+
+func benchmarkRenderGame(b *testing.B) {
+	model := ui.NewNoPersistModel(config.NewManagerWithDefaults(""))
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	model.Game = game.NewSeededGame(1)
+	model.Game.MakeInitialChoice(0)
+	model.CurrentView = ui.GameView
+
+	for b.Loop() {
+		model.View()
+	}
+}
+
+// sampleRecords makes a reproducible history of n games, one a minute
+func sampleRecords(n int) []stats.GameRecord {
+	rng := mathrand.New(mathrand.NewSource(1))
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	records := make([]stats.GameRecord, n)
+	for i := range records {
+		at := start.Add(time.Duration(i) * time.Minute)
+		car, initial := rng.Intn(game.NumDoors), rng.Intn(game.NumDoors)
+		host := (initial + 1 + rng.Intn(2)) % game.NumDoors
+		for host == car || host == initial {
+			host = (host + 1) % game.NumDoors
+		}
+		final, strategy := initial, game.Stay
+		if rng.Intn(2) == 0 {
+			final, strategy = game.NumDoors-initial-host, game.Switch
+		}
+		records[i] = stats.GameRecord{
+			ID:             fmt.Sprintf("bench-%d", i),
+			Timestamp:      at,
+			Strategy:       strategy,
+			Won:            final == car,
+			InitialChoice:  initial,
+			FinalChoice:    final,
+			CarPosition:    car,
+			HostOpenedDoor: host,
+			GameDuration:   time.Duration(5+rng.Intn(20)) * time.Second,
+			DayOfWeek:      at.Weekday().String(),
+			HourOfDay:      at.Hour(),
+		}
+	}
+	return records
+}
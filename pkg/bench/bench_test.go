@@ -0,0 +1,65 @@
+package bench
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func BenchmarkSuite(b *testing.B) {
+	for _, benchmark := range Suite() {
+		b.Run(benchmark.Name, benchmark.Run)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := NewBaseline([]Result{
+		{Name: "game/new", NsPerOp: 1000},
+		{Name: "ui/render-game", NsPerOp: 1000},
+	})
+	path := filepath.Join(t.TempDir(), DefaultBaselineFile)
+	if err := baseline.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadBaseline(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	comparisons := loaded.Compare([]Result{
+		{Name: "game/new", NsPerOp: 1100},
+		{Name: "ui/render-game", NsPerOp: 1500},
+		{Name: "stats/aggregate-100k", NsPerOp: 5},
+	}, DefaultTolerance)
+
+	if c := comparisons[0]; c.Regressed || c.Change < 0.099 || c.Change > 0.101 {
+		t.Errorf("Expected 10%% slower to be within budget, got %+v", c)
+	}
+	if !comparisons[1].Regressed {
+		t.Error("Expected 50% slower to be a regression")
+	}
+	if c := comparisons[2]; c.Baseline != nil || c.Regressed {
+		t.Errorf("Expected a benchmark missing from the baseline to pass, got %+v", c)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	selected, err := Select("^ui/")
+	if err != nil || len(selected) != 1 || selected[0].Name != "ui/render-game" {
+		t.Errorf("Expected only the render benchmark, got %v (%v)", selected, err)
+	}
+	if all, _ := Select(""); len(all) != len(Suite()) {
+		t.Error("Expected an empty pattern to select every benchmark")
+	}
+	if _, err := Select("("); err == nil {
+		t.Error("Expected an invalid pattern to be rejected")
+	}
+}
+
+func TestSampleRecords(t *testing.T) {
+	for _, record := range sampleRecords(1000) {
+		if record.HostOpenedDoor == record.CarPosition || record.HostOpenedDoor == record.InitialChoice ||
+			record.HostOpenedDoor == record.FinalChoice || record.Won != (record.FinalChoice == record.CarPosition) {
+			t.Fatalf("Inconsistent sample record: %+v", record)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// Package chaos injects storage failures, slow writes and malformed config
+// reads to exercise the error handling and recovery paths. It is a hidden
+// developer mode, enabled with the MONTY_HALL_CHAOS environment variable, and
+// does nothing otherwise
+package chaos
+
+import (
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// EnvVar enables chaos mode; its value is the chance of a fault per file
+	// operation, e.g. 0.3, or any other non-empty value for DefaultRate
+	EnvVar = "MONTY_HALL_CHAOS"
+	// SeedEnvVar optionally fixes the seed, so a run's faults can be repeated
+	SeedEnvVar = "MONTY_HALL_CHAOS_SEED"
+
+	DefaultRate = 0.2
+)
+
+// SlowWriteDelay is how long a slow write stalls before it completes
+var SlowWriteDelay = 500 * time.Millisecond
+
+// ErrInjected is wrapped by every failure chaos mode injects
+var ErrInjected = errors.New("injected by chaos mode")
+
+// Fault is a kind of injected failure
+type Fault int
+
+const (
+	FaultStorage   Fault = iota // A write fails with a permission or disk-full error
+	FaultSlowWrite              // A write stalls, then succeeds
+	FaultMalformed              // A config read returns truncated JSON
+)
+
+// String returns the summary label of the fault
+func (f Fault) String() string {
+	switch f {
+	case FaultStorage:
+		return "Storage failures"
+	case FaultSlowWrite:
+		return "Slow writes"
+	case FaultMalformed:
+		return "Malformed config reads"
+	default:
+		return "Unknown"
+	}
+}
+
+// Error is an injected failure. It unwraps to both ErrInjected and the
+// system error it imitates, so errors.Is works with either
+type Error struct {
+	Op   string
+	Path string
+	Err  syscall.Errno
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s: %v", e.Op, e.Path, e.Err)
+}
+
+func (e *Error) Unwrap() []error {
+	return []error{ErrInjected, e.Err}
+}
+
+// Report summarizes the faults injected during a run and how the app coped
+type Report struct {
+	Rate            float64
+	Seed            int64
+	Operations      int // File operations that could have failed
+	Injected        map[Fault]int
+	SlowTime        time.Duration
+	ErrorsShown     int // Errors shown to the user while chaos mode was on
+	WithSuggestions int // Shown errors that came with recovery suggestions
+}
+
+// String renders the report for the terminal
+func (r Report) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Chaos mode summary (fault rate %.0f%%, seed %d; set %s=%d to repeat)\n", r.Rate*100, r.Seed, SeedEnvVar, r.Seed)
+	fmt.Fprintf(&b, "  File operations:        %d\n", r.Operations)
+	for _, fault := range []Fault{FaultStorage, FaultSlowWrite, FaultMalformed} {
+		fmt.Fprintf(&b, "  %-23s %d", fault.String()+":", r.Injected[fault])
+		if fault == FaultSlowWrite && r.Injected[fault] > 0 {
+			fmt.Fprintf(&b, " (%s stalled)", r.SlowTime)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "  Errors shown:           %d, %d with recovery suggestions\n", r.ErrorsShown, r.WithSuggestions)
+	if failures := r.Injected[FaultStorage] + r.Injected[FaultMalformed]; failures > r.ErrorsShown {
+		fmt.Fprintf(&b, "  %d injected failures were not shown to the user; check they were recovered from silently\n", failures-r.ErrorsShown)
+	}
+	return b.String()
+}
+
+// injector holds the state of an active chaos mode
+type injector struct {
+	mu     sync.Mutex
+	rng    *mathrand.Rand
+	report Report
+}
+
+var (
+	activeMu sync.Mutex
+	active   *injector
+)
+
+// Enable turns chaos mode on with the given fault rate and seed
+func Enable(rate float64, seed int64) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active = &injector{
+		rng:    mathrand.New(mathrand.NewSource(seed)),
+		report: Report{Rate: rate, Seed: seed, Injected: make(map[Fault]int)},
+	}
+}
+
+// Disable turns chaos mode off
+func Disable() {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active = nil
+}
+
+// EnableFromEnv turns chaos mode on when EnvVar is set and reports whether it did
+func EnableFromEnv() (bool, error) {
+	value := os.Getenv(EnvVar)
+	if value == "" {
+		return false, nil
+	}
+
+	rate := DefaultRate
+	if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+		if parsed <= 0 || parsed > 1 {
+			return false, fmt.Errorf("%s must be a fault rate between 0 and 1, got %s", EnvVar, value)
+		}
+		rate = parsed
+	}
+
+	seed := time.Now().UnixNano()
+	if value := os.Getenv(SeedEnvVar); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("%s must be an integer, got %s", SeedEnvVar, value)
+		}
+		seed = parsed
+	}
+
+	Enable(rate, seed)
+	return true, nil
+}
+
+// Enabled reports whether chaos mode is on
+func Enabled() bool {
+	return current() != nil
+}
+
+// Summary returns the report of the active chaos mode
+func Summary() (Report, bool) {
+	in := current()
+	if in == nil {
+		return Report{}, false
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	report := in.report
+	report.Injected = make(map[Fault]int, len(in.report.Injected))
+	for fault, count := range in.report.Injected {
+		report.Injected[fault] = count
+	}
+	return report, true
+}
+
+// ObserveError records an error shown to the user, noting whether it came
+// with recovery suggestions
+func ObserveError(withSuggestions bool) {
+	in := current()
+	if in == nil {
+		return
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	in.report.ErrorsShown++
+	if withSuggestions {
+		in.report.WithSuggestions++
+	}
+}
+
+func current() *injector {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	return active
+}
+
+// WriteFile writes like os.WriteFile, but in chaos mode it may fail or stall first
+func WriteFile(name string, data []byte, perm os.FileMode) error {
+	if in := current(); in != nil {
+		if fault, ok := in.roll(FaultStorage, FaultSlowWrite); ok {
+			switch fault {
+			case FaultStorage:
+				return &Error{Op: "write", Path: name, Err: in.storageErrno()}
+			case FaultSlowWrite:
+				time.Sleep(SlowWriteDelay)
+			}
+		}
+	}
+	return os.WriteFile(name, data, perm)
+}
+
+// ReadConfig reads like os.ReadFile, but in chaos mode it may return
+// truncated data so parsing fails
+func ReadConfig(name string) ([]byte, error) {
+	data, err := os.ReadFile(name)
+	if err != nil || len(data) < 2 {
+		return data, err
+	}
+
+	if in := current(); in != nil {
+		if _, ok := in.roll(FaultMalformed); ok {
+			return data[:len(data)/2], nil
+		}
+	}
+	return data, nil
+}
+
+// roll counts an operation and decides which of the faults, if any, to inject
+func (in *injector) roll(faults ...Fault) (Fault, bool) {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	in.report.Operations++
+	if in.rng.Float64() >= in.report.Rate {
+		return 0, false
+	}
+
+	fault := faults[in.rng.Intn(len(faults))]
+	in.report.Injected[fault]++
+	if fault == FaultSlowWrite {
+		in.report.SlowTime += SlowWriteDelay
+	}
+	return fault, true
+}
+
+// storageErrno picks the system error a failed write imitates
+func (in *injector) storageErrno() syscall.Errno {
+	in.mu.Lock()
+	defer in.mu.Unlock()
+
+	if in.rng.Intn(2) == 0 {
+		return syscall.ENOSPC
+	}
+	return syscall.EACCES
+}
@@ -0,0 +1,132 @@
+package chaos
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestDisabledPassesThrough(t *testing.T) {
+	Disable()
+	path := filepath.Join(t.TempDir(), "config.json")
+
+	for range 20 {
+		if err := WriteFile(path, []byte(`{"ok": true}`), 0644); err != nil {
+			t.Fatalf("WriteFile failed with chaos mode off: %v", err)
+		}
+		data, err := ReadConfig(path)
+		if err != nil || string(data) != `{"ok": true}` {
+			t.Fatalf("ReadConfig should return the file unchanged, got %q, %v", data, err)
+		}
+	}
+
+	if _, ok := Summary(); ok {
+		t.Error("No summary expected with chaos mode off")
+	}
+}
+
+func TestInjectedFaults(t *testing.T) {
+	Enable(1, 42)
+	t.Cleanup(Disable)
+
+	delay := SlowWriteDelay
+	SlowWriteDelay = 0
+	t.Cleanup(func() { SlowWriteDelay = delay })
+	path := filepath.Join(t.TempDir(), "stats.json")
+
+	failures := 0
+	for range 20 {
+		err := WriteFile(path, []byte("{}"), 0644)
+		if err == nil {
+			continue // Slow write
+		}
+		failures++
+
+		if !errors.Is(err, ErrInjected) {
+			t.Errorf("Injected error should wrap ErrInjected: %v", err)
+		}
+		if !errors.Is(err, syscall.ENOSPC) && !errors.Is(err, fs.ErrPermission) {
+			t.Errorf("Injected error should imitate a full disk or denied permission: %v", err)
+		}
+	}
+
+	if err := os.WriteFile(path, []byte(`{"ok": true}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ReadConfig(path)
+	if err != nil || string(data) != `{"ok":` {
+		t.Errorf("Config read should be truncated, got %q, %v", data, err)
+	}
+
+	report, ok := Summary()
+	if !ok {
+		t.Fatal("Expected a summary with chaos mode on")
+	}
+	if report.Operations != 21 {
+		t.Errorf("Expected 21 operations, got %d", report.Operations)
+	}
+	if report.Injected[FaultStorage] != failures || report.Injected[FaultStorage]+report.Injected[FaultSlowWrite] != 20 {
+		t.Errorf("Unexpected fault counts: %v", report.Injected)
+	}
+	if report.Injected[FaultMalformed] != 1 {
+		t.Errorf("Expected 1 malformed read, got %d", report.Injected[FaultMalformed])
+	}
+}
+
+func TestReportString(t *testing.T) {
+	Enable(0.5, 7)
+	t.Cleanup(Disable)
+
+	ObserveError(true)
+	ObserveError(false)
+
+	report, _ := Summary()
+	report.Injected[FaultStorage] = 3
+	text := report.String()
+
+	for _, want := range []string{"fault rate 50%", SeedEnvVar + "=7", "Storage failures:       3", "Errors shown:           2, 1 with recovery suggestions", "1 injected failures were not shown"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Summary should contain %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestEnableFromEnv(t *testing.T) {
+	t.Cleanup(Disable)
+
+	tests := []struct {
+		value, seed string
+		enabled     bool
+		rate        float64
+		wantErr     bool
+	}{
+		{value: "", enabled: false},
+		{value: "1", seed: "5", enabled: true, rate: 1},
+		{value: "0.3", enabled: true, rate: 0.3},
+		{value: "on", enabled: true, rate: DefaultRate},
+		{value: "1.5", wantErr: true},
+		{value: "0.3", seed: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		Disable()
+		t.Setenv(EnvVar, tt.value)
+		t.Setenv(SeedEnvVar, tt.seed)
+
+		enabled, err := EnableFromEnv()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s=%q %s=%q: unexpected error %v", EnvVar, tt.value, SeedEnvVar, tt.seed, err)
+			continue
+		}
+		if enabled != tt.enabled || Enabled() != tt.enabled {
+			t.Errorf("%s=%q: expected enabled %v, got %v", EnvVar, tt.value, tt.enabled, enabled)
+		}
+		if report, ok := Summary(); ok && report.Rate != tt.rate {
+			t.Errorf("%s=%q: expected rate %g, got %g", EnvVar, tt.value, tt.rate, report.Rate)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Package client is a Go client for the HTTP API served by "monty-hall serve",
+// for writing bots that play games and read the statistics
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/server"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// Game is a game as returned by the server; doors are numbered from 1
+type Game = server.GameState
+
+// Result is the outcome of a finished game
+type Result = server.Result
+
+// ClassSummary is the class-wide picture of the games students reported
+type ClassSummary = server.ClassSummary
+
+// Game phases
+const (
+	PhaseInitialChoice = server.PhaseInitialChoice
+	PhaseFinalChoice   = server.PhaseFinalChoice
+	PhaseGameOver      = server.PhaseGameOver
+)
+
+// APIError is an error response from the server
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("server returned %d: %s", e.StatusCode, e.Message)
+}
+
+// Client calls the API of a server
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// New creates a client for the server at baseURL, e.g. "http://localhost:8080"
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: http.DefaultClient,
+	}
+}
+
+// NewGame starts a game with the given number of doors; 0 means 3
+func (c *Client) NewGame(ctx context.Context, doors int) (*Game, error) {
+	var g Game
+	if err := c.do(ctx, http.MethodPost, "/api/games", server.NewGameRequest{Doors: doors}, &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// Game returns the current state of an unfinished game
+func (c *Client) Game(ctx context.Context, id string) (*Game, error) {
+	var g Game
+	if err := c.do(ctx, http.MethodGet, "/api/games/"+url.PathEscape(id), nil, &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// Choose picks a door. The first pick makes the host open doors; the second
+// is the final choice, which ends the game
+func (c *Client) Choose(ctx context.Context, id string, door int) (*Game, error) {
+	var g Game
+	path := "/api/games/" + url.PathEscape(id) + "/choose"
+	if err := c.do(ctx, http.MethodPost, path, server.ChooseRequest{Door: door}, &g); err != nil {
+		return nil, err
+	}
+	return &g, nil
+}
+
+// Stay makes the final choice, keeping the door picked first
+func (c *Client) Stay(ctx context.Context, g *Game) (*Game, error) {
+	return c.Choose(ctx, g.ID, g.Selected)
+}
+
+// Switch makes the final choice, moving to a closed door other than the one
+// picked first
+func (c *Client) Switch(ctx context.Context, g *Game) (*Game, error) {
+	for _, door := range g.Available {
+		if door != g.Selected {
+			return c.Choose(ctx, g.ID, door)
+		}
+	}
+	return nil, fmt.Errorf("no door to switch to in game %s", g.ID)
+}
+
+// Stats returns the server's statistics
+func (c *Client) Stats(ctx context.Context) (*stats.GameStats, error) {
+	var s stats.GameStats
+	if err := c.do(ctx, http.MethodGet, "/api/stats", nil, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// ReportClassResult reports a game a student finished to the instructor's
+// server in classroom mode
+func (c *Client) ReportClassResult(ctx context.Context, student string, record stats.GameRecord) error {
+	var out struct {
+		Games int `json:"games"`
+	}
+	return c.do(ctx, http.MethodPost, "/api/class/results", server.ClassResult{Student: student, Game: record}, &out)
+}
+
+// Class returns the games reported by the class so far
+func (c *Client) Class(ctx context.Context) (*ClassSummary, error) {
+	var summary ClassSummary
+	if err := c.do(ctx, http.MethodGet, "/api/class", nil, &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
+
+// do sends a request with body encoded as JSON and decodes the response into out
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr struct {
+			Error string `json:"error"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err != nil || apiErr.Error == "" {
+			apiErr.Error = http.StatusText(resp.StatusCode)
+		}
+		return &APIError{StatusCode: resp.StatusCode, Message: apiErr.Error}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/server"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// newTestClient starts a server with empty statistics and returns a client for it
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(server.New(stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))))
+	t.Cleanup(srv.Close)
+	return New(srv.URL + "/")
+}
+
+func TestClientPlaysGames(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	for i, strategy := range []string{"switch", "stay"} {
+		g, err := c.NewGame(ctx, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.Doors != 4 || g.Phase != PhaseInitialChoice {
+			t.Fatalf("Expected a new 4-door game, got %+v", g)
+		}
+
+		if g, err = c.Choose(ctx, g.ID, 2); err != nil {
+			t.Fatal(err)
+		}
+		if g, err = c.Game(ctx, g.ID); err != nil || g.Phase != PhaseFinalChoice {
+			t.Fatalf("Expected the final choice, got %+v (%v)", g, err)
+		}
+
+		if strategy == "switch" {
+			g, err = c.Switch(ctx, g)
+		} else {
+			g, err = c.Stay(ctx, g)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g.Phase != PhaseGameOver || g.Result.Strategy != strategy {
+			t.Errorf("Game %d: expected a finished %s game, got %+v", i, strategy, g.Result)
+		}
+	}
+
+	s, err := c.Stats(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.TotalGames != 2 || s.SwitchStats.GamesPlayed != 1 || s.StayStats.GamesPlayed != 1 {
+		t.Errorf("Expected one switch and one stay game, got %+v", s)
+	}
+}
+
+func TestClientAPIError(t *testing.T) {
+	c := newTestClient(t)
+
+	_, err := c.Choose(context.Background(), "missing", 1)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound || apiErr.Message != server.ErrGameNotFound.Error() {
+		t.Errorf("Expected a 404 API error, got %v", err)
+	}
+}
+
+func TestClientReportsClassResults(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	for _, student := range []string{"alice", "bob", "alice"} {
+		if err := c.ReportClassResult(ctx, student, stats.GameRecord{Strategy: game.Switch, Won: true}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	summary, err := c.Class(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Games != 3 || summary.Switch.Wins != 3 || len(summary.Students) != 2 || summary.Students[0].Games != 2 {
+		t.Errorf("Expected three switch wins by two students, got %+v", summary)
+	}
+
+	var apiErr *APIError
+	if err := c.ReportClassResult(ctx, "", stats.GameRecord{}); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected a 400 API error for a report without a student, got %v", err)
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"strings"
 
+	"github.com/westhuis/monty-hall/pkg/game"
 	"github.com/westhuis/monty-hall/pkg/stats"
 )
 
@@ -21,37 +24,102 @@ type Config struct {
 
 // UIConfig contains user interface configuration options
 type UIConfig struct {
-	ColorScheme    string `json:"color_scheme"`    // "default", "high-contrast", "colorblind-safe"
-	AnimationSpeed int    `json:"animation_speed"` // 0=disabled, 1=slow, 2=normal, 3=fast
-	ShowTutorial   bool   `json:"show_tutorial"`   // Show tutorial on first run
-	AutoSave       bool   `json:"auto_save"`       // Auto-save statistics
-	TerminalWidth  int    `json:"terminal_width"`  // Preferred terminal width (0=auto)
-	TerminalHeight int    `json:"terminal_height"` // Preferred terminal height (0=auto)
-	ShowAnimations bool   `json:"show_animations"` // Enable/disable animations
-	ReducedMotion  bool   `json:"reduced_motion"`  // Accessibility: reduce motion
-	HighContrast   bool   `json:"high_contrast"`   // Accessibility: high contrast mode
-	LargeText      bool   `json:"large_text"`      // Accessibility: larger text
+	ColorScheme      string `json:"color_scheme"`      // "default", "high-contrast", "colorblind-safe", "light", "matrix", "crt"
+	AnimationSpeed   int    `json:"animation_speed"`   // 0=disabled, 1=slow, 2=normal, 3=fast
+	ShowTutorial     bool   `json:"show_tutorial"`     // Show tutorial on first run
+	AutoSave         bool   `json:"auto_save"`         // Auto-save statistics
+	TerminalWidth    int    `json:"terminal_width"`    // Preferred terminal width (0=auto)
+	TerminalHeight   int    `json:"terminal_height"`   // Preferred terminal height (0=auto)
+	ShowAnimations   bool   `json:"show_animations"`   // Enable/disable animations
+	ReducedMotion    bool   `json:"reduced_motion"`    // Accessibility: reduce motion
+	HighContrast     bool   `json:"high_contrast"`     // Accessibility: high contrast mode
+	LargeText        bool   `json:"large_text"`        // Accessibility: larger text
+	MaxFPS           int    `json:"max_fps"`           // Cap on animation frames per second (0=default)
+	AnimationPack    string `json:"animation_pack"`    // Door and celebration animation style pack
+	EffectsIntensity string `json:"effects_intensity"` // Celebration text effects: "off", "subtle", "full"
+	TerminalTitle    bool   `json:"terminal_title"`    // Show the switch win rate and game number in the terminal title
 }
 
 // GameConfig contains game-specific configuration options
 type GameConfig struct {
-	AutoAdvance     bool   `json:"auto_advance"`     // Auto-advance through game phases
-	ConfirmChoices  bool   `json:"confirm_choices"`  // Require confirmation for choices
-	ShowProbability bool   `json:"show_probability"` // Show probability information
-	DefaultStrategy string `json:"default_strategy"` // "switch", "stay", or "ask"
-	ShowHints       bool   `json:"show_hints"`       // Show strategy hints
-	PlaySounds      bool   `json:"play_sounds"`      // Play sound effects (if supported)
+	AutoAdvance      bool   `json:"auto_advance"`       // Start the next game automatically after the result
+	AutoAdvanceDelay int    `json:"auto_advance_delay"` // Seconds the result is shown before auto-advancing (0=default)
+	ConfirmChoices   bool   `json:"confirm_choices"`    // Require confirmation for choices
+	ShowProbability  bool   `json:"show_probability"`   // Show probability information
+	DefaultStrategy  string `json:"default_strategy"`   // "switch", "stay", or "ask"
+	ShowHints        bool   `json:"show_hints"`         // Show strategy hints
+	PlaySounds       bool   `json:"play_sounds"`        // Play sound effects (if supported)
+	RememberDoor     bool   `json:"remember_door"`      // Start new games on the previously chosen door
+	NumDoors         int    `json:"num_doors"`          // Doors per game; the host opens all but one of the others
+	HostBehavior     string `json:"host_behavior"`      // "classic", "fall" (or "ignorant") or "crawl"
+	HostOpens        int    `json:"host_opens"`         // Doors the host opens, 1 to num_doors-2 (0=all but one)
+	HostPersonality  string `json:"host_personality"`   // How the host talks: "standard", "deadpan", "dramatic" or "statistician"
+
+	// Narratives adds result lines to the built-in ones, keyed like "win",
+	// "switch_loss" or "win_streak"; see GetNarrativeKeys
+	Narratives map[string][]string `json:"narratives,omitempty"`
 }
 
 // StatsConfig contains statistics configuration options
 type StatsConfig struct {
-	AutoExport      bool               `json:"auto_export"`      // Auto-export stats periodically
-	ExportFormat    stats.ExportFormat `json:"export_format"`    // Default export format
-	MaxHistorySize  int                `json:"max_history_size"` // Maximum number of games to keep in history
-	ShowDailyStats  bool               `json:"show_daily_stats"` // Show daily statistics breakdown
-	ShowStreaks     bool               `json:"show_streaks"`     // Show win/loss streaks
-	ShowAdvanced    bool               `json:"show_advanced"`    // Show advanced statistics
-	ExportDirectory string             `json:"export_directory"` // Directory for exported files
+	AutoExport       bool               `json:"auto_export"`       // Auto-export stats periodically
+	AutoExportEvery  int                `json:"auto_export_every"` // Games between auto-exports, also written on exit (0=default)
+	AutoExportKeep   int                `json:"auto_export_keep"`  // Auto-exports kept in the export directory (0=default)
+	ExportFormat     stats.ExportFormat `json:"export_format"`     // Default export format
+	MaxHistorySize   int                `json:"max_history_size"`  // Maximum number of games to keep in history
+	ShowDailyStats   bool               `json:"show_daily_stats"`  // Show daily statistics breakdown
+	ShowStreaks      bool               `json:"show_streaks"`      // Show win/loss streaks
+	ShowAdvanced     bool               `json:"show_advanced"`     // Show advanced statistics
+	ExportDirectory  string             `json:"export_directory"`  // Directory for exported files
+	SyncDirectory    string             `json:"sync_directory"`    // Shared folder to merge stats across devices (empty=off)
+	SyncDevice       string             `json:"sync_device"`       // Name of this device's file in the sync folder (empty=hostname)
+	Backend          string             `json:"backend"`           // Statistics store: "json", "memory" or a registered backend
+	Location         string             `json:"location"`          // Where the backend keeps statistics (empty=backend default)
+	DataDirectory    string             `json:"data_directory"`    // Folder for the stats file and the files beside it (empty=~/.monty-hall; MONTY_HALL_DATA overrides)
+	DateFormat       string             `json:"date_format"`       // Dates in exports: "iso", "us", "eu" or "uk"
+	DecimalSeparator string             `json:"decimal_separator"` // Decimal separator in exports: "." or ","
+	CSVDelimiter     string             `json:"csv_delimiter"`     // CSV field delimiter: ",", ";" or a tab
+	NoPersist        bool               `json:"no_persist"`        // Never save statistics, settings or exports, for public kiosks
+	RecordDraws      bool               `json:"record_draws"`      // Keep each game's random draws, for monty-hall verify -games
+}
+
+// OpenStore opens the statistics store the configuration selects. The JSON
+// store keeps its file in the data directory unless a location is given
+func (s StatsConfig) OpenStore() (stats.StatsStore, error) {
+	location := s.Location
+	if s.usesDataDir() {
+		location = s.StatsPath()
+	}
+	return stats.OpenStore(s.Backend, location)
+}
+
+// usesDataDir reports whether the statistics are kept in the data directory
+func (s StatsConfig) usesDataDir() bool {
+	return s.Location == "" && (s.Backend == "" || s.Backend == stats.StoreJSON)
+}
+
+// DataDir returns the folder statistics are kept in: MONTY_HALL_DATA when
+// set, else data_directory, else ~/.monty-hall
+func (s StatsConfig) DataDir() string {
+	return stats.DataDir(s.DataDirectory)
+}
+
+// StatsPath returns the JSON stats file: the location when one is given,
+// else the stats file in the data directory
+func (s StatsConfig) StatsPath() string {
+	if s.Location != "" {
+		return s.Location
+	}
+	return filepath.Join(s.DataDir(), stats.DefaultStatsFileName)
+}
+
+// ExportLocale returns the date and number formats for exports
+func (s StatsConfig) ExportLocale() stats.ExportLocale {
+	return stats.ExportLocale{
+		DateFormat:       s.DateFormat,
+		DecimalSeparator: s.DecimalSeparator,
+		CSVDelimiter:     s.CSVDelimiter,
+	}
 }
 
 // EducationConfig contains educational feature configuration
@@ -69,33 +137,48 @@ func DefaultConfig() *Config {
 
 	return &Config{
 		UI: UIConfig{
-			ColorScheme:    "default",
-			AnimationSpeed: 2, // Normal speed
-			ShowTutorial:   true,
-			AutoSave:       true,
-			TerminalWidth:  0, // Auto-detect
-			TerminalHeight: 0, // Auto-detect
-			ShowAnimations: true,
-			ReducedMotion:  false,
-			HighContrast:   false,
-			LargeText:      false,
+			ColorScheme:      "default",
+			AnimationSpeed:   2, // Normal speed
+			ShowTutorial:     true,
+			AutoSave:         true,
+			TerminalWidth:    0, // Auto-detect
+			TerminalHeight:   0, // Auto-detect
+			ShowAnimations:   true,
+			ReducedMotion:    false,
+			HighContrast:     false,
+			LargeText:        false,
+			MaxFPS:           30,
+			AnimationPack:    "classic",
+			EffectsIntensity: "full",
+			TerminalTitle:    true,
 		},
 		Game: GameConfig{
-			AutoAdvance:     false,
-			ConfirmChoices:  false,
-			ShowProbability: true,
-			DefaultStrategy: "ask", // Ask user each time
-			ShowHints:       true,
-			PlaySounds:      false, // Disabled by default for terminal app
+			AutoAdvance:      false,
+			AutoAdvanceDelay: 5,
+			ConfirmChoices:   false,
+			ShowProbability:  true,
+			DefaultStrategy:  "ask", // Ask user each time
+			ShowHints:        true,
+			PlaySounds:       false, // Disabled by default for terminal app
+			RememberDoor:     true,
+			NumDoors:         game.NumDoors,
+			HostBehavior:     game.HostClassic.String(),
+			HostPersonality:  game.DefaultHostPersonality,
 		},
 		Stats: StatsConfig{
-			AutoExport:      false,
-			ExportFormat:    stats.ExportJSON,
-			MaxHistorySize:  10000,
-			ShowDailyStats:  true,
-			ShowStreaks:     true,
-			ShowAdvanced:    false,
-			ExportDirectory: exportDir,
+			AutoExport:       false,
+			AutoExportEvery:  25,
+			AutoExportKeep:   10,
+			ExportFormat:     stats.ExportJSON,
+			MaxHistorySize:   10000,
+			ShowDailyStats:   true,
+			ShowStreaks:      true,
+			ShowAdvanced:     false,
+			ExportDirectory:  exportDir,
+			Backend:          stats.StoreJSON,
+			DateFormat:       stats.DefaultExportLocale().DateFormat,
+			DecimalSeparator: stats.DefaultExportLocale().DecimalSeparator,
+			CSVDelimiter:     stats.DefaultExportLocale().CSVDelimiter,
 		},
 		Education: EducationConfig{
 			ShowExplanations: true,
@@ -155,20 +238,31 @@ func GetConfigPath() (string, error) {
 
 // Validate validates the configuration and returns any errors
 func (c *Config) Validate() error {
+	return c.ValidateWithThemes(nil)
+}
+
+// ValidateWithThemes validates the configuration, allowing the color scheme
+// to name one of the given custom themes
+func (c *Config) ValidateWithThemes(themes []ThemeDefinition) error {
 	// Validate UI config
-	validColorSchemes := map[string]bool{
-		"default":         true,
-		"high-contrast":   true,
-		"colorblind-safe": true,
-	}
-	if !validColorSchemes[c.UI.ColorScheme] {
+	validColorScheme := slices.Contains(GetColorSchemes(), c.UI.ColorScheme) ||
+		slices.ContainsFunc(themes, func(t ThemeDefinition) bool { return t.Name == c.UI.ColorScheme })
+	if !validColorScheme {
 		return fmt.Errorf("invalid color scheme: %s", c.UI.ColorScheme)
 	}
 
+	if c.UI.EffectsIntensity != "" && !slices.Contains(GetEffectsIntensities(), c.UI.EffectsIntensity) {
+		return fmt.Errorf("invalid effects intensity: %s", c.UI.EffectsIntensity)
+	}
+
 	if c.UI.AnimationSpeed < 0 || c.UI.AnimationSpeed > 3 {
 		return fmt.Errorf("animation speed must be between 0 and 3, got %d", c.UI.AnimationSpeed)
 	}
 
+	if c.UI.MaxFPS < 0 || c.UI.MaxFPS > 120 {
+		return fmt.Errorf("max FPS must be between 0 and 120, got %d", c.UI.MaxFPS)
+	}
+
 	if c.UI.TerminalWidth < 0 || c.UI.TerminalHeight < 0 {
 		return fmt.Errorf("terminal dimensions cannot be negative")
 	}
@@ -183,13 +277,54 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid default strategy: %s", c.Game.DefaultStrategy)
 	}
 
+	if c.Game.NumDoors != 0 {
+		if err := game.ValidateNumDoors(c.Game.NumDoors); err != nil {
+			return err
+		}
+	}
+
+	numDoors := c.Game.NumDoors
+	if numDoors == 0 {
+		numDoors = game.NumDoors
+	}
+	if err := game.ValidateHostOpens(numDoors, c.Game.HostOpens); err != nil {
+		return err
+	}
+
+	if _, err := game.ParseHostBehavior(c.Game.HostBehavior); err != nil {
+		return err
+	}
+
+	if c.Game.HostPersonality != "" {
+		if _, found := game.FindHostPersonality(c.Game.HostPersonality); !found {
+			return fmt.Errorf("unknown host personality: %s (expected %s)", c.Game.HostPersonality,
+				strings.Join(game.HostPersonalityNames(), ", "))
+		}
+	}
+
+	if c.Game.AutoAdvanceDelay < 0 || c.Game.AutoAdvanceDelay > 60 {
+		return fmt.Errorf("auto-advance delay must be between 0 and 60 seconds, got %d", c.Game.AutoAdvanceDelay)
+	}
+
+	for key := range c.Game.Narratives {
+		if !slices.Contains(GetNarrativeKeys(), key) {
+			return fmt.Errorf("invalid narrative key: %s", key)
+		}
+	}
+
 	// Validate Stats config
 	if c.Stats.MaxHistorySize < 0 {
 		return fmt.Errorf("max history size cannot be negative")
 	}
+	if c.Stats.AutoExportEvery < 0 {
+		return fmt.Errorf("games between auto-exports cannot be negative")
+	}
+	if c.Stats.AutoExportKeep < 0 {
+		return fmt.Errorf("auto-exports kept cannot be negative")
+	}
 
 	// Validate export format
-	validFormats := []stats.ExportFormat{stats.ExportJSON, stats.ExportCSV, stats.ExportText}
+	validFormats := stats.GetExportFormats()
 	validFormat := false
 	for _, format := range validFormats {
 		if c.Stats.ExportFormat == format {
@@ -201,9 +336,26 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid export format: %v", c.Stats.ExportFormat)
 	}
 
+	if c.Stats.Backend != "" && !slices.Contains(stats.StoreBackends(), c.Stats.Backend) {
+		return fmt.Errorf("invalid stats backend: %s", c.Stats.Backend)
+	}
+
+	if err := c.Stats.ExportLocale().Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// parseConfig parses a config file. A missing animation speed takes the
+// default, as one of 0 shows results at once and can't be told from missing
+// once parsed
+func parseConfig(data []byte) (Config, error) {
+	config := Config{UI: UIConfig{AnimationSpeed: DefaultConfig().UI.AnimationSpeed}}
+	err := json.Unmarshal(data, &config)
+	return config, err
+}
+
 // ApplyDefaults fills in any missing values with defaults
 func (c *Config) ApplyDefaults() {
 	defaults := DefaultConfig()
@@ -212,22 +364,55 @@ func (c *Config) ApplyDefaults() {
 	if c.UI.ColorScheme == "" {
 		c.UI.ColorScheme = defaults.UI.ColorScheme
 	}
-	if c.UI.AnimationSpeed == 0 && !c.UI.ReducedMotion {
-		c.UI.AnimationSpeed = defaults.UI.AnimationSpeed
+	if c.UI.MaxFPS == 0 {
+		c.UI.MaxFPS = defaults.UI.MaxFPS
+	}
+	if c.UI.AnimationPack == "" {
+		c.UI.AnimationPack = defaults.UI.AnimationPack
+	}
+	if c.UI.EffectsIntensity == "" {
+		c.UI.EffectsIntensity = defaults.UI.EffectsIntensity
 	}
 
 	// Apply Game defaults
 	if c.Game.DefaultStrategy == "" {
 		c.Game.DefaultStrategy = defaults.Game.DefaultStrategy
 	}
+	if c.Game.NumDoors == 0 {
+		c.Game.NumDoors = defaults.Game.NumDoors
+	}
+	if c.Game.HostBehavior == "" {
+		c.Game.HostBehavior = defaults.Game.HostBehavior
+	}
+	if c.Game.HostPersonality == "" {
+		c.Game.HostPersonality = defaults.Game.HostPersonality
+	}
 
 	// Apply Stats defaults
 	if c.Stats.MaxHistorySize == 0 {
 		c.Stats.MaxHistorySize = defaults.Stats.MaxHistorySize
 	}
+	if c.Stats.AutoExportEvery == 0 {
+		c.Stats.AutoExportEvery = defaults.Stats.AutoExportEvery
+	}
+	if c.Stats.AutoExportKeep == 0 {
+		c.Stats.AutoExportKeep = defaults.Stats.AutoExportKeep
+	}
 	if c.Stats.ExportDirectory == "" {
 		c.Stats.ExportDirectory = defaults.Stats.ExportDirectory
 	}
+	if c.Stats.Backend == "" {
+		c.Stats.Backend = defaults.Stats.Backend
+	}
+	if c.Stats.DateFormat == "" {
+		c.Stats.DateFormat = defaults.Stats.DateFormat
+	}
+	if c.Stats.DecimalSeparator == "" {
+		c.Stats.DecimalSeparator = defaults.Stats.DecimalSeparator
+	}
+	if c.Stats.CSVDelimiter == "" {
+		c.Stats.CSVDelimiter = defaults.Stats.CSVDelimiter
+	}
 
 	// Apply version if missing
 	if c.Version == "" {
@@ -85,6 +85,41 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "Invalid number of doors",
+			modifyFunc: func(c *Config) {
+				c.Game.NumDoors = 2
+			},
+			expectError: true,
+		},
+		{
+			name: "Invalid effects intensity",
+			modifyFunc: func(c *Config) {
+				c.UI.EffectsIntensity = "loud"
+			},
+			expectError: true,
+		},
+		{
+			name: "Invalid host behavior",
+			modifyFunc: func(c *Config) {
+				c.Game.HostBehavior = "lazy"
+			},
+			expectError: true,
+		},
+		{
+			name: "Invalid host personality",
+			modifyFunc: func(c *Config) {
+				c.Game.HostPersonality = "grumpy"
+			},
+			expectError: true,
+		},
+		{
+			name: "Invalid narrative key",
+			modifyFunc: func(c *Config) {
+				c.Game.Narratives = map[string][]string{"draw": {"A tie?"}}
+			},
+			expectError: true,
+		},
 		{
 			name: "Invalid max history size",
 			modifyFunc: func(c *Config) {
@@ -92,12 +127,36 @@ func TestConfigValidation(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "Invalid stats backend",
+			modifyFunc: func(c *Config) {
+				c.Stats.Backend = "floppy"
+			},
+			expectError: true,
+		},
+		{
+			name: "European export locale",
+			modifyFunc: func(c *Config) {
+				c.Stats.DateFormat = "eu"
+				c.Stats.DecimalSeparator = ","
+				c.Stats.CSVDelimiter = ";"
+			},
+			expectError: false,
+		},
+		{
+			name: "Invalid CSV delimiter",
+			modifyFunc: func(c *Config) {
+				c.Stats.CSVDelimiter = "|"
+			},
+			expectError: true,
+		},
 		{
 			name: "Valid edge cases",
 			modifyFunc: func(c *Config) {
 				c.UI.AnimationSpeed = 0    // Disabled
 				c.UI.TerminalWidth = 0     // Auto
 				c.Stats.MaxHistorySize = 0 // Will be set to default
+				c.Game.NumDoors = 0        // Will be set to default
 			},
 			expectError: false,
 		},
@@ -145,6 +204,14 @@ func TestConfigApplyDefaults(t *testing.T) {
 		t.Errorf("Expected default strategy to be set to 'ask', got '%s'", config.Game.DefaultStrategy)
 	}
 
+	if config.Game.NumDoors != 3 {
+		t.Errorf("Expected number of doors to be set to 3, got %d", config.Game.NumDoors)
+	}
+
+	if config.Game.HostBehavior != "classic" {
+		t.Errorf("Expected host behavior to be set to 'classic', got '%s'", config.Game.HostBehavior)
+	}
+
 	if config.Stats.MaxHistorySize != 10000 {
 		t.Errorf("Expected max history size to be set to 10000, got %d", config.Stats.MaxHistorySize)
 	}
@@ -231,7 +298,7 @@ func TestConfigString(t *testing.T) {
 func TestGetColorSchemes(t *testing.T) {
 	schemes := GetColorSchemes()
 
-	expectedSchemes := []string{"default", "high-contrast", "colorblind-safe"}
+	expectedSchemes := []string{"default", "high-contrast", "colorblind-safe", "light", "matrix", "crt"}
 	if len(schemes) != len(expectedSchemes) {
 		t.Errorf("Expected %d color schemes, got %d", len(expectedSchemes), len(schemes))
 	}
@@ -308,3 +375,32 @@ func BenchmarkConfigClone(b *testing.B) {
 		config.Clone()
 	}
 }
+
+func TestDiff(t *testing.T) {
+	old := DefaultConfig()
+	new := DefaultConfig()
+
+	if changes := Diff(old, new); len(changes) != 0 {
+		t.Errorf("Identical configs should have no changes, got %v", changes)
+	}
+
+	new.UI.ColorScheme = "high-contrast"
+	new.Game.ShowHints = false
+	new.Stats.ExportFormat = stats.ExportCSV
+
+	changes := Diff(old, new)
+	if len(changes) != 3 {
+		t.Fatalf("Expected 3 changes, got %d: %v", len(changes), changes)
+	}
+
+	expected := []string{
+		`ui.color_scheme: "default" → "high-contrast"`,
+		"game.show_hints: true → false",
+		"stats.export_format: JSON → CSV",
+	}
+	for i, change := range changes {
+		if change.String() != expected[i] {
+			t.Errorf("Expected change %q, got %q", expected[i], change.String())
+		}
+	}
+}
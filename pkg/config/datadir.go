@@ -0,0 +1,140 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// DataDirRecordFileName is the file beside a config file remembering the
+// data directory its statistics were last opened in, so they can follow a change
+const DataDirRecordFileName = "data-directory"
+
+// DataDirRecordPath returns the path of the data directory record of the
+// config file in use
+func (m *Manager) DataDirRecordPath() string {
+	return dataDirRecordPath(m.GetConfigPath())
+}
+
+// dataDirRecordPath returns the data directory record of a config file:
+// data-directory beside config.json, or <name>.data-directory beside any
+// other config file, so each profile remembers its own
+func dataDirRecordPath(configPath string) string {
+	dir, name := filepath.Split(configPath)
+	if name == "config.json" {
+		return filepath.Join(dir, DataDirRecordFileName)
+	}
+	return filepath.Join(dir, strings.TrimSuffix(name, filepath.Ext(name))+"."+DataDirRecordFileName)
+}
+
+// recordedDataDir returns the data directory a config file's statistics were
+// last opened in, if it has been recorded
+func recordedDataDir(configPath string) (string, bool) {
+	data, err := os.ReadFile(dataDirRecordPath(configPath))
+	if err != nil {
+		return "", false
+	}
+	return strings.TrimSpace(string(data)), true
+}
+
+// OpenStatsStore opens the statistics store the configuration selects. When
+// the data directory has changed since statistics were last opened, they are
+// moved from the old one first, with the files kept beside them. Before the
+// first change the old directory is ~/.monty-hall. Nothing is moved out of a
+// directory another config profile keeps its statistics in. The MONTY_HALL_DATA
+// override never moves anything, so it can point at other statistics for a
+// while without taking the usual ones along
+func (m *Manager) OpenStatsStore() (stats.StatsStore, error) {
+	cfg := m.Get().Stats
+	if cfg.usesDataDir() && !stats.DataDirOverridden() && m.GetConfigPath() != "" {
+		if _, err := m.MigrateDataDir(); err != nil {
+			return nil, err
+		}
+	}
+	return cfg.OpenStore()
+}
+
+// MigrateDataDir moves the statistics into the configured data directory if
+// it differs from the one they were last opened in, and remembers it. It
+// returns the names of the files moved
+func (m *Manager) MigrateDataDir() ([]string, error) {
+	dir := m.Get().Stats.DataDir()
+
+	previous, ok := recordedDataDir(m.GetConfigPath())
+	if !ok {
+		previous = stats.DefaultDataDir()
+	}
+	if filepath.Clean(previous) == filepath.Clean(dir) {
+		return nil, nil
+	}
+
+	var moved []string
+	if !m.dataDirClaimed(previous) {
+		var err error
+		if moved, err = stats.MoveDataDir(previous, dir); err != nil {
+			return moved, fmt.Errorf("failed to move statistics from %s: %w", previous, err)
+		}
+	}
+
+	record := m.DataDirRecordPath()
+	if err := os.MkdirAll(filepath.Dir(record), 0755); err != nil {
+		return moved, fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := os.WriteFile(record, []byte(dir+"\n"), 0644); err != nil {
+		return moved, fmt.Errorf("failed to remember the data directory: %w", err)
+	}
+	return moved, nil
+}
+
+// dataDirClaimed reports whether another config profile keeps its statistics
+// in dir, or last opened them there. A config file given with --config counts
+// every profile as another one
+func (m *Manager) dataDirClaimed(dir string) bool {
+	configDir := m.profileDir
+	if configDir == "" {
+		var err error
+		if configDir, err = GetConfigDir(); err != nil {
+			return false
+		}
+	}
+	profiles, err := ListProfiles(configDir)
+	if err != nil {
+		return false
+	}
+
+	ownPath := absPath(m.GetConfigPath())
+	for _, name := range profiles {
+		path := ProfilePath(configDir, name)
+		if absPath(path) == ownPath {
+			continue
+		}
+		if recorded, ok := recordedDataDir(path); ok && filepath.Clean(recorded) == filepath.Clean(dir) {
+			return true
+		}
+
+		cfg := DefaultConfig()
+		if data, err := os.ReadFile(path); err == nil {
+			parsed, err := parseConfig(data)
+			if err != nil {
+				continue
+			}
+			parsed.ApplyDefaults()
+			cfg = &parsed
+		}
+		if cfg.Stats.usesDataDir() && filepath.Clean(cfg.Stats.DataDir()) == filepath.Clean(dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// absPath returns path made absolute, or cleaned when that fails
+func absPath(path string) string {
+	if abs, err := filepath.Abs(path); err == nil {
+		return abs
+	}
+	return filepath.Clean(path)
+}
@@ -0,0 +1,149 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// newDataDirHome points the home and config directories at a temporary
+// folder and returns the stats file in ~/.monty-hall, holding no games
+func newDataDirHome(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	t.Setenv(stats.DataDirEnvVar, "")
+
+	oldPath := filepath.Join(home, stats.DefaultStatsDir, stats.DefaultStatsFileName)
+	if err := os.MkdirAll(filepath.Dir(oldPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(oldPath, []byte(`{"total_games": 0}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return oldPath
+}
+
+// setDataDir saves dir as the data directory of manager's config
+func setDataDir(t *testing.T, manager *Manager, dir string) {
+	t.Helper()
+
+	cfg := manager.Get().Clone()
+	cfg.Stats.DataDirectory = dir
+	if err := manager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOpenStatsStoreMovesStatistics(t *testing.T) {
+	oldPath := newDataDirHome(t)
+
+	manager, err := NewManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dataDir := filepath.Join(t.TempDir(), "data")
+	cfg := manager.Get().Clone()
+	cfg.Stats.DataDirectory = dataDir
+	if err := manager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	// The statistics follow the data directory away from ~/.monty-hall
+	store, err := manager.OpenStatsStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.Location() != filepath.Join(dataDir, stats.DefaultStatsFileName) {
+		t.Errorf("Expected the stats file in the data directory, got %s", store.Location())
+	}
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Error("Expected the stats file moved out of ~/.monty-hall")
+	}
+
+	// And back again when the setting is cleared
+	cfg.Stats.DataDirectory = ""
+	if err := manager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manager.OpenStatsStore(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(oldPath); err != nil {
+		t.Errorf("Expected the stats file moved back: %v", err)
+	}
+
+	// The environment override leaves the statistics where they are
+	t.Setenv(stats.DataDirEnvVar, filepath.Join(t.TempDir(), "override"))
+	store, err = manager.OpenStatsStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(oldPath); err != nil || filepath.Dir(store.Location()) != os.Getenv(stats.DataDirEnvVar) {
+		t.Errorf("Expected the override to open its own statistics, got %s", store.Location())
+	}
+}
+
+func TestDataDirPerProfile(t *testing.T) {
+	oldPath := newDataDirHome(t)
+
+	manager, err := NewManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultDir := filepath.Join(t.TempDir(), "default")
+	setDataDir(t, manager, defaultDir)
+	if _, err := manager.OpenStatsStore(); err != nil {
+		t.Fatal(err)
+	}
+	defaultPath := filepath.Join(defaultDir, stats.DefaultStatsFileName)
+	if _, err := os.Stat(defaultPath); err != nil {
+		t.Fatalf("Expected the default profile's statistics moved: %v", err)
+	}
+
+	// Another profile with its own data directory leaves them where they are
+	classroom, err := NewManagerForProfile("classroom")
+	if err != nil {
+		t.Fatal(err)
+	}
+	setDataDir(t, classroom, filepath.Join(t.TempDir(), "classroom"))
+	if _, err := classroom.OpenStatsStore(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(defaultPath); err != nil {
+		t.Errorf("Expected the default profile's statistics kept: %v", err)
+	}
+	if classroom.DataDirRecordPath() == manager.DataDirRecordPath() {
+		t.Errorf("Expected each profile to remember its own data directory, both use %s", manager.DataDirRecordPath())
+	}
+
+	// Reopening the default profile finds its statistics where it left them
+	if _, err := manager.OpenStatsStore(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(defaultPath); err != nil {
+		t.Errorf("Expected the default profile's statistics kept: %v", err)
+	}
+
+	// A config file given on the command line never takes the default
+	// profile's statistics out of ~/.monty-hall
+	setDataDir(t, manager, "")
+	if _, err := manager.OpenStatsStore(); err != nil {
+		t.Fatal(err)
+	}
+	file, err := NewManagerWithPath(filepath.Join(t.TempDir(), "kiosk.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	setDataDir(t, file, filepath.Join(t.TempDir(), "kiosk"))
+	if _, err := file.OpenStatsStore(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(oldPath); err != nil {
+		t.Errorf("Expected the statistics in ~/.monty-hall kept: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldChange describes one configuration value that differs between two configs
+type FieldChange struct {
+	Field string // Dotted JSON path, e.g. "ui.color_scheme"
+	Old   string
+	New   string
+}
+
+// String returns the change as "field: old → new"
+func (fc FieldChange) String() string {
+	return fmt.Sprintf("%s: %s → %s", fc.Field, fc.Old, fc.New)
+}
+
+// Diff returns the field-by-field changes needed to turn old into new
+func Diff(old, new *Config) []FieldChange {
+	var changes []FieldChange
+	diffStruct("", reflect.ValueOf(*old), reflect.ValueOf(*new), &changes)
+	return changes
+}
+
+// diffStruct compares two struct values, recursing into nested config sections
+func diffStruct(prefix string, old, new reflect.Value, changes *[]FieldChange) {
+	for i := 0; i < old.NumField(); i++ {
+		field := old.Type().Field(i)
+		name := jsonFieldName(field)
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+
+		oldValue, newValue := old.Field(i), new.Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			diffStruct(name, oldValue, newValue, changes)
+			continue
+		}
+
+		if !reflect.DeepEqual(oldValue.Interface(), newValue.Interface()) {
+			*changes = append(*changes, FieldChange{
+				Field: name,
+				Old:   formatFieldValue(oldValue),
+				New:   formatFieldValue(newValue),
+			})
+		}
+	}
+}
+
+// jsonFieldName returns the JSON name of a struct field
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" {
+		return field.Name
+	}
+	return name
+}
+
+// formatFieldValue renders a config value for display
+func formatFieldValue(v reflect.Value) string {
+	if v.Kind() == reflect.String {
+		return fmt.Sprintf("%q", v.String())
+	}
+	return fmt.Sprint(v.Interface())
+}
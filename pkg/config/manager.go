@@ -5,8 +5,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
 	"sync"
 	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
 )
 
 // Manager handles configuration loading, saving, and management
@@ -15,19 +20,36 @@ type Manager struct {
 	configPath string
 	mutex      sync.RWMutex
 	watchers   []func(*Config)
+	dirty      bool // In-memory changes not yet written to disk
+
+	profileDir string // Config directory holding the profiles, if the manager uses them
+	profile    string // Config profile in use, if any
+
+	themes        []ThemeDefinition // Custom themes from the themes file
+	themesErr     error             // Why the themes file failed to load, if it did
+	themesModTime time.Time
 }
 
-// NewManager creates a new configuration manager
+// NewManager creates a new configuration manager for the active config profile
 func NewManager() (*Manager, error) {
-	configPath, err := GetConfigPath()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get config path: %w", err)
-	}
+	return NewManagerForProfile("")
+}
+
+// NewManagerWithPath creates a configuration manager backed by the given file
+func NewManagerWithPath(configPath string) (*Manager, error) {
+	return newManager(configPath, "", "")
+}
 
+// newManager creates a configuration manager backed by the given file, which
+// holds the named profile when profileDir is set
+func newManager(configPath, profileDir, profile string) (*Manager, error) {
 	manager := &Manager{
 		configPath: configPath,
 		watchers:   make([]func(*Config), 0),
+		profileDir: profileDir,
+		profile:    profile,
 	}
+	manager.loadThemes() // Reported by ThemesError
 
 	// Try to load existing config, create default if not found
 	if err := manager.Load(); err != nil {
@@ -45,26 +67,42 @@ func NewManager() (*Manager, error) {
 	return manager, nil
 }
 
+// NewManagerWithDefaults creates a manager that starts from the default
+// configuration without reading the file at configPath; the file is only
+// overwritten once settings are changed
+func NewManagerWithDefaults(configPath string) *Manager {
+	return &Manager{
+		config:     DefaultConfig(),
+		configPath: configPath,
+		watchers:   make([]func(*Config), 0),
+	}
+}
+
 // Load loads the configuration from disk
 func (m *Manager) Load() error {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	data, err := os.ReadFile(m.configPath)
+	data, err := chaos.ReadConfig(m.configPath)
 	if err != nil {
 		return err
 	}
 
-	var config Config
-	if err := json.Unmarshal(data, &config); err != nil {
+	config, err := parseConfig(data)
+	if err != nil {
 		return fmt.Errorf("failed to parse config file: %w", err)
 	}
 
 	// Apply defaults for any missing values
 	config.ApplyDefaults()
 
+	// A custom theme can't be checked while the themes file is broken
+	if m.themesErr != nil && !slices.Contains(m.colorSchemes(), config.UI.ColorScheme) {
+		config.UI.ColorScheme = DefaultConfig().UI.ColorScheme
+	}
+
 	// Validate the configuration
-	if err := config.Validate(); err != nil {
+	if err := config.ValidateWithThemes(m.themes); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
@@ -98,7 +136,7 @@ func (m *Manager) Save() error {
 
 	// Write to temporary file first, then rename (atomic operation)
 	tempPath := m.configPath + ".tmp"
-	if err := os.WriteFile(tempPath, data, 0644); err != nil {
+	if err := chaos.WriteFile(tempPath, data, 0644); err != nil {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
@@ -107,9 +145,20 @@ func (m *Manager) Save() error {
 		return fmt.Errorf("failed to save config file: %w", err)
 	}
 
+	m.mutex.Lock()
+	if reflect.DeepEqual(m.config, config) { // Changes applied during the write stay pending
+		m.dirty = false
+	}
+	m.mutex.Unlock()
+
 	return nil
 }
 
+// Validate validates a configuration, allowing the custom themes as color schemes
+func (m *Manager) Validate(config *Config) error {
+	return config.ValidateWithThemes(m.Themes())
+}
+
 // Get returns a copy of the current configuration
 func (m *Manager) Get() *Config {
 	m.mutex.RLock()
@@ -121,7 +170,7 @@ func (m *Manager) Get() *Config {
 // Update updates the configuration with the provided config
 func (m *Manager) Update(newConfig *Config) error {
 	// Validate the new configuration
-	if err := newConfig.Validate(); err != nil {
+	if err := m.Validate(newConfig); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
@@ -142,6 +191,45 @@ func (m *Manager) Update(newConfig *Config) error {
 	return nil
 }
 
+// Apply updates the configuration in memory and notifies watchers without
+// saving; call Flush to write pending changes to disk
+func (m *Manager) Apply(newConfig *Config) error {
+	if err := m.Validate(newConfig); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	m.mutex.Lock()
+	m.config = newConfig.Clone()
+	m.dirty = true
+	m.mutex.Unlock()
+
+	for _, watcher := range m.watchers {
+		watcher(m.config)
+	}
+
+	return nil
+}
+
+// Flush saves changes made with Apply; it does nothing if there are none
+func (m *Manager) Flush() error {
+	if !m.IsDirty() {
+		return nil
+	}
+
+	if err := m.Save(); err != nil {
+		return fmt.Errorf("failed to save pending config changes: %w", err)
+	}
+	return nil
+}
+
+// IsDirty returns true if there are applied changes that have not been saved
+func (m *Manager) IsDirty() bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.dirty
+}
+
 // UpdateUI updates only the UI configuration
 func (m *Manager) UpdateUI(uiConfig UIConfig) error {
 	m.mutex.Lock()
@@ -188,8 +276,8 @@ func (m *Manager) Reset() error {
 	return m.Update(defaultConfig)
 }
 
-// Backup creates a backup of the current configuration
-func (m *Manager) Backup() error {
+// Backup creates a backup of the current configuration and returns its path
+func (m *Manager) Backup() (string, error) {
 	timestamp := time.Now().Format("2006-01-02_15-04-05")
 	backupPath := m.configPath + ".backup." + timestamp
 
@@ -199,31 +287,64 @@ func (m *Manager) Backup() error {
 
 	data, err := json.MarshalIndent(config, "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal config for backup: %w", err)
+		return "", fmt.Errorf("failed to marshal config for backup: %w", err)
 	}
 
-	if err := os.WriteFile(backupPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write backup file: %w", err)
+	if err := chaos.WriteFile(backupPath, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write backup file: %w", err)
 	}
 
-	return nil
+	return backupPath, nil
 }
 
 // RestoreFromBackup restores configuration from a backup file
 func (m *Manager) RestoreFromBackup(backupPath string) error {
-	data, err := os.ReadFile(backupPath)
+	data, err := chaos.ReadConfig(backupPath)
 	if err != nil {
 		return fmt.Errorf("failed to read backup file: %w", err)
 	}
 
-	var config Config
-	if err := json.Unmarshal(data, &config); err != nil {
+	config, err := parseConfig(data)
+	if err != nil {
 		return fmt.Errorf("failed to parse backup file: %w", err)
 	}
 
 	return m.Update(&config)
 }
 
+// ListBackups returns the paths of configuration backups, newest first
+func (m *Manager) ListBackups() ([]string, error) {
+	backups, err := filepath.Glob(m.configPath + ".backup.*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backups: %w", err)
+	}
+
+	// Timestamps in the names sort chronologically
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+	return backups, nil
+}
+
+// PreviewFile loads a config file and returns it with the changes it would make
+// to the current configuration, without applying anything
+func (m *Manager) PreviewFile(path string) (*Config, []FieldChange, error) {
+	data, err := chaos.ReadConfig(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	config, err := parseConfig(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	config.ApplyDefaults()
+	if err := m.Validate(&config); err != nil {
+		return nil, nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	return &config, Diff(m.Get(), &config), nil
+}
+
 // AddWatcher adds a function to be called when configuration changes
 func (m *Manager) AddWatcher(watcher func(*Config)) {
 	m.mutex.Lock()
@@ -237,9 +358,20 @@ func (m *Manager) AddWatcher(watcher func(*Config)) {
 
 // GetConfigPath returns the path to the configuration file
 func (m *Manager) GetConfigPath() string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
 	return m.configPath
 }
 
+// configDir returns the directory shared by all profiles, which holds the
+// themes file
+func (m *Manager) configDir() string {
+	if m.profileDir != "" {
+		return m.profileDir
+	}
+	return filepath.Dir(m.configPath)
+}
+
 // Exists checks if the configuration file exists
 func (m *Manager) Exists() bool {
 	_, err := os.Stat(m.configPath)
@@ -288,7 +420,18 @@ func (m *Manager) IsDefault() bool {
 
 // GetColorSchemes returns available color schemes
 func GetColorSchemes() []string {
-	return []string{"default", "high-contrast", "colorblind-safe"}
+	return slices.Clone(builtinColorSchemes)
+}
+
+// GetEffectsIntensities returns available celebration effects intensities
+func GetEffectsIntensities() []string {
+	return []string{"off", "subtle", "full"}
+}
+
+// GetNarrativeKeys returns the keys of result narrative lines: the outcome,
+// the strategy and outcome, and the outcome on a streak
+func GetNarrativeKeys() []string {
+	return []string{"win", "loss", "switch_win", "switch_loss", "stay_win", "stay_loss", "win_streak", "loss_streak"}
 }
 
 // GetAnimationSpeeds returns available animation speeds with descriptions
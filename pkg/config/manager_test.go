@@ -1,6 +1,7 @@
 package config
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 	"time"
@@ -74,6 +75,34 @@ func TestManagerLoadSave(t *testing.T) {
 	}
 }
 
+func TestManagerLoadKeepsInstantAnimations(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+	manager := NewManagerWithDefaults(configPath)
+	cfg := manager.Get().Clone()
+	cfg.UI.AnimationSpeed = 0
+	if err := manager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := manager.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if speed := manager.Get().UI.AnimationSpeed; speed != 0 {
+		t.Errorf("Expected a saved speed of 0 to stay instant, got %d", speed)
+	}
+
+	// A file without the speed gets the default one
+	if err := os.WriteFile(configPath, []byte(`{"ui": {"color_scheme": "default"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := manager.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if speed := manager.Get().UI.AnimationSpeed; speed != DefaultConfig().UI.AnimationSpeed {
+		t.Errorf("Expected the default speed for a file without one, got %d", speed)
+	}
+}
+
 func TestManagerUpdate(t *testing.T) {
 	tempDir := t.TempDir()
 	configPath := filepath.Join(tempDir, "config.json")
@@ -117,6 +146,62 @@ func TestManagerUpdate(t *testing.T) {
 	}
 }
 
+func TestManagerApplyFlush(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+
+	manager, err := NewManagerWithPath(configPath)
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	// Apply several rapid changes without touching the disk
+	for _, scheme := range []string{"high-contrast", "colorblind-safe", "high-contrast"} {
+		newConfig := manager.Get()
+		newConfig.UI.ColorScheme = scheme
+		if err := manager.Apply(newConfig); err != nil {
+			t.Fatalf("Failed to apply config: %v", err)
+		}
+	}
+
+	if manager.Get().UI.ColorScheme != "high-contrast" {
+		t.Error("Applied change should be visible in memory immediately")
+	}
+
+	if !manager.IsDirty() {
+		t.Error("Manager should have pending changes after Apply")
+	}
+
+	onDisk, _ := NewManagerWithPath(configPath)
+	if onDisk.Get().UI.ColorScheme != "default" {
+		t.Error("Apply should not save to disk")
+	}
+
+	if err := manager.Flush(); err != nil {
+		t.Fatalf("Failed to flush config: %v", err)
+	}
+
+	if manager.IsDirty() {
+		t.Error("Manager should have no pending changes after Flush")
+	}
+
+	onDisk, _ = NewManagerWithPath(configPath)
+	if onDisk.Get().UI.ColorScheme != "high-contrast" {
+		t.Errorf("Flushed config not saved: got '%s'", onDisk.Get().UI.ColorScheme)
+	}
+
+	// Invalid changes are rejected without becoming pending
+	invalid := manager.Get()
+	invalid.UI.AnimationSpeed = 99
+	if err := manager.Apply(invalid); err == nil {
+		t.Error("Apply should reject invalid config")
+	}
+
+	if manager.IsDirty() {
+		t.Error("Rejected config should not be pending")
+	}
+}
+
 func TestManagerUpdateSections(t *testing.T) {
 	tempDir := t.TempDir()
 	configPath := filepath.Join(tempDir, "config.json")
@@ -255,7 +340,7 @@ func TestManagerBackupRestore(t *testing.T) {
 	}
 
 	// Create backup
-	if err := manager.Backup(); err != nil {
+	if _, err := manager.Backup(); err != nil {
 		t.Fatalf("Failed to create backup: %v", err)
 	}
 
@@ -290,6 +375,77 @@ func TestManagerBackupRestore(t *testing.T) {
 	}
 }
 
+func TestNewManagerWithDefaults(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.json")
+
+	saved, err := NewManagerWithPath(configPath)
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	custom := saved.Get()
+	custom.UI.ShowAnimations = false
+	if err := saved.Update(custom); err != nil {
+		t.Fatalf("Failed to update config: %v", err)
+	}
+
+	manager := NewManagerWithDefaults(configPath)
+	if !manager.Get().UI.ShowAnimations {
+		t.Error("Manager should ignore the saved config and start from defaults")
+	}
+
+	// The saved file is untouched until settings change
+	reloaded, _ := NewManagerWithPath(configPath)
+	if reloaded.Get().UI.ShowAnimations {
+		t.Error("Creating a defaults manager should not overwrite the saved config")
+	}
+}
+
+func TestManagerPreviewFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	manager, err := NewManagerWithPath(filepath.Join(tempDir, "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	if _, err := manager.Backup(); err != nil {
+		t.Fatalf("Failed to create backup: %v", err)
+	}
+
+	newConfig := manager.Get()
+	newConfig.UI.ShowAnimations = false
+	if err := manager.Update(newConfig); err != nil {
+		t.Fatalf("Failed to update config: %v", err)
+	}
+
+	backups, err := manager.ListBackups()
+	if err != nil || len(backups) != 1 {
+		t.Fatalf("Expected 1 backup, got %v (err: %v)", backups, err)
+	}
+
+	preview, changes, err := manager.PreviewFile(backups[0])
+	if err != nil {
+		t.Fatalf("Failed to preview backup: %v", err)
+	}
+
+	if !preview.UI.ShowAnimations {
+		t.Error("Preview should contain the backed up settings")
+	}
+
+	if len(changes) != 1 || changes[0].Field != "ui.show_animations" {
+		t.Errorf("Expected a single show_animations change, got %v", changes)
+	}
+
+	// Previewing must not apply anything
+	if manager.Get().UI.ShowAnimations {
+		t.Error("PreviewFile should not change the current config")
+	}
+
+	if _, _, err := manager.PreviewFile(filepath.Join(tempDir, "missing.json")); err == nil {
+		t.Error("Expected error previewing a missing file")
+	}
+}
+
 func TestManagerUtilityMethods(t *testing.T) {
 	tempDir := t.TempDir()
 	configPath := filepath.Join(tempDir, "config.json")
@@ -0,0 +1,185 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+)
+
+// DefaultProfile is the config profile kept in config.json, used until another
+// profile is chosen
+const DefaultProfile = "default"
+
+// ProfilesDirName is the folder in the config directory holding the files of
+// the other config profiles
+const ProfilesDirName = "profiles"
+
+// ActiveProfileFileName is the file in the config directory naming the
+// profile to load at launch
+const ActiveProfileFileName = "active-profile"
+
+// profileNamePattern keeps profile names safe to use as file names on every
+// platform, including case-insensitive ones
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// ValidateProfileName checks that a config profile name can be used as a file name
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use up to 32 lowercase letters, digits, - and _", name)
+	}
+	return nil
+}
+
+// ProfilePath returns the file a config profile is stored in
+func ProfilePath(configDir, name string) string {
+	if name == DefaultProfile {
+		return filepath.Join(configDir, "config.json")
+	}
+	return filepath.Join(configDir, ProfilesDirName, name+".json")
+}
+
+// ListProfiles returns the default profile followed by the other saved
+// profiles in alphabetical order
+func ListProfiles(configDir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(configDir, ProfilesDirName, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	profiles := []string{DefaultProfile}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		if name != DefaultProfile && ValidateProfileName(name) == nil {
+			profiles = append(profiles, name)
+		}
+	}
+	slices.Sort(profiles[1:])
+	return profiles, nil
+}
+
+// ActiveProfile returns the profile named by the active profile pointer. It
+// falls back to the default profile when the pointer is missing or names a
+// profile that no longer exists
+func ActiveProfile(configDir string) string {
+	data, err := chaos.ReadConfig(filepath.Join(configDir, ActiveProfileFileName))
+	if err != nil {
+		return DefaultProfile
+	}
+
+	name := strings.TrimSpace(string(data))
+	if ValidateProfileName(name) != nil {
+		return DefaultProfile
+	}
+	if _, err := os.Stat(ProfilePath(configDir, name)); err != nil {
+		return DefaultProfile
+	}
+	return name
+}
+
+// SetActiveProfile points the active profile pointer at a profile, so it is
+// loaded at the next launch
+func SetActiveProfile(configDir, name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if err := chaos.WriteFile(filepath.Join(configDir, ActiveProfileFileName), []byte(name+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to save active profile: %w", err)
+	}
+	return nil
+}
+
+// NewManagerForProfile creates a configuration manager for a named config
+// profile, or the active one when name is empty. A profile that doesn't exist
+// yet is created with the default settings. The active profile pointer is
+// left alone, so a profile chosen at launch only lasts for that session
+func NewManagerForProfile(name string) (*Manager, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config path: %w", err)
+	}
+
+	if name == "" {
+		name = ActiveProfile(configDir)
+	}
+	if err := ValidateProfileName(name); err != nil {
+		return nil, err
+	}
+
+	return newManager(ProfilePath(configDir, name), configDir, name)
+}
+
+// Profile returns the name of the config profile in use, or "" for a manager
+// backed by a single file
+func (m *Manager) Profile() string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.profile
+}
+
+// Profiles returns the config profiles that can be switched to
+func (m *Manager) Profiles() ([]string, error) {
+	if m.profileDir == "" {
+		return nil, errors.New("settings are not stored in profiles")
+	}
+	return ListProfiles(m.profileDir)
+}
+
+// SwitchProfile saves any pending changes, loads another config profile,
+// creating it with the default settings if needed, and makes it the active
+// profile. Watchers are notified of the new settings
+func (m *Manager) SwitchProfile(name string) error {
+	if m.profileDir == "" {
+		return errors.New("settings are not stored in profiles")
+	}
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if err := m.Flush(); err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	previousPath, previousProfile := m.configPath, m.profile
+	m.configPath, m.profile = ProfilePath(m.profileDir, name), name
+	m.mutex.Unlock()
+
+	restore := func() {
+		m.mutex.Lock()
+		m.configPath, m.profile = previousPath, previousProfile
+		m.mutex.Unlock()
+	}
+
+	if err := m.Load(); err != nil {
+		if !os.IsNotExist(err) {
+			restore()
+			return fmt.Errorf("failed to load profile %q: %w", name, err)
+		}
+
+		// A new profile starts from the defaults
+		previous := m.Get()
+		m.mutex.Lock()
+		m.config = DefaultConfig()
+		m.mutex.Unlock()
+		if err := m.Save(); err != nil {
+			m.mutex.Lock()
+			m.config = previous
+			m.mutex.Unlock()
+			restore()
+			return fmt.Errorf("failed to create profile %q: %w", name, err)
+		}
+		for _, watcher := range m.watchers {
+			watcher(m.config)
+		}
+	}
+
+	return SetActiveProfile(m.profileDir, name)
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestConfigProfiles(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	configDir, err := GetConfigDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager, err := NewManagerForProfile("")
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	if manager.Profile() != DefaultProfile || manager.GetConfigPath() != filepath.Join(configDir, "config.json") {
+		t.Errorf("Expected the default profile in config.json, got %s in %s", manager.Profile(), manager.GetConfigPath())
+	}
+
+	// A profile chosen at launch is created without becoming the active one
+	classroom, err := NewManagerForProfile("classroom")
+	if err != nil {
+		t.Fatalf("Failed to create classroom profile: %v", err)
+	}
+	cfg := classroom.Get()
+	cfg.UI.ShowAnimations = false
+	if err := classroom.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+	if ActiveProfile(configDir) != DefaultProfile {
+		t.Errorf("A profile chosen at launch should not become active, got %s", ActiveProfile(configDir))
+	}
+	if profiles, _ := ListProfiles(configDir); !slices.Equal(profiles, []string{DefaultProfile, "classroom"}) {
+		t.Errorf("Expected the default and classroom profiles, got %v", profiles)
+	}
+
+	// Switching loads the profile's settings and makes it active
+	var notified *Config
+	manager.AddWatcher(func(cfg *Config) { notified = cfg })
+	if err := manager.SwitchProfile("classroom"); err != nil {
+		t.Fatalf("Failed to switch profile: %v", err)
+	}
+	if manager.Get().UI.ShowAnimations || notified == nil || notified.UI.ShowAnimations {
+		t.Error("Switching should load and announce the classroom settings")
+	}
+	if ActiveProfile(configDir) != "classroom" {
+		t.Errorf("Expected classroom to become active, got %s", ActiveProfile(configDir))
+	}
+	if active, err := NewManager(); err != nil || active.Profile() != "classroom" {
+		t.Errorf("Expected the next launch to use the classroom profile, got %v", err)
+	}
+
+	// Switching to a new profile starts it from the defaults
+	if err := manager.SwitchProfile("streaming"); err != nil {
+		t.Fatalf("Failed to create profile by switching: %v", err)
+	}
+	if !manager.Get().UI.ShowAnimations {
+		t.Error("A new profile should start from the default settings")
+	}
+
+	// A pointer at a deleted profile falls back to the default
+	if err := os.Remove(ProfilePath(configDir, "streaming")); err != nil {
+		t.Fatal(err)
+	}
+	if ActiveProfile(configDir) != DefaultProfile {
+		t.Errorf("Expected a deleted active profile to fall back to the default, got %s", ActiveProfile(configDir))
+	}
+}
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"classroom", "stream-2", "my_profile"} {
+		if err := ValidateProfileName(name); err != nil {
+			t.Errorf("Expected %q to be valid: %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "Classroom", "../config", "-x", "a b"} {
+		if err := ValidateProfileName(name); err == nil {
+			t.Errorf("Expected %q to be rejected", name)
+		}
+	}
+	if _, err := NewManagerForProfile("../escape"); err == nil {
+		t.Error("An invalid profile name should not create a manager")
+	}
+}
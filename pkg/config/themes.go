@@ -0,0 +1,202 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"time"
+)
+
+// ThemesFileName is the file in the config directory holding custom themes
+const ThemesFileName = "themes.json"
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// builtinColorSchemes are the themes that ship with the application
+var builtinColorSchemes = []string{"default", "high-contrast", "colorblind-safe", "light", "matrix", "crt"}
+
+// ThemeDefinition is a user-defined palette. Colors are "#RRGGBB"; any left
+// out are taken from the built-in Base theme, or the default theme
+type ThemeDefinition struct {
+	Name       string `json:"name"`
+	Base       string `json:"base,omitempty"`
+	Primary    string `json:"primary,omitempty"`
+	Secondary  string `json:"secondary,omitempty"`
+	Accent     string `json:"accent,omitempty"`
+	Warning    string `json:"warning,omitempty"`
+	Text       string `json:"text,omitempty"`
+	Muted      string `json:"muted,omitempty"`
+	Border     string `json:"border,omitempty"`
+	Background string `json:"background,omitempty"`
+	Car        string `json:"car,omitempty"`
+	Goat       string `json:"goat,omitempty"`
+	Door       string `json:"door,omitempty"`
+	Selected   string `json:"selected,omitempty"`
+}
+
+// ThemesFile is the format of themes.json
+type ThemesFile struct {
+	Themes []ThemeDefinition `json:"themes"`
+}
+
+// Colors returns the theme's colors by their JSON name
+func (t ThemeDefinition) Colors() map[string]string {
+	return map[string]string{
+		"primary":    t.Primary,
+		"secondary":  t.Secondary,
+		"accent":     t.Accent,
+		"warning":    t.Warning,
+		"text":       t.Text,
+		"muted":      t.Muted,
+		"border":     t.Border,
+		"background": t.Background,
+		"car":        t.Car,
+		"goat":       t.Goat,
+		"door":       t.Door,
+		"selected":   t.Selected,
+	}
+}
+
+// Validate checks the theme's name, base and colors
+func (t ThemeDefinition) Validate() error {
+	if t.Name == "" {
+		return errors.New("theme has no name")
+	}
+	if slices.Contains(builtinColorSchemes, t.Name) {
+		return fmt.Errorf("theme %q: the name of a built-in theme", t.Name)
+	}
+	if t.Base != "" && !slices.Contains(builtinColorSchemes, t.Base) {
+		return fmt.Errorf("theme %q: unknown base theme %q", t.Name, t.Base)
+	}
+
+	for name, color := range t.Colors() {
+		if color != "" && !hexColorPattern.MatchString(color) {
+			return fmt.Errorf("theme %q: %s color %q is not #RRGGBB", t.Name, name, color)
+		}
+	}
+	return nil
+}
+
+// LoadThemes reads and validates custom themes. A missing file means no
+// custom themes
+func LoadThemes(path string) ([]ThemeDefinition, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read themes: %w", err)
+	}
+
+	var file ThemesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+
+	seen := make(map[string]bool)
+	for _, theme := range file.Themes {
+		if err := theme.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		if seen[theme.Name] {
+			return nil, fmt.Errorf("%s: theme %q is defined twice", filepath.Base(path), theme.Name)
+		}
+		seen[theme.Name] = true
+	}
+	return file.Themes, nil
+}
+
+// ThemesPath returns the path of the custom themes file in the config
+// directory, shared by all config profiles
+func (m *Manager) ThemesPath() string {
+	return filepath.Join(m.configDir(), ThemesFileName)
+}
+
+// Themes returns the custom themes loaded from the themes file
+func (m *Manager) Themes() []ThemeDefinition {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return slices.Clone(m.themes)
+}
+
+// ColorSchemes returns the built-in color schemes followed by the custom themes
+func (m *Manager) ColorSchemes() []string {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.colorSchemes()
+}
+
+// loadThemes reads the themes file, remembering its modification time and
+// any error. On error the themes loaded before are kept
+func (m *Manager) loadThemes() error {
+	path := m.ThemesPath()
+	var modTime time.Time
+	if info, err := os.Stat(path); err == nil {
+		modTime = info.ModTime()
+	}
+
+	themes, err := LoadThemes(path)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.themesModTime = modTime
+	m.themesErr = err
+	if err != nil {
+		return err
+	}
+	m.themes = themes
+	return nil
+}
+
+// ReloadThemesIfChanged reloads the themes file if it changed since it was
+// last read, and notifies watchers. A selected theme that no longer exists
+// falls back to the default color scheme
+func (m *Manager) ReloadThemesIfChanged() (bool, error) {
+	var modTime time.Time
+	if info, err := os.Stat(m.ThemesPath()); err == nil {
+		modTime = info.ModTime()
+	}
+
+	m.mutex.RLock()
+	unchanged := modTime.Equal(m.themesModTime)
+	m.mutex.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+
+	if err := m.loadThemes(); err != nil {
+		return false, err
+	}
+
+	m.mutex.Lock()
+	if !slices.Contains(m.colorSchemes(), m.config.UI.ColorScheme) {
+		m.config.UI.ColorScheme = DefaultConfig().UI.ColorScheme
+	}
+	config := m.config
+	m.mutex.Unlock()
+
+	for _, watcher := range m.watchers {
+		watcher(config)
+	}
+	return true, nil
+}
+
+// colorSchemes returns the valid color scheme names; the caller holds the mutex
+func (m *Manager) colorSchemes() []string {
+	schemes := GetColorSchemes()
+	for _, theme := range m.themes {
+		schemes = append(schemes, theme.Name)
+	}
+	return schemes
+}
+
+// ThemesError returns why the themes file last failed to load, or nil
+func (m *Manager) ThemesError() error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.themesErr
+}
@@ -0,0 +1,111 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func writeThemes(t *testing.T, path, data string, modTime time.Time) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("Failed to write themes: %v", err)
+	}
+	// Set the time explicitly, as writes within the same tick look unchanged
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatalf("Failed to set themes time: %v", err)
+	}
+}
+
+func TestLoadThemes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ThemesFileName)
+
+	themes, err := LoadThemes(path)
+	if err != nil || themes != nil {
+		t.Fatalf("Expected no themes for a missing file, got %v, %v", themes, err)
+	}
+
+	tests := []struct {
+		name  string
+		data  string
+		valid bool
+	}{
+		{"valid", `{"themes": [{"name": "ocean", "base": "light", "primary": "#0077BE", "car": "#ffd700"}]}`, true},
+		{"no name", `{"themes": [{"primary": "#0077BE"}]}`, false},
+		{"built-in name", `{"themes": [{"name": "light"}]}`, false},
+		{"unknown base", `{"themes": [{"name": "ocean", "base": "sepia"}]}`, false},
+		{"bad color", `{"themes": [{"name": "ocean", "door": "blue"}]}`, false},
+		{"short color", `{"themes": [{"name": "ocean", "door": "#FFF"}]}`, false},
+		{"duplicate", `{"themes": [{"name": "ocean"}, {"name": "ocean"}]}`, false},
+		{"malformed", `{"themes": [`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := os.WriteFile(path, []byte(tt.data), 0644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := LoadThemes(path)
+			if (err == nil) != tt.valid {
+				t.Errorf("LoadThemes() error = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestManagerThemes(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	themesPath := filepath.Join(tempDir, ThemesFileName)
+	start := time.Now().Add(-time.Hour)
+
+	writeThemes(t, themesPath, `{"themes": [{"name": "ocean", "primary": "#0077BE"}]}`, start)
+
+	manager, err := NewManagerWithPath(configPath)
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+	if !slices.Contains(manager.ColorSchemes(), "ocean") {
+		t.Fatalf("Expected the custom theme in %v", manager.ColorSchemes())
+	}
+
+	config := manager.Get()
+	config.UI.ColorScheme = "ocean"
+	if err := manager.Update(config); err != nil {
+		t.Fatalf("Expected a custom theme to be selectable: %v", err)
+	}
+
+	if changed, err := manager.ReloadThemesIfChanged(); changed || err != nil {
+		t.Errorf("Expected no reload of an unchanged file, got %v, %v", changed, err)
+	}
+
+	var notified *Config
+	manager.AddWatcher(func(c *Config) { notified = c })
+
+	// A broken edit is reported and the loaded themes are kept
+	writeThemes(t, themesPath, `{"themes": [{"name": "ocean", "primary": "blue"}]}`, start.Add(time.Minute))
+	if _, err := manager.ReloadThemesIfChanged(); err == nil {
+		t.Error("Expected an invalid themes file to be reported")
+	}
+	if manager.ThemesError() == nil || len(manager.Themes()) != 1 {
+		t.Error("Expected the previous themes to be kept")
+	}
+
+	// Removing the selected theme falls back to the default scheme
+	writeThemes(t, themesPath, `{"themes": [{"name": "forest", "primary": "#228B22"}]}`, start.Add(2*time.Minute))
+	changed, err := manager.ReloadThemesIfChanged()
+	if !changed || err != nil {
+		t.Fatalf("Expected the themes to reload, got %v, %v", changed, err)
+	}
+	if manager.ThemesError() != nil {
+		t.Errorf("Expected the error to clear, got %v", manager.ThemesError())
+	}
+	if got := manager.Get().UI.ColorScheme; got != DefaultConfig().UI.ColorScheme {
+		t.Errorf("Expected the default scheme after removing the theme, got %s", got)
+	}
+	if notified == nil {
+		t.Error("Expected watchers to be notified of the reload")
+	}
+}
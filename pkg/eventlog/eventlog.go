@@ -0,0 +1,144 @@
+// Package eventlog keeps a bounded, persistent log of app events such as
+// completed exports, errors and unlocked achievements, so messages that were
+// only on screen briefly can be reviewed later
+package eventlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+)
+
+// DefaultFileName is the name of the event log file
+const DefaultFileName = "monty_hall_events.json"
+
+// MaxEvents is how many events are kept; older ones are dropped
+const MaxEvents = 200
+
+// Kind classifies an event
+type Kind string
+
+const (
+	KindSuccess     Kind = "success"
+	KindError       Kind = "error"
+	KindAchievement Kind = "achievement"
+)
+
+// Event is one logged message
+type Event struct {
+	Time    time.Time `json:"time"`
+	Kind    Kind      `json:"kind"`
+	Message string    `json:"message"`
+}
+
+// Log is a bounded list of events, oldest first, persisted to a file
+type Log struct {
+	filePath string
+	events   []Event
+	readOnly bool // Keep events in memory only
+}
+
+// NewLog creates a log backed by the given file; an empty path keeps events
+// for the session only
+func NewLog(filePath string) *Log {
+	return &Log{
+		filePath: filePath,
+		readOnly: filePath == "",
+	}
+}
+
+// NewReadOnlyLog creates a log that loads events but never writes them
+func NewReadOnlyLog(filePath string) *Log {
+	l := NewLog(filePath)
+	l.readOnly = true
+	return l
+}
+
+// Load reads the events; a missing file means none have been logged
+func (l *Log) Load() error {
+	if l.filePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(l.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			l.events = nil
+			return nil
+		}
+		return fmt.Errorf("failed to read event log: %w", err)
+	}
+
+	var events []Event
+	if err := json.Unmarshal(data, &events); err != nil {
+		return fmt.Errorf("failed to unmarshal event log: %w", err)
+	}
+
+	l.events = trim(events)
+	return nil
+}
+
+// Save writes the events; read-only logs keep them in memory
+func (l *Log) Save() error {
+	if l.readOnly {
+		return nil
+	}
+
+	dir := filepath.Dir(l.filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(l.events, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal event log: %w", err)
+	}
+
+	if err := chaos.WriteFile(l.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write event log: %w", err)
+	}
+
+	return nil
+}
+
+// Add logs an event, dropping the oldest once there are more than MaxEvents
+func (l *Log) Add(event Event) error {
+	l.events = trim(append(l.events, event))
+	return l.Save()
+}
+
+// Events returns the logged events, newest first
+func (l *Log) Events() []Event {
+	events := slices.Clone(l.events)
+	slices.Reverse(events)
+	return events
+}
+
+// Len returns how many events are logged
+func (l *Log) Len() int {
+	return len(l.events)
+}
+
+// Clear removes every event
+func (l *Log) Clear() error {
+	l.events = nil
+	return l.Save()
+}
+
+// GetFilePath returns the path of the event log file
+func (l *Log) GetFilePath() string {
+	return l.filePath
+}
+
+// trim keeps the newest MaxEvents events
+func trim(events []Event) []Event {
+	if len(events) > MaxEvents {
+		return events[len(events)-MaxEvents:]
+	}
+	return events
+}
@@ -0,0 +1,70 @@
+package eventlog
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+
+func TestLogPersistsNewestFirst(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	log := NewLog(path)
+	if err := log.Load(); err != nil || log.Len() != 0 {
+		t.Fatalf("Expected a missing file to load as empty, got %d events (%v)", log.Len(), err)
+	}
+
+	log.Add(Event{Time: start, Kind: KindSuccess, Message: "Statistics exported"})
+	log.Add(Event{Time: start.Add(time.Minute), Kind: KindError, Message: "Failed to save"})
+
+	reloaded := NewLog(path)
+	if err := reloaded.Load(); err != nil {
+		t.Fatal(err)
+	}
+	events := reloaded.Events()
+	if len(events) != 2 || events[0].Kind != KindError || events[1].Message != "Statistics exported" {
+		t.Errorf("Expected both events newest first, got %+v", events)
+	}
+	if !events[1].Time.Equal(start) {
+		t.Errorf("Expected the time to be kept, got %v", events[1].Time)
+	}
+
+	if err := reloaded.Clear(); err != nil {
+		t.Fatal(err)
+	}
+	log.Load()
+	if log.Len() != 0 {
+		t.Errorf("Expected clearing to persist, got %d events", log.Len())
+	}
+}
+
+func TestLogIsBounded(t *testing.T) {
+	log := NewLog("")
+	for i := range MaxEvents + 10 {
+		log.Add(Event{Time: start.Add(time.Duration(i) * time.Second), Kind: KindSuccess})
+	}
+
+	events := log.Events()
+	if len(events) != MaxEvents {
+		t.Fatalf("Expected %d events, got %d", MaxEvents, len(events))
+	}
+	if oldest := events[len(events)-1].Time; !oldest.Equal(start.Add(10 * time.Second)) {
+		t.Errorf("Expected the oldest events to be dropped, oldest is %v", oldest)
+	}
+}
+
+func TestReadOnlyLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	log := NewReadOnlyLog(path)
+	log.Add(Event{Time: start, Kind: KindSuccess, Message: "kept in memory"})
+	if log.Len() != 1 {
+		t.Fatal("Expected the event in memory")
+	}
+
+	reloaded := NewLog(path)
+	reloaded.Load()
+	if reloaded.Len() != 0 {
+		t.Error("Read-only logs should not write the file")
+	}
+}
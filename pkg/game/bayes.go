@@ -0,0 +1,84 @@
+package game
+
+import "slices"
+
+// DoorBelief is the Bayesian update for one door once the host has opened doors
+type DoorBelief struct {
+	Prior      float64 // Chance the car is behind the door before the host acts
+	Likelihood float64 // Chance of what the host showed if the car is behind the door
+	Posterior  float64 // Chance the car is behind the door after seeing what the host showed
+}
+
+// BayesianUpdate returns the prior, likelihood and posterior of the car being
+// behind each door, given the player's first choice and the doors the host
+// opened with what they revealed. It returns nil until the host has opened doors
+func (g *Game) BayesianUpdate() []DoorBelief {
+	if len(g.HostOpenedDoors) == 0 {
+		return nil
+	}
+
+	beliefs := make([]DoorBelief, len(g.Doors))
+	evidence := 0.0
+	for car := range g.Doors {
+		belief := &beliefs[car]
+		belief.Prior = 1 / float64(len(g.Doors))
+		if g.showsCarAt(car) {
+			belief.Likelihood = g.Host.OpeningChance(len(g.Doors), g.PlayerInitialChoice, car, g.HostOpenedDoors)
+		}
+		evidence += belief.Prior * belief.Likelihood
+	}
+
+	for i := range beliefs {
+		if evidence > 0 {
+			beliefs[i].Posterior = beliefs[i].Prior * beliefs[i].Likelihood / evidence
+		}
+	}
+	return beliefs
+}
+
+// showsCarAt reports whether the opened doors look as they would with the car
+// behind door car: a goat behind each, unless car is one of them
+func (g *Game) showsCarAt(car int) bool {
+	for _, door := range g.HostOpenedDoors {
+		if g.Doors[door].HasCar() != (door == car) {
+			return false
+		}
+	}
+	return true
+}
+
+// OpeningChance returns the chance that the host opens exactly the doors
+// opened, in door order, when the player chose playerChoice and the car is
+// behind door car
+func (h *Host) OpeningChance(numDoors, playerChoice, car int, opened []int) float64 {
+	// The doors the host picks from: a falling host doesn't know where the car is
+	var candidates []int
+	for i := range numDoors {
+		if i != playerChoice && (h.Behavior == HostFall || i != car) {
+			candidates = append(candidates, i)
+		}
+	}
+	for _, door := range opened {
+		if !slices.Contains(candidates, door) {
+			return 0
+		}
+	}
+
+	if h.Behavior == HostCrawl {
+		// Always the lowest-numbered goats
+		if slices.Equal(candidates[:min(len(opened), len(candidates))], opened) {
+			return 1
+		}
+		return 0
+	}
+	return 1 / binomial(len(candidates), len(opened))
+}
+
+// binomial returns the number of ways to choose k of n things
+func binomial(n, k int) float64 {
+	result := 1.0
+	for i := range k {
+		result = result * float64(n-i) / float64(i+1)
+	}
+	return result
+}
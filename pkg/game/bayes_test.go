@@ -0,0 +1,78 @@
+package game
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBayesianUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		behavior   HostBehavior
+		car        int
+		numDoors   int
+		opened     []int
+		likelihood []float64
+		posterior  []float64
+	}{
+		{"classic", HostClassic, 2, 3, []int{1}, []float64{0.5, 0, 1}, []float64{1.0 / 3, 0, 2.0 / 3}},
+		{"classic, 4 doors, 1 opened", HostClassic, 3, 4, []int{1}, []float64{1.0 / 3, 0, 0.5, 0.5}, []float64{0.25, 0, 0.375, 0.375}},
+		{"fall, goat revealed", HostFall, 2, 3, []int{1}, []float64{0.5, 0, 0.5}, []float64{0.5, 0, 0.5}},
+		{"fall, car revealed", HostFall, 2, 3, []int{2}, []float64{0, 0, 0.5}, []float64{0, 0, 1}},
+		{"crawl, lowest doors", HostCrawl, 3, 4, []int{1, 2}, []float64{1, 0, 0, 1}, []float64{0.5, 0, 0, 0.5}},
+		{"crawl, door skipped", HostCrawl, 2, 4, []int{1, 3}, []float64{0, 0, 1, 0}, []float64{0, 0, 1, 0}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := newGame(CreateNDoorsWithCarAt(test.numDoors, test.car), NewHost())
+			g.Host.Behavior = test.behavior
+			if g.BayesianUpdate() != nil {
+				t.Error("There is nothing to update on before the host opens a door")
+			}
+
+			g.PlayerInitialChoice = 0
+			g.HostOpenedDoors = test.opened
+			beliefs := g.BayesianUpdate()
+			for i, belief := range beliefs {
+				if belief.Prior != 1/float64(test.numDoors) {
+					t.Errorf("Door %d: expected a uniform prior, got %f", i+1, belief.Prior)
+				}
+				if math.Abs(belief.Likelihood-test.likelihood[i]) > 1e-9 || math.Abs(belief.Posterior-test.posterior[i]) > 1e-9 {
+					t.Errorf("Door %d: expected likelihood %f and posterior %f, got %f and %f",
+						i+1, test.likelihood[i], test.posterior[i], belief.Likelihood, belief.Posterior)
+				}
+			}
+		})
+	}
+}
+
+func TestBayesianUpdateMatchesExpectedWinRates(t *testing.T) {
+	// The crawling host's choice of doors gives away more than the average
+	// rates, so it is left out
+	for _, behavior := range []HostBehavior{HostClassic, HostFall} {
+		g := newGame(CreateNDoorsWithCarAt(5, 4), NewHost())
+		g.Host.Behavior = behavior
+		g.Host.Opens = 2
+		if err := g.MakeInitialChoice(0); err != nil {
+			t.Fatal(err)
+		}
+		if g.CarRevealed() {
+			continue
+		}
+
+		beliefs := g.BayesianUpdate()
+		stay, switchDoors := behavior.ExpectedWinRatesOpening(5, 2)
+		if math.Abs(beliefs[0].Posterior-stay) > 1e-9 {
+			t.Errorf("%s: expected staying to win %f, got %f", behavior, stay, beliefs[0].Posterior)
+		}
+		for i, belief := range beliefs[1:] {
+			if g.Doors[i+1].IsOpen() {
+				continue
+			}
+			if math.Abs(belief.Posterior-switchDoors) > 1e-9 {
+				t.Errorf("%s: expected door %d to win %f, got %f", behavior, i+2, switchDoors, belief.Posterior)
+			}
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package game
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DailyRounds     = 5          // Number of games in each daily challenge
+	DailyDateLayout = "20060102" // Date format used in seeds and share codes
+	shareCodePrefix = "MH"
+)
+
+// shareKey signs share codes. It ships with every build, so the signature only
+// guards against casual edits; verification also replays every round from the seed.
+var shareKey = []byte("monty-hall-daily-challenge")
+
+var (
+	ErrInvalidShareCode = errors.New("invalid share code")
+	ErrInvalidSignature = errors.New("share code signature does not match")
+	ErrResultMismatch   = errors.New("claimed wins do not match the replayed games")
+)
+
+// DailySeed returns the seed for a round of the daily challenge on the given date
+func DailySeed(date string, round int) int64 {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "monty-hall:%s:%d", date, round)
+	return int64(h.Sum64())
+}
+
+// DailyRound records the player's choices in one daily challenge game
+type DailyRound struct {
+	InitialChoice int  // The door initially chosen (0-2)
+	Switched      bool // Whether the player switched doors
+	Won           bool // Whether the player won the car
+}
+
+// DailyChallenge tracks a player's progress through the game of the day
+type DailyChallenge struct {
+	Date   string
+	Rounds []DailyRound
+}
+
+// NewDailyChallenge creates the challenge for the given day
+func NewDailyChallenge(day time.Time) *DailyChallenge {
+	return &DailyChallenge{
+		Date:   day.Format(DailyDateLayout),
+		Rounds: make([]DailyRound, 0, DailyRounds),
+	}
+}
+
+// NextGame returns the seeded game for the next unplayed round
+func (dc *DailyChallenge) NextGame() *Game {
+	return NewSeededGame(DailySeed(dc.Date, len(dc.Rounds)))
+}
+
+// Record adds the result of the current round to the challenge
+func (dc *DailyChallenge) Record(result *GameResult) error {
+	if result == nil {
+		return errors.New("result cannot be nil")
+	}
+	if dc.IsComplete() {
+		return errors.New("daily challenge already complete")
+	}
+
+	dc.Rounds = append(dc.Rounds, DailyRound{
+		InitialChoice: result.InitialChoice - 1, // GameResult doors are 1-indexed
+		Switched:      result.Strategy == Switch,
+		Won:           result.Won,
+	})
+	return nil
+}
+
+// IsComplete returns true once every round has been played
+func (dc *DailyChallenge) IsComplete() bool {
+	return len(dc.Rounds) >= DailyRounds
+}
+
+// Wins returns the number of rounds won
+func (dc *DailyChallenge) Wins() int {
+	wins := 0
+	for _, round := range dc.Rounds {
+		if round.Won {
+			wins++
+		}
+	}
+	return wins
+}
+
+// ShareCode returns a signed code describing the challenge results
+func (dc *DailyChallenge) ShareCode() string {
+	return ShareCode{Date: dc.Date, Rounds: dc.Rounds, Wins: dc.Wins()}.String()
+}
+
+// ShareCode holds the decoded contents of a daily challenge share code.
+// The encoded form is MH-<date>-<moves>-<wins>-<signature>, where each move is
+// the initially chosen door (1-3) followed by S for switch or K for keep.
+type ShareCode struct {
+	Date   string
+	Rounds []DailyRound
+	Wins   int
+}
+
+// String encodes and signs the share code
+func (sc ShareCode) String() string {
+	payload := sc.payload()
+	return fmt.Sprintf("%s-%s-%s", shareCodePrefix, payload, sc.signature(payload))
+}
+
+// payload returns the signed portion of the code
+func (sc ShareCode) payload() string {
+	var moves strings.Builder
+	for _, round := range sc.Rounds {
+		moves.WriteString(strconv.Itoa(round.InitialChoice + 1))
+		if round.Switched {
+			moves.WriteByte('S')
+		} else {
+			moves.WriteByte('K')
+		}
+	}
+	return fmt.Sprintf("%s-%s-%d", sc.Date, moves.String(), sc.Wins)
+}
+
+// signature returns the truncated HMAC of the payload
+func (sc ShareCode) signature(payload string) string {
+	mac := hmac.New(sha256.New, shareKey)
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil)[:6])
+}
+
+// ParseShareCode decodes a share code without verifying it
+func ParseShareCode(code string) (*ShareCode, error) {
+	parts := strings.Split(strings.TrimSpace(code), "-")
+	if len(parts) != 5 || parts[0] != shareCodePrefix {
+		return nil, fmt.Errorf("%w: expected %s-<date>-<moves>-<wins>-<signature>", ErrInvalidShareCode, shareCodePrefix)
+	}
+
+	date, moves, winsText := parts[1], parts[2], parts[3]
+	if _, err := time.Parse(DailyDateLayout, date); err != nil {
+		return nil, fmt.Errorf("%w: bad date %q", ErrInvalidShareCode, date)
+	}
+
+	if len(moves) == 0 || len(moves)%2 != 0 || len(moves)/2 > DailyRounds {
+		return nil, fmt.Errorf("%w: bad moves %q", ErrInvalidShareCode, moves)
+	}
+
+	sc := &ShareCode{Date: date}
+	for i := 0; i < len(moves); i += 2 {
+		door := int(moves[i] - '1')
+		if door < 0 || door >= NumDoors {
+			return nil, fmt.Errorf("%w: bad door in round %d", ErrInvalidShareCode, i/2+1)
+		}
+
+		var switched bool
+		switch moves[i+1] {
+		case 'S':
+			switched = true
+		case 'K':
+			switched = false
+		default:
+			return nil, fmt.Errorf("%w: bad strategy in round %d", ErrInvalidShareCode, i/2+1)
+		}
+
+		sc.Rounds = append(sc.Rounds, DailyRound{InitialChoice: door, Switched: switched})
+	}
+
+	wins, err := strconv.Atoi(winsText)
+	if err != nil || wins < 0 || wins > len(sc.Rounds) {
+		return nil, fmt.Errorf("%w: bad win count %q", ErrInvalidShareCode, winsText)
+	}
+	sc.Wins = wins
+
+	if !hmac.Equal([]byte(parts[4]), []byte(sc.signature(sc.payload()))) {
+		return sc, ErrInvalidSignature
+	}
+
+	return sc, nil
+}
+
+// Verify replays every round from the daily seed and checks the claimed wins
+func (sc *ShareCode) Verify() error {
+	wins := 0
+	for i, round := range sc.Rounds {
+		g := NewSeededGame(DailySeed(sc.Date, i))
+		if err := g.MakeInitialChoice(round.InitialChoice); err != nil {
+			return fmt.Errorf("round %d: %w", i+1, err)
+		}
+
+		var err error
+		if round.Switched {
+			err = g.SwitchChoice()
+		} else {
+			err = g.StayWithChoice()
+		}
+		if err != nil {
+			return fmt.Errorf("round %d: %w", i+1, err)
+		}
+
+		sc.Rounds[i].Won = g.Result.Won
+		if g.Result.Won {
+			wins++
+		}
+	}
+
+	if wins != sc.Wins {
+		return fmt.Errorf("%w: claimed %d, replay gives %d", ErrResultMismatch, sc.Wins, wins)
+	}
+	return nil
+}
+
+// VerifyShareCode parses a share code and checks both its signature and its results
+func VerifyShareCode(code string) (*ShareCode, error) {
+	sc, err := ParseShareCode(code)
+	if err != nil {
+		return sc, err
+	}
+	return sc, sc.Verify()
+}
@@ -0,0 +1,131 @@
+package game
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// playDailyChallenge plays every round, switching on even rounds
+func playDailyChallenge(t *testing.T, day time.Time) *DailyChallenge {
+	t.Helper()
+
+	dc := NewDailyChallenge(day)
+	for !dc.IsComplete() {
+		g := dc.NextGame()
+		if err := g.MakeInitialChoice(len(dc.Rounds) % NumDoors); err != nil {
+			t.Fatalf("Unexpected error making initial choice: %v", err)
+		}
+
+		var err error
+		if len(dc.Rounds)%2 == 0 {
+			err = g.SwitchChoice()
+		} else {
+			err = g.StayWithChoice()
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error making final choice: %v", err)
+		}
+
+		if err := dc.Record(g.Result); err != nil {
+			t.Fatalf("Unexpected error recording round: %v", err)
+		}
+	}
+	return dc
+}
+
+func TestNewSeededGameIsDeterministic(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		g1 := NewSeededGame(seed)
+		g2 := NewSeededGame(seed)
+
+		if g1.CarPosition != g2.CarPosition {
+			t.Fatalf("Seed %d: car positions differ (%d vs %d)", seed, g1.CarPosition, g2.CarPosition)
+		}
+
+		// Choosing the car door leaves the host a random choice between two goats
+		g1.MakeInitialChoice(g1.CarPosition)
+		g2.MakeInitialChoice(g2.CarPosition)
+		if g1.HostOpenedDoor != g2.HostOpenedDoor {
+			t.Fatalf("Seed %d: host opened different doors (%d vs %d)", seed, g1.HostOpenedDoor, g2.HostOpenedDoor)
+		}
+	}
+}
+
+func TestDailySeedVariesByDateAndRound(t *testing.T) {
+	if DailySeed("20260101", 0) == DailySeed("20260102", 0) {
+		t.Error("Different dates should produce different seeds")
+	}
+
+	if DailySeed("20260101", 0) == DailySeed("20260101", 1) {
+		t.Error("Different rounds should produce different seeds")
+	}
+}
+
+func TestDailyChallengeRecord(t *testing.T) {
+	dc := playDailyChallenge(t, time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC))
+
+	if dc.Date != "20260314" {
+		t.Errorf("Expected date 20260314, got %s", dc.Date)
+	}
+
+	if len(dc.Rounds) != DailyRounds {
+		t.Errorf("Expected %d rounds, got %d", DailyRounds, len(dc.Rounds))
+	}
+
+	if err := dc.Record(&GameResult{}); err == nil {
+		t.Error("Expected error recording beyond the final round")
+	}
+}
+
+func TestShareCodeRoundTrip(t *testing.T) {
+	dc := playDailyChallenge(t, time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC))
+	code := dc.ShareCode()
+
+	if !strings.HasPrefix(code, "MH-20260314-") {
+		t.Errorf("Unexpected share code format: %s", code)
+	}
+
+	sc, err := VerifyShareCode(code)
+	if err != nil {
+		t.Fatalf("Expected genuine code to verify, got %v", err)
+	}
+
+	if sc.Wins != dc.Wins() {
+		t.Errorf("Expected %d wins, got %d", dc.Wins(), sc.Wins)
+	}
+
+	for i, round := range sc.Rounds {
+		if round != dc.Rounds[i] {
+			t.Errorf("Round %d: expected %+v, got %+v", i+1, dc.Rounds[i], round)
+		}
+	}
+}
+
+func TestShareCodeRejectsTampering(t *testing.T) {
+	dc := playDailyChallenge(t, time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC))
+	parts := strings.Split(dc.ShareCode(), "-")
+
+	// Editing a move invalidates the signature
+	moves := []byte(parts[2])
+	if moves[1] == 'S' {
+		moves[1] = 'K'
+	} else {
+		moves[1] = 'S'
+	}
+	parts[2] = string(moves)
+	if _, err := VerifyShareCode(strings.Join(parts, "-")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Expected ErrInvalidSignature, got %v", err)
+	}
+
+	// A correctly signed code with an inflated score fails the replay
+	inflated := ShareCode{Date: dc.Date, Rounds: dc.Rounds, Wins: (dc.Wins() + 1) % (DailyRounds + 1)}
+	if _, err := VerifyShareCode(inflated.String()); !errors.Is(err, ErrResultMismatch) {
+		t.Errorf("Expected ErrResultMismatch, got %v", err)
+	}
+
+	if _, err := VerifyShareCode("not-a-code"); !errors.Is(err, ErrInvalidShareCode) {
+		t.Errorf("Expected ErrInvalidShareCode, got %v", err)
+	}
+}
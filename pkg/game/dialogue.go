@@ -0,0 +1,243 @@
+package game
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultHostPersonality is the host the game has always had; it has no lines
+// of its own, so the standard ones are shown
+const DefaultHostPersonality = "standard"
+
+// MaxRevealPace is the most a personality may stretch the host's pause before the result
+const MaxRevealPace = 3.0
+
+// Keys of the lines the host says during a game. A personality may also give
+// result lines, keyed like the result narratives: "win", "switch_loss" or
+// "win_streak"
+const (
+	DialogueGreeting = "greeting" // While the first door is chosen
+	DialogueOffer    = "offer"    // When the host offers the switch
+	DialogueReveal   = "reveal"   // During the pause before the result
+)
+
+//go:embed host_dialogue/*.json
+var embeddedHostDialogue embed.FS
+
+// HostPersonality is how the host talks: the lines said at each step of the
+// game, how long the pause before the result lasts and whether emoji are used.
+// Personalities are data, one JSON file each in host_dialogue, so a new one
+// only needs a new file. Lines may contain {door}, {doors}, {opened}, {stay}
+// and {switch}; see Say
+type HostPersonality struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	RevealPace  float64             `json:"reveal_pace"` // Multiplies the pause before the result
+	Emoji       bool                `json:"emoji"`       // False strips emoji from everything the host says
+	Dialogue    map[string][]string `json:"lines"`       // Lines by key; keys left out keep the standard lines
+}
+
+// DialogueKeys returns the keys a personality may give lines for
+func DialogueKeys() []string {
+	return []string{
+		DialogueGreeting, DialogueOffer, DialogueReveal,
+		"win", "loss", "switch_win", "switch_loss", "stay_win", "stay_loss", "win_streak", "loss_streak",
+	}
+}
+
+// Validate checks the personality's name, pace and lines
+func (p *HostPersonality) Validate() error {
+	if p.Name == "" {
+		return errors.New("personality has no name")
+	}
+	if p.RevealPace <= 0 || p.RevealPace > MaxRevealPace {
+		return fmt.Errorf("reveal pace must be above 0 and at most %g, got %g", MaxRevealPace, p.RevealPace)
+	}
+	for key, lines := range p.Dialogue {
+		if !slices.Contains(DialogueKeys(), key) {
+			return fmt.Errorf("unknown line key %q", key)
+		}
+		if len(lines) == 0 {
+			return fmt.Errorf("%s has no lines", key)
+		}
+		for i, line := range lines {
+			if strings.TrimSpace(line) == "" {
+				return fmt.Errorf("%s line %d is empty", key, i+1)
+			}
+		}
+	}
+	return nil
+}
+
+// Lines returns the personality's lines for key, as written
+func (p *HostPersonality) Lines(key string) []string {
+	return p.Dialogue[key]
+}
+
+// Narrates reports whether the personality announces results in its own words
+func (p *HostPersonality) Narrates() bool {
+	for key := range p.Dialogue {
+		if key != DialogueGreeting && key != DialogueOffer && key != DialogueReveal {
+			return true
+		}
+	}
+	return false
+}
+
+// Say returns one of the lines for key about the game g, or "" when the
+// personality has none. pick chooses the line, so a game can keep the same
+// line while it is shown. The placeholders are replaced by the player's door
+// {door}, the number of doors {doors}, the doors the host opened {opened},
+// and the chance of winning by staying {stay} or switching {switch}
+func (p *HostPersonality) Say(key string, g *Game, pick int) string {
+	lines := p.Dialogue[key]
+	if len(lines) == 0 {
+		return ""
+	}
+	line := lines[pick%len(lines)]
+	if g != nil {
+		line = g.dialogueReplacer().Replace(line)
+	}
+	return p.Voice(line)
+}
+
+// Voice returns line as the personality would say it, without emoji unless it uses them
+func (p *HostPersonality) Voice(line string) string {
+	if p.Emoji {
+		return line
+	}
+	return StripEmoji(line)
+}
+
+// Pace stretches or shortens the host's pause before the result
+func (p *HostPersonality) Pace(delay time.Duration) time.Duration {
+	return time.Duration(float64(delay) * p.RevealPace)
+}
+
+// dialogueReplacer fills in the placeholders of the host's lines
+func (g *Game) dialogueReplacer() *strings.Replacer {
+	door := g.PlayerInitialChoice
+	if g.Phase == GameOver {
+		door = g.PlayerFinalChoice
+	}
+
+	opened := make([]string, len(g.HostOpenedDoors))
+	for i, d := range g.HostOpenedDoors {
+		opened[i] = strconv.Itoa(d + 1)
+	}
+
+	// Before the host acts every door is as likely as any other
+	stay := 1 / float64(len(g.Doors))
+	switchDoors := 1 - stay
+	if beliefs := g.BayesianUpdate(); beliefs != nil && door >= 0 {
+		stay = beliefs[door].Posterior
+		switchDoors = 0
+		for d, belief := range beliefs {
+			if d != door && !slices.Contains(g.HostOpenedDoors, d) {
+				switchDoors += belief.Posterior
+			}
+		}
+	}
+
+	return strings.NewReplacer(
+		"{door}", strconv.Itoa(door+1),
+		"{doors}", strconv.Itoa(len(g.Doors)),
+		"{opened}", strings.Join(opened, ", "),
+		"{stay}", fmt.Sprintf("%.0f%%", stay*100),
+		"{switch}", fmt.Sprintf("%.0f%%", switchDoors*100),
+	)
+}
+
+// isEmoji reports whether r is an emoji, or joins or styles one
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // Pictographs, emoticons, transport and symbols
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // Miscellaneous symbols and dingbats
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // Stars, circles and arrows used as emoji
+		return true
+	case r == 0x200D || r == 0xFE0F || r == 0x20E3: // Joiner, emoji style and keycap
+		return true
+	}
+	return false
+}
+
+// StripEmoji removes emoji from s, along with the spaces they leave doubled
+func StripEmoji(s string) string {
+	stripped := strings.Map(func(r rune) rune {
+		if isEmoji(r) {
+			return -1
+		}
+		return r
+	}, s)
+	return strings.Join(strings.Fields(stripped), " ")
+}
+
+// parseHostPersonality decodes and validates a personality
+func parseHostPersonality(data []byte, source string) (*HostPersonality, error) {
+	var p HostPersonality
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	return &p, nil
+}
+
+// hostPersonalities loads the embedded personalities once, sorted by name
+var hostPersonalities = sync.OnceValue(func() []*HostPersonality {
+	entries, _ := fs.ReadDir(embeddedHostDialogue, "host_dialogue")
+
+	var personalities []*HostPersonality
+	for _, entry := range entries {
+		data, err := embeddedHostDialogue.ReadFile("host_dialogue/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		if p, err := parseHostPersonality(data, entry.Name()); err == nil {
+			personalities = append(personalities, p)
+		}
+	}
+	sort.Slice(personalities, func(i, j int) bool { return personalities[i].Name < personalities[j].Name })
+	return personalities
+})
+
+// HostPersonalities returns the host personalities, sorted by name
+func HostPersonalities() []*HostPersonality {
+	return hostPersonalities()
+}
+
+// HostPersonalityNames returns the names of the host personalities
+func HostPersonalityNames() []string {
+	names := make([]string, 0, len(HostPersonalities()))
+	for _, p := range HostPersonalities() {
+		names = append(names, p.Name)
+	}
+	return names
+}
+
+// FindHostPersonality returns the personality with the given name, falling
+// back to the standard host when there is none
+func FindHostPersonality(name string) (*HostPersonality, bool) {
+	var fallback *HostPersonality
+	for _, p := range HostPersonalities() {
+		if strings.EqualFold(p.Name, name) {
+			return p, true
+		}
+		if p.Name == DefaultHostPersonality {
+			fallback = p
+		}
+	}
+	return fallback, false
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"io/fs"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHostPersonalities(t *testing.T) {
+	files, _ := fs.Glob(embeddedHostDialogue, "host_dialogue/*.json")
+	if len(HostPersonalities()) != len(files) {
+		t.Fatalf("Expected every personality in host_dialogue to load, got %v", HostPersonalityNames())
+	}
+	for _, name := range []string{"standard", "deadpan", "dramatic", "statistician"} {
+		if _, found := FindHostPersonality(name); !found {
+			t.Errorf("Expected the %s host", name)
+		}
+	}
+
+	standard, found := FindHostPersonality("grumpy")
+	if found || standard.Name != DefaultHostPersonality || len(standard.Dialogue) != 0 {
+		t.Errorf("Expected an unknown personality to fall back to the standard host with no lines, got %+v", standard)
+	}
+
+	deadpan, _ := FindHostPersonality("Deadpan")
+	if deadpan.Pace(2*time.Second) != time.Second {
+		t.Errorf("Expected the deadpan host to halve the pause, got %v", deadpan.Pace(2*time.Second))
+	}
+	for key := range deadpan.Dialogue {
+		for _, line := range deadpan.Lines(key) {
+			if deadpan.Voice(line) != line {
+				t.Errorf("Expected no emoji from the deadpan host, got %q", line)
+			}
+		}
+	}
+}
+
+func TestHostPersonalitySay(t *testing.T) {
+	statistician, _ := FindHostPersonality("statistician")
+
+	g := NewSeededGame(1)
+	if line := statistician.Say(DialogueGreeting, g, 0); !strings.Contains(line, "3 doors") || !strings.Contains(line, "33%") {
+		t.Errorf("Expected the doors and the odds of a pick, got %q", line)
+	}
+
+	door := (g.CarPosition + 1) % 3
+	if err := g.MakeInitialChoice(door); err != nil {
+		t.Fatal(err)
+	}
+	line := statistician.Say(DialogueOffer, g, 0)
+	for _, want := range []string{"opened " + strconv.Itoa(g.HostOpenedDoor+1), "Staying on " + strconv.Itoa(door+1), "wins 33%", "switching wins 67%"} {
+		if !strings.Contains(line, want) {
+			t.Errorf("Expected %q in the offer, got %q", want, line)
+		}
+	}
+
+	if line := statistician.Say("win", g, 0); line != "" {
+		t.Errorf("Expected no line for a key the personality leaves out, got %q", line)
+	}
+}
+
+func TestHostPersonalityValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		personality HostPersonality
+	}{
+		{"no name", HostPersonality{RevealPace: 1}},
+		{"no pace", HostPersonality{Name: "quiet"}},
+		{"pace too long", HostPersonality{Name: "slow", RevealPace: MaxRevealPace + 1}},
+		{"unknown key", HostPersonality{Name: "chatty", RevealPace: 1, Dialogue: map[string][]string{"farewell": {"Bye"}}}},
+		{"empty line", HostPersonality{Name: "mute", RevealPace: 1, Dialogue: map[string][]string{DialogueOffer: {" "}}}},
+	}
+	for _, tt := range tests {
+		if err := tt.personality.Validate(); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestStripEmoji(t *testing.T) {
+	if got := StripEmoji("🐐 A goat! At least it's good company. 🛡️"); got != "A goat! At least it's good company." {
+		t.Errorf("Expected the emoji removed, got %q", got)
+	}
+	if got := StripEmoji("Door 3 → 67%"); got != "Door 3 → 67%" {
+		t.Errorf("Expected text without emoji unchanged, got %q", got)
+	}
+}
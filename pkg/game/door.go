@@ -91,12 +91,20 @@ func (d *Door) String() string {
 }
 
 func CreateDoorsWithRandomCar() []*Door {
-	doors := make([]*Door, NumDoors)
-
 	// Use secure random number generation for car placement
-	carPosition := SecureIntn(NumDoors)
+	return CreateDoorsWithCarAt(SecureIntn(NumDoors))
+}
+
+// CreateDoorsWithCarAt creates the doors with the car behind the given position
+func CreateDoorsWithCarAt(carPosition int) []*Door {
+	return CreateNDoorsWithCarAt(NumDoors, carPosition)
+}
+
+// CreateNDoorsWithCarAt creates numDoors doors with the car behind the given position
+func CreateNDoorsWithCarAt(numDoors, carPosition int) []*Door {
+	doors := make([]*Door, numDoors)
 
-	for i := range NumDoors {
+	for i := range numDoors {
 		content := Goat
 		if i == carPosition {
 			content = Car
@@ -0,0 +1,75 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Uses of a random draw
+const (
+	DrawCar    = "car"    // The door hiding the car
+	DrawHost   = "host"   // A choice among the doors the host may open
+	DrawSwitch = "switch" // The door switched to, among several left closed
+)
+
+// ErrDrawsMismatch is returned when a game's recorded draws can't replay it
+var ErrDrawsMismatch = errors.New("draws do not match the game")
+
+// Draw is one random number drawn during a game. The draws of a game, with
+// the player's choices, determine everything else about it, so recording
+// them lets anyone check that the car wasn't moved or the host's doors picked
+// unfairly
+type Draw struct {
+	Use   string `json:"use"`   // DrawCar, DrawHost or DrawSwitch
+	N     int    `json:"n"`     // The value was drawn from 0 to N-1
+	Value int    `json:"value"` // The number drawn
+}
+
+// ReplayDraws plays a game again from its recorded draws: the car is placed,
+// the host opens doors and a switch picks among closed doors as the draws
+// say, while the player picks initialChoice and, unless a switch draw
+// decides, finalChoice. Doors are 0-based. Every draw must be used, in order
+func ReplayDraws(numDoors int, behavior HostBehavior, opens, initialChoice, finalChoice int, draws []Draw) (*Game, error) {
+	if err := ValidateNumDoors(numDoors); err != nil {
+		return nil, err
+	}
+	if err := ValidateHostOpens(numDoors, opens); err != nil {
+		return nil, err
+	}
+
+	host := NewHost()
+	host.Behavior = behavior
+	host.Opens = opens
+	host.replay = append([]Draw{}, draws...) // Non-nil, so nothing is drawn afresh
+
+	// A draw that doesn't fit explains a failed choice better than the choice's error
+	fail := func(err error) (*Game, error) {
+		if host.replayErr != nil {
+			return nil, host.replayErr
+		}
+		return nil, fmt.Errorf("%w: %v", ErrDrawsMismatch, err)
+	}
+
+	g := newGameDrawingCar(numDoors, host)
+	if err := g.MakeInitialChoice(initialChoice); err != nil {
+		return fail(err)
+	}
+
+	var err error
+	if len(host.replay) > 0 && host.replay[0].Use == DrawSwitch {
+		err = g.SwitchChoice()
+	} else {
+		err = g.MakeFinalChoice(finalChoice)
+	}
+	if err != nil {
+		return fail(err)
+	}
+
+	if host.replayErr != nil {
+		return nil, host.replayErr
+	}
+	if len(host.replay) > 0 {
+		return nil, fmt.Errorf("%w: %d draws left over", ErrDrawsMismatch, len(host.replay))
+	}
+	return g, nil
+}
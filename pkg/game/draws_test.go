@@ -0,0 +1,105 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReplayDraws(t *testing.T) {
+	setups := []struct {
+		name     string
+		numDoors int
+		behavior HostBehavior
+		opens    int
+		switches bool
+	}{
+		{"classic stay", 3, HostClassic, 0, false},
+		{"classic switch", 3, HostClassic, 0, true},
+		{"fall with five doors", 5, HostFall, 2, true},
+		{"crawl with partial reveal", 6, HostCrawl, 2, true},
+		{"classic with many doors", 10, HostClassic, 0, false},
+	}
+
+	for _, setup := range setups {
+		for range 20 {
+			g, err := NewGameWithDoors(setup.numDoors)
+			if err != nil {
+				t.Fatal(err)
+			}
+			g.Host.Behavior = setup.behavior
+			g.Host.Opens = setup.opens
+			if err := g.MakeInitialChoice(SecureIntn(setup.numDoors)); err != nil {
+				t.Fatal(err)
+			}
+			if setup.switches {
+				err = g.SwitchChoice()
+			} else {
+				err = g.StayWithChoice()
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			draws := g.Result.Draws
+			if len(draws) == 0 || draws[0].Use != DrawCar || draws[0].Value != g.CarPosition {
+				t.Fatalf("%s: expected the car draw first, got %+v", setup.name, draws)
+			}
+
+			replayed, err := ReplayDraws(setup.numDoors, setup.behavior, setup.opens, g.PlayerInitialChoice, g.PlayerFinalChoice, draws)
+			if err != nil {
+				t.Fatalf("%s: replay failed: %v", setup.name, err)
+			}
+			if replayed.CarPosition != g.CarPosition || replayed.PlayerFinalChoice != g.PlayerFinalChoice ||
+				replayed.Result.Won != g.Result.Won || len(replayed.HostOpenedDoors) != len(g.HostOpenedDoors) {
+				t.Fatalf("%s: replay differs: car %d/%d, final %d/%d", setup.name,
+					replayed.CarPosition, g.CarPosition, replayed.PlayerFinalChoice, g.PlayerFinalChoice)
+			}
+			for i, door := range g.HostOpenedDoors {
+				if replayed.HostOpenedDoors[i] != door {
+					t.Fatalf("%s: host opened %v on replay, %v in play", setup.name, replayed.HostOpenedDoors, g.HostOpenedDoors)
+				}
+			}
+		}
+	}
+}
+
+func TestReplayDrawsRejectsTampering(t *testing.T) {
+	g := NewSeededGame(3)
+	g.MakeInitialChoice(0)
+	g.SwitchChoice()
+	draws := g.Result.Draws
+
+	for name, bad := range map[string][]Draw{
+		"missing":      draws[:0],
+		"out of range": {{Use: DrawCar, N: 3, Value: 3}},
+		"wrong use":    {{Use: DrawHost, N: 3, Value: 0}},
+		"left over":    append(append([]Draw{}, draws...), Draw{Use: DrawHost, N: 2, Value: 0}),
+	} {
+		if _, err := ReplayDraws(3, HostClassic, 0, 0, g.PlayerFinalChoice, bad); !errors.Is(err, ErrDrawsMismatch) {
+			t.Errorf("%s draws: expected ErrDrawsMismatch, got %v", name, err)
+		}
+	}
+}
+
+func TestDrawsSurviveSaveAndRematch(t *testing.T) {
+	g, _ := NewGameWithDoors(5)
+	g.MakeInitialChoice(1)
+
+	data, err := EncodeGame(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, err := DecodeGame(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored.StayWithChoice()
+	if got, want := len(restored.Result.Draws), len(g.Draws()); got != want || restored.Result.Draws[0] != g.Draws()[0] {
+		t.Errorf("Expected the restored game to keep its %d draws, got %+v", want, restored.Result.Draws)
+	}
+
+	rematch := g.Rematch()
+	if draws := rematch.Draws(); len(draws) != 1 || draws[0] != g.Draws()[0] {
+		t.Errorf("Expected a rematch to keep only the car draw, got %+v", draws)
+	}
+}
@@ -3,11 +3,15 @@ package game
 import (
 	"errors"
 	"fmt"
+	mathrand "math/rand"
+	"slices"
 	"time"
 )
 
 const (
-	NumDoors = 3 // Standard Monty Hall problem uses 3 doors
+	NumDoors = 3   // Standard Monty Hall problem uses 3 doors
+	MinDoors = 3   // Fewest doors that leave the host a door to open
+	MaxDoors = 100 // Most doors a game can be configured with
 )
 
 type GamePhase int
@@ -18,6 +22,14 @@ const (
 	HostReveal
 	FinalChoice
 	GameOver
+	Aborted // Abandoned before it was over, without a result
+)
+
+// Doors a person playing the host may not open
+var (
+	ErrHostRevealsCar    = errors.New("the host never reveals the car")
+	ErrHostOpensChoice   = errors.New("the host never opens the contestant's door")
+	ErrHostOpensOpenDoor = errors.New("that door is already open")
 )
 
 type PlayerStrategy int
@@ -27,6 +39,10 @@ const (
 	Switch
 )
 
+// StrategySourceDefault marks a final choice made automatically from the
+// configured default strategy rather than by the player
+const StrategySourceDefault = "default"
+
 // GameResult represents the outcome of a completed Monty Hall game
 type GameResult struct {
 	Won            bool           // Whether the player won the car
@@ -34,7 +50,14 @@ type GameResult struct {
 	InitialChoice  int            // The door initially chosen by the player (0-2)
 	FinalChoice    int            // The door finally chosen by the player (0-2)
 	CarPosition    int            // The door where the car was located (0-2)
-	HostOpenedDoor int            // The door opened by the host (0-2)
+	HostOpenedDoor int            // The first door opened by the host (0-2)
+	DoorsOpened    int            // How many doors the host opened
+	NumDoors       int            // How many doors the game was played with
+	HostBehavior   HostBehavior   // How the host chose the doors to open
+	CarRevealed    bool           // Whether the host revealed the car, so no choice could win
+	StrategySource string         // StrategySourceDefault if the final choice was automatic, empty if the player's
+	Draws          []Draw         // The random numbers drawn during the game, in order
+	Seed           *int64         // Seed of a seeded game, which replays it; nil for other games
 	GameDuration   time.Duration  // How long the game took to complete
 	Timestamp      time.Time      // When the game was completed
 }
@@ -44,23 +67,139 @@ type Game struct {
 	Phase               GamePhase
 	PlayerInitialChoice int
 	PlayerFinalChoice   int
-	HostOpenedDoor      int
+	HostOpenedDoor      int   // First door opened by the host
+	HostOpenedDoors     []int // Every door opened by the host, in door order
 	CarPosition         int
 	GameStartTime       time.Time
 	Result              *GameResult
+	clockStart          time.Time     // When the game's clock started; read from the monotonic clock in play
+	pausedFor           time.Duration // Time the clock was paused, left out of the game's duration
+	pausedAt            time.Time     // When the current pause began, zero while the clock runs
 	Host                *Host
+	seed                int64 // Seed for seeded games, used to replay them
+	seeded              bool
 }
 
 func NewGame() *Game {
+	return newGameDrawingCar(NumDoors, NewHost())
+}
+
+// NewGameWithDoors creates a game with the given number of doors. The host
+// opens every other door but one, so switching wins (n-1)/n of the time
+func NewGameWithDoors(numDoors int) (*Game, error) {
+	if err := ValidateNumDoors(numDoors); err != nil {
+		return nil, err
+	}
+	return newGameDrawingCar(numDoors, NewHost()), nil
+}
+
+// NewSeededGame creates a game whose car placement and host choices are
+// fully determined by the seed, so the same choices always replay identically
+func NewSeededGame(seed int64) *Game {
+	return newSeededGame(seed, NumDoors)
+}
+
+// NewSeededGameWithDoors creates a seeded game with the given number of doors
+func NewSeededGameWithDoors(seed int64, numDoors int) (*Game, error) {
+	if err := ValidateNumDoors(numDoors); err != nil {
+		return nil, err
+	}
+	return newSeededGame(seed, numDoors), nil
+}
+
+func newSeededGame(seed int64, numDoors int) *Game {
+	game := newGameDrawingCar(numDoors, NewHostWithSource(NewSeededSource(seed)))
+	game.seed = seed
+	game.seeded = true
+	return game
+}
+
+// NewGameWithRand creates a game whose car placement and host choices come
+// from rng, so many games can share one deterministic source
+func NewGameWithRand(rng *mathrand.Rand, numDoors int) (*Game, error) {
+	if err := ValidateNumDoors(numDoors); err != nil {
+		return nil, err
+	}
+	return newGameWithRand(rng, numDoors), nil
+}
+
+func newGameWithRand(rng *mathrand.Rand, numDoors int) *Game {
+	return newGameDrawingCar(numDoors, NewHostWithRand(rng))
+}
+
+// NewGameWithSource creates a game whose car placement and host choices come
+// from src, such as a fake source in tests; a nil src uses secure randomness
+func NewGameWithSource(src RandomSource, numDoors int) (*Game, error) {
+	if err := ValidateNumDoors(numDoors); err != nil {
+		return nil, err
+	}
+	return newGameDrawingCar(numDoors, NewHostWithSource(src)), nil
+}
+
+// Seed returns the seed of a seeded game, and false for other games
+func (g *Game) Seed() (int64, bool) {
+	return g.seed, g.seeded
+}
+
+// newGameDrawingCar creates a game with the car behind a door drawn from the
+// host's source, so the placement is recorded with the host's draws
+func newGameDrawingCar(numDoors int, host *Host) *Game {
+	return newGame(CreateNDoorsWithCarAt(numDoors, host.draw(DrawCar, numDoors)), host)
+}
+
+// ValidateNumDoors reports whether a game can be played with numDoors doors
+func ValidateNumDoors(numDoors int) error {
+	if numDoors < MinDoors || numDoors > MaxDoors {
+		return fmt.Errorf("number of doors must be between %d and %d, got %d", MinDoors, MaxDoors, numDoors)
+	}
+	return nil
+}
+
+// Rematch creates a fresh game with the same car placement; seeded games
+// also repeat the host's choices
+func (g *Game) Rematch() *Game {
+	var rematch *Game
+	if g.seeded {
+		rematch = newSeededGame(g.seed, len(g.Doors))
+	} else {
+		rematch = newGame(CreateNDoorsWithCarAt(len(g.Doors), g.CarPosition), NewHost())
+		// The car is where the original draw put it
+		if len(g.Host.draws) > 0 && g.Host.draws[0].Use == DrawCar {
+			rematch.Host.draws = g.Host.draws[:1:1]
+		}
+	}
+	rematch.Host.Behavior = g.Host.Behavior
+	rematch.Host.Opens = g.Host.Opens
+	return rematch
+}
+
+// NumDoors returns how many doors the game is played with
+func (g *Game) NumDoors() int {
+	return len(g.Doors)
+}
+
+// CarRevealed reports whether the host opened the door hiding the car, which
+// only a falling host can do
+func (g *Game) CarRevealed() bool {
+	for _, door := range g.HostOpenedDoors {
+		if door == g.CarPosition {
+			return true
+		}
+	}
+	return false
+}
 
+func newGame(doors []*Door, host *Host) *Game {
+	start := time.Now()
 	game := &Game{
-		Doors:               CreateDoorsWithRandomCar(),
+		Doors:               doors,
 		Phase:               Setup,
 		PlayerInitialChoice: -1,
 		PlayerFinalChoice:   -1,
 		HostOpenedDoor:      -1,
-		GameStartTime:       time.Now(),
-		Host:                NewHost(),
+		GameStartTime:       start,
+		Host:                host,
+		clockStart:          start,
 	}
 
 	for i, door := range game.Doors {
@@ -87,18 +226,81 @@ func (g *Game) MakeInitialChoice(doorIndex int) error {
 	g.Doors[doorIndex].Select()
 	g.Phase = HostReveal
 
-	hostDoor, err := g.Host.ChooseDoorToOpen(g.Doors, doorIndex)
+	return g.OpenHostDoors()
+}
+
+// OpenHostDoors has the host open their doors for an initial choice made with
+// MakeInitialChoiceForHost, moving the game on to the final choice
+func (g *Game) OpenHostDoors() error {
+	if g.Phase != HostReveal || len(g.HostOpenedDoors) > 0 {
+		return errors.New("not waiting for the host to open doors")
+	}
+
+	hostDoors, err := g.Host.ChooseDoorsToOpen(g.Doors, g.PlayerInitialChoice)
 	if err != nil {
 		return fmt.Errorf("host error: %w", err)
 	}
 
-	g.HostOpenedDoor = hostDoor
-	g.Doors[hostDoor].Open()
+	g.HostOpenedDoors = hostDoors
+	g.HostOpenedDoor = hostDoors[0]
+	for _, door := range hostDoors {
+		g.Doors[door].Open()
+	}
 	g.Phase = FinalChoice
 
 	return nil
 }
 
+// MakeInitialChoiceForHost makes the initial choice but leaves the doors to
+// open to a person playing the host, who opens them with OpenDoorAsHost, or
+// to the host with OpenHostDoors
+func (g *Game) MakeInitialChoiceForHost(doorIndex int) error {
+	if g.Phase != InitialChoice {
+		return errors.New("not in initial choice phase")
+	}
+
+	if doorIndex < 0 || doorIndex >= len(g.Doors) {
+		return fmt.Errorf("door index %d out of range [0-%d]", doorIndex, len(g.Doors)-1)
+	}
+
+	g.PlayerInitialChoice = doorIndex
+	g.Doors[doorIndex].Select()
+	g.Phase = HostReveal
+	return nil
+}
+
+// OpenDoorAsHost opens a door for a person playing the host, holding them to
+// the classic host's rules: never the contestant's door, never the car. Once
+// the host has opened all the doors they open, the game moves on to the
+// final choice
+func (g *Game) OpenDoorAsHost(doorIndex int) error {
+	if g.Phase != HostReveal {
+		return errors.New("not in host reveal phase")
+	}
+
+	if doorIndex < 0 || doorIndex >= len(g.Doors) {
+		return fmt.Errorf("door index %d out of range [0-%d]", doorIndex, len(g.Doors)-1)
+	}
+
+	switch {
+	case doorIndex == g.PlayerInitialChoice:
+		return ErrHostOpensChoice
+	case g.Doors[doorIndex].IsOpen():
+		return ErrHostOpensOpenDoor
+	case g.Doors[doorIndex].HasCar():
+		return ErrHostRevealsCar
+	}
+
+	g.Doors[doorIndex].Open()
+	g.HostOpenedDoors = append(g.HostOpenedDoors, doorIndex)
+	slices.Sort(g.HostOpenedDoors)
+	g.HostOpenedDoor = g.HostOpenedDoors[0]
+	if len(g.HostOpenedDoors) == g.Host.DoorsToOpen(len(g.Doors)) {
+		g.Phase = FinalChoice
+	}
+	return nil
+}
+
 func (g *Game) MakeFinalChoice(doorIndex int) error {
 	if g.Phase != FinalChoice {
 		return errors.New("not in final choice phase")
@@ -121,45 +323,141 @@ func (g *Game) MakeFinalChoice(doorIndex int) error {
 	return nil
 }
 
+// SwitchChoice switches to the other closed door. When the host left several
+// closed, one of them is picked at random
 func (g *Game) SwitchChoice() error {
 	if g.Phase != FinalChoice {
 		return errors.New("not in final choice phase")
 	}
 
+	var others []int
 	for i, door := range g.Doors {
 		if !door.IsOpen() && i != g.PlayerInitialChoice {
-			return g.MakeFinalChoice(i)
+			others = append(others, i)
 		}
 	}
 
-	return errors.New("no valid door to switch to")
+	switch len(others) {
+	case 0:
+		return errors.New("no valid door to switch to")
+	case 1:
+		return g.MakeFinalChoice(others[0])
+	default:
+		return g.MakeFinalChoice(others[g.Host.draw(DrawSwitch, len(others))])
+	}
 }
 
 func (g *Game) StayWithChoice() error {
 	return g.MakeFinalChoice(g.PlayerInitialChoice)
 }
 
+// UndoInitialChoice takes back the initial choice of a game waiting for the
+// host to open doors, returning it to its initial choice. Once a door is open
+// the choice stands, since the doors the host opens tell where the car isn't
+func (g *Game) UndoInitialChoice() error {
+	if g.Phase != HostReveal || len(g.HostOpenedDoors) > 0 {
+		return errors.New("the host has already opened doors")
+	}
+
+	g.Doors[g.PlayerInitialChoice].Reset()
+	g.PlayerInitialChoice = -1
+	g.Phase = InitialChoice
+
+	return nil
+}
+
+// Abort abandons a game before it is over. An aborted game has no result,
+// takes no more choices and its clock stops
+func (g *Game) Abort() error {
+	switch g.Phase {
+	case GameOver:
+		return errors.New("game is already over")
+	case Aborted:
+		return errors.New("game is already aborted")
+	}
+
+	g.Pause()
+	g.Phase = Aborted
+	return nil
+}
+
 func (g *Game) calculateResult() {
+	now := time.Now()
+	g.Result = g.buildResult(now, g.Elapsed(now))
+}
+
+// Pause stops the game's clock, so time spent away from the game, such as
+// in help or statistics, is left out of its duration. Finished games have
+// nothing left to time
+func (g *Game) Pause() {
+	if g.pausedAt.IsZero() && g.Result == nil {
+		g.pausedAt = time.Now()
+	}
+}
+
+// Resume restarts the game's clock after Pause
+func (g *Game) Resume() {
+	if !g.pausedAt.IsZero() {
+		g.pausedFor += time.Since(g.pausedAt)
+		g.pausedAt = time.Time{}
+	}
+}
+
+// Paused reports whether the game's clock is stopped
+func (g *Game) Paused() bool {
+	return !g.pausedAt.IsZero()
+}
+
+// Elapsed returns how long the game has been played at now, leaving out
+// pauses. Times taken from time.Now in the same run are compared on the
+// monotonic clock, so changes to the wall clock or time zone don't skew it
+func (g *Game) Elapsed(now time.Time) time.Duration {
+	elapsed := now.Sub(g.clockStart) - g.pausedFor
+	if !g.pausedAt.IsZero() {
+		elapsed -= now.Sub(g.pausedAt)
+	}
+	// A restored game's start was read from the wall clock, which may have
+	// been set back since
+	return max(elapsed, 0)
+}
+
+// buildResult summarizes the finished game as if it ended at finishedAt
+// after being played for duration
+func (g *Game) buildResult(finishedAt time.Time, duration time.Duration) *GameResult {
 	strategy := Stay
 	if g.PlayerFinalChoice != g.PlayerInitialChoice {
 		strategy = Switch
 	}
 
-	won := g.Doors[g.PlayerFinalChoice].HasCar()
-	duration := time.Since(g.GameStartTime)
+	var seed *int64
+	if g.seeded {
+		s := g.seed
+		seed = &s
+	}
 
-	g.Result = &GameResult{
-		Won:            won,
+	return &GameResult{
+		Won:            g.Doors[g.PlayerFinalChoice].HasCar(),
 		Strategy:       strategy,
 		InitialChoice:  g.PlayerInitialChoice + 1, // 1-indexed for display
 		FinalChoice:    g.PlayerFinalChoice + 1,   // 1-indexed for display
 		CarPosition:    g.CarPosition + 1,         // 1-indexed for display
 		HostOpenedDoor: g.HostOpenedDoor + 1,      // 1-indexed for display
+		DoorsOpened:    len(g.HostOpenedDoors),
+		NumDoors:       len(g.Doors),
+		HostBehavior:   g.Host.Behavior,
+		CarRevealed:    g.CarRevealed(),
 		GameDuration:   duration,
-		Timestamp:      time.Now(),
+		Timestamp:      finishedAt,
+		Draws:          g.Draws(),
+		Seed:           seed,
 	}
 }
 
+// Draws returns the random numbers drawn during the game so far, in order
+func (g *Game) Draws() []Draw {
+	return slices.Clone(g.Host.draws)
+}
+
 func (g *Game) GetAvailableChoices() []int {
 	var choices []int
 	for i, door := range g.Doors {
@@ -205,6 +503,8 @@ func (g *Game) GetPhaseDescription() string {
 		return "Make your final choice: stay or switch?"
 	case GameOver:
 		return "Game over!"
+	case Aborted:
+		return "Game abandoned"
 	default:
 		return "Unknown phase"
 	}
@@ -221,6 +521,11 @@ func (g *Game) GetGameState() map[string]interface{} {
 		"playerInitialChoice": g.PlayerInitialChoice,
 		"playerFinalChoice":   g.PlayerFinalChoice,
 		"hostOpenedDoor":      g.HostOpenedDoor,
+		"hostOpenedDoors":     g.HostOpenedDoors,
+		"numDoors":            len(g.Doors),
+		"hostBehavior":        g.Host.Behavior.String(),
+		"hostOpens":           g.Host.DoorsToOpen(len(g.Doors)),
+		"carRevealed":         g.CarRevealed(),
 		"carPosition":         g.CarPosition,
 		"result":              g.Result,
 		"availableChoices":    g.GetAvailableChoices(),
@@ -1,7 +1,10 @@
 package game
 
 import (
+	"errors"
+	"slices"
 	"testing"
+	"time"
 )
 
 func TestNewGame(t *testing.T) {
@@ -406,3 +409,258 @@ func TestProbabilityDistribution(t *testing.T) {
 
 	t.Logf("Switch win rate: %.3f, Stay win rate: %.3f", switchRate, stayRate)
 }
+
+func TestRematch(t *testing.T) {
+	original := NewGame()
+	original.MakeInitialChoice(0)
+	original.SwitchChoice()
+
+	rematch := original.Rematch()
+	if rematch.CarPosition != original.CarPosition {
+		t.Errorf("Rematch should keep the car at door %d, got %d", original.CarPosition, rematch.CarPosition)
+	}
+
+	if rematch.Phase != InitialChoice || rematch.Result != nil {
+		t.Error("Rematch should start a fresh game")
+	}
+
+	seeded := NewSeededGame(42)
+	seeded.MakeInitialChoice(seeded.CarPosition)
+	replay := seeded.Rematch()
+	replay.MakeInitialChoice(replay.CarPosition)
+	if replay.HostOpenedDoor != seeded.HostOpenedDoor {
+		t.Error("Seeded rematch should repeat the host's choice")
+	}
+}
+
+func TestNewGameWithDoors(t *testing.T) {
+	game, err := NewGameWithDoors(10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if game.NumDoors() != 10 {
+		t.Errorf("Expected 10 doors, got %d", game.NumDoors())
+	}
+
+	if err := game.MakeInitialChoice(3); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(game.HostOpenedDoors) != 8 || game.HostOpenedDoor != game.HostOpenedDoors[0] {
+		t.Errorf("Expected the host to open 8 doors, got %v", game.HostOpenedDoors)
+	}
+
+	if choices := game.GetAvailableChoices(); len(choices) != 2 {
+		t.Errorf("Expected 2 closed doors after the host opens, got %d", len(choices))
+	}
+
+	if err := game.SwitchChoice(); err != nil {
+		t.Fatalf("Unexpected error switching: %v", err)
+	}
+
+	if game.Result.Won != (game.CarPosition != 3) {
+		t.Error("Switching should win exactly when the initial choice was a goat")
+	}
+
+	if game.Result.NumDoors != 10 {
+		t.Errorf("Expected result to record 10 doors, got %d", game.Result.NumDoors)
+	}
+
+	if game.Rematch().NumDoors() != 10 {
+		t.Error("Rematch should keep the number of doors")
+	}
+
+	for _, numDoors := range []int{MinDoors - 1, MaxDoors + 1} {
+		if _, err := NewGameWithDoors(numDoors); err == nil {
+			t.Errorf("Expected error for %d doors", numDoors)
+		}
+	}
+}
+
+func TestSeededGameWithDoorsIsDeterministic(t *testing.T) {
+	first, err := NewSeededGameWithDoors(7, 100)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	first.MakeInitialChoice(first.CarPosition)
+
+	replay := first.Rematch()
+	replay.MakeInitialChoice(replay.CarPosition)
+
+	if replay.NumDoors() != 100 || replay.CarPosition != first.CarPosition {
+		t.Fatal("Seeded rematch should repeat the doors and car placement")
+	}
+
+	if len(replay.HostOpenedDoors) != 98 || !slices.Equal(replay.HostOpenedDoors, first.HostOpenedDoors) {
+		t.Error("Seeded rematch should repeat the host's choices")
+	}
+}
+
+func TestElapsedLeavesOutPauses(t *testing.T) {
+	g := NewGame()
+	now := time.Now()
+	g.clockStart = now.Add(-10 * time.Second)
+	g.pausedFor = 4 * time.Second
+	if got := g.Elapsed(now); got != 6*time.Second {
+		t.Errorf("Expected 6s played, got %v", got)
+	}
+
+	// A pause still under way is left out too
+	g.pausedAt = now.Add(-time.Second)
+	if !g.Paused() {
+		t.Error("Game should be paused")
+	}
+	if got := g.Elapsed(now); got != 5*time.Second {
+		t.Errorf("Expected 5s played, got %v", got)
+	}
+
+	g.Resume()
+	if g.Paused() || g.pausedFor < 5*time.Second {
+		t.Errorf("Resume should add the pause to the time paused, got %v", g.pausedFor)
+	}
+
+	// A wall clock set back never makes the duration negative
+	if got := g.Elapsed(now.Add(-time.Hour)); got != 0 {
+		t.Errorf("Expected no time played, got %v", got)
+	}
+}
+
+func TestPausedTimeIsNotRecorded(t *testing.T) {
+	g := NewGame()
+	// Started 63s ago, and paused for a minute of it
+	g.clockStart = g.clockStart.Add(-63 * time.Second)
+	g.MakeInitialChoice(0)
+	g.Pause()
+	g.pausedAt = g.pausedAt.Add(-time.Minute)
+	g.Resume()
+	g.StayWithChoice()
+
+	if g.Result.GameDuration < 3*time.Second || g.Result.GameDuration > 10*time.Second {
+		t.Errorf("Expected about 3s without the minute paused, got %v", g.Result.GameDuration)
+	}
+
+	// Finished games have no clock to pause
+	g.Pause()
+	if g.Paused() {
+		t.Error("A finished game should not pause")
+	}
+}
+
+func TestUndoInitialChoice(t *testing.T) {
+	g := NewSeededGame(7)
+	if err := g.UndoInitialChoice(); err == nil {
+		t.Error("Expected no undo before the initial choice")
+	}
+
+	g.MakeInitialChoiceForHost(g.CarPosition)
+	if err := g.UndoInitialChoice(); err != nil {
+		t.Fatalf("UndoInitialChoice failed: %v", err)
+	}
+	if g.Phase != InitialChoice || g.PlayerInitialChoice != -1 {
+		t.Errorf("Expected the game back at its initial choice, got phase %v, choice %d", g.Phase, g.PlayerInitialChoice)
+	}
+	for i, door := range g.Doors {
+		if !door.IsClosed() {
+			t.Errorf("Expected door %d closed again, got %v", i, door.State)
+		}
+	}
+
+	// The game plays on, and its draws replay it as played after the undo
+	g.MakeInitialChoiceForHost((g.CarPosition + 1) % g.NumDoors())
+	if err := g.OpenHostDoors(); err != nil {
+		t.Fatalf("OpenHostDoors failed: %v", err)
+	}
+	// The doors the host opened give the car away, so the choice stands
+	if err := g.UndoInitialChoice(); err == nil {
+		t.Error("Expected no undo once the host opened doors")
+	}
+	g.SwitchChoice()
+	result := g.Result
+	replayed, err := ReplayDraws(g.NumDoors(), g.Host.Behavior, g.Host.Opens, g.PlayerInitialChoice, g.PlayerFinalChoice, result.Draws)
+	if err != nil {
+		t.Fatalf("Expected the draws to replay the game, got %v", err)
+	}
+	if replayed.HostOpenedDoor != g.HostOpenedDoor || replayed.Result.Won != result.Won {
+		t.Errorf("Replay differs: host %d, won %v", replayed.HostOpenedDoor, replayed.Result.Won)
+	}
+
+	if err := g.UndoInitialChoice(); err == nil {
+		t.Error("Expected no undo once the game is over")
+	}
+}
+
+func TestAbort(t *testing.T) {
+	g := NewGame()
+	g.MakeInitialChoice(0)
+	if err := g.Abort(); err != nil {
+		t.Fatalf("Abort failed: %v", err)
+	}
+	if g.Phase != Aborted || g.Result != nil || g.IsGameOver() || !g.Paused() {
+		t.Errorf("Expected an aborted game without a result, its clock stopped, got phase %v", g.Phase)
+	}
+	if err := g.StayWithChoice(); err == nil {
+		t.Error("Expected an aborted game to refuse choices")
+	}
+	if err := g.Abort(); err == nil {
+		t.Error("Expected a second abort to fail")
+	}
+
+	finished := NewGame()
+	finished.MakeInitialChoice(0)
+	finished.StayWithChoice()
+	if err := finished.Abort(); err == nil || finished.Phase != GameOver {
+		t.Error("Expected a finished game to keep its result")
+	}
+}
+
+func TestOpenDoorAsHost(t *testing.T) {
+	g, err := NewGameWithDoors(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := g.OpenDoorAsHost(0); err == nil {
+		t.Error("Expected no door opened before the contestant has picked")
+	}
+
+	pick := (g.CarPosition + 1) % g.NumDoors()
+	if err := g.MakeInitialChoiceForHost(pick); err != nil {
+		t.Fatalf("MakeInitialChoiceForHost failed: %v", err)
+	}
+	if g.Phase != HostReveal || len(g.HostOpenedDoors) != 0 {
+		t.Fatalf("Expected the game to wait for the host, got phase %v", g.Phase)
+	}
+
+	if err := g.OpenDoorAsHost(g.CarPosition); !errors.Is(err, ErrHostRevealsCar) {
+		t.Errorf("Expected the car kept closed, got %v", err)
+	}
+	if err := g.OpenDoorAsHost(pick); !errors.Is(err, ErrHostOpensChoice) {
+		t.Errorf("Expected the contestant's door kept closed, got %v", err)
+	}
+
+	var goats []int
+	for i, door := range g.Doors {
+		if i != pick && door.HasGoat() {
+			goats = append(goats, i)
+		}
+	}
+	if err := g.OpenDoorAsHost(goats[1]); err != nil {
+		t.Fatalf("OpenDoorAsHost failed: %v", err)
+	}
+	if err := g.OpenDoorAsHost(goats[1]); !errors.Is(err, ErrHostOpensOpenDoor) {
+		t.Errorf("Expected an open door refused, got %v", err)
+	}
+	if g.Phase != HostReveal {
+		t.Error("Expected the host to open another door with 4 doors")
+	}
+	if err := g.OpenDoorAsHost(goats[0]); err != nil {
+		t.Fatalf("OpenDoorAsHost failed: %v", err)
+	}
+	if g.Phase != FinalChoice || g.HostOpenedDoor != goats[0] || !slices.Equal(g.HostOpenedDoors, goats) {
+		t.Errorf("Expected the final choice after the host's doors, got phase %v and doors %v", g.Phase, g.HostOpenedDoors)
+	}
+
+	if err := g.SwitchChoice(); err != nil || !g.Result.Won {
+		t.Errorf("Expected switching to the car to win, got %v", err)
+	}
+}
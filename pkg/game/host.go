@@ -3,10 +3,133 @@ package game
 import (
 	"errors"
 	"fmt"
+	mathrand "math/rand"
+	"slices"
+	"strings"
 )
 
+// HostBehavior is how the host decides which doors to open
+type HostBehavior int
+
+const (
+	// HostClassic knows where the car is and always reveals goats
+	HostClassic HostBehavior = iota
+	// HostFall opens doors at random, possibly revealing the car. This is
+	// "Monty Fall", and also "ignorant Monty", who does not know where the car is
+	HostFall
+	// HostCrawl knows where the car is and, when free to choose, opens the
+	// lowest-numbered goat doors ("Monty Crawl")
+	HostCrawl
+)
+
+// String returns the config name of the behavior
+func (b HostBehavior) String() string {
+	switch b {
+	case HostClassic:
+		return "classic"
+	case HostFall:
+		return "fall"
+	case HostCrawl:
+		return "crawl"
+	default:
+		return "unknown"
+	}
+}
+
+// DisplayName returns the name shown to players
+func (b HostBehavior) DisplayName() string {
+	switch b {
+	case HostClassic:
+		return "Classic Monty"
+	case HostFall:
+		return "Monty Fall"
+	case HostCrawl:
+		return "Monty Crawl"
+	default:
+		return "Unknown"
+	}
+}
+
+// HostBehaviors returns every behavior in display order
+func HostBehaviors() []HostBehavior {
+	return []HostBehavior{HostClassic, HostFall, HostCrawl}
+}
+
+// ParseHostBehavior parses a config name; "ignorant" is accepted for HostFall
+// and an empty name means classic
+func ParseHostBehavior(name string) (HostBehavior, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "classic":
+		return HostClassic, nil
+	case "fall", "ignorant":
+		return HostFall, nil
+	case "crawl":
+		return HostCrawl, nil
+	default:
+		return HostClassic, fmt.Errorf("unknown host behavior: %s (expected classic, fall, ignorant or crawl)", name)
+	}
+}
+
+// ExpectedWinRates returns the theoretical stay and switch win rates with
+// numDoors doors, counting only games in which the host revealed no car
+func (b HostBehavior) ExpectedWinRates(numDoors int) (stay, switchDoors float64) {
+	return b.ExpectedWinRatesOpening(numDoors, numDoors-2)
+}
+
+// ExpectedWinRatesOpening returns the theoretical stay and switch win rates
+// when the host opens opened of the numDoors doors, counting only games in
+// which the host revealed no car. Switching picks one of the other closed
+// doors at random
+func (b HostBehavior) ExpectedWinRatesOpening(numDoors, opened int) (stay, switchDoors float64) {
+	if b == HostFall {
+		// Given only goats were revealed, the car is equally likely behind
+		// any closed door
+		return 1 / float64(numDoors-opened), 1 / float64(numDoors-opened)
+	}
+	others := float64(numDoors - 1 - opened) // Closed doors to switch to
+	return 1 / float64(numDoors), float64(numDoors-1) / float64(numDoors) / others
+}
+
+// CarRevealRate returns how often the host reveals the car with numDoors doors
+func (b HostBehavior) CarRevealRate(numDoors int) float64 {
+	return b.CarRevealRateOpening(numDoors, numDoors-2)
+}
+
+// CarRevealRateOpening returns how often the host reveals the car when
+// opening opened of the numDoors doors
+func (b HostBehavior) CarRevealRateOpening(numDoors, opened int) float64 {
+	if b == HostFall {
+		return float64(opened) / float64(numDoors)
+	}
+	return 0
+}
+
+// ValidateHostOpens reports whether the host can open opens doors in a game
+// with numDoors doors. Zero stands for every door but the player's and one other
+func ValidateHostOpens(numDoors, opens int) error {
+	if opens != 0 && (opens < 1 || opens > numDoors-2) {
+		return fmt.Errorf("the host must open between 1 and %d doors with %d doors, got %d", numDoors-2, numDoors, opens)
+	}
+	return nil
+}
+
 type Host struct {
-	Name string
+	Name     string
+	Behavior HostBehavior
+	Opens    int          // Doors the host opens; 0 opens all but the player's and one other
+	rng      RandomSource // Source of the host's draws; nil uses secure randomness
+
+	draws     []Draw // Every number drawn so far, in order
+	replay    []Draw // Draws still to be replayed by ReplayDraws; nil draws new numbers
+	replayErr error  // Why the replayed draws could not be used
+}
+
+// DoorsToOpen returns how many doors the host opens in a game with numDoors doors
+func (h *Host) DoorsToOpen(numDoors int) int {
+	if h.Opens == 0 {
+		return numDoors - 2
+	}
+	return h.Opens
 }
 
 func NewHost() *Host {
@@ -15,9 +138,26 @@ func NewHost() *Host {
 	}
 }
 
+// NewHostWithRand creates a host whose choices come from rng, so they can be
+// replayed; a nil rng uses secure randomness
+func NewHostWithRand(rng *mathrand.Rand) *Host {
+	if rng == nil {
+		return NewHost()
+	}
+	return NewHostWithSource(rng)
+}
+
+// NewHostWithSource creates a host whose choices come from src; a nil src
+// uses secure randomness
+func NewHostWithSource(src RandomSource) *Host {
+	host := NewHost()
+	host.rng = src
+	return host
+}
+
 func (h *Host) ChooseDoorToOpen(doors []*Door, playerChoice int) (int, error) {
-	if len(doors) != NumDoors {
-		return -1, fmt.Errorf("invalid number of doors: expected %d, got %d", NumDoors, len(doors))
+	if err := ValidateNumDoors(len(doors)); err != nil {
+		return -1, fmt.Errorf("invalid number of doors: %w", err)
 	}
 
 	if playerChoice < 0 || playerChoice >= len(doors) {
@@ -39,13 +179,96 @@ func (h *Host) ChooseDoorToOpen(doors []*Door, playerChoice int) (int, error) {
 		return validChoices[0], nil
 	}
 
-	randomIndex := SecureIntn(len(validChoices))
+	randomIndex := h.intn(len(validChoices))
 	return validChoices[randomIndex], nil
 }
 
+// ChooseDoorsToOpen returns every door the host opens, in door order: all
+// doors except the player's choice and one other, or Opens of them. A classic
+// or crawling host keeps the car closed unless the player already picked it;
+// a falling host picks the doors at random, so the car may be revealed
+func (h *Host) ChooseDoorsToOpen(doors []*Door, playerChoice int) ([]int, error) {
+	if err := ValidateNumDoors(len(doors)); err != nil {
+		return nil, fmt.Errorf("invalid number of doors: %w", err)
+	}
+
+	if playerChoice < 0 || playerChoice >= len(doors) {
+		return nil, errors.New("invalid player choice")
+	}
+
+	if err := ValidateHostOpens(len(doors), h.Opens); err != nil {
+		return nil, err
+	}
+	if opens := h.DoorsToOpen(len(doors)); opens < len(doors)-2 {
+		return h.chooseSomeDoorsToOpen(doors, playerChoice, opens), nil
+	}
+
+	var others []int
+	keepClosed := -1
+	for i, door := range doors {
+		if i == playerChoice {
+			continue
+		}
+		if door.HasCar() {
+			if keepClosed != -1 {
+				return nil, errors.New("no valid doors to open")
+			}
+			keepClosed = i
+		}
+		others = append(others, i)
+	}
+
+	switch {
+	case h.Behavior == HostFall:
+		keepClosed = others[h.intn(len(others))]
+	case keepClosed != -1:
+		// The car stays closed
+	case h.Behavior == HostCrawl:
+		// The player holds the car; opening the lowest-numbered goats leaves the highest closed
+		keepClosed = others[len(others)-1]
+	default:
+		// The player holds the car, so any one of the goats can stay closed
+		keepClosed = others[h.intn(len(others))]
+	}
+
+	opened := make([]int, 0, len(others)-1)
+	for _, i := range others {
+		if i != keepClosed {
+			opened = append(opened, i)
+		}
+	}
+	return opened, nil
+}
+
+// chooseSomeDoorsToOpen picks opens of the doors the player did not choose,
+// leaving more than one of them closed
+func (h *Host) chooseSomeDoorsToOpen(doors []*Door, playerChoice, opens int) []int {
+	var candidates []int
+	for i, door := range doors {
+		if i == playerChoice || (h.Behavior != HostFall && door.HasCar()) {
+			continue
+		}
+		candidates = append(candidates, i)
+	}
+
+	if h.Behavior == HostCrawl {
+		// The lowest-numbered goats
+		return candidates[:opens]
+	}
+
+	// A partial shuffle picks opens candidates at random
+	for i := range opens {
+		j := i + h.intn(len(candidates)-i)
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	}
+	opened := candidates[:opens]
+	slices.Sort(opened)
+	return opened
+}
+
 func (h *Host) GetSwitchRecommendation(doors []*Door, playerChoice int) (int, error) {
-	if len(doors) != NumDoors {
-		return -1, fmt.Errorf("invalid number of doors: expected %d, got %d", NumDoors, len(doors))
+	if err := ValidateNumDoors(len(doors)); err != nil {
+		return -1, fmt.Errorf("invalid number of doors: %w", err)
 	}
 
 	if playerChoice < 0 || playerChoice >= len(doors) {
@@ -86,3 +309,49 @@ func (h *Host) GetHint(doors []*Door, playerChoice int) string {
 
 	return fmt.Sprintf("Statistically, switching to door %d gives you better odds!", switchDoor+1)
 }
+
+// intn returns a random integer in [0, n) from the host's source
+func (h *Host) intn(n int) int {
+	return h.draw(DrawHost, n)
+}
+
+// draw returns a random integer in [0, n) from the host's source, recording
+// it with its use. A replaying host takes the next recorded draw instead
+func (h *Host) draw(use string, n int) int {
+	var value int
+	switch {
+	case h.replay != nil:
+		value = h.nextReplayed(use, n)
+	case h.rng != nil:
+		value = h.rng.Intn(n)
+	default:
+		value = SecureIntn(n)
+	}
+	h.draws = append(h.draws, Draw{Use: use, N: n, Value: value})
+	return value
+}
+
+// nextReplayed returns the next recorded draw, checking that it was drawn
+// for the same use from the same range. After a mismatch it returns 0, and
+// replayErr says what went wrong
+func (h *Host) nextReplayed(use string, n int) int {
+	if h.replayErr != nil {
+		return 0
+	}
+	if len(h.replay) == 0 {
+		h.replayErr = fmt.Errorf("%w: no %s draw left", ErrDrawsMismatch, use)
+		return 0
+	}
+
+	next := h.replay[0]
+	h.replay = h.replay[1:]
+	switch {
+	case next.Use != use || next.N != n:
+		h.replayErr = fmt.Errorf("%w: expected a %s draw from %d values, found a %s draw from %d", ErrDrawsMismatch, use, n, next.Use, next.N)
+		return 0
+	case next.Value < 0 || next.Value >= n:
+		h.replayErr = fmt.Errorf("%w: %s draw %d is not between 0 and %d", ErrDrawsMismatch, use, next.Value, n-1)
+		return 0
+	}
+	return next.Value
+}
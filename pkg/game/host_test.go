@@ -1,6 +1,7 @@
 package game
 
 import (
+	"math"
 	"testing"
 )
 
@@ -152,3 +153,153 @@ func TestHostGetHint(t *testing.T) {
 		t.Errorf("Expected '%s', got '%s'", expected, hint)
 	}
 }
+
+func TestHostChooseDoorsToOpen(t *testing.T) {
+	host := NewHost()
+
+	for _, playerChoice := range []int{0, 4} {
+		doors := CreateNDoorsWithCarAt(10, 4)
+
+		opened, err := host.ChooseDoorsToOpen(doors, playerChoice)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(opened) != 8 {
+			t.Errorf("Host should open all but one of the other 9 doors, opened %d", len(opened))
+		}
+
+		for _, door := range opened {
+			if door == playerChoice {
+				t.Error("Host should not open the player's chosen door")
+			}
+			if doors[door].HasCar() {
+				t.Error("Host should not open a door with a car")
+			}
+		}
+	}
+
+	_, err := host.ChooseDoorsToOpen(CreateNDoorsWithCarAt(MaxDoors+1, 0), 0)
+	if err == nil {
+		t.Error("Expected error for too many doors")
+	}
+
+	cars := []*Door{NewDoor(1, 0, Goat), NewDoor(2, 1, Car), NewDoor(3, 2, Car)}
+	if _, err := host.ChooseDoorsToOpen(cars, 0); err == nil {
+		t.Error("Expected error when no valid doors to open")
+	}
+}
+
+func TestHostBehaviors(t *testing.T) {
+	for _, name := range []string{"", "classic", "fall", "ignorant", "crawl"} {
+		if _, err := ParseHostBehavior(name); err != nil {
+			t.Errorf("Expected %q to parse: %v", name, err)
+		}
+	}
+	if behavior, _ := ParseHostBehavior("ignorant"); behavior != HostFall {
+		t.Errorf("Expected ignorant Monty to behave like Monty Fall, got %v", behavior)
+	}
+	if _, err := ParseHostBehavior("lazy"); err == nil {
+		t.Error("Expected an unknown behavior to be rejected")
+	}
+
+	// A crawling host opens the lowest-numbered goat when the player holds the car
+	host := NewHost()
+	host.Behavior = HostCrawl
+	opened, err := host.ChooseDoorsToOpen(CreateNDoorsWithCarAt(3, 0), 0)
+	if err != nil || len(opened) != 1 || opened[0] != 1 {
+		t.Errorf("Expected a crawling host to open door index 1, got %v (%v)", opened, err)
+	}
+
+	// A falling host reveals the car about a third of the time with three doors
+	revealed := 0
+	for seed := int64(0); seed < 3000; seed++ {
+		g := NewSeededGame(seed)
+		g.Host.Behavior = HostFall
+		if err := g.MakeInitialChoice(0); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if g.CarRevealed() {
+			revealed++
+			if err := g.SwitchChoice(); err != nil {
+				t.Fatalf("Expected the game to finish after the car is revealed: %v", err)
+			}
+			if g.Result.Won || !g.Result.CarRevealed || g.Result.HostBehavior != HostFall {
+				t.Errorf("Expected a recorded loss with the car revealed, got %+v", g.Result)
+			}
+		}
+	}
+	if rate := float64(revealed) / 3000; rate < 0.28 || rate > 0.39 {
+		t.Errorf("Expected the car to be revealed about 1/3 of the time, got %.3f", rate)
+	}
+
+	if stay, switchDoors := HostFall.ExpectedWinRates(3); stay != 0.5 || switchDoors != 0.5 {
+		t.Errorf("Expected 1/2 and 1/2 for Monty Fall, got %v and %v", stay, switchDoors)
+	}
+}
+
+func TestHostPartialReveal(t *testing.T) {
+	if err := ValidateHostOpens(5, 4); err == nil {
+		t.Error("Expected opening every other door to be rejected")
+	}
+	for _, opens := range []int{0, 1, 3} {
+		if err := ValidateHostOpens(5, opens); err != nil {
+			t.Errorf("Expected the host to open %d of 5 doors: %v", opens, err)
+		}
+	}
+
+	// Crawling opens the lowest-numbered goats
+	host := NewHost()
+	host.Behavior = HostCrawl
+	host.Opens = 2
+	opened, err := host.ChooseDoorsToOpen(CreateNDoorsWithCarAt(6, 1), 0)
+	if err != nil || len(opened) != 2 || opened[0] != 2 || opened[1] != 3 {
+		t.Errorf("Expected a crawling host to open door indexes 2 and 3, got %v (%v)", opened, err)
+	}
+
+	// Switching to one of the doors left wins 4/15 with 5 doors and 1 opened
+	for _, behavior := range []HostBehavior{HostClassic, HostFall} {
+		const games = 20000
+		played, switchWins, revealed := 0, 0, 0
+		for seed := range int64(games) {
+			g, _ := NewSeededGameWithDoors(seed, 5)
+			g.Host.Behavior = behavior
+			g.Host.Opens = 1
+			if err := g.MakeInitialChoice(0); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(g.HostOpenedDoors) != 1 || len(g.GetAvailableChoices()) != 4 {
+				t.Fatalf("Expected one door opened and four closed, got %v", g.HostOpenedDoors)
+			}
+			if err := g.SwitchChoice(); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if g.Result.DoorsOpened != 1 {
+				t.Errorf("Expected the result to record 1 door opened, got %d", g.Result.DoorsOpened)
+			}
+			if g.Result.CarRevealed {
+				revealed++
+				continue
+			}
+			played++
+			if g.Result.Won {
+				switchWins++
+			}
+		}
+
+		_, expected := behavior.ExpectedWinRatesOpening(5, 1)
+		if rate := float64(switchWins) / float64(played); rate < expected-0.02 || rate > expected+0.02 {
+			t.Errorf("%s: expected switching to win about %.3f, got %.3f", behavior, expected, rate)
+		}
+		if rate := float64(revealed) / games; rate < behavior.CarRevealRateOpening(5, 1)-0.02 || rate > behavior.CarRevealRateOpening(5, 1)+0.02 {
+			t.Errorf("%s: expected the car revealed in about %.3f of games, got %.3f", behavior, behavior.CarRevealRateOpening(5, 1), rate)
+		}
+	}
+
+	if stay, switchDoors := HostClassic.ExpectedWinRatesOpening(5, 1); stay != 0.2 || math.Abs(switchDoors-4.0/15) > 1e-9 {
+		t.Errorf("Expected 1/5 and 4/15, got %v and %v", stay, switchDoors)
+	}
+	if stay, switchDoors := HostFall.ExpectedWinRatesOpening(5, 1); stay != 0.25 || switchDoors != 0.25 {
+		t.Errorf("Expected 1/4 and 1/4 for Monty Fall, got %v and %v", stay, switchDoors)
+	}
+}
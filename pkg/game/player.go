@@ -0,0 +1,192 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Player is an automated strategy that plays games on its own. It is not the
+// PlayerStrategy of a result, which only records whether the final choice
+// stayed or switched
+type Player interface {
+	Name() string
+	// ChooseInitialDoor returns the door to pick first, from 0
+	ChooseInitialDoor(g *Game, rng *mathrand.Rand) int
+	// DecideFinalChoice reports whether to switch once the host has opened doors
+	DecideFinalChoice(g *Game, rng *mathrand.Rand) bool
+}
+
+// ResultObserver is implemented by players that adapt to how their games went
+type ResultObserver interface {
+	ObserveResult(result *GameResult)
+}
+
+// ExpectedWinRater is implemented by players whose long-run win rate is known.
+// opened is how many doors the host opens
+type ExpectedWinRater interface {
+	ExpectedWinRate(numDoors, opened int, behavior HostBehavior) float64
+}
+
+var ErrUnknownPlayer = errors.New("unknown player")
+
+var (
+	playersMu sync.RWMutex
+	players   = map[string]func() Player{}
+)
+
+func init() {
+	RegisterPlayer("switch", func() Player { return fixedPlayer{switchDoors: true} })
+	RegisterPlayer("stay", func() Player { return fixedPlayer{switchDoors: false} })
+	RegisterPlayer("random", func() Player { return randomPlayer{} })
+	RegisterPlayer("switch-after-streak", func() Player { return NewStreakPlayer(DefaultStreak) })
+}
+
+// RegisterPlayer makes a player available to NewPlayer by its name, replacing
+// any player already registered with it. Players may keep state between
+// games, so newPlayer is called for every independent run
+func RegisterPlayer(name string, newPlayer func() Player) {
+	playersMu.Lock()
+	defer playersMu.Unlock()
+	players[name] = newPlayer
+}
+
+// PlayerNames returns the names of the registered players
+func PlayerNames() []string {
+	playersMu.RLock()
+	defer playersMu.RUnlock()
+	names := make([]string, 0, len(players))
+	for name := range players {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NewPlayer creates the registered player with the given name
+func NewPlayer(name string) (Player, error) {
+	playersMu.RLock()
+	newPlayer, ok := players[name]
+	playersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %q (available: %s)", ErrUnknownPlayer, name, strings.Join(PlayerNames(), ", "))
+	}
+	return newPlayer(), nil
+}
+
+// PlayGame plays a game from the start with the player
+func PlayGame(g *Game, player Player, rng *mathrand.Rand) error {
+	if err := g.MakeInitialChoice(player.ChooseInitialDoor(g, rng)); err != nil {
+		return err
+	}
+	return FinishGame(g, player, rng)
+}
+
+// FinishGame lets the player make the final choice of a game in the final
+// choice phase, and shows the result to players that observe them
+func FinishGame(g *Game, player Player, rng *mathrand.Rand) error {
+	var err error
+	if player.DecideFinalChoice(g, rng) {
+		err = g.SwitchChoice()
+	} else {
+		err = g.StayWithChoice()
+	}
+	if err != nil {
+		return err
+	}
+
+	if observer, ok := player.(ResultObserver); ok {
+		observer.ObserveResult(g.Result)
+	}
+	return nil
+}
+
+// fixedPlayer picks a random door and always switches or always stays
+type fixedPlayer struct {
+	switchDoors bool
+}
+
+func (p fixedPlayer) Name() string {
+	if p.switchDoors {
+		return "switch"
+	}
+	return "stay"
+}
+
+func (p fixedPlayer) ChooseInitialDoor(g *Game, rng *mathrand.Rand) int {
+	return rng.Intn(g.NumDoors())
+}
+
+func (p fixedPlayer) DecideFinalChoice(g *Game, rng *mathrand.Rand) bool {
+	return p.switchDoors
+}
+
+func (p fixedPlayer) ExpectedWinRate(numDoors, opened int, behavior HostBehavior) float64 {
+	stay, switchDoors := behavior.ExpectedWinRatesOpening(numDoors, opened)
+	if p.switchDoors {
+		return switchDoors
+	}
+	return stay
+}
+
+// randomPlayer picks a random door and switches half the time
+type randomPlayer struct{}
+
+func (randomPlayer) Name() string { return "random" }
+
+func (randomPlayer) ChooseInitialDoor(g *Game, rng *mathrand.Rand) int {
+	return rng.Intn(g.NumDoors())
+}
+
+func (randomPlayer) DecideFinalChoice(g *Game, rng *mathrand.Rand) bool {
+	return rng.Intn(2) == 1
+}
+
+func (randomPlayer) ExpectedWinRate(numDoors, opened int, behavior HostBehavior) float64 {
+	stay, switchDoors := behavior.ExpectedWinRatesOpening(numDoors, opened)
+	return (stay + switchDoors) / 2
+}
+
+// DefaultStreak is how many losses in a row make the switch-after-streak
+// player change its mind
+const DefaultStreak = 3
+
+// StreakPlayer stays until it loses Streak games in a row, then switches
+// until it loses Streak games in a row again, and so on: the hunch a player
+// acts on after a bad run
+type StreakPlayer struct {
+	Streak    int
+	switching bool
+	losses    int
+}
+
+// NewStreakPlayer creates a player that changes its mind after streak losses in a row
+func NewStreakPlayer(streak int) *StreakPlayer {
+	return &StreakPlayer{Streak: max(streak, 1)}
+}
+
+func (p *StreakPlayer) Name() string { return "switch-after-streak" }
+
+func (p *StreakPlayer) ChooseInitialDoor(g *Game, rng *mathrand.Rand) int {
+	return rng.Intn(g.NumDoors())
+}
+
+func (p *StreakPlayer) DecideFinalChoice(g *Game, rng *mathrand.Rand) bool {
+	return p.switching
+}
+
+// ObserveResult counts losses in a row, changing strategy after Streak of them
+func (p *StreakPlayer) ObserveResult(result *GameResult) {
+	if result.Won {
+		p.losses = 0
+		return
+	}
+	p.losses++
+	if p.losses >= p.Streak {
+		p.switching = !p.switching
+		p.losses = 0
+	}
+}
@@ -0,0 +1,63 @@
+package game
+
+import (
+	"errors"
+	mathrand "math/rand"
+	"slices"
+	"testing"
+)
+
+func TestRegisteredPlayers(t *testing.T) {
+	for _, name := range []string{"switch", "stay", "random", "switch-after-streak"} {
+		if !slices.Contains(PlayerNames(), name) {
+			t.Errorf("Expected %s to be registered", name)
+		}
+		player, err := NewPlayer(name)
+		if err != nil || player.Name() != name {
+			t.Errorf("Expected NewPlayer(%q) to create it, got %v (%v)", name, player, err)
+		}
+	}
+
+	if _, err := NewPlayer("psychic"); !errors.Is(err, ErrUnknownPlayer) {
+		t.Errorf("Expected an unknown player error, got %v", err)
+	}
+}
+
+func TestPlayGame(t *testing.T) {
+	rng := mathrand.New(mathrand.NewSource(1))
+	for _, name := range []string{"switch", "stay"} {
+		player, _ := NewPlayer(name)
+		g, _ := NewGameWithRand(rng, NumDoors)
+		if err := PlayGame(g, player, rng); err != nil {
+			t.Fatal(err)
+		}
+		if !g.IsGameOver() || (g.Result.Strategy == Switch) != (name == "switch") {
+			t.Errorf("%s: expected a finished game with its strategy, got %+v", name, g.Result)
+		}
+	}
+
+	stay, _ := NewPlayer("stay")
+	if rate := stay.(ExpectedWinRater).ExpectedWinRate(4, 2, HostClassic); rate != 0.25 {
+		t.Errorf("Expected staying with 4 doors to win 25%%, got %v", rate)
+	}
+}
+
+func TestStreakPlayer(t *testing.T) {
+	player := NewStreakPlayer(2)
+	decisions := func() bool { return player.DecideFinalChoice(nil, nil) }
+
+	for i, won := range []bool{false, true, false, false, false, false} {
+		before := decisions()
+		player.ObserveResult(&GameResult{Won: won})
+		switch i {
+		case 3: // Second loss in a row
+			if before || !decisions() {
+				t.Fatal("Expected the player to start switching after two losses in a row")
+			}
+		case 5:
+			if decisions() {
+				t.Fatal("Expected the player to go back to staying after two more losses")
+			}
+		}
+	}
+}
@@ -8,6 +8,26 @@ import (
 	"time"
 )
 
+// RandomSource supplies the random numbers a game draws: where the car is,
+// which doors the host opens and which door a switch picks. Games use a
+// SecureSource unless another is injected with NewGameWithSource, such as a
+// seeded one for reproducible demos and tests
+type RandomSource interface {
+	// Intn returns a random integer in [0, n)
+	Intn(n int) int
+}
+
+// SecureSource returns the cryptographically secure source games use by default
+func SecureSource() RandomSource {
+	return globalSecureRandom
+}
+
+// NewSeededSource returns a source whose numbers are fully determined by the
+// seed, so games drawn from it replay identically
+func NewSeededSource(seed int64) RandomSource {
+	return mathrand.New(mathrand.NewSource(seed))
+}
+
 // SecureRandom provides cryptographically secure random number generation
 // with fallback to math/rand if crypto/rand fails
 type SecureRandom struct {
@@ -102,3 +102,63 @@ func BenchmarkSecureFloat64(b *testing.B) {
 		sr.Float64()
 	}
 }
+
+// fixedSource is a RandomSource that always draws the same number
+type fixedSource int
+
+func (f fixedSource) Intn(n int) int {
+	return int(f) % n
+}
+
+func TestNewGameWithSource(t *testing.T) {
+	g, err := NewGameWithSource(fixedSource(2), NumDoors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.CarPosition != 2 {
+		t.Errorf("Expected the injected source to place the car behind door 3, got door %d", g.CarPosition+1)
+	}
+
+	// Holding the car, the host draws which goat stays closed from the source
+	// too: the first of doors 1 and 2, so door 2 opens
+	g.MakeInitialChoice(2)
+	if g.HostOpenedDoor != 1 {
+		t.Errorf("Expected the host to open door 2, got door %d", g.HostOpenedDoor+1)
+	}
+	g.StayWithChoice()
+	if g.Result.Seed != nil {
+		t.Error("Expected no seed recorded for a game without one")
+	}
+
+	if _, err := NewGameWithSource(SecureSource(), 2); err == nil {
+		t.Error("Expected too few doors to be refused")
+	}
+}
+
+func TestSeededGamesRecordSeed(t *testing.T) {
+	first := NewSeededGame(42)
+	if seed, ok := first.Seed(); !ok || seed != 42 {
+		t.Errorf("Expected seed 42, got %d (%v)", seed, ok)
+	}
+
+	first.MakeInitialChoice(0)
+	first.SwitchChoice()
+	if first.Result.Seed == nil || *first.Result.Seed != 42 {
+		t.Fatalf("Expected the result to record seed 42, got %v", first.Result.Seed)
+	}
+
+	// The recorded seed replays the game
+	replay := NewSeededGame(*first.Result.Seed)
+	replay.MakeInitialChoice(0)
+	replay.SwitchChoice()
+	if replay.CarPosition != first.CarPosition || replay.HostOpenedDoor != first.HostOpenedDoor || replay.Result.Won != first.Result.Won {
+		t.Error("Expected the seed to replay the game")
+	}
+
+	a, b := NewSeededSource(7), NewSeededSource(7)
+	for i := 0; i < 10; i++ {
+		if x, y := a.Intn(100), b.Intn(100); x != y {
+			t.Fatalf("Expected seeded sources to draw the same numbers, got %d and %d", x, y)
+		}
+	}
+}
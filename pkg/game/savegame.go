@@ -0,0 +1,228 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// SaveVersion is the savegame format version written by EncodeGame. Fields
+// are only ever added to the format, so DecodeGame also reads files written
+// by newer versions and ignores the fields it doesn't know
+const SaveVersion = 1
+
+// ErrInvalidSave is returned when a savegame can't be turned back into a game
+var ErrInvalidSave = errors.New("invalid savegame")
+
+// SaveGame is the serialized form of a Game, shared by resume, transcripts and
+// replays. Door indexes are 0-based; -1 means no choice was made yet
+type SaveGame struct {
+	Version         int           `json:"version"`
+	NumDoors        int           `json:"num_doors"`
+	CarPosition     int           `json:"car_position"`
+	HostBehavior    string        `json:"host_behavior"`
+	HostOpens       int           `json:"host_opens,omitempty"` // Doors the host opens; 0 for all but one
+	Phase           string        `json:"phase"`
+	InitialChoice   int           `json:"initial_choice"`
+	FinalChoice     int           `json:"final_choice"`
+	HostOpenedDoors []int         `json:"host_opened_doors,omitempty"`
+	Seed            *int64        `json:"seed,omitempty"` // Set for seeded games so they can be replayed
+	StartedAt       time.Time     `json:"started_at"`
+	FinishedAt      *time.Time    `json:"finished_at,omitempty"` // Set once the game is over
+	PausedFor       time.Duration `json:"paused_for,omitempty"`  // Time paused, left out of the duration
+	Duration        time.Duration `json:"duration,omitempty"`    // How long the game was played, once it is over
+	Draws           []Draw        `json:"draws,omitempty"`       // The random numbers drawn so far
+}
+
+// phaseNames are the savegame names of each phase
+var phaseNames = map[GamePhase]string{
+	Setup:         "setup",
+	InitialChoice: "initial_choice",
+	HostReveal:    "host_reveal",
+	FinalChoice:   "final_choice",
+	GameOver:      "game_over",
+	Aborted:       "aborted",
+}
+
+// Save returns the serializable state of the game
+func (g *Game) Save() *SaveGame {
+	save := &SaveGame{
+		Version:         SaveVersion,
+		NumDoors:        len(g.Doors),
+		CarPosition:     g.CarPosition,
+		HostBehavior:    g.Host.Behavior.String(),
+		HostOpens:       g.Host.Opens,
+		Phase:           phaseNames[g.Phase],
+		InitialChoice:   g.PlayerInitialChoice,
+		FinalChoice:     g.PlayerFinalChoice,
+		HostOpenedDoors: slices.Clone(g.HostOpenedDoors),
+		StartedAt:       g.GameStartTime,
+		PausedFor:       g.pausedFor,
+		Draws:           g.Draws(),
+	}
+	if g.seeded {
+		seed := g.seed
+		save.Seed = &seed
+	}
+	if g.Result != nil {
+		finishedAt := g.Result.Timestamp
+		save.FinishedAt = &finishedAt
+		save.Duration = g.Result.GameDuration
+	}
+	return save
+}
+
+// EncodeGame serializes the game as a versioned JSON savegame
+func EncodeGame(g *Game) ([]byte, error) {
+	return json.Marshal(g.Save())
+}
+
+// DecodeGame restores a game from a savegame written by EncodeGame
+func DecodeGame(data []byte) (*Game, error) {
+	var save SaveGame
+	if err := json.Unmarshal(data, &save); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSave, err)
+	}
+	return save.Game()
+}
+
+// Game rebuilds the saved game. Seeded games get their random source back,
+// so a game saved before the host's reveal continues exactly as it would have
+func (s *SaveGame) Game() (*Game, error) {
+	if s.Version < 1 {
+		return nil, fmt.Errorf("%w: missing or unsupported version %d", ErrInvalidSave, s.Version)
+	}
+
+	numDoors := s.NumDoors
+	if numDoors == 0 {
+		numDoors = NumDoors
+	}
+	if err := ValidateNumDoors(numDoors); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSave, err)
+	}
+	if s.CarPosition < 0 || s.CarPosition >= numDoors {
+		return nil, fmt.Errorf("%w: car position %d out of range", ErrInvalidSave, s.CarPosition)
+	}
+
+	behavior, err := ParseHostBehavior(s.HostBehavior)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSave, err)
+	}
+	if err := ValidateHostOpens(numDoors, s.HostOpens); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSave, err)
+	}
+
+	phase, ok := parsePhase(s.Phase)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown phase %q", ErrInvalidSave, s.Phase)
+	}
+
+	var g *Game
+	if s.Seed != nil {
+		g = newSeededGame(*s.Seed, numDoors)
+		if g.CarPosition != s.CarPosition {
+			return nil, fmt.Errorf("%w: car position %d does not match seed %d", ErrInvalidSave, s.CarPosition, *s.Seed)
+		}
+	} else {
+		g = newGame(CreateNDoorsWithCarAt(numDoors, s.CarPosition), NewHost())
+	}
+	g.Host.Behavior = behavior
+	g.Host.Opens = s.HostOpens
+	if len(s.Draws) > 0 {
+		g.Host.draws = slices.Clone(s.Draws)
+	}
+	// The monotonic clock doesn't carry across runs, so a restored game is
+	// timed from its wall clock start
+	g.GameStartTime = s.StartedAt
+	g.clockStart = s.StartedAt
+	g.pausedFor = s.PausedFor
+
+	if err := g.restore(phase, s); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// restore replays the saved choices onto a fresh game, checking that each
+// one could have been made in play
+func (g *Game) restore(phase GamePhase, s *SaveGame) error {
+	// An abandoned game keeps the choices made before it was abandoned
+	if phase == Aborted {
+		reached := InitialChoice
+		if s.InitialChoice >= 0 {
+			reached = FinalChoice
+		}
+		if err := g.restore(reached, s); err != nil {
+			return err
+		}
+		g.Phase = Aborted
+		return nil
+	}
+
+	numDoors := len(g.Doors)
+	inRange := func(door int) bool { return door >= 0 && door < numDoors }
+
+	if phase <= InitialChoice {
+		g.Phase = InitialChoice
+		return nil
+	}
+
+	// The host's reveal happens within the initial choice, so a game saved
+	// mid-reveal resumes at the final choice
+	if !inRange(s.InitialChoice) {
+		return fmt.Errorf("%w: initial choice %d out of range", ErrInvalidSave, s.InitialChoice)
+	}
+	if opens := g.Host.DoorsToOpen(numDoors); len(s.HostOpenedDoors) != opens {
+		return fmt.Errorf("%w: host opened %d doors, expected %d", ErrInvalidSave, len(s.HostOpenedDoors), opens)
+	}
+	for _, door := range s.HostOpenedDoors {
+		if !inRange(door) || door == s.InitialChoice {
+			return fmt.Errorf("%w: host cannot open door %d", ErrInvalidSave, door)
+		}
+	}
+
+	g.PlayerInitialChoice = s.InitialChoice
+	g.Doors[s.InitialChoice].Select()
+	g.HostOpenedDoors = slices.Clone(s.HostOpenedDoors)
+	g.HostOpenedDoor = g.HostOpenedDoors[0]
+	for _, door := range g.HostOpenedDoors {
+		g.Doors[door].Open()
+	}
+	g.Phase = FinalChoice
+
+	if phase < GameOver {
+		return nil
+	}
+
+	if !inRange(s.FinalChoice) || g.Doors[s.FinalChoice].IsOpen() {
+		return fmt.Errorf("%w: final choice %d is not a closed door", ErrInvalidSave, s.FinalChoice)
+	}
+	g.Doors[g.PlayerInitialChoice].Reset()
+	g.PlayerFinalChoice = s.FinalChoice
+	g.Doors[s.FinalChoice].Select()
+	g.Phase = GameOver
+
+	finishedAt := s.StartedAt
+	if s.FinishedAt != nil {
+		finishedAt = *s.FinishedAt
+	}
+	duration := s.Duration
+	if duration == 0 {
+		// Saves from before durations were kept
+		duration = g.Elapsed(finishedAt)
+	}
+	g.Result = g.buildResult(finishedAt, duration)
+	return nil
+}
+
+// parsePhase returns the phase with the given savegame name
+func parsePhase(name string) (GamePhase, bool) {
+	for phase, phaseName := range phaseNames {
+		if phaseName == name {
+			return phase, true
+		}
+	}
+	return Setup, false
+}
@@ -0,0 +1,159 @@
+package game
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// roundTrip encodes and decodes the game
+func roundTrip(t *testing.T, g *Game) *Game {
+	t.Helper()
+
+	data, err := EncodeGame(g)
+	if err != nil {
+		t.Fatalf("EncodeGame failed: %v", err)
+	}
+	restored, err := DecodeGame(data)
+	if err != nil {
+		t.Fatalf("DecodeGame failed: %v\n%s", err, data)
+	}
+	return restored
+}
+
+func TestSaveGameRoundTripEveryPhase(t *testing.T) {
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	phases := []struct {
+		name string
+		play func(g *Game)
+	}{
+		{"initial choice", func(g *Game) {}},
+		{"final choice", func(g *Game) { g.MakeInitialChoice(1) }},
+		{"game over after switching", func(g *Game) {
+			g.MakeInitialChoice(1)
+			g.SwitchChoice()
+		}},
+		{"game over after staying", func(g *Game) {
+			g.MakeInitialChoice(0)
+			g.StayWithChoice()
+		}},
+		{"aborted before choosing", func(g *Game) { g.Abort() }},
+		{"aborted at the final choice", func(g *Game) {
+			g.MakeInitialChoice(2)
+			g.Abort()
+		}},
+	}
+
+	for _, behavior := range HostBehaviors() {
+		for _, numDoors := range []int{3, 7} {
+			for _, phase := range phases {
+				t.Run(behavior.String()+"/"+phase.name, func(t *testing.T) {
+					g, err := NewSeededGameWithDoors(42, numDoors)
+					if err != nil {
+						t.Fatal(err)
+					}
+					g.Host.Behavior = behavior
+					g.GameStartTime = start
+					phase.play(g)
+					if g.Result != nil {
+						g.Result = g.buildResult(start.Add(90*time.Second), 90*time.Second)
+					}
+
+					restored := roundTrip(t, g)
+
+					if !reflect.DeepEqual(restored.Save(), g.Save()) {
+						t.Errorf("Save differs after round trip:\n got %+v\nwant %+v", restored.Save(), g.Save())
+					}
+					if restored.Phase != g.Phase {
+						t.Errorf("Expected phase %v, got %v", g.Phase, restored.Phase)
+					}
+					for i, door := range g.Doors {
+						if *restored.Doors[i] != *door {
+							t.Errorf("Door %d: expected %+v, got %+v", i, *door, *restored.Doors[i])
+						}
+					}
+					if !reflect.DeepEqual(restored.Result, g.Result) {
+						t.Errorf("Expected result %+v, got %+v", g.Result, restored.Result)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestSaveGameSeededResume(t *testing.T) {
+	original := NewSeededGame(7)
+	restored := roundTrip(t, original)
+
+	// The restored game continues with the same host choices
+	original.MakeInitialChoice(original.CarPosition)
+	restored.MakeInitialChoice(restored.CarPosition)
+	if !reflect.DeepEqual(restored.HostOpenedDoors, original.HostOpenedDoors) {
+		t.Errorf("Expected host to open %v, got %v", original.HostOpenedDoors, restored.HostOpenedDoors)
+	}
+
+	// Rematches still replay the seed
+	if restored.Rematch().CarPosition != original.CarPosition {
+		t.Error("Rematch of a restored seeded game should keep the car position")
+	}
+}
+
+func TestDecodeGameForwardCompatible(t *testing.T) {
+	data := []byte(`{
+		"version": 3,
+		"num_doors": 3,
+		"car_position": 2,
+		"host_behavior": "classic",
+		"phase": "final_choice",
+		"initial_choice": 0,
+		"final_choice": -1,
+		"host_opened_doors": [1],
+		"started_at": "2025-03-01T12:00:00Z",
+		"player_name": "added in a later version"
+	}`)
+
+	g, err := DecodeGame(data)
+	if err != nil {
+		t.Fatalf("Newer savegames should decode: %v", err)
+	}
+	if g.Phase != FinalChoice || g.HostOpenedDoor != 1 || g.CarPosition != 2 {
+		t.Errorf("Unexpected game state: %+v", g.Save())
+	}
+	if err := g.SwitchChoice(); err != nil || !g.Result.Won {
+		t.Errorf("Switching should win the restored game: %v", err)
+	}
+}
+
+func TestDecodeGameRejectsInvalidSaves(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"not JSON", `{`},
+		{"missing version", `{"num_doors": 3, "phase": "initial_choice"}`},
+		{"too many doors", `{"version": 1, "num_doors": 101, "phase": "initial_choice"}`},
+		{"car out of range", `{"version": 1, "car_position": 3, "phase": "initial_choice"}`},
+		{"unknown host", `{"version": 1, "host_behavior": "sneaky", "phase": "initial_choice"}`},
+		{"unknown phase", `{"version": 1, "phase": "bonus_round"}`},
+		{"host opened the player's door", `{"version": 1, "phase": "final_choice", "initial_choice": 0, "host_opened_doors": [0]}`},
+		{"host opened too few doors", `{"version": 1, "num_doors": 5, "phase": "final_choice", "initial_choice": 0, "host_opened_doors": [1]}`},
+		{"final choice on an open door", `{"version": 1, "phase": "game_over", "initial_choice": 0, "final_choice": 1, "host_opened_doors": [1]}`},
+		{"seed disagrees with car", `{"version": 1, "seed": 7, "car_position": 1, "phase": "initial_choice"}`},
+	}
+
+	// Make sure the seed case really disagrees
+	if NewSeededGame(7).CarPosition == 1 {
+		t.Fatal("Seed 7 places the car behind door 1; pick another seed")
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeGame([]byte(tt.data))
+			if !errors.Is(err, ErrInvalidSave) {
+				t.Errorf("Expected ErrInvalidSave, got %v", err)
+			}
+		})
+	}
+}
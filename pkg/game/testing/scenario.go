@@ -0,0 +1,231 @@
+// Package gametest declares host scenarios, such as "car at 2, player picks
+// 2, expect the host to open 1 or 3 uniformly", and plays them thousands of
+// times to check the host's choices statistically
+package gametest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	mathrand "math/rand"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats/analysis"
+)
+
+// Confidence is the confidence level scenarios are checked at. It is high, as
+// a scenario tests each of its outcomes separately
+const Confidence = 0.999
+
+// DefaultTrials is how many times Assert plays a scenario
+const DefaultTrials = 10000
+
+// Outcome is a set of doors the host may open and the chance of it
+type Outcome struct {
+	Opens  []int // Door numbers, from 1, in order
+	Chance float64
+}
+
+// Scenario is a game set up with the car and the player's pick behind given
+// doors, and the doors a host is expected to open. Door numbers start at 1,
+// as players see them
+type Scenario struct {
+	doors    int
+	behavior game.HostBehavior
+	opens    int
+	car      int
+	pick     int
+	seed     int64
+	expected []Outcome
+}
+
+// New starts a scenario with the given number of doors, a classic host, and
+// the car and the player's pick behind door 1
+func New(doors int) *Scenario {
+	return &Scenario{doors: doors, car: 1, pick: 1, seed: 1}
+}
+
+// Host sets the host's behavior and how many doors the host opens; 0 opens
+// every door but the player's and one other
+func (s *Scenario) Host(behavior game.HostBehavior, opens int) *Scenario {
+	s.behavior, s.opens = behavior, opens
+	return s
+}
+
+// CarAt puts the car behind door
+func (s *Scenario) CarAt(door int) *Scenario {
+	s.car = door
+	return s
+}
+
+// PlayerPicks sets the player's first choice
+func (s *Scenario) PlayerPicks(door int) *Scenario {
+	s.pick = door
+	return s
+}
+
+// Seed sets the seed the host's choices are drawn from, so runs replay
+func (s *Scenario) Seed(seed int64) *Scenario {
+	s.seed = seed
+	return s
+}
+
+// Expect adds an outcome: the host opens exactly doors with the given chance
+func (s *Scenario) Expect(chance float64, doors ...int) *Scenario {
+	opens := slices.Clone(doors)
+	slices.Sort(opens)
+	s.expected = append(s.expected, Outcome{Opens: opens, Chance: chance})
+	return s
+}
+
+// ExpectOpens expects the host to always open exactly doors
+func (s *Scenario) ExpectOpens(doors ...int) *Scenario {
+	return s.Expect(1, doors...)
+}
+
+// ExpectUniform expects the host to open one of doors, each equally often
+func (s *Scenario) ExpectUniform(doors ...int) *Scenario {
+	for _, door := range doors {
+		s.Expect(1/float64(len(doors)), door)
+	}
+	return s
+}
+
+// String describes the scenario, such as "3 doors, classic host, car at 2,
+// player picks 2"
+func (s *Scenario) String() string {
+	return fmt.Sprintf("%d doors, %s host, car at %d, player picks %d", s.doors, s.behavior, s.car, s.pick)
+}
+
+// Result is how often the host opened each set of doors when a scenario was
+// played
+type Result struct {
+	Scenario *Scenario
+	Trials   int
+	Counts   map[string]int // Times each set of doors was opened, keyed like "1,3"
+	Err      error          // The first error the host returned, if any
+}
+
+// Run plays the scenario trials times with a host drawing from the
+// scenario's seed
+func (s *Scenario) Run(trials int) Result {
+	result := Result{Scenario: s, Trials: trials, Counts: make(map[string]int)}
+	if err := s.validate(); err != nil {
+		result.Err = err
+		return result
+	}
+
+	host := game.NewHostWithRand(mathrand.New(mathrand.NewSource(s.seed)))
+	host.Behavior = s.behavior
+	host.Opens = s.opens
+	for range trials {
+		opened, err := host.ChooseDoorsToOpen(game.CreateNDoorsWithCarAt(s.doors, s.car-1), s.pick-1)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		doors := make([]int, len(opened))
+		for i, index := range opened {
+			doors[i] = index + 1
+		}
+		result.Counts[outcomeKey(doors)]++
+	}
+	return result
+}
+
+// validate reports a scenario that cannot be played or whose expected
+// outcomes do not add up to certainty
+func (s *Scenario) validate() error {
+	if err := game.ValidateNumDoors(s.doors); err != nil {
+		return err
+	}
+	for _, door := range []int{s.car, s.pick} {
+		if door < 1 || door > s.doors {
+			return fmt.Errorf("door %d is not one of the %d doors", door, s.doors)
+		}
+	}
+	if len(s.expected) == 0 {
+		return errors.New("the scenario expects no outcomes")
+	}
+	total := 0.0
+	for _, outcome := range s.expected {
+		total += outcome.Chance
+	}
+	if math.Abs(total-1) > 1e-9 {
+		return fmt.Errorf("the expected chances add up to %g, not 1", total)
+	}
+	return nil
+}
+
+// Check reports every way the host's choices disagree with the scenario: an
+// error from the host, a set of doors it was not expected to open, or an
+// expected set opened too often or too rarely at the given confidence
+func (r Result) Check(confidence float64) error {
+	if r.Err != nil {
+		return fmt.Errorf("%s: %w", r.Scenario, r.Err)
+	}
+
+	var errs []error
+	expected := make(map[string]bool)
+	for _, outcome := range r.Scenario.expected {
+		key := outcomeKey(outcome.Opens)
+		expected[key] = true
+		test := analysis.BinomialTest(r.Counts[key], r.Trials, outcome.Chance, confidence)
+		if !test.Consistent() {
+			errs = append(errs, fmt.Errorf("%s: host opened %s in %.1f%% of %d trials, expected %s (p = %.4f)",
+				r.Scenario, describe(key), test.Rate()*100, r.Trials, analysis.Fraction(outcome.Chance), test.PValue))
+		}
+	}
+
+	keys := make([]string, 0, len(r.Counts))
+	for key := range r.Counts {
+		if !expected[key] {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		errs = append(errs, fmt.Errorf("%s: host unexpectedly opened %s in %d of %d trials",
+			r.Scenario, describe(key), r.Counts[key], r.Trials))
+	}
+	return errors.Join(errs...)
+}
+
+// TB is the part of testing.TB that Assert needs
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+}
+
+// Assert plays the scenario DefaultTrials times and fails the test if the
+// host's choices disagree with it
+func (s *Scenario) Assert(t TB) {
+	t.Helper()
+	if err := s.Run(DefaultTrials).Check(Confidence); err != nil {
+		t.Errorf("%v", err)
+	}
+}
+
+// outcomeKey joins door numbers into a map key, such as "1,3"
+func outcomeKey(doors []int) string {
+	parts := make([]string, len(doors))
+	for i, door := range doors {
+		parts[i] = strconv.Itoa(door)
+	}
+	return strings.Join(parts, ",")
+}
+
+// describe names the doors of a key, such as "doors 1,3" or "no doors"
+func describe(key string) string {
+	switch {
+	case key == "":
+		return "no doors"
+	case strings.Contains(key, ","):
+		return "doors " + key
+	default:
+		return "door " + key
+	}
+}
@@ -0,0 +1,82 @@
+package gametest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestHostScenarios(t *testing.T) {
+	// The classic host picks either goat when the player holds the car
+	New(3).CarAt(2).PlayerPicks(2).ExpectUniform(1, 3).Assert(t)
+	// and has no choice otherwise
+	New(3).CarAt(2).PlayerPicks(1).ExpectOpens(3).Assert(t)
+	New(5).CarAt(4).PlayerPicks(1).ExpectOpens(2, 3, 5).Assert(t)
+
+	// The crawling host opens the lowest-numbered goat when free to choose
+	New(3).Host(game.HostCrawl, 0).CarAt(2).PlayerPicks(2).ExpectOpens(1).Assert(t)
+	New(3).Host(game.HostCrawl, 0).CarAt(3).PlayerPicks(1).ExpectOpens(2).Assert(t)
+	New(6).Host(game.HostCrawl, 2).CarAt(2).PlayerPicks(1).ExpectOpens(3, 4).Assert(t)
+
+	// The falling host opens either other door, car or not
+	New(3).Host(game.HostFall, 0).CarAt(2).PlayerPicks(1).ExpectUniform(2, 3).Assert(t)
+
+	// Opening one of five doors leaves the car closed but picks among the goats
+	New(5).Host(game.HostClassic, 1).CarAt(1).PlayerPicks(2).ExpectUniform(3, 4, 5).Assert(t)
+	New(5).Host(game.HostFall, 1).CarAt(1).PlayerPicks(2).ExpectUniform(1, 3, 4, 5).Assert(t)
+	New(4).Host(game.HostClassic, 2).CarAt(1).PlayerPicks(1).
+		Expect(1.0/3, 2, 3).Expect(1.0/3, 2, 4).Expect(1.0/3, 3, 4).Assert(t)
+}
+
+func TestScenarioCheck(t *testing.T) {
+	// A biased expectation is rejected
+	err := New(3).CarAt(2).PlayerPicks(2).Expect(0.8, 1).Expect(0.2, 3).Run(DefaultTrials).Check(Confidence)
+	if err == nil || !strings.Contains(err.Error(), "expected 1/5") {
+		t.Errorf("Expected a uniform host to fail a 4:1 expectation, got %v", err)
+	}
+
+	// So is a door the host was not expected to open
+	err = New(3).CarAt(2).PlayerPicks(2).ExpectOpens(1).Run(1000).Check(Confidence)
+	if err == nil || !strings.Contains(err.Error(), "unexpectedly opened door 3") {
+		t.Errorf("Expected opening door 3 to be reported, got %v", err)
+	}
+
+	// Scenarios that cannot be played are reported rather than run
+	for _, scenario := range []*Scenario{
+		New(3).CarAt(4).ExpectOpens(2),
+		New(3).PlayerPicks(0).ExpectOpens(2),
+		New(3).Expect(0.5, 2),
+		New(3),
+	} {
+		result := scenario.Run(10)
+		if result.Err == nil || len(result.Counts) != 0 {
+			t.Errorf("Expected %s to be rejected, got %v", scenario, result.Counts)
+		}
+	}
+
+	// The same seed replays the same choices
+	first := New(4).Seed(7).ExpectUniform(2, 3, 4).Run(100)
+	second := New(4).Seed(7).ExpectUniform(2, 3, 4).Run(100)
+	if fmt.Sprint(first.Counts) != fmt.Sprint(second.Counts) {
+		t.Errorf("Expected seeded runs to match, got %v and %v", first.Counts, second.Counts)
+	}
+
+	failing := &recordingTB{}
+	New(3).ExpectOpens(2).Assert(failing)
+	if len(failing.errors) != 1 {
+		t.Errorf("Expected Assert to fail the test once, got %v", failing.errors)
+	}
+}
+
+// recordingTB records the failures Assert reports
+type recordingTB struct {
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
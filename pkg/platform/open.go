@@ -0,0 +1,90 @@
+// Package platform opens files and folders in the operating system's file
+// manager, falling back to an error the caller can explain when there is no
+// desktop to open them on, such as over SSH or in a container
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+// ErrNoGUI is returned when there is no desktop to show a folder on
+var ErrNoGUI = errors.New("no graphical desktop to open the folder on")
+
+// startCommand starts a command without waiting for it; tests replace it
+var startCommand = func(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	// File managers outlive the call, so the command is reaped in the background
+	go cmd.Wait()
+	return nil
+}
+
+// lookPath finds a command on the PATH; tests replace it
+var lookPath = exec.LookPath
+
+// FolderOf returns the folder holding path, or path itself when it is a folder
+func FolderOf(path string) string {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return path
+	}
+	return filepath.Dir(path)
+}
+
+// OpenFolder shows the folder holding path, or the folder path names, in the
+// file manager: Finder on macOS, Explorer on Windows and the desktop's default
+// through xdg-open elsewhere
+func OpenFolder(path string) error {
+	folder, err := filepath.Abs(FolderOf(path))
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(folder); err != nil {
+		return fmt.Errorf("failed to open folder: %w", err)
+	}
+
+	name, args, err := openCommand(runtime.GOOS, folder, os.Getenv)
+	if err != nil {
+		return err
+	}
+	if _, err := lookPath(name); err != nil {
+		return fmt.Errorf("%w: %s is not installed", ErrNoGUI, name)
+	}
+	if err := startCommand(name, args...); err != nil {
+		return fmt.Errorf("failed to open folder: %w", err)
+	}
+	return nil
+}
+
+// openCommand returns the command showing folder in the file manager of goos,
+// or ErrNoGUI when getenv shows the session has no desktop
+func openCommand(goos, folder string, getenv func(string) string) (string, []string, error) {
+	// Over SSH the file manager would open on the remote machine's desktop,
+	// if it has one, rather than in front of the player
+	remote := getenv("SSH_CONNECTION") != "" || getenv("SSH_TTY") != ""
+
+	switch goos {
+	case "darwin":
+		if remote {
+			return "", nil, ErrNoGUI
+		}
+		return "open", []string{folder}, nil
+	case "windows":
+		if remote {
+			return "", nil, ErrNoGUI
+		}
+		return "explorer", []string{folder}, nil
+	default:
+		// X11 forwarding over SSH brings the window back to the player
+		if getenv("DISPLAY") == "" && getenv("WAYLAND_DISPLAY") == "" {
+			return "", nil, ErrNoGUI
+		}
+		return "xdg-open", []string{folder}, nil
+	}
+}
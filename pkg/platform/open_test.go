@@ -0,0 +1,85 @@
+package platform
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func env(vars map[string]string) func(string) string {
+	return func(key string) string { return vars[key] }
+}
+
+func TestOpenCommand(t *testing.T) {
+	tests := []struct {
+		name string
+		goos string
+		env  map[string]string
+		want string
+	}{
+		{"macOS", "darwin", nil, "open"},
+		{"macOS over SSH", "darwin", map[string]string{"SSH_CONNECTION": "10.0.0.1 22 10.0.0.2 22"}, ""},
+		{"Windows", "windows", nil, "explorer"},
+		{"X11", "linux", map[string]string{"DISPLAY": ":0"}, "xdg-open"},
+		{"Wayland", "freebsd", map[string]string{"WAYLAND_DISPLAY": "wayland-0"}, "xdg-open"},
+		{"X11 forwarded over SSH", "linux", map[string]string{"DISPLAY": "localhost:10.0", "SSH_TTY": "/dev/pts/1"}, "xdg-open"},
+		{"Linux console", "linux", nil, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			name, args, err := openCommand(test.goos, "/tmp/exports", env(test.env))
+			if test.want == "" {
+				if !errors.Is(err, ErrNoGUI) {
+					t.Errorf("Expected ErrNoGUI, got %q, %v", name, err)
+				}
+				return
+			}
+			if err != nil || name != test.want || !slices.Equal(args, []string{"/tmp/exports"}) {
+				t.Errorf("Expected %s /tmp/exports, got %s %v, %v", test.want, name, args, err)
+			}
+		})
+	}
+}
+
+func TestOpenFolder(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "stats.csv")
+	if err := os.WriteFile(file, []byte("export"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("DISPLAY", ":0")
+	t.Setenv("WAYLAND_DISPLAY", "")
+	t.Setenv("SSH_CONNECTION", "")
+	t.Setenv("SSH_TTY", "")
+
+	var started []string
+	defer func(start func(string, ...string) error, look func(string) (string, error)) {
+		startCommand, lookPath = start, look
+	}(startCommand, lookPath)
+	startCommand = func(name string, args ...string) error {
+		started = append([]string{name}, args...)
+		return nil
+	}
+	lookPath = func(name string) (string, error) { return "/usr/bin/" + name, nil }
+
+	if err := OpenFolder(file); err != nil {
+		t.Fatalf("Failed to open folder: %v", err)
+	}
+	if len(started) != 2 || started[1] != dir {
+		t.Errorf("Expected the file's folder to open, got %v", started)
+	}
+
+	// A missing file manager is reported like a missing desktop
+	lookPath = func(name string) (string, error) { return "", errors.New("not found") }
+	if err := OpenFolder(dir); !errors.Is(err, ErrNoGUI) {
+		t.Errorf("Expected ErrNoGUI without a file manager, got %v", err)
+	}
+
+	if err := OpenFolder(filepath.Join(dir, "missing", "stats.csv")); err == nil {
+		t.Error("A missing folder should not open")
+	}
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+const (
+	// MaxStudentNameLength is the longest name a student can report under
+	MaxStudentNameLength = 32
+	// MaxClassGames is how many of the class's games are kept for the
+	// convergence chart; totals count every game
+	MaxClassGames = 10000
+)
+
+var (
+	ErrInvalidStudent = errors.New("invalid student name")
+	ErrInvalidResult  = errors.New("invalid game result")
+)
+
+// ClassResult is the body of POST /api/class/results: a game a student
+// finished in their own copy of the app
+type ClassResult struct {
+	Student string           `json:"student"`
+	Game    stats.GameRecord `json:"game"`
+}
+
+// StudentSummary is one student's games in the class
+type StudentSummary struct {
+	Name     string    `json:"name"`
+	Games    int       `json:"games"`
+	Wins     int       `json:"wins"`
+	Switched int       `json:"switched"` // Games won or lost by switching
+	LastSeen time.Time `json:"last_seen"`
+}
+
+// ClassSummary is the class-wide picture returned by GET /api/class
+type ClassSummary struct {
+	Games    int                 `json:"games"`
+	Switch   stats.StrategyStats `json:"switch"`
+	Stay     stats.StrategyStats `json:"stay"`
+	Students []StudentSummary    `json:"students"` // Most games first
+}
+
+// Classroom gathers the games students report while an instructor runs
+// serve mode, so the class sees its win rates converge together. Reports are
+// kept in memory for the session, apart from the server's own statistics
+type Classroom struct {
+	mu       sync.Mutex
+	history  []stats.GameRecord
+	summary  ClassSummary
+	students map[string]*StudentSummary
+}
+
+// NewClassroom creates a classroom with no games yet
+func NewClassroom() *Classroom {
+	return &Classroom{students: make(map[string]*StudentSummary)}
+}
+
+// Add counts a game a student reported
+func (c *Classroom) Add(result ClassResult) error {
+	name := strings.TrimSpace(result.Student)
+	if name == "" || utf8.RuneCountInString(name) > MaxStudentNameLength {
+		return fmt.Errorf("%w: use 1 to %d characters", ErrInvalidStudent, MaxStudentNameLength)
+	}
+	record := result.Game
+	if doors := record.Doors(); doors < game.MinDoors || doors > game.MaxDoors {
+		return fmt.Errorf("%w: %d doors", ErrInvalidResult, doors)
+	}
+	if record.Strategy != game.Stay && record.Strategy != game.Switch {
+		return fmt.Errorf("%w: unknown strategy", ErrInvalidResult)
+	}
+	record.Draws = nil // Only the outcome matters to the class
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.history = append(c.history, record)
+	if len(c.history) > MaxClassGames {
+		c.history = slices.Delete(c.history, 0, len(c.history)-MaxClassGames)
+	}
+
+	c.summary.Games++
+	strategy := &c.summary.Stay
+	if record.Strategy == game.Switch {
+		strategy = &c.summary.Switch
+	}
+	addGame(strategy, record.Won)
+
+	student, ok := c.students[name]
+	if !ok {
+		student = &StudentSummary{Name: name}
+		c.students[name] = student
+	}
+	student.Games++
+	if record.Won {
+		student.Wins++
+	}
+	if record.Strategy == game.Switch {
+		student.Switched++
+	}
+	student.LastSeen = time.Now()
+	return nil
+}
+
+// addGame counts a game towards a strategy's totals
+func addGame(s *stats.StrategyStats, won bool) {
+	s.GamesPlayed++
+	if won {
+		s.Wins++
+	} else {
+		s.Losses++
+	}
+	s.WinRate = float64(s.Wins) / float64(s.GamesPlayed)
+}
+
+// Summary returns the class's totals and each student's games
+func (c *Classroom) Summary() ClassSummary {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	summary := c.summary
+	summary.Students = make([]StudentSummary, 0, len(c.students))
+	for _, student := range c.students {
+		summary.Students = append(summary.Students, *student)
+	}
+	slices.SortFunc(summary.Students, func(a, b StudentSummary) int {
+		return cmp.Or(cmp.Compare(b.Games, a.Games), cmp.Compare(a.Name, b.Name))
+	})
+	return summary
+}
+
+// Convergence traces the class's win rates game by game, over the most
+// recent games kept
+func (c *Classroom) Convergence() stats.Convergence {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return stats.ConvergenceOf(c.history)
+}
+
+// handleClassResult counts a game a student reported
+func (s *Server) handleClassResult(w http.ResponseWriter, r *http.Request) {
+	var result ClassResult
+	if err := json.NewDecoder(r.Body).Decode(&result); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %v", ErrInvalidRequest, err))
+		return
+	}
+	if err := s.class.Add(result); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	summary := s.class.Summary()
+	writeJSON(w, http.StatusCreated, map[string]int{"games": summary.Games, "students": len(summary.Students)})
+}
+
+// handleClass returns the class-wide summary
+func (s *Server) handleClass(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.class.Summary())
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestClassroomAPI(t *testing.T) {
+	s, statsManager := newTestServer(t)
+
+	reports := []ClassResult{
+		{Student: "alice", Game: stats.GameRecord{Strategy: game.Switch, Won: true}},
+		{Student: "alice", Game: stats.GameRecord{Strategy: game.Switch, Won: false}},
+		{Student: "bob", Game: stats.GameRecord{Strategy: game.Stay, Won: true}},
+	}
+	for _, report := range reports {
+		if rec, _ := postJSON(t, s, "/api/class/results", report); rec.Code != http.StatusCreated {
+			t.Fatalf("Expected 201 for a reported game, got %d: %s", rec.Code, rec.Body)
+		}
+	}
+
+	for _, bad := range []ClassResult{
+		{Student: " ", Game: stats.GameRecord{Strategy: game.Stay}},
+		{Student: "carol", Game: stats.GameRecord{Strategy: game.Stay, NumDoors: 2}},
+		{Student: "carol", Game: stats.GameRecord{Strategy: game.PlayerStrategy(7)}},
+	} {
+		if rec, _ := postJSON(t, s, "/api/class/results", bad); rec.Code != http.StatusBadRequest {
+			t.Errorf("Expected 400 for %+v, got %d", bad, rec.Code)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/class", nil))
+	var summary ClassSummary
+	if err := json.Unmarshal(rec.Body.Bytes(), &summary); err != nil {
+		t.Fatalf("Invalid response: %v", err)
+	}
+	if summary.Games != 3 || summary.Switch.GamesPlayed != 2 || summary.Switch.Wins != 1 || summary.Stay.Wins != 1 {
+		t.Errorf("Expected the class totals of the valid reports, got %+v", summary)
+	}
+	if len(summary.Students) != 2 || summary.Students[0].Name != "alice" || summary.Students[0].Switched != 2 {
+		t.Errorf("Expected students with the most games first, got %+v", summary.Students)
+	}
+
+	if convergence := s.Classroom().Convergence(); len(convergence.Switch) != 3 {
+		t.Errorf("Expected the class's win rates after each game, got %d points", len(convergence.Switch))
+	}
+	if statsManager.GetStats().TotalGames != 0 {
+		t.Error("Expected students' games kept apart from the server's statistics")
+	}
+}
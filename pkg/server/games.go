@@ -0,0 +1,214 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// MaxOpenGames is how many unfinished games the server keeps at once
+const MaxOpenGames = 1000
+
+// Game phases as reported by the API
+const (
+	PhaseInitialChoice = "initial_choice" // Waiting for the first pick
+	PhaseFinalChoice   = "final_choice"   // The host opened doors; stay or switch
+	PhaseGameOver      = "game_over"      // The game is finished and recorded
+)
+
+var (
+	ErrGameNotFound   = errors.New("game not found")
+	ErrTooManyGames   = errors.New("too many unfinished games")
+	ErrInvalidRequest = errors.New("invalid request body")
+)
+
+// NewGameRequest is the body of POST /api/games
+type NewGameRequest struct {
+	Doors int `json:"doors,omitempty"` // Number of doors; 0 means 3
+}
+
+// ChooseRequest is the body of POST /api/games/{id}/choose
+type ChooseRequest struct {
+	Door int `json:"door"` // The door to pick, numbered from 1
+}
+
+// GameState is a game as seen by the player. Doors are numbered from 1 and
+// the car stays hidden until the game is over
+type GameState struct {
+	ID        string  `json:"id"`
+	Phase     string  `json:"phase"`
+	Doors     int     `json:"doors"`
+	Selected  int     `json:"selected,omitempty"`   // The door currently picked
+	OpenDoors []int   `json:"open_doors,omitempty"` // Doors opened by the host
+	Available []int   `json:"available"`            // Doors that can be picked next
+	Result    *Result `json:"result,omitempty"`
+}
+
+// Result is the outcome of a finished game
+type Result struct {
+	Won      bool   `json:"won"`
+	Strategy string `json:"strategy"` // "stay" or "switch"
+	Car      int    `json:"car"`      // The door hiding the car
+}
+
+// gameTable holds the games played over the API
+type gameTable struct {
+	mu    sync.Mutex
+	games map[string]*game.Game
+}
+
+// handleNewGame starts a game
+func (s *Server) handleNewGame(w http.ResponseWriter, r *http.Request) {
+	var req NewGameRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %v", ErrInvalidRequest, err))
+			return
+		}
+	}
+	if req.Doors == 0 {
+		req.Doors = game.NumDoors
+	}
+
+	g, err := game.NewGameWithDoors(req.Doors)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	id, err := newGameID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	s.games.mu.Lock()
+	defer s.games.mu.Unlock()
+	if len(s.games.games) >= MaxOpenGames {
+		writeError(w, http.StatusTooManyRequests, ErrTooManyGames)
+		return
+	}
+	s.games.games[id] = g
+
+	writeJSON(w, http.StatusCreated, StateOf(id, g))
+}
+
+// handleGame returns the state of an unfinished game
+func (s *Server) handleGame(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	s.games.mu.Lock()
+	defer s.games.mu.Unlock()
+	g, ok := s.games.games[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrGameNotFound)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, StateOf(id, g))
+}
+
+// handleChoose picks a door: the first pick makes the host open doors, the
+// second ends the game and records it in the statistics
+func (s *Server) handleChoose(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	var req ChooseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("%w: %v", ErrInvalidRequest, err))
+		return
+	}
+
+	s.games.mu.Lock()
+	defer s.games.mu.Unlock()
+	g, ok := s.games.games[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, ErrGameNotFound)
+		return
+	}
+
+	var err error
+	if g.Phase == game.InitialChoice {
+		err = g.MakeInitialChoice(req.Door - 1)
+	} else {
+		err = g.MakeFinalChoice(req.Door - 1)
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if g.IsGameOver() {
+		// Finished games are forgotten once recorded
+		delete(s.games.games, id)
+
+		s.statsMutex.Lock()
+		err := s.stats.RecordGame(g.Result)
+		s.statsMutex.Unlock()
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, fmt.Errorf("failed to record game: %w", err))
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, StateOf(id, g))
+}
+
+// StateOf describes g as the player sees it, as returned by the API
+func StateOf(id string, g *game.Game) GameState {
+	state := GameState{
+		ID:        id,
+		Doors:     g.NumDoors(),
+		Available: []int{},
+	}
+
+	switch g.Phase {
+	case game.InitialChoice:
+		state.Phase = PhaseInitialChoice
+	case game.GameOver:
+		state.Phase = PhaseGameOver
+	default:
+		state.Phase = PhaseFinalChoice
+	}
+
+	if selected := g.GetSelectedDoor(); selected >= 0 {
+		state.Selected = selected + 1
+	}
+	for _, door := range g.GetOpenDoors() {
+		state.OpenDoors = append(state.OpenDoors, door+1)
+	}
+	if !g.IsGameOver() {
+		for _, door := range g.GetAvailableChoices() {
+			state.Available = append(state.Available, door+1)
+		}
+	}
+
+	if g.Result != nil {
+		strategy := "stay"
+		if g.Result.Strategy == game.Switch {
+			strategy = "switch"
+		}
+		state.Result = &Result{
+			Won:      g.Result.Won,
+			Strategy: strategy,
+			Car:      g.Result.CarPosition,
+		}
+	}
+
+	return state
+}
+
+// newGameID returns a random, unguessable game ID
+func newGameID() (string, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to generate game ID: %w", err)
+	}
+	return hex.EncodeToString(b[:]), nil
+}
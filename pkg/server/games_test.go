@@ -0,0 +1,78 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// postJSON sends body as JSON and decodes the game in the response
+func postJSON(t *testing.T, s *Server, path string, body interface{}) (*httptest.ResponseRecorder, GameState) {
+	t.Helper()
+
+	data, _ := json.Marshal(body)
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(data)))
+
+	var state GameState
+	if rec.Code < http.StatusBadRequest {
+		if err := json.Unmarshal(rec.Body.Bytes(), &state); err != nil {
+			t.Fatalf("Invalid response: %v", err)
+		}
+	}
+	return rec, state
+}
+
+func TestPlayGameOverAPI(t *testing.T) {
+	s, statsManager := newTestServer(t)
+
+	rec, state := postJSON(t, s, "/api/games", NewGameRequest{})
+	if rec.Code != http.StatusCreated || state.Phase != PhaseInitialChoice || state.Doors != 3 {
+		t.Fatalf("Expected a new 3-door game, got %d %+v", rec.Code, state)
+	}
+	if len(state.Available) != 3 || state.Result != nil {
+		t.Errorf("Expected all doors available and no result, got %+v", state)
+	}
+
+	rec, state = postJSON(t, s, "/api/games/"+state.ID+"/choose", ChooseRequest{Door: 1})
+	if rec.Code != http.StatusOK || state.Phase != PhaseFinalChoice || state.Selected != 1 {
+		t.Fatalf("Expected the host to open a door, got %d %+v", rec.Code, state)
+	}
+	if len(state.OpenDoors) != 1 || state.OpenDoors[0] == 1 || len(state.Available) != 2 {
+		t.Errorf("Expected one other door open and two to choose from, got %+v", state)
+	}
+
+	if rec, _ := postJSON(t, s, "/api/games/"+state.ID+"/choose", ChooseRequest{Door: state.OpenDoors[0]}); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for choosing an open door, got %d", rec.Code)
+	}
+
+	rec, state = postJSON(t, s, "/api/games/"+state.ID+"/choose", ChooseRequest{Door: 1})
+	if rec.Code != http.StatusOK || state.Phase != PhaseGameOver || state.Result == nil {
+		t.Fatalf("Expected a finished game, got %d %+v", rec.Code, state)
+	}
+	if state.Result.Strategy != "stay" || state.Result.Won != (state.Result.Car == 1) {
+		t.Errorf("Unexpected result %+v", state.Result)
+	}
+	if statsManager.GetStats().TotalGames != 1 {
+		t.Error("Expected the finished game to be recorded")
+	}
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/games/"+state.ID, nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected finished games to be forgotten, got %d", rec.Code)
+	}
+}
+
+func TestNewGameValidation(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	if rec, _ := postJSON(t, s, "/api/games", NewGameRequest{Doors: 2}); rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected 400 for too few doors, got %d", rec.Code)
+	}
+	if rec, _ := postJSON(t, s, "/api/games/unknown/choose", ChooseRequest{Door: 1}); rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown game, got %d", rec.Code)
+	}
+}
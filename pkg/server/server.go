@@ -0,0 +1,98 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// DefaultAddr is the address serve mode listens on unless told otherwise
+const DefaultAddr = "localhost:8080"
+
+// Server exposes Monty Hall data over HTTP for dashboards and external tools
+type Server struct {
+	mux *http.ServeMux
+
+	stats        *stats.StatsManager
+	statsMutex   sync.Mutex       // Guards stats and statsVersion; StatsManager is not thread-safe
+	statsVersion statsFileVersion // The stats file and its journal when last loaded
+	snapshot     atomic.Pointer[statsSnapshot]
+
+	games gameTable  // Games being played over the API
+	class *Classroom // Games reported by students in classroom mode
+}
+
+// New creates a server for the given statistics with all API routes registered
+func New(statsManager *stats.StatsManager) *Server {
+	s := &Server{
+		mux:   http.NewServeMux(),
+		stats: statsManager,
+		games: gameTable{games: make(map[string]*game.Game)},
+		class: NewClassroom(),
+	}
+
+	// New games make the cached snapshot stale
+	statsManager.AddRecordHook(func(stats.GameRecord, *stats.GameStats) error {
+		s.invalidateSnapshot()
+		return nil
+	})
+
+	s.mux.HandleFunc("GET /api/stats", s.handleStats)
+	s.mux.HandleFunc("GET /api/schema", s.handleSchemaList)
+	s.mux.HandleFunc("GET /api/schema/{name}", s.handleSchema)
+	s.mux.HandleFunc("POST /api/games", s.handleNewGame)
+	s.mux.HandleFunc("GET /api/games/{id}", s.handleGame)
+	s.mux.HandleFunc("POST /api/games/{id}/choose", s.handleChoose)
+	s.mux.HandleFunc("GET /api/class", s.handleClass)
+	s.mux.HandleFunc("POST /api/class/results", s.handleClassResult)
+
+	return s
+}
+
+// Classroom returns the games students have reported to the server
+func (s *Server) Classroom() *Classroom {
+	return s.class
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handleSchemaList lists the published JSON schemas
+func (s *Server) handleSchemaList(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string][]string{"schemas": stats.SchemaNames()})
+}
+
+// handleSchema serves a JSON Schema document by name
+func (s *Server) handleSchema(w http.ResponseWriter, r *http.Request) {
+	schema, err := stats.Schema(r.PathValue("name"))
+	if errors.Is(err, stats.ErrUnknownSchema) {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.Write(schema)
+}
+
+// writeJSON writes value as a JSON response
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(value)
+}
+
+// writeError writes an error as a JSON response
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,65 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// newTestServer creates a server whose statistics are stored in a temp directory
+func newTestServer(t *testing.T) (*Server, *stats.StatsManager) {
+	t.Helper()
+
+	statsManager := stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	return New(statsManager), statsManager
+}
+
+func TestSchemaEndpoint(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	for _, name := range []string{"stats", "export"} {
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/schema/"+name, nil))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("Expected 200 for %s schema, got %d", name, rec.Code)
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != "application/schema+json" {
+			t.Errorf("Unexpected content type %q", ct)
+		}
+
+		var schema map[string]interface{}
+		if err := json.Unmarshal(rec.Body.Bytes(), &schema); err != nil {
+			t.Fatalf("Schema %s is not valid JSON: %v", name, err)
+		}
+		if schema["$schema"] == nil {
+			t.Errorf("Schema %s should declare $schema", name)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/schema/unknown", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404 for an unknown schema, got %d", rec.Code)
+	}
+}
+
+func TestSchemaList(t *testing.T) {
+	rec := httptest.NewRecorder()
+	s, _ := newTestServer(t)
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/schema", nil))
+
+	var body struct {
+		Schemas []string `json:"schemas"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Invalid response: %v", err)
+	}
+	if len(body.Schemas) != 2 {
+		t.Errorf("Expected 2 schemas, got %v", body.Schemas)
+	}
+}
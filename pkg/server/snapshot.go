@@ -0,0 +1,154 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// statsSnapshot is a serialized copy of the statistics, reused until a game is recorded
+type statsSnapshot struct {
+	etag    string
+	body    []byte
+	gzipped []byte
+}
+
+// newStatsSnapshot serializes value and precomputes its ETag and gzip encoding
+func newStatsSnapshot(value interface{}) (*statsSnapshot, error) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal stats: %w", err)
+	}
+
+	var gzipped bytes.Buffer
+	zw := gzip.NewWriter(&gzipped)
+	if _, err := zw.Write(body); err != nil {
+		return nil, fmt.Errorf("failed to compress stats: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress stats: %w", err)
+	}
+
+	sum := sha256.Sum256(body)
+	return &statsSnapshot{
+		etag:    `"` + hex.EncodeToString(sum[:8]) + `"`,
+		body:    body,
+		gzipped: gzipped.Bytes(),
+	}, nil
+}
+
+// invalidateSnapshot drops the cached snapshot so the next request rebuilds it
+func (s *Server) invalidateSnapshot() {
+	s.snapshot.Store(nil)
+}
+
+// statsFileVersion tells saves of the stats file apart. Games recorded by
+// other processes are appended to the journal and leave the stats file alone
+// until it is compacted, so the journal is watched too
+type statsFileVersion struct {
+	statsModTime   time.Time
+	journalModTime time.Time
+	journalSize    int64
+}
+
+// statsFileVersionOf returns the version of the stats file at path, or false
+// if it can't be read
+func statsFileVersionOf(path string) (statsFileVersion, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return statsFileVersion{}, false
+	}
+
+	version := statsFileVersion{statsModTime: info.ModTime()}
+	if journal, err := os.Stat(path + stats.JournalSuffix); err == nil {
+		version.journalModTime = journal.ModTime()
+		version.journalSize = journal.Size()
+	}
+	return version, true
+}
+
+// currentSnapshot returns the cached snapshot, rebuilding it if a game was
+// recorded or the stats file or its journal was changed by another process
+func (s *Server) currentSnapshot() (*statsSnapshot, error) {
+	s.statsMutex.Lock()
+	defer s.statsMutex.Unlock()
+
+	if version, ok := statsFileVersionOf(s.stats.GetFilePath()); ok && version != s.statsVersion {
+		if err := s.stats.Reload(); err != nil {
+			return nil, fmt.Errorf("failed to reload stats: %w", err)
+		}
+		s.statsVersion = version
+		s.invalidateSnapshot()
+	}
+
+	if snapshot := s.snapshot.Load(); snapshot != nil {
+		return snapshot, nil
+	}
+
+	snapshot, err := newStatsSnapshot(s.stats.GetStats())
+	if err != nil {
+		return nil, err
+	}
+	s.snapshot.Store(snapshot)
+	return snapshot, nil
+}
+
+// handleStats serves the statistics, answering 304 when the client's copy is
+// current and compressing the body for clients that accept gzip
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	snapshot, err := s.currentSnapshot()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("ETag", snapshot.etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Vary", "Accept-Encoding")
+
+	if etagMatches(r.Header.Get("If-None-Match"), snapshot.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	body := snapshot.body
+	if acceptsGzip(r.Header.Get("Accept-Encoding")) {
+		w.Header().Set("Content-Encoding", "gzip")
+		body = snapshot.gzipped
+	}
+	w.Header().Set("Content-Length", fmt.Sprint(len(body)))
+	w.Write(body)
+}
+
+// etagMatches reports whether an If-None-Match header includes etag
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip
+func acceptsGzip(header string) bool {
+	for _, encoding := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+		if strings.TrimSpace(name) == "gzip" {
+			return strings.ReplaceAll(params, " ", "") != "q=0"
+		}
+	}
+	return false
+}
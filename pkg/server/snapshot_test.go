@@ -0,0 +1,130 @@
+package server
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// getStats requests the stats endpoint with the given headers
+func getStats(s *Server, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/api/stats", nil)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestStatsETag(t *testing.T) {
+	s, statsManager := newTestServer(t)
+
+	first := getStats(s, nil)
+	if first.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", first.Code)
+	}
+	etag := first.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("Expected an ETag header")
+	}
+
+	if rec := getStats(s, map[string]string{"If-None-Match": etag}); rec.Code != http.StatusNotModified {
+		t.Errorf("Expected 304 for a current ETag, got %d", rec.Code)
+	} else if rec.Body.Len() != 0 {
+		t.Error("A 304 response should have no body")
+	}
+
+	err := statsManager.RecordGame(&game.GameResult{
+		Won:            true,
+		Strategy:       game.Switch,
+		InitialChoice:  1,
+		FinalChoice:    2,
+		CarPosition:    2,
+		HostOpenedDoor: 3,
+		Timestamp:      time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to record game: %v", err)
+	}
+
+	rec := getStats(s, map[string]string{"If-None-Match": etag})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200 after a new game, got %d", rec.Code)
+	}
+	if rec.Header().Get("ETag") == etag {
+		t.Error("ETag should change after a new game")
+	}
+
+	var body stats.GameStats
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Invalid stats response: %v", err)
+	}
+	if body.TotalGames != 1 {
+		t.Errorf("Expected 1 game, got %d", body.TotalGames)
+	}
+}
+
+func TestStatsSeesJournaledGames(t *testing.T) {
+	s, statsManager := newTestServer(t)
+
+	// Another process records games; after the first they go to the journal
+	other := stats.NewStatsManager(statsManager.GetFilePath())
+	for played := 1; played <= 6; played++ {
+		err := other.RecordGame(&game.GameResult{
+			Won:            true,
+			Strategy:       game.Switch,
+			InitialChoice:  1,
+			FinalChoice:    2,
+			CarPosition:    2,
+			HostOpenedDoor: 3,
+			Timestamp:      time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+
+		var body stats.GameStats
+		if err := json.Unmarshal(getStats(s, nil).Body.Bytes(), &body); err != nil {
+			t.Fatalf("Invalid stats response: %v", err)
+		}
+		if body.TotalGames != played {
+			t.Fatalf("Expected %d games, got %d", played, body.TotalGames)
+		}
+	}
+}
+
+func TestStatsGzip(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	plain := getStats(s, nil)
+	rec := getStats(s, map[string]string{"Accept-Encoding": "br, gzip"})
+
+	if rec.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatalf("Expected a gzip response, got %q", rec.Header().Get("Content-Encoding"))
+	}
+
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("Invalid gzip body: %v", err)
+	}
+	body, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("Failed to decompress body: %v", err)
+	}
+	if string(body) != plain.Body.String() {
+		t.Error("Decompressed body should match the uncompressed response")
+	}
+
+	if rec := getStats(s, map[string]string{"Accept-Encoding": "gzip;q=0"}); rec.Header().Get("Content-Encoding") != "" {
+		t.Error("gzip;q=0 should disable compression")
+	}
+}
@@ -0,0 +1,321 @@
+// Package simulation plays Monty Hall games in bulk across worker goroutines,
+// reporting running totals over a channel so the simulate command and the
+// simulation view share one engine
+package simulation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"runtime"
+	"sync"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// DefaultBatchSize is how many games a worker plays per unit of work
+const DefaultBatchSize = 10000
+
+// Config configures a Runner
+type Config struct {
+	Players   []string // Names of registered players, see game.RegisterPlayer
+	Games     int      // Games played per player
+	NumDoors  int
+	Host      game.HostBehavior
+	HostOpens int // Doors the host opens; 0 opens all but one
+	Seed      int64
+	Workers   int // Worker goroutines; 0 means one per CPU
+	BatchSize int // Games per unit of work; 0 means DefaultBatchSize
+
+	// OnGame, if set, is called with every game, counting from 1 for each
+	// player. Calls come from a single goroutine in game order per player,
+	// so games can be streamed to a file
+	OnGame func(player string, n int, result *game.GameResult) error
+}
+
+// Tally counts the games played by one player
+type Tally struct {
+	Player      string
+	Games       int
+	Wins        int
+	CarRevealed int // Games where the host revealed the car
+}
+
+// WinRate returns the fraction of games won, or 0 before any games
+func (t Tally) WinRate() float64 {
+	if t.Games == 0 {
+		return 0
+	}
+	return float64(t.Wins) / float64(t.Games)
+}
+
+// Update reports the running totals after a batch of games
+type Update struct {
+	Tallies []Tally // In the order of Config.Players
+	Played  int64   // Games played over all strategies
+	Total   int64   // Games the run will play
+	Done    bool    // The run finished; this is the last update
+	Err     error   // The run failed; this is the last update
+}
+
+// Runner plays games for several players across worker goroutines. Every
+// batch has its own seed and a new player, so the totals for a seed are the
+// same whatever the number of workers
+type Runner struct {
+	config Config
+}
+
+// NewRunner validates the configuration and creates a runner
+func NewRunner(config Config) (*Runner, error) {
+	if len(config.Players) == 0 {
+		return nil, errors.New("a simulation needs at least one player")
+	}
+	for _, name := range config.Players {
+		if _, err := game.NewPlayer(name); err != nil {
+			return nil, err
+		}
+	}
+	if config.Games <= 0 {
+		return nil, fmt.Errorf("number of games must be positive, got %d", config.Games)
+	}
+	if err := game.ValidateNumDoors(config.NumDoors); err != nil {
+		return nil, err
+	}
+	if err := game.ValidateHostOpens(config.NumDoors, config.HostOpens); err != nil {
+		return nil, err
+	}
+	if config.Workers < 0 {
+		return nil, fmt.Errorf("number of workers cannot be negative, got %d", config.Workers)
+	}
+	if config.Workers == 0 {
+		config.Workers = runtime.GOMAXPROCS(0)
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	return &Runner{config: config}, nil
+}
+
+// batch is a unit of work: games [start, start+size) of one player
+type batch struct {
+	player int
+	index  int
+	start  int
+	size   int
+}
+
+// batchResult is a played batch
+type batchResult struct {
+	batch
+	tally   Tally
+	results []*game.GameResult // Kept only when OnGame is set
+	err     error
+}
+
+// Start plays the games in the background and returns a channel of updates,
+// one per finished batch. The last update has Done or Err set, after which
+// the channel is closed. Canceling ctx stops the run and closes the channel
+// without a final update
+func (r *Runner) Start(ctx context.Context) <-chan Update {
+	ctx, cancel := context.WithCancel(ctx)
+	jobs := make(chan batch)
+	results := make(chan batchResult)
+	updates := make(chan Update)
+
+	go func() {
+		defer close(jobs)
+		// Take turns between players so their totals grow together
+		for index, start := 0, 0; start < r.config.Games; index, start = index+1, start+r.config.BatchSize {
+			for p := range r.config.Players {
+				job := batch{player: p, index: index, start: start, size: min(r.config.BatchSize, r.config.Games-start)}
+				select {
+				case jobs <- job:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	var workers sync.WaitGroup
+	for range r.config.Workers {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for job := range jobs {
+				select {
+				case results <- r.play(job):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		workers.Wait()
+		close(results)
+	}()
+
+	go func() {
+		defer close(updates)
+		defer cancel()
+
+		send := func(update Update) bool {
+			select {
+			case updates <- update:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		c := newCollector(r.config)
+		for result := range results {
+			if err := c.add(result); err != nil {
+				send(Update{Tallies: c.snapshot(), Played: c.played, Total: c.total, Err: err})
+				return
+			}
+			if !send(Update{Tallies: c.snapshot(), Played: c.played, Total: c.total}) {
+				return
+			}
+		}
+		if ctx.Err() == nil {
+			send(Update{Tallies: c.snapshot(), Played: c.played, Total: c.total, Done: true})
+		}
+	}()
+
+	return updates
+}
+
+// Run plays every game and returns the final totals
+func (r *Runner) Run(ctx context.Context) ([]Tally, error) {
+	var last Update
+	for update := range r.Start(ctx) {
+		last = update
+	}
+	switch {
+	case last.Err != nil:
+		return nil, last.Err
+	case !last.Done:
+		return nil, ctx.Err()
+	}
+	return last.Tallies, nil
+}
+
+// play plays one batch with its own random source and player
+func (r *Runner) play(job batch) batchResult {
+	name := r.config.Players[job.player]
+	result := batchResult{batch: job, tally: Tally{Player: name}}
+	player, err := game.NewPlayer(name)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	if r.config.OnGame != nil {
+		result.results = make([]*game.GameResult, 0, job.size)
+	}
+
+	rng := mathrand.New(mathrand.NewSource(batchSeed(r.config.Seed, job.player, job.index)))
+	for range job.size {
+		g, err := game.NewGameWithRand(rng, r.config.NumDoors)
+		if err != nil {
+			result.err = err
+			return result
+		}
+		g.Host.Behavior = r.config.Host
+		g.Host.Opens = r.config.HostOpens
+		if err := game.PlayGame(g, player, rng); err != nil {
+			result.err = fmt.Errorf("%s: %w", name, err)
+			return result
+		}
+
+		result.tally.Games++
+		if g.Result.Won {
+			result.tally.Wins++
+		}
+		if g.Result.CarRevealed {
+			result.tally.CarRevealed++
+		}
+		if result.results != nil {
+			result.results = append(result.results, g.Result)
+		}
+	}
+	return result
+}
+
+// batchSeed derives the seed of a batch with the SplitMix64 finalizer, so
+// neighboring batches get unrelated random sequences
+func batchSeed(seed int64, player, index int) int64 {
+	z := uint64(seed) + uint64(player)<<32 + uint64(index)*0x9E3779B97F4A7C15
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return int64(z ^ (z >> 31))
+}
+
+// collector adds up finished batches, passing their games to OnGame in order
+type collector struct {
+	config  Config
+	tallies []Tally
+	played  int64
+	total   int64
+
+	// Batches that finished ahead of an earlier one, by player and index
+	pending []map[int]batchResult
+	next    []int
+}
+
+func newCollector(config Config) *collector {
+	c := &collector{
+		config:  config,
+		tallies: make([]Tally, len(config.Players)),
+		total:   int64(config.Games) * int64(len(config.Players)),
+		pending: make([]map[int]batchResult, len(config.Players)),
+		next:    make([]int, len(config.Players)),
+	}
+	for i, name := range config.Players {
+		c.tallies[i].Player = name
+		c.pending[i] = make(map[int]batchResult)
+	}
+	return c
+}
+
+// add counts a finished batch
+func (c *collector) add(result batchResult) error {
+	if result.err != nil {
+		return result.err
+	}
+
+	tally := &c.tallies[result.player]
+	tally.Games += result.tally.Games
+	tally.Wins += result.tally.Wins
+	tally.CarRevealed += result.tally.CarRevealed
+	c.played += int64(result.tally.Games)
+
+	if c.config.OnGame == nil {
+		return nil
+	}
+
+	p := result.player
+	c.pending[p][result.index] = result
+	for {
+		ready, ok := c.pending[p][c.next[p]]
+		if !ok {
+			return nil
+		}
+		delete(c.pending[p], c.next[p])
+		c.next[p]++
+		for i, game := range ready.results {
+			if err := c.config.OnGame(c.config.Players[p], ready.start+i+1, game); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// snapshot copies the running totals
+func (c *collector) snapshot() []Tally {
+	tallies := make([]Tally, len(c.tallies))
+	copy(tallies, c.tallies)
+	return tallies
+}
@@ -0,0 +1,111 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// Options configures a headless batch simulation of replay policies
+type Options struct {
+	Policies   []stats.ReplayPolicy
+	Games      int // Games played per policy
+	NumDoors   int
+	Seed       int64
+	Workers    int     // Worker goroutines; 0 means one per CPU
+	Confidence float64 // Confidence level for the win rate intervals, e.g. 0.95
+
+	// OnGame, if set, is called with every game in order, counting from 1
+	// for each policy, so games can be streamed rather than kept
+	OnGame func(policy stats.ReplayPolicy, n int, result *game.GameResult) error
+
+	// OnProgress, if set, is called after every batch of games
+	OnProgress func(update Update)
+}
+
+// Simulate plays games for each policy across worker goroutines and reports
+// the win rates with confidence intervals
+func Simulate(ctx context.Context, options Options) (*stats.SimulationReport, error) {
+	if options.Confidence <= 0 || options.Confidence >= 1 {
+		return nil, fmt.Errorf("confidence must be between 0 and 1, got %g", options.Confidence)
+	}
+
+	players := make([]string, len(options.Policies))
+	policies := make(map[string]stats.ReplayPolicy, len(options.Policies))
+	for i, policy := range options.Policies {
+		players[i] = PolicyPlayer(policy)
+		policies[players[i]] = policy
+	}
+
+	config := Config{
+		Players:  players,
+		Games:    options.Games,
+		NumDoors: options.NumDoors,
+		Seed:     options.Seed,
+		Workers:  options.Workers,
+	}
+	if options.OnGame != nil {
+		config.OnGame = func(player string, n int, result *game.GameResult) error {
+			return options.OnGame(policies[player], n, result)
+		}
+	}
+	runner, err := NewRunner(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var last Update
+	for update := range runner.Start(ctx) {
+		if options.OnProgress != nil {
+			options.OnProgress(update)
+		}
+		last = update
+	}
+	switch {
+	case last.Err != nil:
+		return nil, last.Err
+	case !last.Done:
+		return nil, ctx.Err()
+	}
+	return Report(options, last.Tallies), nil
+}
+
+// PolicyPlayer returns the name of the registered player that plays a replay policy
+func PolicyPlayer(policy stats.ReplayPolicy) string {
+	switch policy {
+	case stats.PolicyAlwaysSwitch:
+		return "switch"
+	case stats.PolicyRandom:
+		return "random"
+	default:
+		return "stay"
+	}
+}
+
+// Report turns the tallies of a policy run into a simulation report
+func Report(options Options, tallies []Tally) *stats.SimulationReport {
+	report := &stats.SimulationReport{
+		NumDoors:   options.NumDoors,
+		Seed:       options.Seed,
+		Confidence: options.Confidence,
+	}
+
+	z := stats.ZScore(options.Confidence)
+	for i, tally := range tallies {
+		policy := options.Policies[i]
+		lower, upper := stats.WilsonInterval(tally.Wins, tally.Games, z)
+		report.Results = append(report.Results, stats.SimulationResult{
+			Policy:   policy,
+			Strategy: policy.String(),
+			Games:    tally.Games,
+			Wins:     tally.Wins,
+			WinRate:  tally.WinRate(),
+			Expected: policy.ExpectedWinRate(options.NumDoors),
+			Lower:    lower,
+			Upper:    upper,
+		})
+	}
+	return report
+}
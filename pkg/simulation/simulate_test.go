@@ -0,0 +1,176 @@
+package simulation
+
+import (
+	"context"
+	"errors"
+	mathrand "math/rand"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestSimulate(t *testing.T) {
+	options := Options{
+		Policies:   stats.ReplayPolicies(),
+		Games:      20000,
+		NumDoors:   3,
+		Seed:       1,
+		Confidence: stats.DefaultConfidence,
+	}
+	report, err := Simulate(context.Background(), options)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(report.Results) != 3 {
+		t.Fatalf("Expected 3 results, got %d", len(report.Results))
+	}
+
+	for _, result := range report.Results {
+		if result.Games != 20000 {
+			t.Errorf("%s: expected 20000 games, got %d", result.Strategy, result.Games)
+		}
+		if result.Lower > result.WinRate || result.Upper < result.WinRate {
+			t.Errorf("%s: interval %.3f-%.3f should contain %.3f", result.Strategy, result.Lower, result.Upper, result.WinRate)
+		}
+		// Widen the interval so the fixed seed is not a coin flip away from failing
+		if result.Expected < result.Lower-0.01 || result.Expected > result.Upper+0.01 {
+			t.Errorf("%s: expected %.3f outside interval %.3f-%.3f", result.Strategy, result.Expected, result.Lower, result.Upper)
+		}
+	}
+
+	// The seed fixes the results whatever the number of workers
+	options.Workers = 1
+	again, _ := Simulate(context.Background(), options)
+	for i := range report.Results {
+		if again.Results[i].Wins != report.Results[i].Wins {
+			t.Errorf("%s: the same seed should reproduce the same results", report.Results[i].Strategy)
+		}
+	}
+}
+
+func TestSimulateInvalidOptions(t *testing.T) {
+	valid := Options{Policies: stats.ReplayPolicies(), Games: 10, NumDoors: 3, Confidence: stats.DefaultConfidence}
+
+	for name, modify := range map[string]func(*Options){
+		"no policies":     func(o *Options) { o.Policies = nil },
+		"no games":        func(o *Options) { o.Games = 0 },
+		"too few doors":   func(o *Options) { o.NumDoors = 2 },
+		"bad confidence":  func(o *Options) { o.Confidence = 1 },
+		"zero confidence": func(o *Options) { o.Confidence = 0 },
+		"bad workers":     func(o *Options) { o.Workers = -1 },
+	} {
+		options := valid
+		modify(&options)
+		if _, err := Simulate(context.Background(), options); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestRunnerUpdates(t *testing.T) {
+	var order []int
+	runner, err := NewRunner(Config{
+		Players:   []string{"switch"},
+		Games:     1000,
+		NumDoors:  3,
+		Seed:      1,
+		Workers:   4,
+		BatchSize: 64,
+		OnGame: func(player string, n int, result *game.GameResult) error {
+			order = append(order, n)
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var updates []Update
+	for update := range runner.Start(context.Background()) {
+		updates = append(updates, update)
+	}
+
+	// One update per batch of 64, then the final one
+	if len(updates) != 17 {
+		t.Fatalf("Expected 17 updates, got %d", len(updates))
+	}
+	last := updates[len(updates)-1]
+	if !last.Done || last.Played != 1000 || last.Total != 1000 || last.Tallies[0].Games != 1000 {
+		t.Errorf("Unexpected final update: %+v", last)
+	}
+	for i := 1; i < len(updates); i++ {
+		if updates[i].Played < updates[i-1].Played {
+			t.Fatal("Expected the games played never to go down")
+		}
+	}
+
+	if len(order) != 1000 {
+		t.Fatalf("Expected OnGame for every game, got %d calls", len(order))
+	}
+	for i, n := range order {
+		if n != i+1 {
+			t.Fatalf("Expected games in order, got game %d at position %d", n, i+1)
+		}
+	}
+}
+
+func TestRunnerCancel(t *testing.T) {
+	runner, _ := NewRunner(Config{Players: []string{"stay"}, Games: 10_000_000, NumDoors: 3, BatchSize: 100})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := runner.Start(ctx)
+	<-updates
+	cancel()
+
+	var last Update
+	for update := range updates {
+		last = update
+	}
+	if last.Done || last.Played >= 10_000_000 {
+		t.Errorf("Expected the run to stop early, got %+v", last)
+	}
+
+	if _, err := runner.Run(ctx); err != context.Canceled {
+		t.Errorf("Expected Run to report the cancellation, got %v", err)
+	}
+}
+
+// threeOnly switches with three doors and stays with more
+type threeOnly struct{}
+
+func (threeOnly) Name() string { return "three-only" }
+
+func (threeOnly) ChooseInitialDoor(g *game.Game, rng *mathrand.Rand) int {
+	return rng.Intn(g.NumDoors())
+}
+
+func (threeOnly) DecideFinalChoice(g *game.Game, rng *mathrand.Rand) bool {
+	return g.NumDoors() == 3
+}
+
+func TestRegisteredPlayer(t *testing.T) {
+	game.RegisterPlayer("three-only", func() game.Player { return threeOnly{} })
+
+	for doors, expected := range map[int]float64{3: 2.0 / 3, 4: 1.0 / 4} {
+		runner, err := NewRunner(Config{Players: []string{"three-only"}, Games: 20000, NumDoors: doors, Seed: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tallies, err := runner.Run(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tallies[0].Player != "three-only" {
+			t.Errorf("Expected the player's name, got %q", tallies[0].Player)
+		}
+		if rate := tallies[0].WinRate(); rate < expected-0.02 || rate > expected+0.02 {
+			t.Errorf("%d doors: expected a win rate near %.3f, got %.3f", doors, expected, rate)
+		}
+	}
+
+	if _, err := NewRunner(Config{Players: []string{"psychic"}, Games: 10, NumDoors: 3}); !errors.Is(err, game.ErrUnknownPlayer) {
+		t.Errorf("Expected an unknown player error, got %v", err)
+	}
+}
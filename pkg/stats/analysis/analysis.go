@@ -0,0 +1,183 @@
+// Package analysis tests recorded win rates against Monty Hall theory and
+// puts the results into words
+package analysis
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// DefaultConfidence is the confidence level conclusions are drawn at
+const DefaultConfidence = 0.95
+
+// MinGames is how many games a strategy needs before its win rate is tested
+const MinGames = 10
+
+// Test is a test of one strategy's win rate against the rate theory predicts
+type Test struct {
+	Strategy   string // "switch" or "stay"
+	Group      string // Host and door count of the games, or "" for the classic three-door game
+	Wins       int
+	Games      int
+	Expected   float64 // Win rate theory predicts
+	Lower      float64 // Confidence interval for the true win rate
+	Upper      float64
+	PValue     float64 // Chance of a result at least this far from Expected if theory holds
+	Method     string  // "chi-square", or "exact binomial" for small samples
+	Confidence float64
+}
+
+// Rate returns the observed win rate
+func (t Test) Rate() float64 {
+	if t.Games == 0 {
+		return 0
+	}
+	return float64(t.Wins) / float64(t.Games)
+}
+
+// Enough reports whether there are enough games for the test to mean anything
+func (t Test) Enough() bool {
+	return t.Games >= MinGames
+}
+
+// Consistent reports whether the win rate is consistent with theory, that is
+// whether the test fails to reject it at the confidence level
+func (t Test) Consistent() bool {
+	return t.PValue >= 1-t.Confidence
+}
+
+// Conclusion puts the result into words, such as "Your switch win rate of
+// 64.0% is consistent with 2/3 at 95% confidence"
+func (t Test) Conclusion() string {
+	subject := fmt.Sprintf("Your %s win rate", t.Strategy)
+	if t.Group != "" {
+		subject = fmt.Sprintf("%s: your %s win rate", t.Group, t.Strategy)
+	}
+	confidence := fmt.Sprintf("%.0f%% confidence", t.Confidence*100)
+
+	switch {
+	case !t.Enough():
+		return fmt.Sprintf("%s can be tested after %d more games", subject, MinGames-t.Games)
+	case t.Consistent():
+		return fmt.Sprintf("%s of %.1f%% is consistent with %s at %s", subject, t.Rate()*100, Fraction(t.Expected), confidence)
+	case t.Rate() > t.Expected:
+		return fmt.Sprintf("%s of %.1f%% is above %s at %s (p = %.3f)", subject, t.Rate()*100, Fraction(t.Expected), confidence, t.PValue)
+	default:
+		return fmt.Sprintf("%s of %.1f%% is below %s at %s (p = %.3f)", subject, t.Rate()*100, Fraction(t.Expected), confidence, t.PValue)
+	}
+}
+
+// BinomialTest tests wins out of games against an expected win rate. The
+// chi-square test is used when both wins and losses are expected at least 5
+// times, and the exact binomial test otherwise
+func BinomialTest(wins, games int, expected, confidence float64) Test {
+	test := Test{Wins: wins, Games: games, Expected: expected, Confidence: confidence, PValue: 1}
+	if games == 0 {
+		return test
+	}
+
+	test.Lower, test.Upper = stats.WilsonInterval(wins, games, stats.ZScore(confidence))
+	n := float64(games)
+	if n*expected >= 5 && n*(1-expected) >= 5 {
+		test.Method = "chi-square"
+		test.PValue = chiSquarePValue(wins, games, expected)
+	} else {
+		test.Method = "exact binomial"
+		test.PValue = exactBinomialPValue(wins, games, expected)
+	}
+	return test
+}
+
+// Analyze tests the switch and stay win rates of each group of games played
+// with the same host, door count and doors opened, most played first. Games
+// in which the host revealed the car are left out, as neither strategy can win
+// them
+func Analyze(history []stats.GameRecord, confidence float64) []Test {
+	var tests []Test
+	for _, group := range stats.StatsByHost(history) {
+		name := ""
+		if group.IsVariant() || group.NumDoors != game.NumDoors {
+			name = group.Name()
+		}
+
+		for _, strategy := range []struct {
+			strategy string
+			stats    stats.StrategyStats
+			expected float64
+		}{
+			{"switch", group.SwitchStats, group.ExpectedSwitchRate()},
+			{"stay", group.StayStats, group.ExpectedStayRate()},
+		} {
+			if strategy.stats.GamesPlayed == 0 {
+				continue
+			}
+			test := BinomialTest(strategy.stats.Wins, strategy.stats.GamesPlayed, strategy.expected, confidence)
+			test.Strategy = strategy.strategy
+			test.Group = name
+			tests = append(tests, test)
+		}
+	}
+
+	sort.SliceStable(tests, func(i, j int) bool {
+		return tests[i].Games > tests[j].Games
+	})
+	return tests
+}
+
+// Fraction formats a rate as a simple fraction such as "2/3" when it is one,
+// or as a percentage otherwise
+func Fraction(rate float64) string {
+	for denominator := 1; denominator <= 20; denominator++ {
+		numerator := math.Round(rate * float64(denominator))
+		if math.Abs(rate*float64(denominator)-numerator) < 1e-9 {
+			if numerator == 0 || int(numerator) == denominator {
+				return fmt.Sprintf("%.0f%%", rate*100)
+			}
+			return fmt.Sprintf("%.0f/%d", numerator, denominator)
+		}
+	}
+	return fmt.Sprintf("%.1f%%", rate*100)
+}
+
+// chiSquarePValue returns the p-value of the chi-square goodness-of-fit test
+// of wins and losses against the expected rate. With two outcomes there is
+// one degree of freedom, for which the chi-square tail has a closed form
+func chiSquarePValue(wins, games int, expected float64) float64 {
+	n := float64(games)
+	deviation := float64(wins) - n*expected
+	chiSquare := deviation * deviation / (n * expected * (1 - expected))
+	return math.Erfc(math.Sqrt(chiSquare / 2))
+}
+
+// exactBinomialPValue returns the two-sided p-value of the exact binomial
+// test: the chance of any number of wins no more likely than the observed one
+func exactBinomialPValue(wins, games int, expected float64) float64 {
+	if expected <= 0 || expected >= 1 {
+		// Theory allows only one outcome
+		if (expected <= 0 && wins == 0) || (expected >= 1 && wins == games) {
+			return 1
+		}
+		return 0
+	}
+
+	observed := binomialLogPMF(wins, games, expected)
+	pValue := 0.0
+	for k := 0; k <= games; k++ {
+		if logP := binomialLogPMF(k, games, expected); logP <= observed+1e-7 {
+			pValue += math.Exp(logP)
+		}
+	}
+	return math.Min(1, pValue)
+}
+
+// binomialLogPMF returns the log of the chance of k wins in n games
+func binomialLogPMF(k, n int, p float64) float64 {
+	lgN, _ := math.Lgamma(float64(n + 1))
+	lgK, _ := math.Lgamma(float64(k + 1))
+	lgNK, _ := math.Lgamma(float64(n - k + 1))
+	return lgN - lgK - lgNK + float64(k)*math.Log(p) + float64(n-k)*math.Log(1-p)
+}
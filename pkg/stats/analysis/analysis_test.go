@@ -0,0 +1,84 @@
+package analysis
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestBinomialTest(t *testing.T) {
+	// 60 heads in 100 fair tosses is two standard deviations out
+	test := BinomialTest(60, 100, 0.5, DefaultConfidence)
+	if test.Method != "chi-square" || math.Abs(test.PValue-0.0455) > 0.0005 {
+		t.Errorf("Expected a chi-square p-value of 0.0455, got %s %.4f", test.Method, test.PValue)
+	}
+	if test.Consistent() {
+		t.Error("60 of 100 should be inconsistent with 1/2 at 95% confidence")
+	}
+	if test.Lower > 0.6 || test.Upper < 0.6 || test.Lower < 0.49 {
+		t.Errorf("Expected the interval to hold 60%% and not 50%%, got %.3f-%.3f", test.Lower, test.Upper)
+	}
+
+	// Small samples use the exact test: 7 of 8 is 18/256 either way
+	test = BinomialTest(7, 8, 0.5, DefaultConfidence)
+	if test.Method != "exact binomial" || math.Abs(test.PValue-18.0/256) > 1e-9 {
+		t.Errorf("Expected an exact p-value of %.5f, got %s %.5f", 18.0/256, test.Method, test.PValue)
+	}
+
+	if test := BinomialTest(3, 10, 1.0/3, DefaultConfidence); !test.Consistent() || test.PValue > 1 {
+		t.Errorf("3 of 10 should be consistent with 1/3, got p = %f", test.PValue)
+	}
+	if test := BinomialTest(0, 0, 0.5, DefaultConfidence); !test.Consistent() || test.Enough() {
+		t.Error("No games should neither contradict theory nor be enough to test")
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	var history []stats.GameRecord
+	for i := range 30 {
+		history = append(history, stats.GameRecord{Strategy: game.Switch, Won: i%3 != 0})
+	}
+	for i := range 20 {
+		history = append(history, stats.GameRecord{Strategy: game.Stay, Won: i < 15})
+	}
+	for range 4 {
+		history = append(history, stats.GameRecord{Strategy: game.Stay, NumDoors: 5})
+	}
+
+	tests := Analyze(history, DefaultConfidence)
+	if len(tests) != 3 {
+		t.Fatalf("Expected switch, stay and five-door stay tests, got %d", len(tests))
+	}
+
+	want := []string{
+		"Your switch win rate of 66.7% is consistent with 2/3 at 95% confidence",
+		"Your stay win rate of 75.0% is above 1/3 at 95% confidence (p = 0.000)",
+		"Classic Monty, 5 doors: your stay win rate can be tested after 6 more games",
+	}
+	for i, test := range tests {
+		if got := test.Conclusion(); got != want[i] {
+			t.Errorf("Expected %q, got %q", want[i], got)
+		}
+	}
+	if !strings.Contains(tests[2].Conclusion(), "5 doors") || tests[2].Expected != 0.2 {
+		t.Errorf("Expected the five-door stay rate to be tested against 1/5, got %f", tests[2].Expected)
+	}
+}
+
+func TestFraction(t *testing.T) {
+	for rate, want := range map[float64]string{
+		2.0 / 3:  "2/3",
+		0.25:     "1/4",
+		7.0 / 16: "7/16",
+		1:        "100%",
+		0:        "0%",
+		0.123:    "12.3%",
+	} {
+		if got := Fraction(rate); got != want {
+			t.Errorf("Fraction(%f) = %q, want %q", rate, got, want)
+		}
+	}
+}
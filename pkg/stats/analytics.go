@@ -0,0 +1,104 @@
+package stats
+
+import "time"
+
+// PlayTimeMinGames is how many games a weekday or hour needs before its win
+// rate is singled out as the best
+const PlayTimeMinGames = 5
+
+// PlayTimeStats counts the games played at one time of the week
+type PlayTimeStats struct {
+	Games int
+	Wins  int
+}
+
+// WinRate returns the fraction of the games won, or 0 before any games
+func (p PlayTimeStats) WinRate() float64 {
+	if p.Games == 0 {
+		return 0
+	}
+	return float64(p.Wins) / float64(p.Games)
+}
+
+// add counts one game
+func (p *PlayTimeStats) add(won bool) {
+	p.Games++
+	if won {
+		p.Wins++
+	}
+}
+
+// PlayTimes breaks the games down by the weekday and hour they were played,
+// in the player's local time when they were recorded
+type PlayTimes struct {
+	ByWeekday [7]PlayTimeStats     // Indexed by time.Weekday, Sunday first
+	ByHour    [24]PlayTimeStats    // Indexed by hour of the day
+	Grid      [7][24]PlayTimeStats // By weekday, then hour
+}
+
+// PlayTimesOf groups the history by weekday and hour of play
+func PlayTimesOf(history []GameRecord) PlayTimes {
+	var times PlayTimes
+	for _, record := range history {
+		weekday, hour := playTimeOf(record)
+		times.ByWeekday[weekday].add(record.Won)
+		times.ByHour[hour].add(record.Won)
+		times.Grid[weekday][hour].add(record.Won)
+	}
+	return times
+}
+
+// playTimeOf returns the weekday and hour a game was recorded at, falling
+// back to its timestamp for records without them
+func playTimeOf(record GameRecord) (time.Weekday, int) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if day.String() == record.DayOfWeek && record.HourOfDay >= 0 && record.HourOfDay < 24 {
+			return day, record.HourOfDay
+		}
+	}
+	return record.Timestamp.Weekday(), record.Timestamp.Hour()
+}
+
+// Busiest returns the weekday and hour with the most games played
+func (p PlayTimes) Busiest() (time.Weekday, int) {
+	var weekday time.Weekday
+	var hour int
+	for day := range p.Grid {
+		for h := range p.Grid[day] {
+			if p.Grid[day][h].Games > p.Grid[weekday][hour].Games {
+				weekday, hour = time.Weekday(day), h
+			}
+		}
+	}
+	return weekday, hour
+}
+
+// BestWeekday returns the weekday with the highest win rate among those with
+// at least PlayTimeMinGames games, or false when none has that many
+func (p PlayTimes) BestWeekday() (time.Weekday, bool) {
+	best, found := time.Sunday, false
+	for day, stats := range p.ByWeekday {
+		if stats.Games < PlayTimeMinGames {
+			continue
+		}
+		if !found || stats.WinRate() > p.ByWeekday[best].WinRate() {
+			best, found = time.Weekday(day), true
+		}
+	}
+	return best, found
+}
+
+// BestHour returns the hour with the highest win rate among those with at
+// least PlayTimeMinGames games, or false when none has that many
+func (p PlayTimes) BestHour() (int, bool) {
+	best, found := 0, false
+	for hour, stats := range p.ByHour {
+		if stats.Games < PlayTimeMinGames {
+			continue
+		}
+		if !found || stats.WinRate() > p.ByHour[best].WinRate() {
+			best, found = hour, true
+		}
+	}
+	return best, found
+}
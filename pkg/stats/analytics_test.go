@@ -0,0 +1,45 @@
+package stats
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlayTimesOf(t *testing.T) {
+	monday := time.Date(2026, time.October, 12, 21, 30, 0, 0, time.Local)
+	var history []GameRecord
+	for i := range 6 {
+		history = append(history, GameRecord{Won: i < 5, DayOfWeek: "Monday", HourOfDay: 21})
+	}
+	for i := range 5 {
+		history = append(history, GameRecord{Won: i < 2, DayOfWeek: "Friday", HourOfDay: 8})
+	}
+	// Records without a weekday fall back to their timestamp
+	history = append(history, GameRecord{Won: true, Timestamp: monday.AddDate(0, 0, 1)})
+
+	times := PlayTimesOf(history)
+	if got := times.ByWeekday[time.Monday]; got.Games != 6 || got.Wins != 5 {
+		t.Errorf("Expected 5 of 6 won on Monday, got %+v", got)
+	}
+	if got := times.ByHour[8]; got.Games != 5 || got.WinRate() != 0.4 {
+		t.Errorf("Expected 40%% won at 8:00, got %+v", got)
+	}
+	if got := times.Grid[time.Tuesday][21]; got.Games != 1 {
+		t.Errorf("Expected the game without a weekday on Tuesday at 21:00, got %+v", got)
+	}
+
+	if weekday, hour := times.Busiest(); weekday != time.Monday || hour != 21 {
+		t.Errorf("Expected Monday at 21:00 to be busiest, got %v at %d", weekday, hour)
+	}
+	if weekday, ok := times.BestWeekday(); !ok || weekday != time.Monday {
+		t.Errorf("Expected Monday to be the best weekday, got %v", weekday)
+	}
+	if hour, ok := times.BestHour(); !ok || hour != 21 {
+		t.Errorf("Expected 21:00 to be the best hour, got %d", hour)
+	}
+
+	// Too few games to single out a best time
+	if _, ok := PlayTimesOf(history[:4]).BestWeekday(); ok {
+		t.Error("Four games should be too few for a best weekday")
+	}
+}
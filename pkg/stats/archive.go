@@ -0,0 +1,174 @@
+package stats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ArchiveDirName is the folder beside the stats file that keeps the games
+// trimmed from the history
+const ArchiveDirName = "monty_hall_archive"
+
+// archiveMonth names an archive file after the month its games were played
+const archiveMonth = "2006-01"
+
+// Archive keeps the games trimmed from the history once it grows past
+// MaxHistorySize, so they are never lost. Games are filed by the month they
+// were played, one file per month with one JSON game record per line, and
+// are only read when asked for
+type Archive struct {
+	dir string
+}
+
+// NewArchive opens the archive in dir; the folder is created on the first game
+func NewArchive(dir string) *Archive {
+	return &Archive{dir: dir}
+}
+
+// Dir returns the folder the archive is kept in
+func (a *Archive) Dir() string {
+	return a.dir
+}
+
+// Path returns the file of the games played in the month of t
+func (a *Archive) Path(t time.Time) string {
+	return filepath.Join(a.dir, t.UTC().Format(archiveMonth)+SyncFileExt)
+}
+
+// Add appends records to the files of the months they were played in
+func (a *Archive) Add(records ...GameRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	if err := os.MkdirAll(a.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create archive directory %s: %w", a.dir, err)
+	}
+
+	months := make(map[string]*bytes.Buffer)
+	for _, record := range records {
+		path := a.Path(record.Timestamp)
+		if months[path] == nil {
+			months[path] = &bytes.Buffer{}
+		}
+		if err := json.NewEncoder(months[path]).Encode(record); err != nil {
+			return fmt.Errorf("failed to marshal game record: %w", err)
+		}
+	}
+
+	for path, buf := range months {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open archive file: %w", err)
+		}
+		if _, err := file.Write(buf.Bytes()); err != nil {
+			file.Close()
+			return fmt.Errorf("failed to write archive file: %w", err)
+		}
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("failed to write archive file: %w", err)
+		}
+	}
+	return nil
+}
+
+// Months returns the months with archived games, oldest first, as YYYY-MM
+func (a *Archive) Months() ([]string, error) {
+	entries, err := os.ReadDir(a.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive directory: %w", err)
+	}
+
+	var months []string
+	for _, entry := range entries {
+		month, ok := strings.CutSuffix(entry.Name(), SyncFileExt)
+		if _, err := time.Parse(archiveMonth, month); ok && err == nil && !entry.IsDir() {
+			months = append(months, month)
+		}
+	}
+	slices.Sort(months)
+	return months, nil
+}
+
+// Games returns the archived games played from from up to but not including
+// to, ordered by when they were played. A zero from or to leaves that end of
+// the range open. Only the files of the months in the range are read; lines
+// that can't be parsed are skipped, as are games archived twice
+func (a *Archive) Games(from, to time.Time) ([]GameRecord, error) {
+	months, err := a.Months()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var records []GameRecord
+	for _, month := range months {
+		if !from.IsZero() && month < from.UTC().Format(archiveMonth) || !to.IsZero() && month > to.UTC().Format(archiveMonth) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(a.dir, month+SyncFileExt))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive file: %w", err)
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		scanner.Buffer(nil, len(data)+1)
+		for scanner.Scan() {
+			var record GameRecord
+			if err := json.Unmarshal(scanner.Bytes(), &record); err != nil || seen[record.ID] {
+				continue
+			}
+			if !from.IsZero() && record.Timestamp.Before(from) || !to.IsZero() && !record.Timestamp.Before(to) {
+				continue
+			}
+			seen[record.ID] = true
+			records = append(records, record)
+		}
+	}
+
+	sortRecords(records)
+	return records, nil
+}
+
+// archiveTrimmed moves the games trimmed from the history into the archive.
+// They stay queued if the archive can't be written, so the next save tries
+// again; statistics kept without a file drop them as before
+func (sm *StatsManager) archiveTrimmed() error {
+	stats := sm.collector.GetStats()
+	if sm.archive == nil || len(stats.trimmed) == 0 {
+		stats.trimmed = nil
+		return nil
+	}
+	if err := sm.archive.Add(stats.trimmed...); err != nil {
+		return fmt.Errorf("failed to archive trimmed games: %w", err)
+	}
+	stats.trimmed = nil
+	return nil
+}
+
+// Archive returns the archive of games trimmed from the history, or nil for
+// stores that don't keep a file
+func (sm *StatsManager) Archive() *Archive {
+	return sm.archive
+}
+
+// ArchivedGames returns the games trimmed from the history that were played
+// from from up to but not including to; see Archive.Games
+func (sm *StatsManager) ArchivedGames(from, to time.Time) ([]GameRecord, error) {
+	if sm.archive == nil {
+		return nil, fmt.Errorf("%w: archives", ErrStoreUnsupported)
+	}
+	return sm.archive.Games(from, to)
+}
@@ -0,0 +1,69 @@
+package stats
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestArchiveTrimmedGames(t *testing.T) {
+	dir := t.TempDir()
+	sm := NewStatsManager(filepath.Join(dir, DefaultStatsFileName))
+
+	// A full history, a game an hour from the start of the year
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	history := make([]GameRecord, MaxHistorySize)
+	for i := range history {
+		history[i] = GameRecord{ID: generateID(), Timestamp: start.Add(time.Duration(i) * time.Hour), Strategy: game.Stay, CarPosition: 1, FinalChoice: 1, Won: true}
+	}
+	sm.collector = &Collector{stats: StatsOf(history)}
+
+	if err := sm.RecordGame(&game.GameResult{Strategy: game.Switch, InitialChoice: 1, FinalChoice: 2, CarPosition: 2, HostOpenedDoor: 3, Won: true, Timestamp: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	if games := len(sm.GetStats().GameHistory); games != MaxHistorySize-TrimSize+1 {
+		t.Fatalf("Expected the history trimmed to %d games, got %d", MaxHistorySize-TrimSize+1, games)
+	}
+
+	archived, err := sm.ArchivedGames(time.Time{}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(archived) != TrimSize {
+		t.Fatalf("Expected the %d trimmed games archived, got %d", TrimSize, len(archived))
+	}
+	for i, record := range archived {
+		if record.ID != history[i].ID {
+			t.Fatalf("Expected the oldest games archived in order, got %s at %d", record.ID, i)
+		}
+	}
+
+	// 1000 hours run from January into February
+	if months, err := sm.Archive().Months(); err != nil || len(months) != 2 || months[0] != "2025-01" {
+		t.Errorf("Expected the games filed by month, got %v, %v", months, err)
+	}
+	february := time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC)
+	january, err := sm.ArchivedGames(start, february)
+	if err != nil || len(january) != 31*24 {
+		t.Errorf("Expected the %d games played in January, got %d, %v", 31*24, len(january), err)
+	}
+
+	// Saving again doesn't archive the games twice
+	reloaded := NewStatsManager(filepath.Join(dir, DefaultStatsFileName))
+	if err := reloaded.save(); err != nil {
+		t.Fatal(err)
+	}
+	if archived, _ := reloaded.ArchivedGames(time.Time{}, time.Time{}); len(archived) != TrimSize {
+		t.Errorf("Expected %d archived games after reload, got %d", TrimSize, len(archived))
+	}
+}
+
+func TestArchiveNeedsStatsFile(t *testing.T) {
+	sm := NewSessionStatsManager()
+	if _, err := sm.ArchivedGames(time.Time{}, time.Time{}); !errors.Is(err, ErrStoreUnsupported) {
+		t.Errorf("Expected statistics without a file to have no archive, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// AutoExportPrefix begins the names of automatic exports. Rotation only
+// removes files with it, so exports saved by hand are never touched
+const AutoExportPrefix = "monty-hall-auto_"
+
+// AutoExport is an automatic export rendered from the statistics at one
+// moment, ready to be written while play goes on
+type AutoExport struct {
+	Filename string
+	Format   ExportFormat
+	Keep     int // Most automatic exports of the format kept in the directory
+	data     []byte
+}
+
+// PrepareAutoExport renders an automatic export into dir, named after the
+// current time. Rendering reads the statistics, so it must happen where games
+// are recorded; Write can then run anywhere
+func (sm *StatsManager) PrepareAutoExport(dir string, options ExportOptions, keep int) (*AutoExport, error) {
+	if err := options.Locale.Validate(); err != nil {
+		return nil, err
+	}
+
+	timestamp := time.Now().Format("2006-01-02_15-04-05")
+	options.Filename = filepath.Join(dir, AutoExportPrefix+timestamp+options.Format.GetFileExtension())
+
+	data, err := sm.renderExport(options)
+	if err != nil {
+		return nil, err
+	}
+	return &AutoExport{Filename: options.Filename, Format: options.Format, Keep: keep, data: data}, nil
+}
+
+// Write writes the export, under a numbered name if one was already written
+// this second, then removes the oldest automatic exports of its format beyond
+// Keep
+func (e *AutoExport) Write() error {
+	dir := filepath.Dir(e.Filename)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	e.Filename = AvailableFilename(e.Filename)
+	if err := os.WriteFile(e.Filename, e.data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s file: %w", e.Format, err)
+	}
+
+	return RotateAutoExports(dir, e.Format, e.Keep)
+}
+
+// RotateAutoExports removes the oldest automatic exports of a format in dir
+// so at most keep remain. A keep below one keeps them all
+func RotateAutoExports(dir string, format ExportFormat, keep int) error {
+	if keep < 1 {
+		return nil
+	}
+
+	exports, err := filepath.Glob(filepath.Join(dir, AutoExportPrefix+"*"+format.GetFileExtension()))
+	if err != nil {
+		return err
+	}
+	if len(exports) <= keep {
+		return nil
+	}
+
+	// Timestamped names sort oldest first once the extension is dropped, which
+	// also puts stats-2.csv after stats.csv
+	slices.SortFunc(exports, func(a, b string) int {
+		return strings.Compare(strings.TrimSuffix(a, filepath.Ext(a)), strings.TrimSuffix(b, filepath.Ext(b)))
+	})
+	for _, path := range exports[:len(exports)-keep] {
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to remove old export %s: %w", filepath.Base(path), err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAutoExportRotation(t *testing.T) {
+	dir := t.TempDir()
+	sm := NewStatsManager(filepath.Join(dir, "stats.json"))
+
+	// Older automatic exports, and an export saved by hand
+	old := []string{
+		AutoExportPrefix + "2026-01-01_10-00-00.csv",
+		AutoExportPrefix + "2026-01-02_10-00-00.csv",
+		AutoExportPrefix + "2026-01-03_10-00-00.csv",
+		AutoExportPrefix + "2026-01-01_10-00-00.json",
+		"monty-hall-stats_2026-01-01_09-00-00.csv",
+	}
+	for _, name := range old {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	options := DefaultExportOptions()
+	options.Format = ExportCSV
+	export, err := sm.PrepareAutoExport(dir, options, 2)
+	if err != nil {
+		t.Fatalf("PrepareAutoExport() error = %v", err)
+	}
+	if err := export.Write(); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if _, err := os.Stat(export.Filename); err != nil {
+		t.Fatalf("Expected the export to be written: %v", err)
+	}
+
+	for name, kept := range map[string]bool{
+		old[0]: false,
+		old[1]: false,
+		old[2]: true,
+		old[3]: true, // Other formats rotate separately
+		old[4]: true, // Exports saved by hand are never removed
+	} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if exists := err == nil; exists != kept {
+			t.Errorf("%s: exists = %v, want %v", name, exists, kept)
+		}
+	}
+
+	// A second export in the same second gets its own name
+	again, err := sm.PrepareAutoExport(dir, options, 0)
+	if err != nil {
+		t.Fatalf("PrepareAutoExport() error = %v", err)
+	}
+	if err := again.Write(); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if again.Filename == export.Filename {
+		t.Error("A second export should not overwrite the first")
+	}
+}
@@ -3,6 +3,7 @@ package stats
 import (
 	"crypto/rand"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/westhuis/monty-hall/pkg/game"
@@ -14,7 +15,8 @@ const (
 )
 
 type Collector struct {
-	stats *GameStats
+	stats       *GameStats
+	recordDraws bool // Keep each game's random draws in its record
 }
 
 func NewCollector() *Collector {
@@ -26,17 +28,60 @@ func NewCollector() *Collector {
 }
 
 func (c *Collector) RecordGame(result *game.GameResult) error {
+	return c.recordGame(result, recordOptions{})
+}
+
+// recordOptions marks how a recorded game was played
+type recordOptions struct {
+	practice bool   // Played in practice mode
+	player   string // Hotseat player who played; empty for the usual single player
+	ladder   bool   // Played on the door-count ladder
+}
+
+// recordGame adds a game to the statistics, marked as the options say
+func (c *Collector) recordGame(result *game.GameResult, options recordOptions) error {
 	if result == nil {
 		return fmt.Errorf("game result cannot be nil")
 	}
 
 	record := c.createGameRecord(result)
+	record.Practice = options.practice
+	record.Player = options.player
+	record.Ladder = options.ladder
+	if result.NumDoors != game.NumDoors {
+		record.NumDoors = result.NumDoors
+	}
+	if result.HostBehavior != game.HostClassic {
+		record.HostBehavior = result.HostBehavior.String()
+	}
+	if result.DoorsOpened > 0 && result.DoorsOpened != result.NumDoors-2 {
+		record.DoorsOpened = result.DoorsOpened
+	}
+	record.CarRevealed = result.CarRevealed
+	record.StrategySource = result.StrategySource
+	record.Seed = result.Seed
+	if c.recordDraws {
+		record.Draws = slices.Clone(result.Draws)
+	}
+
+	c.addRecord(record)
+	return nil
+}
+
+// addRecord adds a game record to the history and updates the aggregates. A
+// hotseat player's game only counts towards that player's totals
+func (c *Collector) addRecord(record GameRecord) {
+	if record.Player != "" {
+		c.addPlayerRecord(record)
+		return
+	}
 
 	c.stats.GameHistory = append(c.stats.GameHistory, record)
 
 	// Manage memory by trimming old games if history gets too large
 	if len(c.stats.GameHistory) > MaxHistorySize {
-		// Keep the most recent games, remove the oldest ones
+		// Keep the most recent games, archiving the oldest ones when saved
+		c.stats.trimmed = append(c.stats.trimmed, c.stats.GameHistory[:TrimSize]...)
 		c.stats.GameHistory = c.stats.GameHistory[TrimSize:]
 	}
 
@@ -44,8 +89,18 @@ func (c *Collector) RecordGame(result *game.GameResult) error {
 	c.updateDailyStats(record)
 	c.updateStreakStats(record)
 	c.updateTimeStats(record)
+	c.addCarDraw(record)
+}
 
-	return nil
+// addPlayerRecord adds a hotseat game to its player's totals
+func (c *Collector) addPlayerRecord(record GameRecord) {
+	if c.stats.Players == nil {
+		c.stats.Players = make(map[string]PlayerStats)
+	}
+	player := c.stats.Players[record.Player]
+	player.Name = record.Player
+	player.add(record)
+	c.stats.Players[record.Player] = player
 }
 
 func (c *Collector) createGameRecord(result *game.GameResult) GameRecord {
@@ -56,10 +111,10 @@ func (c *Collector) createGameRecord(result *game.GameResult) GameRecord {
 		Timestamp:      result.Timestamp,
 		Strategy:       result.Strategy,
 		Won:            result.Won,
-		InitialChoice:  result.InitialChoice,
-		FinalChoice:    result.FinalChoice,
-		CarPosition:    result.CarPosition,
-		HostOpenedDoor: result.HostOpenedDoor,
+		InitialChoice:  result.InitialChoice - 1, // GameResult doors are 1-indexed
+		FinalChoice:    result.FinalChoice - 1,
+		CarPosition:    result.CarPosition - 1,
+		HostOpenedDoor: result.HostOpenedDoor - 1,
 		GameDuration:   result.GameDuration,
 		DayOfWeek:      result.Timestamp.Weekday().String(),
 		HourOfDay:      result.Timestamp.Hour(),
@@ -67,6 +122,11 @@ func (c *Collector) createGameRecord(result *game.GameResult) GameRecord {
 }
 
 func (c *Collector) generateGameID() string {
+	return generateID()
+}
+
+// generateID returns a random hex identifier
+func generateID() string {
 	bytes := make([]byte, 8)
 	_, err := rand.Read(bytes)
 	if err != nil {
@@ -86,21 +146,9 @@ func (c *Collector) updateAggregateStats(record GameRecord) {
 	}
 
 	if record.Strategy == game.Switch {
-		c.stats.SwitchStats.GamesPlayed++
-		if record.Won {
-			c.stats.SwitchStats.Wins++
-		} else {
-			c.stats.SwitchStats.Losses++
-		}
-		c.stats.SwitchStats.WinRate = float64(c.stats.SwitchStats.Wins) / float64(c.stats.SwitchStats.GamesPlayed)
+		c.stats.SwitchStats.add(record.Won)
 	} else {
-		c.stats.StayStats.GamesPlayed++
-		if record.Won {
-			c.stats.StayStats.Wins++
-		} else {
-			c.stats.StayStats.Losses++
-		}
-		c.stats.StayStats.WinRate = float64(c.stats.StayStats.Wins) / float64(c.stats.StayStats.GamesPlayed)
+		c.stats.StayStats.add(record.Won)
 	}
 }
 
@@ -298,6 +346,16 @@ func (c *Collector) matchesFilter(record GameRecord, filter StatsFilter) bool {
 	return true
 }
 
+// StatsOf calculates the statistics of a game history, oldest game first
+func StatsOf(history []GameRecord) *GameStats {
+	collector := NewCollector()
+	collector.stats.Version = CurrentStatsVersion
+	for _, record := range history {
+		collector.addRecord(record)
+	}
+	return collector.stats
+}
+
 func (c *Collector) Reset() {
 	c.stats = &GameStats{
 		DailyStats: make(map[string]DailyStats),
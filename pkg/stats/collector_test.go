@@ -64,6 +64,28 @@ func TestRecordGame(t *testing.T) {
 	}
 }
 
+func TestRecordGameStoresSeed(t *testing.T) {
+	collector := NewCollector()
+
+	seeded := game.NewSeededGame(42)
+	seeded.MakeInitialChoice(0)
+	seeded.StayWithChoice()
+	if err := collector.RecordGame(seeded.Result); err != nil {
+		t.Fatal(err)
+	}
+	if err := collector.RecordGame(createTestGameResult(game.Stay, false)); err != nil {
+		t.Fatal(err)
+	}
+
+	history := collector.GetStats().GameHistory
+	if history[0].Seed == nil || *history[0].Seed != 42 {
+		t.Errorf("Expected the seeded game recorded with seed 42, got %v", history[0].Seed)
+	}
+	if history[1].Seed != nil {
+		t.Errorf("Expected no seed for an unseeded game, got %d", *history[1].Seed)
+	}
+}
+
 func TestRecordGameNilResult(t *testing.T) {
 	collector := NewCollector()
 
@@ -279,6 +301,32 @@ func TestGetFilteredGames(t *testing.T) {
 	}
 }
 
+func TestStatsOfTimeRange(t *testing.T) {
+	collector := NewCollector()
+	now := time.Now()
+
+	for i, won := range []bool{true, true, true, false, true} {
+		result := createTestGameResult(game.Switch, won)
+		result.Timestamp = now.AddDate(0, 0, -10+i*2) // 10, 8, 6, 4 and 2 days ago
+		collector.RecordGame(result)
+	}
+
+	sm := &StatsManager{collector: collector}
+	week := sm.GetFilteredStats(StatsFilter{TimeRange: &TimeRange{Start: now.AddDate(0, 0, -7), End: now}})
+	if week.TotalGames != 3 || week.TotalWins != 2 || week.SwitchStats.GamesPlayed != 3 {
+		t.Errorf("Expected 3 games and 2 wins in the last week, got %d and %d", week.TotalGames, week.TotalWins)
+	}
+	if week.StreakStats.LongestWinStreak != 1 || week.StreakStats.CurrentWinStreak != 1 {
+		t.Errorf("Expected streaks of the week only, got %+v", week.StreakStats)
+	}
+	if len(week.DailyStats) != 3 {
+		t.Errorf("Expected 3 days of daily statistics, got %d", len(week.DailyStats))
+	}
+	if collector.stats.TotalGames != 5 || collector.stats.StreakStats.LongestWinStreak != 3 {
+		t.Error("Filtering should leave the lifetime statistics alone")
+	}
+}
+
 func TestReset(t *testing.T) {
 	collector := NewCollector()
 
@@ -0,0 +1,66 @@
+package stats
+
+import (
+	"math"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// Convergence traces the cumulative win rate of each strategy over the history
+type Convergence struct {
+	Switch         []float64 // Switch win rate after each game, NaN until the first switch
+	Stay           []float64 // Stay win rate after each game, NaN until the first stay
+	ExpectedSwitch float64   // Theoretical switch win rate for the games played
+	ExpectedStay   float64   // Theoretical stay win rate for the games played
+}
+
+// ConvergenceOf computes the cumulative win rates game by game. Games where
+// the host revealed the car are left out, as no choice could win them; the
+// expected rates average the theory over the door counts and hosts played
+func ConvergenceOf(history []GameRecord) Convergence {
+	var c Convergence
+	var switchStats, stayStats StrategyStats
+	var switchExpected, stayExpected float64
+
+	for _, record := range history {
+		if record.CarRevealed {
+			continue
+		}
+
+		stay, switchDoors := record.Host().ExpectedWinRatesOpening(record.Doors(), record.Opened())
+		if record.Strategy == game.Switch {
+			switchStats.add(record.Won)
+			switchExpected += switchDoors
+		} else {
+			stayStats.add(record.Won)
+			stayExpected += stay
+		}
+
+		c.Switch = append(c.Switch, cumulativeRate(switchStats))
+		c.Stay = append(c.Stay, cumulativeRate(stayStats))
+	}
+
+	c.ExpectedSwitch = 2.0 / 3
+	if switchStats.GamesPlayed > 0 {
+		c.ExpectedSwitch = switchExpected / float64(switchStats.GamesPlayed)
+	}
+	c.ExpectedStay = 1.0 / 3
+	if stayStats.GamesPlayed > 0 {
+		c.ExpectedStay = stayExpected / float64(stayStats.GamesPlayed)
+	}
+
+	return c
+}
+
+// cumulativeRate returns the win rate so far, or NaN before any games
+func cumulativeRate(s StrategyStats) float64 {
+	if s.GamesPlayed == 0 {
+		return math.NaN()
+	}
+	return float64(s.Wins) / float64(s.GamesPlayed)
+}
+
+// Convergence traces the win rates over the recorded history
+func (sm *StatsManager) Convergence() Convergence {
+	return ConvergenceOf(sm.GetStats().GameHistory)
+}
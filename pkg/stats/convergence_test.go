@@ -0,0 +1,47 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestConvergenceOf(t *testing.T) {
+	history := []GameRecord{
+		{Strategy: game.Switch, Won: true},
+		{Strategy: game.Switch, Won: false},
+		{Strategy: game.Stay, Won: true, HostBehavior: "fall", CarRevealed: true},
+		{Strategy: game.Stay, Won: false, NumDoors: 10},
+		{Strategy: game.Switch, Won: true, NumDoors: 10},
+	}
+
+	c := ConvergenceOf(history)
+
+	wantSwitch := []float64{1, 0.5, 0.5, 2.0 / 3}
+	wantStay := []float64{math.NaN(), math.NaN(), 0, 0}
+	if len(c.Switch) != len(wantSwitch) || len(c.Stay) != len(wantStay) {
+		t.Fatalf("Expected %d points without the car-revealed game, got %d and %d", len(wantSwitch), len(c.Switch), len(c.Stay))
+	}
+	for i := range wantSwitch {
+		if math.Abs(c.Switch[i]-wantSwitch[i]) > 1e-9 {
+			t.Errorf("Switch rate after game %d: expected %.3f, got %.3f", i+1, wantSwitch[i], c.Switch[i])
+		}
+		if math.IsNaN(wantStay[i]) != math.IsNaN(c.Stay[i]) || (!math.IsNaN(wantStay[i]) && c.Stay[i] != wantStay[i]) {
+			t.Errorf("Stay rate after game %d: expected %.3f, got %.3f", i+1, wantStay[i], c.Stay[i])
+		}
+	}
+
+	// Two 3-door switches at 2/3 and one 10-door switch at 9/10
+	if want := (2.0/3*2 + 0.9) / 3; math.Abs(c.ExpectedSwitch-want) > 1e-9 {
+		t.Errorf("Expected switch theory %.3f, got %.3f", want, c.ExpectedSwitch)
+	}
+	if math.Abs(c.ExpectedStay-0.1) > 1e-9 {
+		t.Errorf("Expected stay theory 0.1, got %.3f", c.ExpectedStay)
+	}
+
+	empty := ConvergenceOf(nil)
+	if len(empty.Switch) != 0 || math.Abs(empty.ExpectedSwitch-2.0/3) > 1e-9 || math.Abs(empty.ExpectedStay-1.0/3) > 1e-9 {
+		t.Errorf("Empty history should have no points and the 3-door theory, got %+v", empty)
+	}
+}
@@ -0,0 +1,188 @@
+package stats
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// DefaultFlushRows is how many rows a GameCSVWriter buffers between flushes
+const DefaultFlushRows = 10000
+
+// gameCSVHeader is the header of every file written by a GameCSVWriter
+var gameCSVHeader = []string{
+	"Strategy",
+	"Game",
+	"Won",
+	"Initial Choice",
+	"Final Choice",
+	"Car Position",
+	"Host Opened Door",
+	"Doors",
+	"Car Revealed",
+}
+
+// CSVStreamOptions configures a GameCSVWriter
+type CSVStreamOptions struct {
+	ShardRows int              // Rows per file; 0 writes a single file
+	FlushRows int              // Rows between flushes; 0 means DefaultFlushRows
+	Progress  func(rows int64) // Called after each flush with the rows written so far
+}
+
+// GameCSVWriter streams simulated games to CSV without keeping them in
+// memory, flushing in chunks and optionally splitting the output into files
+// of a fixed number of rows
+type GameCSVWriter struct {
+	path    string
+	options CSVStreamOptions
+
+	file     *os.File
+	buffer   *bufio.Writer
+	writer   *csv.Writer
+	files    []string
+	rows     int64
+	fileRows int
+	pending  int // Rows written since the last flush
+	record   []string
+}
+
+// NewGameCSVWriter creates a writer for path. Sharded output goes to
+// numbered files beside it: results.csv becomes results-0001.csv,
+// results-0002.csv and so on
+func NewGameCSVWriter(path string, options CSVStreamOptions) (*GameCSVWriter, error) {
+	if options.ShardRows < 0 {
+		return nil, fmt.Errorf("rows per file cannot be negative, got %d", options.ShardRows)
+	}
+	if options.FlushRows <= 0 {
+		options.FlushRows = DefaultFlushRows
+	}
+
+	w := &GameCSVWriter{
+		path:    path,
+		options: options,
+		record:  make([]string, len(gameCSVHeader)),
+	}
+	if err := w.openFile(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// shardPath returns the path of the nth file, counting from 1
+func (w *GameCSVWriter) shardPath(n int) string {
+	if w.options.ShardRows == 0 {
+		return w.path
+	}
+	ext := filepath.Ext(w.path)
+	return fmt.Sprintf("%s-%04d%s", strings.TrimSuffix(w.path, ext), n, ext)
+}
+
+// openFile starts the next output file and writes its header
+func (w *GameCSVWriter) openFile() error {
+	path := w.shardPath(len(w.files) + 1)
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create CSV file: %w", err)
+	}
+
+	w.file = file
+	w.buffer = bufio.NewWriterSize(file, 64*1024)
+	w.writer = csv.NewWriter(w.buffer)
+	w.files = append(w.files, path)
+	w.fileRows = 0
+
+	if err := w.writer.Write(gameCSVHeader); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	return nil
+}
+
+// closeFile flushes and closes the current output file
+func (w *GameCSVWriter) closeFile() error {
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.flush()
+	if closeErr := w.file.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close CSV file: %w", closeErr)
+	}
+	w.file = nil
+	return err
+}
+
+// flush writes the buffered rows to the current file and reports progress
+func (w *GameCSVWriter) flush() error {
+	w.writer.Flush()
+	if err := w.writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV record: %w", err)
+	}
+	if err := w.buffer.Flush(); err != nil {
+		return fmt.Errorf("failed to write CSV file: %w", err)
+	}
+
+	if w.pending > 0 && w.options.Progress != nil {
+		w.options.Progress(w.rows)
+	}
+	w.pending = 0
+	return nil
+}
+
+// WriteGame writes one simulated game, the nth played with the strategy
+func (w *GameCSVWriter) WriteGame(strategy string, n int, result *game.GameResult) error {
+	if w.file == nil {
+		return fmt.Errorf("CSV writer is closed")
+	}
+
+	if w.options.ShardRows > 0 && w.fileRows == w.options.ShardRows {
+		if err := w.closeFile(); err != nil {
+			return err
+		}
+		if err := w.openFile(); err != nil {
+			return err
+		}
+	}
+
+	// The record is reused, as there may be millions of rows
+	w.record[0] = strategy
+	w.record[1] = strconv.Itoa(n)
+	w.record[2] = strconv.FormatBool(result.Won)
+	w.record[3] = strconv.Itoa(result.InitialChoice)
+	w.record[4] = strconv.Itoa(result.FinalChoice)
+	w.record[5] = strconv.Itoa(result.CarPosition)
+	w.record[6] = strconv.Itoa(result.HostOpenedDoor)
+	w.record[7] = strconv.Itoa(result.NumDoors)
+	w.record[8] = strconv.FormatBool(result.CarRevealed)
+	if err := w.writer.Write(w.record); err != nil {
+		return fmt.Errorf("failed to write CSV record: %w", err)
+	}
+
+	w.rows++
+	w.fileRows++
+	w.pending++
+	if w.pending >= w.options.FlushRows {
+		return w.flush()
+	}
+	return nil
+}
+
+// Close flushes the remaining rows and closes the output
+func (w *GameCSVWriter) Close() error {
+	return w.closeFile()
+}
+
+// Rows returns how many games have been written
+func (w *GameCSVWriter) Rows() int64 {
+	return w.rows
+}
+
+// Files returns the paths of the files written so far
+func (w *GameCSVWriter) Files() []string {
+	return w.files
+}
@@ -0,0 +1,75 @@
+package stats
+
+import (
+	"encoding/csv"
+	mathrand "math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestGameCSVWriterShards(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "games.csv")
+
+	var progress []int64
+	writer, err := NewGameCSVWriter(path, CSVStreamOptions{
+		ShardRows: 40,
+		FlushRows: 25,
+		Progress:  func(rows int64) { progress = append(progress, rows) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rng := mathrand.New(mathrand.NewSource(1))
+	for _, policy := range []ReplayPolicy{PolicyAlwaysSwitch, PolicyAlwaysStay} {
+		for n := range 50 {
+			g, _ := game.NewGameWithRand(rng, 3)
+			g.MakeInitialChoice(rng.Intn(3))
+			if policy == PolicyAlwaysSwitch {
+				g.SwitchChoice()
+			} else {
+				g.StayWithChoice()
+			}
+			if err := writer.WriteGame(policy.String(), n+1, g.Result); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if writer.Rows() != 100 || len(writer.Files()) != 3 {
+		t.Fatalf("Expected 100 rows in 3 files, got %d rows in %v", writer.Rows(), writer.Files())
+	}
+	if writer.Files()[0] != filepath.Join(filepath.Dir(path), "games-0001.csv") {
+		t.Errorf("Unexpected shard name %s", writer.Files()[0])
+	}
+
+	total := 0
+	for i, file := range writer.Files() {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(f).ReadAll()
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if records[0][0] != "Strategy" {
+			t.Errorf("File %d should start with the header", i+1)
+		}
+		total += len(records) - 1
+	}
+	if total != 100 {
+		t.Errorf("Expected 100 games across the files, got %d", total)
+	}
+
+	if len(progress) == 0 || progress[len(progress)-1] != 100 {
+		t.Errorf("Expected progress to end at 100 rows, got %v", progress)
+	}
+}
@@ -0,0 +1,136 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DataDirEnvVar overrides the data directory, whatever the config says
+const DataDirEnvVar = "MONTY_HALL_DATA"
+
+// DataFilePrefix starts the name of every file kept in the data directory:
+// the stats file, its goals, profiles and backups, and the files the UI
+// keeps beside them
+const DataFilePrefix = "monty_hall_"
+
+// DefaultDataDir returns the directory statistics are kept in unless one is
+// configured: .monty-hall in the home directory, or the current directory
+// when there is no home directory
+func DefaultDataDir() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, DefaultStatsDir)
+}
+
+// DataDirOverridden reports whether MONTY_HALL_DATA sets the data directory
+func DataDirOverridden() bool {
+	return os.Getenv(DataDirEnvVar) != ""
+}
+
+// DataDir returns the directory statistics are kept in: MONTY_HALL_DATA when
+// set, else the configured directory, else DefaultDataDir
+func DataDir(configured string) string {
+	if dir := os.Getenv(DataDirEnvVar); dir != "" {
+		return dir
+	}
+	if configured != "" {
+		return configured
+	}
+	return DefaultDataDir()
+}
+
+// MoveDataDir moves the stats file and everything kept beside it from one
+// data directory to another, returning the names of what was moved. Nothing
+// is moved when the new directory already has a stats file, so statistics
+// are never overwritten
+func MoveDataDir(from, to string) ([]string, error) {
+	if filepath.Clean(from) == filepath.Clean(to) {
+		return nil, nil
+	}
+	if _, err := os.Stat(filepath.Join(to, DefaultStatsFileName)); err == nil {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(from)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read data directory: %w", err)
+	}
+
+	if err := os.MkdirAll(to, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory %s: %w", to, err)
+	}
+
+	var moved []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, DataFilePrefix) {
+			continue
+		}
+		if err := moveDataFile(filepath.Join(from, name), filepath.Join(to, name)); err != nil {
+			return moved, fmt.Errorf("failed to move %s to %s: %w", name, to, err)
+		}
+		moved = append(moved, name)
+	}
+	return moved, nil
+}
+
+// moveDataFile moves a file or folder, copying it when it cannot be renamed,
+// as between disks
+func moveDataFile(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	if err := copyDataFile(src, dst); err != nil {
+		os.RemoveAll(dst)
+		return err
+	}
+	return os.RemoveAll(src)
+}
+
+// copyDataFile copies a file, or a folder and everything in it
+func copyDataFile(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		if err := os.MkdirAll(dst, info.Mode().Perm()); err != nil {
+			return err
+		}
+		entries, err := os.ReadDir(src)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := copyDataFile(filepath.Join(src, entry.Name()), filepath.Join(dst, entry.Name())); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,71 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestDataDir(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(DataDirEnvVar, "")
+
+	if dir := DataDir(""); dir != filepath.Join(home, DefaultStatsDir) {
+		t.Errorf("Expected ~/.monty-hall by default, got %s", dir)
+	}
+	if dir := DataDir("/srv/monty"); dir != "/srv/monty" {
+		t.Errorf("Expected the configured directory, got %s", dir)
+	}
+
+	t.Setenv(DataDirEnvVar, "/tmp/monty")
+	if dir := DataDir("/srv/monty"); dir != "/tmp/monty" || !DataDirOverridden() {
+		t.Errorf("Expected %s to override the configured directory, got %s", DataDirEnvVar, dir)
+	}
+	if path := NewPersistenceManager().GetFilePath(); path != filepath.Join("/tmp/monty", DefaultStatsFileName) {
+		t.Errorf("Expected the default stats file in the overridden directory, got %s", path)
+	}
+}
+
+func TestMoveDataDir(t *testing.T) {
+	from := t.TempDir()
+	to := filepath.Join(t.TempDir(), "data")
+
+	sm := NewStatsManager(filepath.Join(from, DefaultStatsFileName))
+	if err := sm.save(); err != nil {
+		t.Fatal(err)
+	}
+	profile := NewProfileManager(sm.GetFilePath()).Path("alice")
+	if err := os.MkdirAll(filepath.Dir(profile), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(profile, []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(from, "notes.txt"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	moved, err := MoveDataDir(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(moved, []string{ProfilesDirName, DefaultStatsFileName}) {
+		t.Errorf("Expected the stats file and profiles moved, got %v", moved)
+	}
+	if _, err := os.Stat(NewProfileManager(filepath.Join(to, DefaultStatsFileName)).Path("alice")); err != nil {
+		t.Errorf("Expected the profile in the new directory: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(from, "notes.txt")); err != nil {
+		t.Error("Expected files that aren't the game's to stay")
+	}
+
+	// Statistics already in the new directory are never overwritten
+	if err := os.WriteFile(filepath.Join(from, DefaultStatsFileName), []byte("{}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if moved, err := MoveDataDir(from, to); err != nil || len(moved) != 0 {
+		t.Errorf("Expected nothing moved onto existing statistics, got %v, %v", moved, err)
+	}
+}
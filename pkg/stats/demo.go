@@ -0,0 +1,78 @@
+package stats
+
+import (
+	"fmt"
+	mathrand "math/rand"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+const (
+	DemoGames = 300 // Games in the history written by SeedDemoData
+	DemoDays  = 30  // Days the demo history is spread over
+)
+
+// DemoHistory plays a synthetic history of games over the days up to end,
+// for screenshots and demos. The player starts out mostly staying and learns
+// to switch, playing short sessions in the afternoon or evening. The same
+// seed and end give the same history
+func DemoHistory(seed int64, games, days int, end time.Time) ([]*game.GameResult, error) {
+	if games <= 0 || days <= 0 {
+		return nil, fmt.Errorf("a demo history needs games and days, got %d games over %d days", games, days)
+	}
+
+	rng := mathrand.New(mathrand.NewSource(seed))
+	first := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location()).AddDate(0, 0, 1-days)
+
+	results := make([]*game.GameResult, 0, games)
+	var clock time.Time
+	for i := range games {
+		day := i * days / games
+		if i == 0 || day != (i-1)*days/games {
+			// A new session starts between noon and 10pm
+			clock = first.AddDate(0, 0, day).Add(time.Duration(12+rng.Intn(10))*time.Hour + time.Duration(rng.Intn(60))*time.Minute)
+		}
+
+		g, err := game.NewGameWithRand(rng, game.NumDoors)
+		if err != nil {
+			return nil, err
+		}
+		if err := g.MakeInitialChoice(rng.Intn(game.NumDoors)); err != nil {
+			return nil, err
+		}
+
+		// Switching grows from a 30% habit to 80% as the player learns
+		if rng.Float64() < 0.3+0.5*float64(i)/float64(games) {
+			err = g.SwitchChoice()
+		} else {
+			err = g.StayWithChoice()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		duration := time.Duration(3000+rng.Intn(15000)) * time.Millisecond
+		clock = clock.Add(duration + time.Duration(rng.Intn(20))*time.Second)
+		g.Result.GameDuration = duration
+		g.Result.Timestamp = clock
+		results = append(results, g.Result)
+	}
+	return results, nil
+}
+
+// SeedDemoData adds a demo history of DemoGames games over the DemoDays days
+// up to end to the statistics and saves them once. Goals and record hooks
+// are not run, so the history arrives without notifications
+func (sm *StatsManager) SeedDemoData(seed int64, end time.Time) error {
+	results, err := DemoHistory(seed, DemoGames, DemoDays, end)
+	if err != nil {
+		return err
+	}
+	for _, result := range results {
+		if err := sm.collector.recordGame(result, recordOptions{}); err != nil {
+			return err
+		}
+	}
+	return sm.save()
+}
@@ -0,0 +1,74 @@
+package stats
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestDemoHistory(t *testing.T) {
+	end := time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC)
+	history, err := DemoHistory(42, DemoGames, DemoDays, end)
+	if err != nil {
+		t.Fatalf("DemoHistory() error = %v", err)
+	}
+	if len(history) != DemoGames {
+		t.Fatalf("Expected %d games, got %d", DemoGames, len(history))
+	}
+
+	again, _ := DemoHistory(42, DemoGames, DemoDays, end)
+	if !reflect.DeepEqual(history, again) {
+		t.Error("The same seed should give the same history")
+	}
+
+	firstDay := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	for i, result := range history {
+		if result.Timestamp.Before(firstDay) || result.Timestamp.After(end.AddDate(0, 0, 1)) {
+			t.Fatalf("Game %d at %s is outside the %d days", i, result.Timestamp, DemoDays)
+		}
+		if i > 0 && !result.Timestamp.After(history[i-1].Timestamp) {
+			t.Fatalf("Game %d is not after the one before it", i)
+		}
+	}
+
+	// The player learns to switch
+	switched := func(games []*game.GameResult) int {
+		n := 0
+		for _, result := range games {
+			if result.Strategy == game.Switch {
+				n++
+			}
+		}
+		return n
+	}
+	if early, late := switched(history[:100]), switched(history[200:]); early >= late {
+		t.Errorf("Expected more switching late in the history, got %d early and %d late", early, late)
+	}
+
+	if _, err := DemoHistory(42, 0, DemoDays, end); err == nil {
+		t.Error("Expected an error for a history without games")
+	}
+}
+
+func TestSeedDemoData(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	sm := NewStatsManager(path)
+	if err := sm.SeedDemoData(7, time.Now()); err != nil {
+		t.Fatalf("SeedDemoData() error = %v", err)
+	}
+	if len(sm.TakeCompletedGoals()) != 0 {
+		t.Error("Seeding should not complete goals")
+	}
+
+	reloaded := NewStatsManager(path)
+	stats := reloaded.GetStats()
+	if stats.TotalGames != DemoGames || len(stats.DailyStats) != DemoDays {
+		t.Errorf("Expected %d saved games over %d days, got %d over %d", DemoGames, DemoDays, stats.TotalGames, len(stats.DailyStats))
+	}
+	if stats.SwitchStats.GamesPlayed == 0 || stats.StayStats.GamesPlayed == 0 {
+		t.Errorf("Expected both strategies in the demo history, got %+v", stats)
+	}
+}
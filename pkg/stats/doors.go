@@ -0,0 +1,74 @@
+package stats
+
+import (
+	"sort"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// DoorCountStats breaks down strategy results for games with one door count
+type DoorCountStats struct {
+	NumDoors    int
+	SwitchStats StrategyStats
+	StayStats   StrategyStats
+}
+
+// ExpectedStayRate returns the theoretical win rate for staying
+func (d DoorCountStats) ExpectedStayRate() float64 {
+	return 1 / float64(d.NumDoors)
+}
+
+// ExpectedSwitchRate returns the theoretical win rate for switching, since
+// the host opens every other door but one
+func (d DoorCountStats) ExpectedSwitchRate() float64 {
+	return float64(d.NumDoors-1) / float64(d.NumDoors)
+}
+
+// StatsByDoors groups the history by door count, fewest doors first. Games
+// in which the host left several doors closed are broken down by StatsByHost
+// instead, as switching has other odds in them
+func StatsByDoors(history []GameRecord) []DoorCountStats {
+	byDoors := make(map[int]*DoorCountStats)
+	for _, record := range history {
+		if record.Opened() != record.Doors()-2 {
+			continue
+		}
+		doors := record.Doors()
+		entry, ok := byDoors[doors]
+		if !ok {
+			entry = &DoorCountStats{NumDoors: doors}
+			byDoors[doors] = entry
+		}
+
+		strategy := &entry.StayStats
+		if record.Strategy == game.Switch {
+			strategy = &entry.SwitchStats
+		}
+		strategy.add(record.Won)
+	}
+
+	breakdown := make([]DoorCountStats, 0, len(byDoors))
+	for _, entry := range byDoors {
+		breakdown = append(breakdown, *entry)
+	}
+	sort.Slice(breakdown, func(i, j int) bool {
+		return breakdown[i].NumDoors < breakdown[j].NumDoors
+	})
+	return breakdown
+}
+
+// add counts one game played with the strategy
+func (s *StrategyStats) add(won bool) {
+	s.GamesPlayed++
+	if won {
+		s.Wins++
+	} else {
+		s.Losses++
+	}
+	s.WinRate = float64(s.Wins) / float64(s.GamesPlayed)
+}
+
+// StatsByDoors groups the recorded games by door count
+func (sm *StatsManager) StatsByDoors() []DoorCountStats {
+	return StatsByDoors(sm.GetStats().GameHistory)
+}
@@ -0,0 +1,69 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestStatsByDoors(t *testing.T) {
+	history := []GameRecord{
+		{Strategy: game.Switch, Won: true},
+		{Strategy: game.Stay, Won: false},
+		{Strategy: game.Switch, Won: true, NumDoors: 10},
+		{Strategy: game.Switch, Won: false, NumDoors: 10},
+	}
+
+	byDoors := StatsByDoors(history)
+	if len(byDoors) != 2 {
+		t.Fatalf("Expected 2 door counts, got %d", len(byDoors))
+	}
+
+	three, ten := byDoors[0], byDoors[1]
+	if three.NumDoors != 3 || ten.NumDoors != 10 {
+		t.Fatalf("Expected door counts 3 and 10, got %d and %d", three.NumDoors, ten.NumDoors)
+	}
+
+	if three.SwitchStats.Wins != 1 || three.StayStats.Losses != 1 {
+		t.Errorf("Unexpected 3-door stats: %+v", three)
+	}
+	if ten.SwitchStats.GamesPlayed != 2 || ten.SwitchStats.WinRate != 0.5 {
+		t.Errorf("Unexpected 10-door stats: %+v", ten)
+	}
+
+	if ten.ExpectedStayRate() != 0.1 || ten.ExpectedSwitchRate() != 0.9 {
+		t.Errorf("Expected 10%%/90%% theoretical rates, got %.2f/%.2f", ten.ExpectedStayRate(), ten.ExpectedSwitchRate())
+	}
+}
+
+func TestRecordGameStoresDoorCount(t *testing.T) {
+	collector := NewCollector()
+
+	for _, numDoors := range []int{3, 10} {
+		result := &game.GameResult{
+			Strategy:       game.Switch,
+			Won:            true,
+			InitialChoice:  1,
+			FinalChoice:    numDoors,
+			CarPosition:    numDoors,
+			HostOpenedDoor: 2,
+			NumDoors:       numDoors,
+			Timestamp:      time.Now(),
+		}
+		if err := collector.RecordGame(result); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	history := collector.GetStats().GameHistory
+	if history[0].NumDoors != 0 || history[0].Doors() != 3 {
+		t.Errorf("Expected standard games to omit the door count, got %d", history[0].NumDoors)
+	}
+	if history[1].NumDoors != 10 {
+		t.Errorf("Expected 10 doors recorded, got %d", history[1].NumDoors)
+	}
+	if err := history[1].checkReplayable(); err != nil {
+		t.Errorf("Expected 10-door game to be replayable: %v", err)
+	}
+}
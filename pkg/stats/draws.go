@@ -0,0 +1,42 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// ErrNoDraws is returned when verifying a game recorded without its draws
+var ErrNoDraws = errors.New("game was recorded without its draws")
+
+// VerifyDraws replays the game from its recorded draws and the player's
+// choices, and reports where the replay differs from the recorded outcome
+func (r GameRecord) VerifyDraws() error {
+	if len(r.Draws) == 0 {
+		return ErrNoDraws
+	}
+
+	g, err := game.ReplayDraws(r.Doors(), r.Host(), r.DoorsOpened, r.InitialChoice, r.FinalChoice, r.Draws)
+	if err != nil {
+		return err
+	}
+
+	var diffs []string
+	check := func(name string, recorded, replayed any) {
+		if recorded != replayed {
+			diffs = append(diffs, fmt.Sprintf("%s is %v, the draws give %v", name, recorded, replayed))
+		}
+	}
+	// Doors are numbered from 1, as players know them
+	check("car", r.CarPosition+1, g.CarPosition+1)
+	check("host's first door", r.HostOpenedDoor+1, g.HostOpenedDoor+1)
+	check("final door", r.FinalChoice+1, g.PlayerFinalChoice+1)
+	check("won", r.Won, g.Result.Won)
+	check("car revealed", r.CarRevealed, g.CarRevealed())
+	if len(diffs) > 0 {
+		return fmt.Errorf("%w: %s", game.ErrDrawsMismatch, strings.Join(diffs, "; "))
+	}
+	return nil
+}
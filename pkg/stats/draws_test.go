@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestVerifyDraws(t *testing.T) {
+	sm := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+
+	play := func() {
+		t.Helper()
+		g, err := game.NewGameWithDoors(4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g.Host.Opens = 1
+		g.MakeInitialChoice(0)
+		g.SwitchChoice()
+		if err := sm.RecordGame(g.Result); err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	// Draws are only kept when asked for
+	play()
+	if err := sm.GetStats().GameHistory[0].VerifyDraws(); !errors.Is(err, ErrNoDraws) {
+		t.Errorf("Expected a game without draws to be unverifiable, got %v", err)
+	}
+
+	sm.SetRecordDraws(true)
+	play()
+	record := sm.GetStats().GameHistory[1]
+	if err := record.VerifyDraws(); err != nil {
+		t.Fatalf("Expected the recorded game to verify, got %v", err)
+	}
+
+	// Draws are still kept once the statistics are reloaded
+	if err := sm.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	play()
+	if err := sm.GetStats().GameHistory[2].VerifyDraws(); err != nil {
+		t.Errorf("Expected draws kept after a reload, got %v", err)
+	}
+
+	// Editing the outcome after the fact is caught
+	tampered := record
+	tampered.Won = !record.Won
+	if err := tampered.VerifyDraws(); !errors.Is(err, game.ErrDrawsMismatch) {
+		t.Errorf("Expected a changed result to fail verification, got %v", err)
+	}
+	tampered = record
+	tampered.CarPosition = (record.CarPosition + 1) % 4
+	if err := tampered.VerifyDraws(); !errors.Is(err, game.ErrDrawsMismatch) {
+		t.Errorf("Expected a moved car to fail verification, got %v", err)
+	}
+}
@@ -1,9 +1,12 @@
 package stats
 
 import (
+	"bytes"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,6 +22,7 @@ const (
 	ExportJSON ExportFormat = iota
 	ExportCSV
 	ExportText
+	ExportMarkdown
 )
 
 // String returns the string representation of the export format
@@ -30,6 +34,8 @@ func (ef ExportFormat) String() string {
 		return "CSV"
 	case ExportText:
 		return "Text"
+	case ExportMarkdown:
+		return "Markdown"
 	default:
 		return "Unknown"
 	}
@@ -44,6 +50,8 @@ func (ef ExportFormat) GetFileExtension() string {
 		return ".csv"
 	case ExportText:
 		return ".txt"
+	case ExportMarkdown:
+		return ".md"
 	default:
 		return ".txt"
 	}
@@ -56,6 +64,7 @@ type ExportOptions struct {
 	IncludeHistory    bool
 	IncludeDailyStats bool
 	TimeRange         *TimeRange
+	Locale            ExportLocale // Date and number formats of CSV, text and Markdown exports
 }
 
 // DefaultExportOptions returns default export options
@@ -66,22 +75,54 @@ func DefaultExportOptions() ExportOptions {
 		IncludeHistory:    true,
 		IncludeDailyStats: true,
 		TimeRange:         nil,
+		Locale:            DefaultExportLocale(),
 	}
 }
 
-// ExportStats exports statistics to a file in the specified format
-func (sm *StatsManager) ExportStats(options ExportOptions) error {
-	stats := sm.GetStats()
-
-	// Generate filename if not provided
-	if options.Filename == "" {
+// ResolvedFilename returns the file the options export to: the given
+// filename, or a timestamped one, with the format's extension
+func (o ExportOptions) ResolvedFilename() string {
+	filename := o.Filename
+	if filename == "" {
 		timestamp := time.Now().Format("2006-01-02_15-04-05")
-		options.Filename = fmt.Sprintf("monty-hall-stats_%s%s", timestamp, options.Format.GetFileExtension())
+		filename = fmt.Sprintf("monty-hall-stats_%s", timestamp)
 	}
 
 	// Ensure filename has correct extension
-	if !strings.HasSuffix(options.Filename, options.Format.GetFileExtension()) {
-		options.Filename += options.Format.GetFileExtension()
+	if !strings.HasSuffix(filename, o.Format.GetFileExtension()) {
+		filename += o.Format.GetFileExtension()
+	}
+	return filename
+}
+
+// AvailableFilename returns filename if no file exists there, or else the
+// first free name with a number before the extension: stats-2.csv,
+// stats-3.csv and so on
+func AvailableFilename(filename string) string {
+	if _, err := os.Stat(filename); errors.Is(err, fs.ErrNotExist) {
+		return filename
+	}
+
+	ext := filepath.Ext(filename)
+	base := strings.TrimSuffix(filename, ext)
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d%s", base, n, ext)
+		if _, err := os.Stat(candidate); errors.Is(err, fs.ErrNotExist) {
+			return candidate
+		}
+	}
+}
+
+// ExportStats exports statistics to a file in the specified format
+func (sm *StatsManager) ExportStats(options ExportOptions) error {
+	if err := options.Locale.Validate(); err != nil {
+		return err
+	}
+	options.Filename = options.ResolvedFilename()
+
+	data, err := sm.renderExport(options)
+	if err != nil {
+		return err
 	}
 
 	// Create directory if it doesn't exist
@@ -92,7 +133,39 @@ func (sm *StatsManager) ExportStats(options ExportOptions) error {
 		}
 	}
 
-	// Export based on format
+	if err := os.WriteFile(options.Filename, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s file: %w", options.Format, err)
+	}
+	return nil
+}
+
+// ExportPreview returns the first lines an export would write, for the
+// line-based CSV, text and Markdown formats. JSON exports have no useful preview and
+// return nil
+func (sm *StatsManager) ExportPreview(options ExportOptions, lines int) ([]string, error) {
+	if options.Format == ExportJSON {
+		return nil, nil
+	}
+	if err := options.Locale.Validate(); err != nil {
+		return nil, err
+	}
+
+	data, err := sm.renderExport(options)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+	if len(preview) > lines {
+		preview = preview[:lines]
+	}
+	return preview, nil
+}
+
+// renderExport renders the statistics in the options' format
+func (sm *StatsManager) renderExport(options ExportOptions) ([]byte, error) {
+	stats := sm.GetStats()
+
 	switch options.Format {
 	case ExportJSON:
 		return sm.exportJSON(stats, options)
@@ -100,13 +173,15 @@ func (sm *StatsManager) ExportStats(options ExportOptions) error {
 		return sm.exportCSV(stats, options)
 	case ExportText:
 		return sm.exportText(stats, options)
+	case ExportMarkdown:
+		return sm.exportMarkdown(stats, options)
 	default:
-		return fmt.Errorf("unsupported export format: %v", options.Format)
+		return nil, fmt.Errorf("unsupported export format: %v", options.Format)
 	}
 }
 
-// exportJSON exports statistics as JSON
-func (sm *StatsManager) exportJSON(stats *GameStats, options ExportOptions) error {
+// exportJSON renders statistics as JSON
+func (sm *StatsManager) exportJSON(stats *GameStats, options ExportOptions) ([]byte, error) {
 	// Create export data structure
 	exportData := map[string]interface{}{
 		"export_info": map[string]interface{}{
@@ -147,27 +222,17 @@ func (sm *StatsManager) exportJSON(stats *GameStats, options ExportOptions) erro
 	// Marshal to JSON with indentation
 	data, err := json.MarshalIndent(exportData, "", "  ")
 	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
-	}
-
-	// Write to file
-	if err := os.WriteFile(options.Filename, data, 0644); err != nil {
-		return fmt.Errorf("failed to write JSON file: %w", err)
+		return nil, fmt.Errorf("failed to marshal JSON: %w", err)
 	}
 
-	return nil
+	return data, nil
 }
 
-// exportCSV exports game history as CSV
-func (sm *StatsManager) exportCSV(stats *GameStats, options ExportOptions) error {
-	file, err := os.Create(options.Filename)
-	if err != nil {
-		return fmt.Errorf("failed to create CSV file: %w", err)
-	}
-	defer file.Close()
-
-	writer := csv.NewWriter(file)
-	defer writer.Flush()
+// exportCSV renders game history as CSV
+func (sm *StatsManager) exportCSV(stats *GameStats, options ExportOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Comma = options.Locale.Delimiter()
 
 	// Write header
 	header := []string{
@@ -182,9 +247,12 @@ func (sm *StatsManager) exportCSV(stats *GameStats, options ExportOptions) error
 		"Game Duration (ms)",
 		"Day of Week",
 		"Hour of Day",
+		"Doors",
+		"Host",
+		"Car Revealed",
 	}
 	if err := writer.Write(header); err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
+		return nil, fmt.Errorf("failed to write CSV header: %w", err)
 	}
 
 	// Filter games by time range if specified
@@ -202,7 +270,7 @@ func (sm *StatsManager) exportCSV(stats *GameStats, options ExportOptions) error
 
 		record := []string{
 			gameRecord.ID,
-			gameRecord.Timestamp.Format(time.RFC3339),
+			options.Locale.Timestamp(gameRecord.Timestamp),
 			strategyStr,
 			fmt.Sprintf("%t", gameRecord.Won),
 			fmt.Sprintf("%d", gameRecord.InitialChoice+1),  // Convert to 1-based
@@ -212,41 +280,49 @@ func (sm *StatsManager) exportCSV(stats *GameStats, options ExportOptions) error
 			fmt.Sprintf("%d", gameRecord.GameDuration.Milliseconds()),
 			gameRecord.DayOfWeek,
 			fmt.Sprintf("%d", gameRecord.HourOfDay),
+			fmt.Sprintf("%d", gameRecord.Doors()),
+			gameRecord.Host().String(),
+			fmt.Sprintf("%t", gameRecord.CarRevealed),
 		}
 		if err := writer.Write(record); err != nil {
-			return fmt.Errorf("failed to write CSV record: %w", err)
+			return nil, fmt.Errorf("failed to write CSV record: %w", err)
 		}
 	}
 
-	return nil
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
 }
 
-// exportText exports statistics as human-readable text
-func (sm *StatsManager) exportText(stats *GameStats, options ExportOptions) error {
+// exportText renders statistics as human-readable text
+func (sm *StatsManager) exportText(stats *GameStats, options ExportOptions) ([]byte, error) {
 	var content strings.Builder
+	locale := options.Locale
 
 	// Header
 	content.WriteString("MONTY HALL GAME STATISTICS REPORT\n")
 	content.WriteString("==================================\n\n")
-	content.WriteString(fmt.Sprintf("Generated: %s\n", time.Now().Format("2006-01-02 15:04:05")))
+	content.WriteString(fmt.Sprintf("Generated: %s\n", locale.DateTime(time.Now(), true)))
 	content.WriteString(fmt.Sprintf("Total Games: %d\n\n", stats.TotalGames))
 
 	// Overall Statistics
 	content.WriteString("OVERALL STATISTICS\n")
 	content.WriteString("------------------\n")
 	if stats.TotalGames > 0 {
-		overallWinRate := float64(stats.TotalWins) / float64(stats.TotalGames) * 100
+		overallWinRate := float64(stats.TotalWins) / float64(stats.TotalGames)
 		content.WriteString(fmt.Sprintf("Total Games Played: %d\n", stats.TotalGames))
 		content.WriteString(fmt.Sprintf("Total Wins: %d\n", stats.TotalWins))
 		content.WriteString(fmt.Sprintf("Total Losses: %d\n", stats.TotalLosses))
-		content.WriteString(fmt.Sprintf("Overall Win Rate: %.1f%%\n", overallWinRate))
+		content.WriteString(fmt.Sprintf("Overall Win Rate: %s\n", locale.Percent(overallWinRate, 1)))
 		content.WriteString(fmt.Sprintf("Average Game Time: %s\n", stats.AverageGameTime))
 		content.WriteString(fmt.Sprintf("Total Play Time: %s\n", stats.TotalGameTime))
 		if stats.FirstGameTime != nil {
-			content.WriteString(fmt.Sprintf("First Game: %s\n", stats.FirstGameTime.Format("2006-01-02 15:04:05")))
+			content.WriteString(fmt.Sprintf("First Game: %s\n", locale.DateTime(*stats.FirstGameTime, true)))
 		}
 		if stats.LastGameTime != nil {
-			content.WriteString(fmt.Sprintf("Last Game: %s\n", stats.LastGameTime.Format("2006-01-02 15:04:05")))
+			content.WriteString(fmt.Sprintf("Last Game: %s\n", locale.DateTime(*stats.LastGameTime, true)))
 		}
 	} else {
 		content.WriteString("No games played yet.\n")
@@ -261,7 +337,7 @@ func (sm *StatsManager) exportText(stats *GameStats, options ExportOptions) erro
 	content.WriteString(fmt.Sprintf("  Wins: %d\n", stats.StayStats.Wins))
 	content.WriteString(fmt.Sprintf("  Losses: %d\n", stats.StayStats.Losses))
 	if stats.StayStats.GamesPlayed > 0 {
-		content.WriteString(fmt.Sprintf("  Win Rate: %.1f%%\n", stats.StayStats.WinRate*100))
+		content.WriteString(fmt.Sprintf("  Win Rate: %s\n", locale.Percent(stats.StayStats.WinRate, 1)))
 	}
 	content.WriteString("\n")
 
@@ -270,7 +346,7 @@ func (sm *StatsManager) exportText(stats *GameStats, options ExportOptions) erro
 	content.WriteString(fmt.Sprintf("  Wins: %d\n", stats.SwitchStats.Wins))
 	content.WriteString(fmt.Sprintf("  Losses: %d\n", stats.SwitchStats.Losses))
 	if stats.SwitchStats.GamesPlayed > 0 {
-		content.WriteString(fmt.Sprintf("  Win Rate: %.1f%%\n", stats.SwitchStats.WinRate*100))
+		content.WriteString(fmt.Sprintf("  Win Rate: %s\n", locale.Percent(stats.SwitchStats.WinRate, 1)))
 	}
 	content.WriteString("\n")
 
@@ -278,18 +354,44 @@ func (sm *StatsManager) exportText(stats *GameStats, options ExportOptions) erro
 	content.WriteString("THEORETICAL vs ACTUAL\n")
 	content.WriteString("---------------------\n")
 	content.WriteString("Theoretical Probabilities:\n")
-	content.WriteString("  STAY Strategy: 33.3% (1/3)\n")
-	content.WriteString("  SWITCH Strategy: 66.7% (2/3)\n\n")
+	content.WriteString(fmt.Sprintf("  STAY Strategy: %s (1/3)\n", locale.Percent(1.0/3, 1)))
+	content.WriteString(fmt.Sprintf("  SWITCH Strategy: %s (2/3)\n\n", locale.Percent(2.0/3, 1)))
+
+	if byDoors := StatsByDoors(stats.GameHistory); len(byDoors) > 1 || (len(byDoors) == 1 && byDoors[0].NumDoors != game.NumDoors) {
+		content.WriteString("By Number of Doors:\n")
+		for _, doorStats := range byDoors {
+			content.WriteString(fmt.Sprintf("  %d doors: STAY %s expected (%d/%d games won), SWITCH %s expected (%d/%d games won)\n",
+				doorStats.NumDoors,
+				locale.Percent(doorStats.ExpectedStayRate(), 1), doorStats.StayStats.Wins, doorStats.StayStats.GamesPlayed,
+				locale.Percent(doorStats.ExpectedSwitchRate(), 1), doorStats.SwitchStats.Wins, doorStats.SwitchStats.GamesPlayed))
+		}
+		content.WriteString("\n")
+	}
+
+	if hasHostVariants(stats.GameHistory) {
+		content.WriteString("By Host Behavior (games where only goats were revealed):\n")
+		for _, hostStats := range StatsByHost(stats.GameHistory) {
+			content.WriteString(fmt.Sprintf("  %s: STAY %s expected (%d/%d games won), SWITCH %s expected (%d/%d games won)",
+				hostStats.Name(),
+				locale.Percent(hostStats.ExpectedStayRate(), 1), hostStats.StayStats.Wins, hostStats.StayStats.GamesPlayed,
+				locale.Percent(hostStats.ExpectedSwitchRate(), 1), hostStats.SwitchStats.Wins, hostStats.SwitchStats.GamesPlayed))
+			if hostStats.CarRevealed > 0 {
+				content.WriteString(fmt.Sprintf(", car revealed in %d", hostStats.CarRevealed))
+			}
+			content.WriteString("\n")
+		}
+		content.WriteString("\n")
+	}
 
 	if stats.StayStats.GamesPlayed > 0 || stats.SwitchStats.GamesPlayed > 0 {
 		content.WriteString("Actual Results:\n")
 		if stats.StayStats.GamesPlayed > 0 {
-			content.WriteString(fmt.Sprintf("  STAY Strategy: %.1f%% (%d/%d games)\n",
-				stats.StayStats.WinRate*100, stats.StayStats.Wins, stats.StayStats.GamesPlayed))
+			content.WriteString(fmt.Sprintf("  STAY Strategy: %s (%d/%d games)\n",
+				locale.Percent(stats.StayStats.WinRate, 1), stats.StayStats.Wins, stats.StayStats.GamesPlayed))
 		}
 		if stats.SwitchStats.GamesPlayed > 0 {
-			content.WriteString(fmt.Sprintf("  SWITCH Strategy: %.1f%% (%d/%d games)\n",
-				stats.SwitchStats.WinRate*100, stats.SwitchStats.Wins, stats.SwitchStats.GamesPlayed))
+			content.WriteString(fmt.Sprintf("  SWITCH Strategy: %s (%d/%d games)\n",
+				locale.Percent(stats.SwitchStats.WinRate, 1), stats.SwitchStats.Wins, stats.SwitchStats.GamesPlayed))
 		}
 	}
 	content.WriteString("\n")
@@ -332,7 +434,7 @@ func (sm *StatsManager) exportText(stats *GameStats, options ExportOptions) erro
 				strategyStr = "SWITCH"
 			}
 			content.WriteString(fmt.Sprintf("%s | %s | %s | Door %d→%d | %s\n",
-				gameRecord.Timestamp.Format("2006-01-02 15:04"),
+				locale.DateTime(gameRecord.Timestamp, false),
 				strategyStr,
 				result,
 				gameRecord.InitialChoice+1,
@@ -346,12 +448,127 @@ func (sm *StatsManager) exportText(stats *GameStats, options ExportOptions) erro
 	content.WriteString("Generated by Monty Hall Terminal Application\n")
 	content.WriteString("For more information, visit: https://github.com/westhuis/monty-hall\n")
 
-	// Write to file
-	if err := os.WriteFile(options.Filename, []byte(content.String()), 0644); err != nil {
-		return fmt.Errorf("failed to write text file: %w", err)
+	return []byte(content.String()), nil
+}
+
+// exportMarkdown renders statistics as a GitHub-flavored Markdown report
+// that can be pasted into issues or assignments
+func (sm *StatsManager) exportMarkdown(stats *GameStats, options ExportOptions) ([]byte, error) {
+	var content strings.Builder
+	locale := options.Locale
+
+	content.WriteString("# Monty Hall Game Statistics\n\n")
+	content.WriteString(fmt.Sprintf("Generated %s from %d games.\n\n", locale.DateTime(time.Now(), true), stats.TotalGames))
+
+	// Overall Statistics
+	content.WriteString("## Overall\n\n")
+	if stats.TotalGames > 0 {
+		overallWinRate := float64(stats.TotalWins) / float64(stats.TotalGames)
+		content.WriteString("| Games | Wins | Losses | Win Rate | Average Game Time | Total Play Time |\n")
+		content.WriteString("| ---: | ---: | ---: | ---: | ---: | ---: |\n")
+		content.WriteString(fmt.Sprintf("| %d | %d | %d | %s | %s | %s |\n\n",
+			stats.TotalGames, stats.TotalWins, stats.TotalLosses, locale.Percent(overallWinRate, 1),
+			stats.AverageGameTime.Round(time.Millisecond), stats.TotalGameTime.Round(time.Millisecond)))
+	} else {
+		content.WriteString("No games played yet.\n\n")
 	}
 
-	return nil
+	// Strategy Statistics
+	content.WriteString("## Strategies\n\n")
+	content.WriteString("| Strategy | Games | Wins | Losses | Win Rate | Expected |\n")
+	content.WriteString("| --- | ---: | ---: | ---: | ---: | ---: |\n")
+	strategies := []struct {
+		name     string
+		stats    StrategyStats
+		expected float64
+	}{
+		{"Stay", stats.StayStats, 1.0 / 3},
+		{"Switch", stats.SwitchStats, 2.0 / 3},
+	}
+	for _, strategy := range strategies {
+		winRate := "–"
+		if strategy.stats.GamesPlayed > 0 {
+			winRate = locale.Percent(strategy.stats.WinRate, 1)
+		}
+		content.WriteString(fmt.Sprintf("| %s | %d | %d | %d | %s | %s |\n",
+			strategy.name, strategy.stats.GamesPlayed, strategy.stats.Wins, strategy.stats.Losses,
+			winRate, locale.Percent(strategy.expected, 1)))
+	}
+	content.WriteString("\n")
+
+	if byDoors := StatsByDoors(stats.GameHistory); len(byDoors) > 1 || (len(byDoors) == 1 && byDoors[0].NumDoors != game.NumDoors) {
+		content.WriteString("### By Number of Doors\n\n")
+		content.WriteString("| Doors | Stay | Stay Expected | Switch | Switch Expected |\n")
+		content.WriteString("| ---: | ---: | ---: | ---: | ---: |\n")
+		for _, doorStats := range byDoors {
+			content.WriteString(fmt.Sprintf("| %d | %d/%d | %s | %d/%d | %s |\n",
+				doorStats.NumDoors,
+				doorStats.StayStats.Wins, doorStats.StayStats.GamesPlayed, locale.Percent(doorStats.ExpectedStayRate(), 1),
+				doorStats.SwitchStats.Wins, doorStats.SwitchStats.GamesPlayed, locale.Percent(doorStats.ExpectedSwitchRate(), 1)))
+		}
+		content.WriteString("\n")
+	}
+
+	if hasHostVariants(stats.GameHistory) {
+		content.WriteString("### By Host Behavior\n\n")
+		content.WriteString("Games where only goats were revealed.\n\n")
+		content.WriteString("| Host | Stay | Stay Expected | Switch | Switch Expected | Car Revealed |\n")
+		content.WriteString("| --- | ---: | ---: | ---: | ---: | ---: |\n")
+		for _, hostStats := range StatsByHost(stats.GameHistory) {
+			content.WriteString(fmt.Sprintf("| %s | %d/%d | %s | %d/%d | %s | %d |\n",
+				hostStats.Name(),
+				hostStats.StayStats.Wins, hostStats.StayStats.GamesPlayed, locale.Percent(hostStats.ExpectedStayRate(), 1),
+				hostStats.SwitchStats.Wins, hostStats.SwitchStats.GamesPlayed, locale.Percent(hostStats.ExpectedSwitchRate(), 1),
+				hostStats.CarRevealed))
+		}
+		content.WriteString("\n")
+	}
+
+	// Streak Statistics
+	content.WriteString("## Streaks\n\n")
+	content.WriteString("| Streak | Current | Longest |\n")
+	content.WriteString("| --- | ---: | ---: |\n")
+	content.WriteString(fmt.Sprintf("| Wins | %d | %d |\n", stats.StreakStats.CurrentWinStreak, stats.StreakStats.LongestWinStreak))
+	content.WriteString(fmt.Sprintf("| Losses | %d | %d |\n", stats.StreakStats.CurrentLossStreak, stats.StreakStats.LongestLossStreak))
+	content.WriteString(fmt.Sprintf("| Switch | %d | – |\n", stats.StreakStats.CurrentSwitchStreak))
+	content.WriteString(fmt.Sprintf("| Stay | %d | – |\n", stats.StreakStats.CurrentStayStreak))
+	content.WriteString("\n")
+
+	// Recent Games (if history is included)
+	if options.IncludeHistory && len(stats.GameHistory) > 0 {
+		games := stats.GameHistory
+		if options.TimeRange != nil {
+			games = sm.filterGamesByTimeRange(stats.GameHistory, *options.TimeRange)
+		}
+		games = games[max(len(games)-10, 0):]
+
+		content.WriteString("## Recent Games\n\n")
+		content.WriteString("| Time | Strategy | Result | Doors | Duration |\n")
+		content.WriteString("| --- | --- | --- | --- | ---: |\n")
+		for _, gameRecord := range games {
+			result := "Loss"
+			if gameRecord.Won {
+				result = "**Win**"
+			}
+			strategyStr := "Stay"
+			if gameRecord.Strategy == game.Switch {
+				strategyStr = "Switch"
+			}
+			content.WriteString(fmt.Sprintf("| %s | %s | %s | %d → %d | %s |\n",
+				locale.DateTime(gameRecord.Timestamp, false),
+				strategyStr,
+				result,
+				gameRecord.InitialChoice+1,
+				gameRecord.FinalChoice+1,
+				gameRecord.GameDuration.Round(time.Millisecond)))
+		}
+		content.WriteString("\n")
+	}
+
+	content.WriteString("---\n\n")
+	content.WriteString("Generated by [Monty Hall](https://github.com/westhuis/monty-hall)\n")
+
+	return []byte(content.String()), nil
 }
 
 // filterGamesByTimeRange filters games by the specified time range
@@ -367,7 +584,7 @@ func (sm *StatsManager) filterGamesByTimeRange(games []GameRecord, timeRange Tim
 
 // GetExportFormats returns all available export formats
 func GetExportFormats() []ExportFormat {
-	return []ExportFormat{ExportJSON, ExportCSV, ExportText}
+	return []ExportFormat{ExportJSON, ExportCSV, ExportText, ExportMarkdown}
 }
 
 // ParseExportFormat parses a string into an ExportFormat
@@ -379,6 +596,8 @@ func ParseExportFormat(format string) (ExportFormat, error) {
 		return ExportCSV, nil
 	case "text", "txt":
 		return ExportText, nil
+	case "markdown", "md":
+		return ExportMarkdown, nil
 	default:
 		return ExportJSON, fmt.Errorf("unknown export format: %s", format)
 	}
@@ -0,0 +1,127 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestAvailableFilename(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "stats.csv")
+
+	if got := AvailableFilename(path); got != path {
+		t.Errorf("AvailableFilename() = %s, want the free name %s", got, path)
+	}
+
+	for _, name := range []string{"stats.csv", "stats-2.csv"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	if got, want := AvailableFilename(path), filepath.Join(dir, "stats-3.csv"); got != want {
+		t.Errorf("AvailableFilename() = %s, want %s", got, want)
+	}
+}
+
+func TestExportPreview(t *testing.T) {
+	dir := t.TempDir()
+	sm := NewStatsManager(filepath.Join(dir, "stats.json"))
+	for i := range 3 {
+		err := sm.RecordGame(&game.GameResult{
+			Won:           i%2 == 0,
+			Strategy:      game.Switch,
+			CarPosition:   1,
+			GameDuration:  time.Second,
+			Timestamp:     time.Date(2026, 3, 14, 9, i, 0, 0, time.UTC),
+			InitialChoice: 0,
+			FinalChoice:   1,
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	options := DefaultExportOptions()
+	options.Format = ExportCSV
+	options.Filename = filepath.Join(dir, "export.csv")
+
+	preview, err := sm.ExportPreview(options, 2)
+	if err != nil {
+		t.Fatalf("ExportPreview() error = %v", err)
+	}
+	if len(preview) != 2 || !strings.HasPrefix(preview[0], "Game ID,Timestamp") {
+		t.Errorf("Expected the CSV header and first record, got %q", preview)
+	}
+	if _, err := os.Stat(options.Filename); err == nil {
+		t.Error("Previewing should not write the export")
+	}
+
+	// The preview matches the start of the file
+	if err := sm.ExportStats(options); err != nil {
+		t.Fatalf("Failed to export CSV: %v", err)
+	}
+	data, err := os.ReadFile(options.Filename)
+	if err != nil {
+		t.Fatalf("Failed to read export: %v", err)
+	}
+	if !strings.HasPrefix(string(data), strings.Join(preview, "\n")) {
+		t.Errorf("Preview %q should match the exported file", preview)
+	}
+
+	options.Format = ExportJSON
+	if preview, err := sm.ExportPreview(options, 2); err != nil || preview != nil {
+		t.Errorf("JSON exports should have no preview, got %q, %v", preview, err)
+	}
+}
+
+func TestExportMarkdown(t *testing.T) {
+	dir := t.TempDir()
+	sm := NewStatsManager(filepath.Join(dir, "stats.json"))
+	for i := range 3 {
+		err := sm.RecordGame(&game.GameResult{
+			Won:           i != 1,
+			Strategy:      game.Switch,
+			CarPosition:   1,
+			GameDuration:  time.Second,
+			Timestamp:     time.Date(2026, 3, 14, 9, i, 0, 0, time.UTC),
+			InitialChoice: 1,
+			FinalChoice:   2,
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	if format, err := ParseExportFormat("md"); err != nil || format != ExportMarkdown {
+		t.Fatalf("ParseExportFormat(md) = %v, %v", format, err)
+	}
+
+	options := DefaultExportOptions()
+	options.Format = ExportMarkdown
+	options.Filename = filepath.Join(dir, "report")
+	if err := sm.ExportStats(options); err != nil {
+		t.Fatalf("Failed to export Markdown: %v", err)
+	}
+	data, err := os.ReadFile(options.Filename + ".md")
+	if err != nil {
+		t.Fatalf("Failed to read export: %v", err)
+	}
+
+	report := string(data)
+	for _, want := range []string{
+		"# Monty Hall Game Statistics",
+		"| Strategy | Games | Wins | Losses | Win Rate | Expected |",
+		"| Switch | 3 | 2 | 1 | 66.7% | 66.7% |",
+		"| Wins | 1 | 1 |",
+		"| 2026-03-14 09:02 | Switch | **Win** | 1 → 2 | 1s |",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected the report to contain %q, got:\n%s", want, report)
+		}
+	}
+}
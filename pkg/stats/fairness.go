@@ -0,0 +1,113 @@
+package stats
+
+import (
+	"math"
+	"slices"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// FairnessWindow is how many of the latest car placements the fairness audit keeps
+const FairnessWindow = 1000
+
+// FairnessSignificance is the p-value below which the audit calls a spread
+// of car placements unusual
+const FairnessSignificance = 0.01
+
+// CarDraw records where the car was placed in one game, for the fairness audit
+type CarDraw struct {
+	NumDoors int `json:"num_doors,omitempty"` // 0 for the standard three doors
+	Car      int `json:"car"`                 // 0-indexed door hiding the car
+}
+
+// Doors returns how many doors the game was played with
+func (d CarDraw) Doors() int {
+	if d.NumDoors == 0 {
+		return game.NumDoors
+	}
+	return d.NumDoors
+}
+
+// carDrawOf returns the car placement of a recorded game
+func carDrawOf(record GameRecord) CarDraw {
+	return CarDraw{NumDoors: record.NumDoors, Car: record.CarPosition}
+}
+
+// addCarDraw adds a game's car placement to the audit, forgetting the oldest
+// beyond FairnessWindow
+func (c *Collector) addCarDraw(record GameRecord) {
+	c.stats.CarDraws = append(c.stats.CarDraws, carDrawOf(record))
+	if len(c.stats.CarDraws) > FairnessWindow {
+		c.stats.CarDraws = slices.Clone(c.stats.CarDraws[len(c.stats.CarDraws)-FairnessWindow:])
+	}
+}
+
+// FairnessReport counts where the car was placed in the audited games played
+// with one number of doors, and tests the counts against an even spread
+type FairnessReport struct {
+	NumDoors  int
+	Games     int
+	Counts    []int   // Games with the car behind each door, by 0-indexed door
+	Expected  float64 // Games each door should hide the car in on average
+	ChiSquare float64 // Pearson's chi-square statistic of the counts
+	PValue    float64 // Chance of a spread at least this uneven from a fair draw
+}
+
+// Suspicious reports whether the spread is unusual enough to look into
+func (r FairnessReport) Suspicious() bool {
+	return r.PValue < FairnessSignificance
+}
+
+// FairnessOf groups car placements by door count, fewest doors first
+func FairnessOf(draws []CarDraw) []FairnessReport {
+	byDoors := make(map[int]*FairnessReport)
+	for _, draw := range draws {
+		doors := draw.Doors()
+		if draw.Car < 0 || draw.Car >= doors {
+			continue
+		}
+		report, ok := byDoors[doors]
+		if !ok {
+			report = &FairnessReport{NumDoors: doors, Counts: make([]int, doors)}
+			byDoors[doors] = report
+		}
+		report.Counts[draw.Car]++
+		report.Games++
+	}
+
+	var reports []FairnessReport
+	for _, report := range byDoors {
+		report.Expected, report.ChiSquare, report.PValue = evenSpread(report.Counts, report.Games)
+		reports = append(reports, *report)
+	}
+	slices.SortFunc(reports, func(a, b FairnessReport) int { return a.NumDoors - b.NumDoors })
+	return reports
+}
+
+// Fairness audits the car placements of the latest FairnessWindow games
+func (sm *StatsManager) Fairness() []FairnessReport {
+	return FairnessOf(sm.GetStats().CarDraws)
+}
+
+// evenSpread tests counts adding up to total against an even spread, returning
+// the count expected in each, Pearson's chi-square statistic and its p-value
+func evenSpread(counts []int, total int) (expected, chiSquare, pValue float64) {
+	expected = float64(total) / float64(len(counts))
+	for _, count := range counts {
+		diff := float64(count) - expected
+		chiSquare += diff * diff / expected
+	}
+	return expected, chiSquare, chiSquarePValue(chiSquare, len(counts)-1)
+}
+
+// chiSquarePValue returns the chance that a chi-square variable with df
+// degrees of freedom is at least x, using the Wilson–Hilferty approximation,
+// which is accurate to well under a percentage point for the door counts played
+func chiSquarePValue(x float64, df int) float64 {
+	if df <= 0 || x <= 0 {
+		return 1
+	}
+	k := float64(df)
+	z := (math.Cbrt(x/k) - (1 - 2/(9*k))) / math.Sqrt(2/(9*k))
+	return 0.5 * math.Erfc(z/math.Sqrt2)
+}
@@ -0,0 +1,109 @@
+package stats
+
+import (
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestFairnessOf(t *testing.T) {
+	var draws []CarDraw
+	for i := range 300 {
+		draws = append(draws, CarDraw{Car: i % 3})
+	}
+	draws = append(draws, CarDraw{NumDoors: 4, Car: 0}, CarDraw{NumDoors: 4, Car: 0}, CarDraw{NumDoors: 4, Car: 9})
+
+	reports := FairnessOf(draws)
+	if len(reports) != 2 || reports[0].NumDoors != 3 || reports[1].NumDoors != 4 {
+		t.Fatalf("Expected reports for 3 and 4 doors, got %+v", reports)
+	}
+
+	even := reports[0]
+	if even.Games != 300 || even.Expected != 100 || even.ChiSquare != 0 || even.PValue != 1 || even.Suspicious() {
+		t.Errorf("An even spread should look fair, got %+v", even)
+	}
+
+	// Doors outside the game are ignored
+	if reports[1].Games != 2 || reports[1].Counts[0] != 2 {
+		t.Errorf("Expected 2 games behind door 1 of 4, got %+v", reports[1])
+	}
+
+	// The car behind door 1 in 200 of 300 games and never behind door 3 is far from even
+	var skewed []CarDraw
+	for i := range 300 {
+		skewed = append(skewed, CarDraw{Car: i % 3 / 2})
+	}
+	report := FairnessOf(skewed)[0]
+	if !report.Suspicious() {
+		t.Errorf("Counts %v should be suspicious, got p = %f", report.Counts, report.PValue)
+	}
+}
+
+func TestChiSquarePValue(t *testing.T) {
+	// Critical values of the chi-square distribution
+	tests := []struct {
+		x    float64
+		df   int
+		want float64
+	}{
+		{5.991, 2, 0.05},
+		{9.210, 2, 0.01},
+		{7.815, 3, 0.05},
+		{16.919, 9, 0.05},
+	}
+	for _, test := range tests {
+		if got := chiSquarePValue(test.x, test.df); math.Abs(got-test.want) > 0.005 {
+			t.Errorf("chiSquarePValue(%v, %d) = %f, want about %f", test.x, test.df, got, test.want)
+		}
+	}
+}
+
+func TestCarDrawsPersistAndRoll(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	sm := NewStatsManager(path)
+	for i := range FairnessWindow + 5 {
+		err := sm.RecordGame(&game.GameResult{
+			Strategy:       game.Stay,
+			InitialChoice:  1,
+			FinalChoice:    1,
+			CarPosition:    i%3 + 1,
+			HostOpenedDoor: 2,
+			Timestamp:      time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	draws := sm.GetStats().CarDraws
+	if len(draws) != FairnessWindow {
+		t.Fatalf("Expected the last %d draws, got %d", FairnessWindow, len(draws))
+	}
+	if draws[len(draws)-1].Car != (FairnessWindow+4)%3 {
+		t.Errorf("Expected the newest draw last, got %+v", draws[len(draws)-1])
+	}
+
+	// Clearing the game history keeps the audit
+	if _, err := sm.ResetWithBackup(ResetHistory); err != nil {
+		t.Fatalf("Failed to reset history: %v", err)
+	}
+	reloaded := NewStatsManager(path)
+	if got := reloaded.Fairness(); len(got) != 1 || got[0].Games != FairnessWindow {
+		t.Errorf("Expected the audit to survive a history reset, got %+v", got)
+	}
+}
+
+func TestMigrateStartsCarDrawsFromHistory(t *testing.T) {
+	stats := &GameStats{Version: CurrentStatsVersion}
+	for i := range 4 {
+		stats.GameHistory = append(stats.GameHistory, GameRecord{CarPosition: i % 2, NumDoors: 5})
+	}
+	stats.migrate()
+
+	if len(stats.CarDraws) != 4 || stats.CarDraws[1] != (CarDraw{NumDoors: 5, Car: 1}) {
+		t.Errorf("Expected draws from the history, got %+v", stats.CarDraws)
+	}
+}
@@ -0,0 +1,281 @@
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+)
+
+const (
+	DefaultGoalsFileName = "monty_hall_goals.json"
+	DefaultGoalMinGames  = 10 // Rate goals need this many games before they can complete
+)
+
+// ErrGoalNotFound is returned when a goal ID does not exist
+var ErrGoalNotFound = errors.New("goal not found")
+
+// GoalKind identifies the statistic a goal tracks
+type GoalKind int
+
+const (
+	GoalGamesPlayed    GoalKind = iota // Total number of games played
+	GoalSwitchUsage                    // Percentage of games where the player switched
+	GoalSwitchWinRate                  // Win rate (percent) when switching
+	GoalOverallWinRate                 // Overall win rate (percent)
+	GoalWinStreak                      // Longest win streak
+)
+
+// String returns a short name for the goal kind
+func (gk GoalKind) String() string {
+	switch gk {
+	case GoalGamesPlayed:
+		return "Games Played"
+	case GoalSwitchUsage:
+		return "Switch Usage"
+	case GoalSwitchWinRate:
+		return "Switch Win Rate"
+	case GoalOverallWinRate:
+		return "Overall Win Rate"
+	case GoalWinStreak:
+		return "Win Streak"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsRate returns true if the goal target is a percentage
+func (gk GoalKind) IsRate() bool {
+	return gk == GoalSwitchUsage || gk == GoalSwitchWinRate || gk == GoalOverallWinRate
+}
+
+// GetGoalKinds returns all available goal kinds
+func GetGoalKinds() []GoalKind {
+	return []GoalKind{GoalGamesPlayed, GoalSwitchUsage, GoalSwitchWinRate, GoalOverallWinRate, GoalWinStreak}
+}
+
+// Goal represents a user-defined target tracked against the statistics
+type Goal struct {
+	ID          string     `json:"id"`
+	Kind        GoalKind   `json:"kind"`
+	Target      float64    `json:"target"`    // Count for counting goals, percent (0-100) for rate goals
+	MinGames    int        `json:"min_games"` // Minimum games before a rate goal can complete
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// IsComplete returns true if the goal has been achieved
+func (g Goal) IsComplete() bool {
+	return g.CompletedAt != nil
+}
+
+// Description returns a human-readable description of the goal
+func (g Goal) Description() string {
+	switch g.Kind {
+	case GoalGamesPlayed:
+		return fmt.Sprintf("Play %.0f games", g.Target)
+	case GoalSwitchUsage:
+		return fmt.Sprintf("Reach %.0f%% switch usage", g.Target)
+	case GoalSwitchWinRate:
+		return fmt.Sprintf("Reach a %.0f%% switch win rate", g.Target)
+	case GoalOverallWinRate:
+		return fmt.Sprintf("Reach a %.0f%% overall win rate", g.Target)
+	case GoalWinStreak:
+		return fmt.Sprintf("Win %.0f games in a row", g.Target)
+	default:
+		return "Unknown goal"
+	}
+}
+
+// Current returns the current value of the tracked statistic
+func (g Goal) Current(stats *GameStats) float64 {
+	if stats == nil {
+		return 0
+	}
+
+	switch g.Kind {
+	case GoalGamesPlayed:
+		return float64(stats.TotalGames)
+	case GoalSwitchUsage:
+		if stats.TotalGames == 0 {
+			return 0
+		}
+		return float64(stats.SwitchStats.GamesPlayed) / float64(stats.TotalGames) * 100
+	case GoalSwitchWinRate:
+		return stats.SwitchStats.WinRate * 100
+	case GoalOverallWinRate:
+		if stats.TotalGames == 0 {
+			return 0
+		}
+		return float64(stats.TotalWins) / float64(stats.TotalGames) * 100
+	case GoalWinStreak:
+		return float64(stats.StreakStats.LongestWinStreak)
+	default:
+		return 0
+	}
+}
+
+// Progress returns the completion fraction of the goal in [0, 1]
+func (g Goal) Progress(stats *GameStats) float64 {
+	if g.IsComplete() {
+		return 1.0
+	}
+	if g.Target <= 0 {
+		return 0
+	}
+
+	progress := g.Current(stats) / g.Target
+	if progress > 1.0 {
+		progress = 1.0
+	}
+	return progress
+}
+
+// isMet checks whether the goal condition is satisfied by the statistics
+func (g Goal) isMet(stats *GameStats) bool {
+	if stats == nil {
+		return false
+	}
+	if g.Kind.IsRate() && g.RatedGames(stats) < g.MinGames {
+		return false
+	}
+	return g.Current(stats) >= g.Target
+}
+
+// RatedGames returns how many games a rate goal's rate is taken over: the
+// switch win rate only counts games where the player switched
+func (g Goal) RatedGames(stats *GameStats) int {
+	if g.Kind == GoalSwitchWinRate {
+		return stats.SwitchStats.GamesPlayed
+	}
+	return stats.TotalGames
+}
+
+// GoalManager stores goals and persists them alongside the statistics file
+type GoalManager struct {
+	filePath string
+	goals    []Goal
+	readOnly bool // Keep changes in memory only
+}
+
+// NewGoalManager creates a goal manager backed by the given file
+func NewGoalManager(filePath string) *GoalManager {
+	return &GoalManager{
+		filePath: filePath,
+		goals:    make([]Goal, 0),
+	}
+}
+
+// Load reads goals from disk; a missing file yields an empty goal list
+func (gm *GoalManager) Load() error {
+	data, err := os.ReadFile(gm.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			gm.goals = make([]Goal, 0)
+			return nil
+		}
+		return fmt.Errorf("failed to read goals file: %w", err)
+	}
+
+	var goals []Goal
+	if err := json.Unmarshal(data, &goals); err != nil {
+		return fmt.Errorf("failed to unmarshal goals: %w", err)
+	}
+
+	gm.goals = goals
+	return nil
+}
+
+// Save writes goals to disk; read-only managers keep them in memory
+func (gm *GoalManager) Save() error {
+	if gm.readOnly {
+		return nil
+	}
+
+	dir := filepath.Dir(gm.filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(gm.goals, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal goals: %w", err)
+	}
+
+	if err := chaos.WriteFile(gm.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write goals file: %w", err)
+	}
+
+	return nil
+}
+
+// Add creates a new goal and persists it
+func (gm *GoalManager) Add(kind GoalKind, target float64) (Goal, error) {
+	if target <= 0 {
+		return Goal{}, fmt.Errorf("goal target must be positive, got %.0f", target)
+	}
+	if kind.IsRate() && target > 100 {
+		return Goal{}, fmt.Errorf("rate goal target cannot exceed 100%%, got %.0f", target)
+	}
+
+	goal := Goal{
+		ID:        generateID(),
+		Kind:      kind,
+		Target:    target,
+		CreatedAt: time.Now(),
+	}
+	if kind.IsRate() {
+		goal.MinGames = DefaultGoalMinGames
+	}
+
+	gm.goals = append(gm.goals, goal)
+	return goal, gm.Save()
+}
+
+// Remove deletes the goal with the given ID and persists the change
+func (gm *GoalManager) Remove(id string) error {
+	for i, goal := range gm.goals {
+		if goal.ID == id {
+			gm.goals = append(gm.goals[:i], gm.goals[i+1:]...)
+			return gm.Save()
+		}
+	}
+	return ErrGoalNotFound
+}
+
+// Goals returns a copy of all goals
+func (gm *GoalManager) Goals() []Goal {
+	goals := make([]Goal, len(gm.goals))
+	copy(goals, gm.goals)
+	return goals
+}
+
+// Evaluate marks goals met by the statistics as complete and returns the newly completed ones
+func (gm *GoalManager) Evaluate(stats *GameStats) ([]Goal, error) {
+	var completed []Goal
+	now := time.Now()
+
+	for i := range gm.goals {
+		if gm.goals[i].IsComplete() || !gm.goals[i].isMet(stats) {
+			continue
+		}
+		completedAt := now
+		gm.goals[i].CompletedAt = &completedAt
+		completed = append(completed, gm.goals[i])
+	}
+
+	if len(completed) == 0 {
+		return nil, nil
+	}
+
+	return completed, gm.Save()
+}
+
+// GetFilePath returns the path of the goals file
+func (gm *GoalManager) GetFilePath() string {
+	return gm.filePath
+}
@@ -0,0 +1,193 @@
+package stats
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestGoalManagerAddAndRemove(t *testing.T) {
+	gm := NewGoalManager(filepath.Join(t.TempDir(), DefaultGoalsFileName))
+
+	goal, err := gm.Add(GoalSwitchUsage, 60)
+	if err != nil {
+		t.Fatalf("Unexpected error adding goal: %v", err)
+	}
+
+	if goal.ID == "" {
+		t.Error("Goal ID should not be empty")
+	}
+
+	if goal.MinGames != DefaultGoalMinGames {
+		t.Errorf("Expected rate goal to require %d games, got %d", DefaultGoalMinGames, goal.MinGames)
+	}
+
+	if len(gm.Goals()) != 1 {
+		t.Fatalf("Expected 1 goal, got %d", len(gm.Goals()))
+	}
+
+	if err := gm.Remove(goal.ID); err != nil {
+		t.Errorf("Unexpected error removing goal: %v", err)
+	}
+
+	if len(gm.Goals()) != 0 {
+		t.Errorf("Expected no goals after removal, got %d", len(gm.Goals()))
+	}
+
+	if err := gm.Remove(goal.ID); err != ErrGoalNotFound {
+		t.Errorf("Expected ErrGoalNotFound, got %v", err)
+	}
+}
+
+func TestGoalManagerAddInvalidTarget(t *testing.T) {
+	gm := NewGoalManager(filepath.Join(t.TempDir(), DefaultGoalsFileName))
+
+	if _, err := gm.Add(GoalGamesPlayed, 0); err == nil {
+		t.Error("Expected error for non-positive target")
+	}
+
+	if _, err := gm.Add(GoalOverallWinRate, 150); err == nil {
+		t.Error("Expected error for rate target above 100%")
+	}
+}
+
+func TestGoalManagerSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultGoalsFileName)
+	gm := NewGoalManager(path)
+
+	if _, err := gm.Add(GoalGamesPlayed, 50); err != nil {
+		t.Fatalf("Unexpected error adding goal: %v", err)
+	}
+
+	loaded := NewGoalManager(path)
+	if err := loaded.Load(); err != nil {
+		t.Fatalf("Unexpected error loading goals: %v", err)
+	}
+
+	goals := loaded.Goals()
+	if len(goals) != 1 {
+		t.Fatalf("Expected 1 goal, got %d", len(goals))
+	}
+
+	if goals[0].Kind != GoalGamesPlayed || goals[0].Target != 50 {
+		t.Errorf("Loaded goal does not match: %+v", goals[0])
+	}
+}
+
+func TestGoalManagerLoadMissingFile(t *testing.T) {
+	gm := NewGoalManager(filepath.Join(t.TempDir(), "missing.json"))
+
+	if err := gm.Load(); err != nil {
+		t.Errorf("Missing file should not be an error: %v", err)
+	}
+
+	if len(gm.Goals()) != 0 {
+		t.Errorf("Expected no goals, got %d", len(gm.Goals()))
+	}
+}
+
+func TestGoalProgress(t *testing.T) {
+	stats := &GameStats{
+		TotalGames: 20,
+		TotalWins:  10,
+		SwitchStats: StrategyStats{
+			GamesPlayed: 5,
+		},
+	}
+
+	tests := []struct {
+		goal     Goal
+		expected float64
+	}{
+		{Goal{Kind: GoalGamesPlayed, Target: 40}, 0.5},
+		{Goal{Kind: GoalSwitchUsage, Target: 50}, 0.5},
+		{Goal{Kind: GoalOverallWinRate, Target: 25}, 1.0},
+		{Goal{Kind: GoalGamesPlayed, Target: 0}, 0},
+	}
+
+	for _, test := range tests {
+		if progress := test.goal.Progress(stats); progress != test.expected {
+			t.Errorf("%s: expected progress %.2f, got %.2f", test.goal.Description(), test.expected, progress)
+		}
+	}
+}
+
+func TestGoalEvaluate(t *testing.T) {
+	gm := NewGoalManager(filepath.Join(t.TempDir(), DefaultGoalsFileName))
+
+	if _, err := gm.Add(GoalGamesPlayed, 5); err != nil {
+		t.Fatalf("Unexpected error adding goal: %v", err)
+	}
+	if _, err := gm.Add(GoalOverallWinRate, 50); err != nil {
+		t.Fatalf("Unexpected error adding goal: %v", err)
+	}
+
+	// Win rate is met but not enough games have been played for it to count
+	stats := &GameStats{TotalGames: 5, TotalWins: 5}
+	completed, err := gm.Evaluate(stats)
+	if err != nil {
+		t.Fatalf("Unexpected error evaluating goals: %v", err)
+	}
+
+	if len(completed) != 1 || completed[0].Kind != GoalGamesPlayed {
+		t.Fatalf("Expected only the games played goal to complete, got %+v", completed)
+	}
+
+	stats = &GameStats{TotalGames: DefaultGoalMinGames, TotalWins: DefaultGoalMinGames}
+	completed, _ = gm.Evaluate(stats)
+	if len(completed) != 1 || completed[0].Kind != GoalOverallWinRate {
+		t.Fatalf("Expected the win rate goal to complete, got %+v", completed)
+	}
+
+	// Completed goals are not reported again
+	completed, _ = gm.Evaluate(stats)
+	if len(completed) != 0 {
+		t.Errorf("Expected no newly completed goals, got %d", len(completed))
+	}
+}
+
+func TestSwitchWinRateGoalCountsSwitchGames(t *testing.T) {
+	gm := NewGoalManager(filepath.Join(t.TempDir(), "goals.json"))
+	if _, err := gm.Add(GoalSwitchWinRate, 60); err != nil {
+		t.Fatalf("Unexpected error adding goal: %v", err)
+	}
+
+	// Plenty of games, but one lucky switch is not a win rate
+	stats := &GameStats{TotalGames: 50, TotalWins: 20, SwitchStats: StrategyStats{GamesPlayed: 1, Wins: 1, WinRate: 1}}
+	if completed, _ := gm.Evaluate(stats); len(completed) != 0 {
+		t.Fatalf("Expected the goal to wait for %d switch games, got %+v", DefaultGoalMinGames, completed)
+	}
+
+	stats.SwitchStats = StrategyStats{GamesPlayed: DefaultGoalMinGames, Wins: 7, WinRate: 0.7}
+	if completed, _ := gm.Evaluate(stats); len(completed) != 1 {
+		t.Errorf("Expected the goal met after %d switch games, got %+v", DefaultGoalMinGames, completed)
+	}
+}
+
+func TestStatsManagerGoalHook(t *testing.T) {
+	sm := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+
+	if _, err := sm.Goals().Add(GoalGamesPlayed, 2); err != nil {
+		t.Fatalf("Unexpected error adding goal: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := sm.RecordGame(createTestGameResult(game.Switch, true)); err != nil {
+			t.Fatalf("Unexpected error recording game: %v", err)
+		}
+	}
+
+	completed := sm.TakeCompletedGoals()
+	if len(completed) != 1 {
+		t.Fatalf("Expected 1 completed goal, got %d", len(completed))
+	}
+
+	if len(sm.TakeCompletedGoals()) != 0 {
+		t.Error("Completed goals should only be returned once")
+	}
+
+	if !sm.Goals().Goals()[0].IsComplete() {
+		t.Error("Goal should be marked complete")
+	}
+}
@@ -0,0 +1,116 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// HostStats breaks down strategy results for games with one host behavior,
+// door count and number of doors opened. Games in which the host revealed the
+// car count towards CarRevealed only, so the strategy rates compare with
+// ExpectedWinRatesOpening
+type HostStats struct {
+	Behavior    game.HostBehavior
+	NumDoors    int
+	Opened      int // Doors the host opened
+	SwitchStats StrategyStats
+	StayStats   StrategyStats
+	CarRevealed int
+}
+
+// GamesPlayed returns every game in the group, including those with the car revealed
+func (h HostStats) GamesPlayed() int {
+	return h.SwitchStats.GamesPlayed + h.StayStats.GamesPlayed + h.CarRevealed
+}
+
+// ExpectedStayRate returns the theoretical win rate for staying when only goats were revealed
+func (h HostStats) ExpectedStayRate() float64 {
+	stay, _ := h.Behavior.ExpectedWinRatesOpening(h.NumDoors, h.Opened)
+	return stay
+}
+
+// ExpectedSwitchRate returns the theoretical win rate for switching when only goats were revealed
+func (h HostStats) ExpectedSwitchRate() float64 {
+	_, switchDoors := h.Behavior.ExpectedWinRatesOpening(h.NumDoors, h.Opened)
+	return switchDoors
+}
+
+// IsVariant reports whether the group differs from the classic game, where
+// a host who knows where the car is opens every other door but one
+func (h HostStats) IsVariant() bool {
+	return h.Behavior != game.HostClassic || h.Opened != h.NumDoors-2
+}
+
+// Name describes the group, such as "Monty Fall, 5 doors, 2 opened"; the
+// number opened is left out when the host opened every other door but one
+func (h HostStats) Name() string {
+	name := fmt.Sprintf("%s, %d doors", h.Behavior.DisplayName(), h.NumDoors)
+	if h.Opened != h.NumDoors-2 {
+		name += fmt.Sprintf(", %d opened", h.Opened)
+	}
+	return name
+}
+
+// StatsByHost groups the history by host behavior, door count and doors
+// opened, in behavior order, then fewest doors and fewest opened first
+func StatsByHost(history []GameRecord) []HostStats {
+	type key struct {
+		behavior game.HostBehavior
+		doors    int
+		opened   int
+	}
+
+	byHost := make(map[key]*HostStats)
+	for _, record := range history {
+		k := key{record.Host(), record.Doors(), record.Opened()}
+		entry, ok := byHost[k]
+		if !ok {
+			entry = &HostStats{Behavior: k.behavior, NumDoors: k.doors, Opened: k.opened}
+			byHost[k] = entry
+		}
+
+		if record.CarRevealed {
+			entry.CarRevealed++
+			continue
+		}
+
+		strategy := &entry.StayStats
+		if record.Strategy == game.Switch {
+			strategy = &entry.SwitchStats
+		}
+		strategy.add(record.Won)
+	}
+
+	breakdown := make([]HostStats, 0, len(byHost))
+	for _, entry := range byHost {
+		breakdown = append(breakdown, *entry)
+	}
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Behavior != breakdown[j].Behavior {
+			return breakdown[i].Behavior < breakdown[j].Behavior
+		}
+		if breakdown[i].NumDoors != breakdown[j].NumDoors {
+			return breakdown[i].NumDoors < breakdown[j].NumDoors
+		}
+		return breakdown[i].Opened < breakdown[j].Opened
+	})
+	return breakdown
+}
+
+// StatsByHost groups the recorded games by host behavior
+func (sm *StatsManager) StatsByHost() []HostStats {
+	return StatsByHost(sm.GetStats().GameHistory)
+}
+
+// hasHostVariants reports whether any game was played with a non-classic
+// host, or one that left more than one other door closed
+func hasHostVariants(history []GameRecord) bool {
+	for _, record := range history {
+		if record.Host() != game.HostClassic || record.Opened() != record.Doors()-2 {
+			return true
+		}
+	}
+	return false
+}
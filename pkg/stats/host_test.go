@@ -0,0 +1,92 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestStatsByHost(t *testing.T) {
+	history := []GameRecord{
+		{Strategy: game.Switch, Won: true},
+		{Strategy: game.Stay, Won: true, HostBehavior: "fall"},
+		{Strategy: game.Switch, Won: false, HostBehavior: "fall"},
+		{Strategy: game.Switch, Won: false, HostBehavior: "fall", CarRevealed: true},
+		{Strategy: game.Stay, Won: false, HostBehavior: "crawl"},
+	}
+
+	byHost := StatsByHost(history)
+	if len(byHost) != 3 {
+		t.Fatalf("Expected 3 host behaviors, got %d", len(byHost))
+	}
+	if byHost[0].Behavior != game.HostClassic || byHost[1].Behavior != game.HostFall || byHost[2].Behavior != game.HostCrawl {
+		t.Fatalf("Expected classic, fall and crawl in order, got %+v", byHost)
+	}
+
+	fall := byHost[1]
+	if fall.CarRevealed != 1 || fall.GamesPlayed() != 3 {
+		t.Errorf("Expected 3 Monty Fall games with 1 car revealed, got %+v", fall)
+	}
+	if fall.StayStats.Wins != 1 || fall.SwitchStats.GamesPlayed != 1 {
+		t.Errorf("Expected the revealed car to be left out of the strategy stats, got %+v", fall)
+	}
+	if fall.ExpectedStayRate() != 0.5 || fall.ExpectedSwitchRate() != 0.5 {
+		t.Errorf("Expected 50%%/50%% for Monty Fall, got %.2f/%.2f", fall.ExpectedStayRate(), fall.ExpectedSwitchRate())
+	}
+
+	if !hasHostVariants(history) || hasHostVariants(history[:1]) {
+		t.Error("Expected host variants to be detected only when present")
+	}
+}
+
+func TestRecordGameStoresHostBehavior(t *testing.T) {
+	collector := NewCollector()
+	err := collector.RecordGame(&game.GameResult{
+		Strategy:       game.Stay,
+		InitialChoice:  1,
+		FinalChoice:    1,
+		CarPosition:    2,
+		HostOpenedDoor: 2,
+		NumDoors:       3,
+		HostBehavior:   game.HostFall,
+		CarRevealed:    true,
+	})
+	if err != nil {
+		t.Fatalf("RecordGame failed: %v", err)
+	}
+
+	record := collector.GetStats().GameHistory[0]
+	if record.HostBehavior != "fall" || !record.CarRevealed || record.Host() != game.HostFall {
+		t.Errorf("Expected a Monty Fall record with the car revealed, got %+v", record)
+	}
+	if err := record.checkReplayable(); err == nil {
+		t.Error("Expected a game with the car revealed not to be replayable")
+	}
+}
+
+func TestStatsByHostPartialReveal(t *testing.T) {
+	history := []GameRecord{
+		{Strategy: game.Switch, Won: true, NumDoors: 5},
+		{Strategy: game.Switch, Won: false, NumDoors: 5, DoorsOpened: 1},
+		{Strategy: game.Stay, Won: true, NumDoors: 5, DoorsOpened: 1},
+	}
+
+	byHost := StatsByHost(history)
+	if len(byHost) != 2 {
+		t.Fatalf("Expected the partial reveals in their own group, got %+v", byHost)
+	}
+	if byHost[0].Opened != 1 || byHost[1].Opened != 3 {
+		t.Fatalf("Expected groups ordered by doors opened, got %+v", byHost)
+	}
+
+	partial := byHost[0]
+	if partial.GamesPlayed() != 2 || !partial.IsVariant() || byHost[1].IsVariant() {
+		t.Errorf("Expected 2 partial reveal games marked as a variant, got %+v", partial)
+	}
+	if partial.Name() != "Classic Monty, 5 doors, 1 opened" || byHost[1].Name() != "Classic Monty, 5 doors" {
+		t.Errorf("Unexpected names %q and %q", partial.Name(), byHost[1].Name())
+	}
+	if !hasHostVariants(history[1:2]) {
+		t.Error("Expected a partial reveal to count as a host variant")
+	}
+}
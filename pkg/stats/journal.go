@@ -0,0 +1,134 @@
+package stats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// JournalSuffix ends the name of the journal beside the stats file
+const JournalSuffix = ".journal"
+
+// JournalCompactEvery is how many games are appended to the journal before it
+// is folded into the stats file
+const JournalCompactEvery = 100
+
+// JournalStore is a store that can save one game at a time, rather than all
+// the statistics each game. PersistenceManager appends each game to a journal
+// beside the stats file and folds the journal into the file now and then
+type JournalStore interface {
+	StatsStore
+	// Append saves record, the latest game in stats
+	Append(record GameRecord, stats *GameStats) error
+}
+
+// journalEntry is one line of the journal: a game and its place in the journal
+type journalEntry struct {
+	Seq    int        `json:"seq"`
+	Record GameRecord `json:"record"`
+}
+
+// JournalPath returns the journal of games played since the last save,
+// beside the stats file
+func (pm *PersistenceManager) JournalPath() string {
+	return pm.filePath + JournalSuffix
+}
+
+// Append adds record, the latest game in stats, to the end of the journal,
+// which costs the same however long the history is. Every JournalCompactEvery
+// games the journal is folded into the stats file with Save
+func (pm *PersistenceManager) Append(record GameRecord, stats *GameStats) error {
+	if stats == nil {
+		return ErrNilStats
+	}
+
+	// Save in full for the first game, so there is always a stats file
+	// beside the journal, in place of a damaged stats file, every
+	// JournalCompactEvery games, and after a torn last line, which would
+	// swallow the next game
+	if !pm.Exists() || pm.damaged || pm.compact || pm.journaled+1 >= JournalCompactEvery {
+		return pm.Save(stats)
+	}
+
+	entry := journalEntry{Seq: stats.JournalSeq + 1, Record: record}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal game: %w", err)
+	}
+
+	dir := filepath.Dir(pm.filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	file, err := os.OpenFile(pm.JournalPath(), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open stats journal: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write stats journal: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write stats journal: %w", err)
+	}
+
+	stats.JournalSeq = entry.Seq
+	pm.journaled++
+	return nil
+}
+
+// replayJournal adds the games in the journal that stats doesn't have yet.
+// Games saved in the stats file are skipped by their place in the journal, so
+// a crash between saving and clearing the journal never counts a game twice.
+// A line that can't be parsed, as one cut short by a crash, is skipped
+func (pm *PersistenceManager) replayJournal(stats *GameStats) error {
+	pm.journaled = 0
+	pm.compact = false
+
+	data, err := os.ReadFile(pm.JournalPath())
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read stats journal: %w", err)
+	}
+
+	collector := &Collector{stats: stats}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		var entry journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			pm.compact = true
+			continue
+		}
+		pm.journaled++
+		if entry.Seq <= stats.JournalSeq {
+			continue
+		}
+		collector.addRecord(entry.Record)
+		stats.JournalSeq = entry.Seq
+	}
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		pm.compact = true
+	}
+	// Games the replay trims were archived when they were recorded
+	stats.trimmed = nil
+	return nil
+}
+
+// clearJournal removes the journal once its games are in the stats file
+func (pm *PersistenceManager) clearJournal() error {
+	pm.journaled = 0
+	pm.compact = false
+	if err := os.Remove(pm.JournalPath()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to clear stats journal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func journalResult() *game.GameResult {
+	return &game.GameResult{Strategy: game.Switch, Won: true, NumDoors: 3, InitialChoice: 1, FinalChoice: 2, CarPosition: 2, HostOpenedDoor: 3, Timestamp: time.Now()}
+}
+
+func TestJournalAppendsGames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultStatsFileName)
+	sm := NewStatsManager(path)
+	for range 3 {
+		if err := sm.RecordGame(journalResult()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Only the first game rewrote the stats file; the others were appended
+	if saved, err := readStatsFile(path); err != nil || saved.TotalGames != 1 {
+		t.Fatalf("Expected the stats file left as saved after the first game, got %+v, %v", saved, err)
+	}
+	data, err := os.ReadFile(path + JournalSuffix)
+	if err != nil || strings.Count(string(data), "\n") != 2 {
+		t.Fatalf("Expected 2 games in the journal, got %q, %v", data, err)
+	}
+
+	reloaded := NewStatsManager(path)
+	stats := reloaded.GetStats()
+	if stats.TotalGames != 3 || len(stats.GameHistory) != 3 || stats.SwitchStats.Wins != 3 || stats.StreakStats.CurrentWinStreak != 3 {
+		t.Fatalf("Expected the journaled games replayed, got %+v", stats)
+	}
+	for i, record := range sm.GetStats().GameHistory {
+		if stats.GameHistory[i].ID != record.ID {
+			t.Errorf("Expected game %d replayed as recorded, got %s for %s", i, stats.GameHistory[i].ID, record.ID)
+		}
+	}
+
+	size, err := reloaded.GetFileSize()
+	info, _ := os.Stat(path)
+	if err != nil || size != info.Size()+int64(len(data)) {
+		t.Errorf("Expected the size to include the journal, got %d, %v", size, err)
+	}
+
+	backup := filepath.Join(t.TempDir(), "backup.json")
+	if err := reloaded.Backup(backup); err != nil {
+		t.Fatal(err)
+	}
+	if saved, err := readStatsFile(backup); err != nil || saved.TotalGames != 3 {
+		t.Errorf("Expected the backup to include the journaled games, got %+v, %v", saved, err)
+	}
+}
+
+func TestJournalCompacts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultStatsFileName)
+	sm := NewStatsManager(path)
+	// The first game is saved in full, then the journal fills up
+	games := JournalCompactEvery + 1
+	for range games {
+		if err := sm.RecordGame(journalResult()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	saved, err := readStatsFile(path)
+	if err != nil || saved.TotalGames != games {
+		t.Fatalf("Expected every game folded into the stats file, got %+v, %v", saved, err)
+	}
+	if _, err := os.Stat(path + JournalSuffix); !os.IsNotExist(err) {
+		t.Errorf("Expected the journal cleared once folded in, got %v", err)
+	}
+	if got := NewStatsManager(path).GetStats().TotalGames; got != games {
+		t.Errorf("Expected %d games after reload, got %d", games, got)
+	}
+}
+
+func TestJournalReplaysOnce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultStatsFileName)
+	sm := NewStatsManager(path)
+	for range 3 {
+		if err := sm.RecordGame(journalResult()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	journal, err := os.ReadFile(path + JournalSuffix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A crash after saving but before clearing the journal leaves its games twice
+	if err := sm.save(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path+JournalSuffix, journal, 0644); err != nil {
+		t.Fatal(err)
+	}
+	sm = NewStatsManager(path)
+	if games := sm.GetStats().TotalGames; games != 3 {
+		t.Fatalf("Expected games saved and journaled counted once, got %d", games)
+	}
+
+	// A crash mid-append leaves a torn line, which is skipped
+	if err := sm.RecordGame(journalResult()); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.OpenFile(path+JournalSuffix, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString(`{"seq": 5, "record": {"id": "tor`)
+	file.Close()
+
+	sm = NewStatsManager(path)
+	if games := sm.GetStats().TotalGames; games != 4 {
+		t.Fatalf("Expected the torn game skipped, got %d games", games)
+	}
+
+	// The next game is saved in full rather than appended after the torn line
+	if err := sm.RecordGame(journalResult()); err != nil {
+		t.Fatal(err)
+	}
+	if saved, err := readStatsFile(path); err != nil || saved.TotalGames != 5 {
+		t.Errorf("Expected the game after a torn line saved in full, got %+v, %v", saved, err)
+	}
+	if games := NewStatsManager(path).GetStats().TotalGames; games != 5 {
+		t.Errorf("Expected 5 games after reload, got %d", games)
+	}
+}
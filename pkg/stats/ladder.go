@@ -0,0 +1,60 @@
+package stats
+
+import (
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// LadderStartDoors is the door count every climb of the ladder starts from
+const LadderStartDoors = game.NumDoors
+
+// LadderPoints returns the points for winning a ladder game with numDoors
+// doors: the more doors, the more the win is worth
+func LadderPoints(numDoors int) int {
+	return numDoors
+}
+
+// LadderStats summarizes the climbs of the door-count ladder: each climb
+// starts at LadderStartDoors and adds a door for every game won
+type LadderStats struct {
+	Climbs    int
+	BestScore int
+	TopDoors  int              // Most doors in a ladder game won
+	Rungs     []DoorCountStats // Ladder games by door count, fewest doors first
+}
+
+// LadderOf replays the ladder games in the history into climbs. A game at
+// LadderStartDoors starts a new climb, and each game won scores LadderPoints
+func LadderOf(history []GameRecord) LadderStats {
+	var ladder LadderStats
+	var games []GameRecord
+	score := 0
+	for _, record := range history {
+		if !record.Ladder {
+			continue
+		}
+		games = append(games, record)
+
+		if record.Doors() == LadderStartDoors {
+			ladder.Climbs++
+			score = 0
+		}
+		if record.Won {
+			score += LadderPoints(record.Doors())
+			ladder.BestScore = max(ladder.BestScore, score)
+			ladder.TopDoors = max(ladder.TopDoors, record.Doors())
+		}
+	}
+	ladder.Rungs = StatsByDoors(games)
+	return ladder
+}
+
+// Ladder summarizes the recorded climbs of the door-count ladder
+func (sm *StatsManager) Ladder() LadderStats {
+	return LadderOf(sm.GetStats().GameHistory)
+}
+
+// RecordLadderGame records a game played on the door-count ladder; it counts
+// towards all statistics and is marked so the climbs can be scored
+func (sm *StatsManager) RecordLadderGame(result *game.GameResult) error {
+	return sm.recordGame(result, recordOptions{ladder: true})
+}
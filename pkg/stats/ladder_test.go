@@ -0,0 +1,55 @@
+package stats
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestLadderOf(t *testing.T) {
+	history := []GameRecord{
+		{Strategy: game.Switch, Won: true, Ladder: true},
+		{Strategy: game.Switch, Won: true, NumDoors: 4, Ladder: true},
+		{Strategy: game.Switch, Won: true},
+		{Strategy: game.Stay, Won: false, NumDoors: 5, Ladder: true},
+		{Strategy: game.Switch, Won: true, Ladder: true},
+		{Strategy: game.Stay, Won: false, NumDoors: 4, Ladder: true},
+	}
+
+	ladder := LadderOf(history)
+	if ladder.Climbs != 2 {
+		t.Errorf("Expected 2 climbs, got %d", ladder.Climbs)
+	}
+	if ladder.BestScore != 7 || ladder.TopDoors != 4 {
+		t.Errorf("Expected a best score of 3+4 reaching 4 doors, got %d and %d doors", ladder.BestScore, ladder.TopDoors)
+	}
+	if len(ladder.Rungs) != 3 || ladder.Rungs[0].SwitchStats.GamesPlayed != 2 || ladder.Rungs[2].StayStats.Losses != 1 {
+		t.Errorf("Expected only ladder games broken down by door count, got %+v", ladder.Rungs)
+	}
+
+	if empty := LadderOf(nil); empty.Climbs != 0 || empty.BestScore != 0 || len(empty.Rungs) != 0 {
+		t.Errorf("Expected no climbs without ladder games, got %+v", empty)
+	}
+}
+
+func TestRecordLadderGame(t *testing.T) {
+	sm := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+
+	result := &game.GameResult{Strategy: game.Switch, Won: true, NumDoors: 3, InitialChoice: 1, FinalChoice: 2, CarPosition: 2, HostOpenedDoor: 3, Timestamp: time.Now()}
+	if err := sm.RecordLadderGame(result); err != nil {
+		t.Fatal(err)
+	}
+	if err := sm.RecordGame(result); err != nil {
+		t.Fatal(err)
+	}
+
+	history := sm.GetStats().GameHistory
+	if !history[0].Ladder || history[1].Ladder {
+		t.Error("Expected only the ladder game marked")
+	}
+	if ladder := sm.Ladder(); ladder.Climbs != 1 || ladder.BestScore != 3 {
+		t.Errorf("Expected one climb scoring 3, got %+v", ladder)
+	}
+}
@@ -0,0 +1,127 @@
+package stats
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Date formats of exports
+const (
+	DateISO = "iso" // 2006-01-02
+	DateUS  = "us"  // 01/02/2006
+	DateEU  = "eu"  // 02.01.2006
+	DateUK  = "uk"  // 02/01/2006
+)
+
+// dateLayouts maps the date formats to Go time layouts
+var dateLayouts = map[string]string{
+	DateISO: "2006-01-02",
+	DateUS:  "01/02/2006",
+	DateEU:  "02.01.2006",
+	DateUK:  "02/01/2006",
+}
+
+// ExportLocale controls how dates and numbers are written in CSV and text
+// exports. JSON exports always use ISO dates and decimal points
+type ExportLocale struct {
+	DateFormat       string // One of DateFormats
+	DecimalSeparator string // "." or ","
+	CSVDelimiter     string // ",", ";" or "\t"; ";" suits Excel in European locales
+}
+
+// DefaultExportLocale returns ISO dates, decimal points and comma-separated CSV
+func DefaultExportLocale() ExportLocale {
+	return ExportLocale{
+		DateFormat:       DateISO,
+		DecimalSeparator: ".",
+		CSVDelimiter:     ",",
+	}
+}
+
+// DateFormats returns the supported date formats
+func DateFormats() []string {
+	return []string{DateISO, DateUS, DateEU, DateUK}
+}
+
+// DecimalSeparators returns the supported decimal separators
+func DecimalSeparators() []string {
+	return []string{".", ","}
+}
+
+// CSVDelimiters returns the supported CSV delimiters
+func CSVDelimiters() []string {
+	return []string{",", ";", "\t"}
+}
+
+// Validate checks every option is supported; empty options use the default
+func (l ExportLocale) Validate() error {
+	if l.DateFormat != "" && !slices.Contains(DateFormats(), l.DateFormat) {
+		return fmt.Errorf("invalid date format: %s", l.DateFormat)
+	}
+	if l.DecimalSeparator != "" && !slices.Contains(DecimalSeparators(), l.DecimalSeparator) {
+		return fmt.Errorf("invalid decimal separator: %q", l.DecimalSeparator)
+	}
+	if l.CSVDelimiter != "" && !slices.Contains(CSVDelimiters(), l.CSVDelimiter) {
+		return fmt.Errorf("invalid CSV delimiter: %q", l.CSVDelimiter)
+	}
+	return nil
+}
+
+// withDefaults fills in empty options
+func (l ExportLocale) withDefaults() ExportLocale {
+	defaults := DefaultExportLocale()
+	if l.DateFormat == "" {
+		l.DateFormat = defaults.DateFormat
+	}
+	if l.DecimalSeparator == "" {
+		l.DecimalSeparator = defaults.DecimalSeparator
+	}
+	if l.CSVDelimiter == "" {
+		l.CSVDelimiter = defaults.CSVDelimiter
+	}
+	return l
+}
+
+// Date formats a date
+func (l ExportLocale) Date(t time.Time) string {
+	return t.Format(dateLayouts[l.withDefaults().DateFormat])
+}
+
+// DateTime formats a date and a time of day, to the second when seconds is set
+func (l ExportLocale) DateTime(t time.Time, seconds bool) string {
+	layout := dateLayouts[l.withDefaults().DateFormat] + " 15:04"
+	if seconds {
+		layout += ":05"
+	}
+	return t.Format(layout)
+}
+
+// Timestamp formats a time for CSV records. ISO dates keep RFC 3339, which
+// spreadsheets and scripts parse without help
+func (l ExportLocale) Timestamp(t time.Time) string {
+	if l.withDefaults().DateFormat == DateISO {
+		return t.Format(time.RFC3339)
+	}
+	return l.DateTime(t, true)
+}
+
+// Number formats a number with the given decimals
+func (l ExportLocale) Number(value float64, decimals int) string {
+	s := strconv.FormatFloat(value, 'f', decimals, 64)
+	return strings.Replace(s, ".", l.withDefaults().DecimalSeparator, 1)
+}
+
+// Percent formats a fraction as a percentage, 0.5 as "50.0%"
+func (l ExportLocale) Percent(fraction float64, decimals int) string {
+	return l.Number(fraction*100, decimals) + "%"
+}
+
+// Delimiter returns the CSV field delimiter
+func (l ExportLocale) Delimiter() rune {
+	r, _ := utf8.DecodeRuneInString(l.withDefaults().CSVDelimiter)
+	return r
+}
@@ -0,0 +1,101 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestExportLocaleFormats(t *testing.T) {
+	when := time.Date(2026, 3, 14, 9, 26, 53, 0, time.UTC)
+
+	tests := []struct {
+		locale  ExportLocale
+		date    string
+		percent string
+		stamp   string
+		delim   rune
+	}{
+		{DefaultExportLocale(), "2026-03-14", "66.7%", "2026-03-14T09:26:53Z", ','},
+		{ExportLocale{}, "2026-03-14", "66.7%", "2026-03-14T09:26:53Z", ','},
+		{ExportLocale{DateFormat: DateUS}, "03/14/2026", "66.7%", "03/14/2026 09:26:53", ','},
+		{ExportLocale{DateFormat: DateEU, DecimalSeparator: ",", CSVDelimiter: ";"}, "14.03.2026", "66,7%", "14.03.2026 09:26:53", ';'},
+		{ExportLocale{DateFormat: DateUK, CSVDelimiter: "\t"}, "14/03/2026", "66.7%", "14/03/2026 09:26:53", '\t'},
+	}
+
+	for _, tt := range tests {
+		if got := tt.locale.Date(when); got != tt.date {
+			t.Errorf("%+v: Date() = %s, want %s", tt.locale, got, tt.date)
+		}
+		if got := tt.locale.Percent(2.0/3, 1); got != tt.percent {
+			t.Errorf("%+v: Percent() = %s, want %s", tt.locale, got, tt.percent)
+		}
+		if got := tt.locale.Timestamp(when); got != tt.stamp {
+			t.Errorf("%+v: Timestamp() = %s, want %s", tt.locale, got, tt.stamp)
+		}
+		if got := tt.locale.Delimiter(); got != tt.delim {
+			t.Errorf("%+v: Delimiter() = %q, want %q", tt.locale, got, tt.delim)
+		}
+	}
+
+	if err := (ExportLocale{DecimalSeparator: "'"}).Validate(); err == nil {
+		t.Error("Expected an unsupported decimal separator to be rejected")
+	}
+}
+
+func TestExportWithLocale(t *testing.T) {
+	dir := t.TempDir()
+	sm := NewStatsManager(filepath.Join(dir, "stats.json"))
+	err := sm.RecordGame(&game.GameResult{
+		Won:            true,
+		Strategy:       game.Switch,
+		InitialChoice:  0,
+		FinalChoice:    1,
+		CarPosition:    1,
+		HostOpenedDoor: 2,
+		GameDuration:   time.Second,
+		Timestamp:      time.Date(2026, 3, 14, 9, 26, 53, 0, time.Local),
+	})
+	if err != nil {
+		t.Fatalf("Failed to record game: %v", err)
+	}
+
+	locale := ExportLocale{DateFormat: DateEU, DecimalSeparator: ",", CSVDelimiter: ";"}
+
+	options := DefaultExportOptions()
+	options.Format = ExportCSV
+	options.Filename = filepath.Join(dir, "export.csv")
+	options.Locale = locale
+	if err := sm.ExportStats(options); err != nil {
+		t.Fatalf("Failed to export CSV: %v", err)
+	}
+	data, _ := os.ReadFile(options.Filename)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if !strings.HasPrefix(lines[0], "Game ID;Timestamp;Strategy;") {
+		t.Errorf("Expected a semicolon-separated header, got %s", lines[0])
+	}
+	if !strings.Contains(lines[1], ";14.03.2026 09:26:53;SWITCH;true;") {
+		t.Errorf("Expected European dates, got %s", lines[1])
+	}
+
+	options.Format = ExportText
+	options.Filename = filepath.Join(dir, "export.txt")
+	if err := sm.ExportStats(options); err != nil {
+		t.Fatalf("Failed to export text: %v", err)
+	}
+	data, _ = os.ReadFile(options.Filename)
+	for _, want := range []string{"Overall Win Rate: 100,0%", "SWITCH Strategy: 66,7% (2/3)", "14.03.2026 09:26 | SWITCH | WIN"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("Expected %q in the text export", want)
+		}
+	}
+
+	options.Locale.CSVDelimiter = "|"
+	if err := sm.ExportStats(options); err == nil {
+		t.Error("Expected an invalid locale to be rejected")
+	}
+}
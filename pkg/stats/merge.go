@@ -0,0 +1,128 @@
+package stats
+
+import (
+	"fmt"
+	"maps"
+	"os"
+)
+
+// MergeResult reports what merging two sets of statistics does
+type MergeResult struct {
+	Added   int // Games from the other statistics that were new here
+	Removed int // Games in the base that one side has deleted since
+	Dropped int // Games counted in totals but kept in neither history
+	Games   int // Games in the merged history
+}
+
+// MergeStats three-way merges the game histories of ours and theirs: games
+// are matched by ID, and games in the base that one side no longer has were
+// deleted there and stay deleted. Without a base, the histories are simply
+// combined. The totals, streaks and daily statistics are recalculated from
+// the merged history rather than trusting either side's, so games counted in
+// totals whose records were trimmed or cleared are dropped, see
+// MergeResult.Dropped
+func MergeStats(base, ours, theirs *GameStats) (*GameStats, MergeResult) {
+	var result MergeResult
+
+	inBase := make(map[string]bool)
+	if base != nil {
+		for _, record := range base.GameHistory {
+			inBase[record.ID] = true
+		}
+	}
+	inOurs := make(map[string]bool, len(ours.GameHistory))
+	for _, record := range ours.GameHistory {
+		inOurs[record.ID] = true
+	}
+	inTheirs := make(map[string]bool, len(theirs.GameHistory))
+	for _, record := range theirs.GameHistory {
+		inTheirs[record.ID] = true
+	}
+
+	var history []GameRecord
+	for _, record := range ours.GameHistory {
+		if inBase[record.ID] && !inTheirs[record.ID] {
+			result.Removed++
+			continue
+		}
+		history = append(history, record)
+	}
+	for _, record := range theirs.GameHistory {
+		switch {
+		case record.ID == "" || inOurs[record.ID]:
+			continue
+		case inBase[record.ID]:
+			result.Removed++
+		default:
+			inOurs[record.ID] = true
+			history = append(history, record)
+			result.Added++
+		}
+	}
+	sortRecords(history)
+
+	for _, stats := range []*GameStats{ours, theirs} {
+		result.Dropped += max(stats.TotalGames-len(stats.GameHistory), 0)
+	}
+
+	collector := NewCollector()
+	collector.stats.Version = ours.Version
+	// Hotseat totals have no games to match, so ours are kept
+	collector.stats.Players = maps.Clone(ours.Players)
+	for _, record := range history {
+		collector.addRecord(record)
+	}
+	result.Games = len(collector.stats.GameHistory)
+	return collector.stats, result
+}
+
+// ReadStatsFile loads the statistics in a stats file, such as one copied from
+// another computer or a backup
+func ReadStatsFile(path string) (*GameStats, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("failed to read stats file: %w", err)
+	}
+	return NewPersistenceManager(path).Load()
+}
+
+// StatsMerge is a merge of a stats file, prepared so it can be previewed
+// before it is applied
+type StatsMerge struct {
+	MergeResult
+	Before *GameStats // The statistics before the merge
+	After  *GameStats // The merged statistics
+}
+
+// PrepareMerge merges the stats file at path into the current statistics
+// without changing them. basePath names the common ancestor of both, such as
+// the file last merged; an empty basePath combines the histories
+func (sm *StatsManager) PrepareMerge(path, basePath string) (*StatsMerge, error) {
+	theirs, err := ReadStatsFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var base *GameStats
+	if basePath != "" {
+		if base, err = ReadStatsFile(basePath); err != nil {
+			return nil, fmt.Errorf("merge base: %w", err)
+		}
+	}
+
+	ours := sm.collector.GetStats()
+	merged, result := MergeStats(base, ours, theirs)
+	return &StatsMerge{MergeResult: result, Before: ours, After: merged}, nil
+}
+
+// ApplyMerge backs up the stats file and replaces the statistics with the
+// merged ones. It returns the backup's path, or "" when there was no file to
+// back up; read-only statistics are only merged for the session
+func (sm *StatsManager) ApplyMerge(merge *StatsMerge) (string, error) {
+	backup, err := sm.backupStatsFile()
+	if err != nil {
+		return "", err
+	}
+
+	sm.useStats(merge.After)
+	return backup, sm.save()
+}
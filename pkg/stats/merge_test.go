@@ -0,0 +1,96 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestMergeStats(t *testing.T) {
+	// Both computers start from the same two games
+	base := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	base.RecordGame(syncTestResult(0, game.Switch, true))
+	base.RecordGame(syncTestResult(1, game.Stay, false))
+	shared := base.GetStats().GameHistory
+
+	ours := NewCollector()
+	theirs := NewCollector()
+	for _, record := range shared {
+		ours.addRecord(record)
+		theirs.addRecord(record)
+	}
+	ours.RecordGame(syncTestResult(2, game.Switch, true))
+	theirs.RecordGame(syncTestResult(3, game.Switch, true))
+
+	// The other computer deleted the lost game, and claims totals it has no records for
+	theirs.stats.GameHistory = withoutRecord(theirs.stats.GameHistory, shared[1].ID)
+	theirs.stats.TotalGames += 5
+
+	merged, result := MergeStats(base.GetStats(), ours.GetStats(), theirs.GetStats())
+	if result.Added != 1 || result.Removed != 1 || result.Games != 3 || result.Dropped != 6 {
+		t.Errorf("Unexpected merge result %+v", result)
+	}
+	if merged.TotalGames != 3 || merged.SwitchStats.Wins != 3 || merged.StayStats.GamesPlayed != 0 {
+		t.Errorf("Expected totals recalculated from the 3 merged games, got %+v", merged)
+	}
+	if merged.StreakStats.CurrentWinStreak != 3 || merged.DailyStats["2026-03-01"].GamesPlayed != 3 {
+		t.Errorf("Expected streaks and daily statistics recalculated, got %+v", merged)
+	}
+	for i := 1; i < len(merged.GameHistory); i++ {
+		if merged.GameHistory[i].Timestamp.Before(merged.GameHistory[i-1].Timestamp) {
+			t.Fatal("Expected the merged history in play order")
+		}
+	}
+
+	// Without a base, deleted games come back: the histories are combined
+	_, result = MergeStats(nil, ours.GetStats(), theirs.GetStats())
+	if result.Removed != 0 || result.Games != 4 {
+		t.Errorf("Expected a plain union without a base, got %+v", result)
+	}
+}
+
+func TestApplyMerge(t *testing.T) {
+	dir := t.TempDir()
+	other := NewStatsManager(filepath.Join(dir, "other.json"))
+	other.RecordGame(syncTestResult(0, game.Switch, true))
+
+	path := filepath.Join(dir, "stats.json")
+	sm := NewStatsManager(path)
+	sm.RecordGame(syncTestResult(1, game.Stay, false))
+
+	merge, err := sm.PrepareMerge(filepath.Join(dir, "other.json"), "")
+	if err != nil {
+		t.Fatalf("PrepareMerge() error = %v", err)
+	}
+	if merge.Added != 1 || sm.GetStats().TotalGames != 1 {
+		t.Fatalf("Preparing should not change the statistics, got %+v", merge.MergeResult)
+	}
+
+	backup, err := sm.ApplyMerge(merge)
+	if err != nil {
+		t.Fatalf("ApplyMerge() error = %v", err)
+	}
+	if got := NewStatsManager(path).GetStats().TotalGames; got != 2 {
+		t.Errorf("Expected 2 saved games after merging, got %d", got)
+	}
+	if _, err := os.Stat(backup); err != nil {
+		t.Errorf("Expected a backup of the statistics before merging: %v", err)
+	}
+
+	if _, err := sm.PrepareMerge(filepath.Join(dir, "missing.json"), ""); err == nil {
+		t.Error("Expected an error merging a missing file")
+	}
+}
+
+// withoutRecord returns the records without the one with the given ID
+func withoutRecord(records []GameRecord, id string) []GameRecord {
+	var kept []GameRecord
+	for _, record := range records {
+		if record.ID != id {
+			kept = append(kept, record)
+		}
+	}
+	return kept
+}
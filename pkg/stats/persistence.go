@@ -14,6 +14,7 @@ import (
 var (
 	ErrNilStats     = errors.New("stats cannot be nil")
 	ErrFileNotFound = errors.New("stats file not found")
+	ErrReadOnly     = errors.New("statistics are read-only")
 )
 
 const (
@@ -23,6 +24,11 @@ const (
 
 type PersistenceManager struct {
 	filePath string
+	damaged  bool      // The stats file could not be parsed, so it must not become the backup
+	recovery *Recovery // Set when Load fell back to the backup
+
+	journaled int  // Games in the journal, folded into the stats file every JournalCompactEvery
+	compact   bool // The journal ends in a torn line, so the next game is saved in full
 }
 
 func NewPersistenceManager(customPath ...string) *PersistenceManager {
@@ -31,13 +37,7 @@ func NewPersistenceManager(customPath ...string) *PersistenceManager {
 	if len(customPath) > 0 && customPath[0] != "" {
 		filePath = customPath[0]
 	} else {
-		homeDir, err := os.UserHomeDir()
-		if err != nil {
-			filePath = DefaultStatsFileName
-		} else {
-			statsDir := filepath.Join(homeDir, DefaultStatsDir)
-			filePath = filepath.Join(statsDir, DefaultStatsFileName)
-		}
+		filePath = filepath.Join(DataDir(""), DefaultStatsFileName)
 	}
 
 	return &PersistenceManager{
@@ -55,26 +55,65 @@ func (pm *PersistenceManager) Save(stats *GameStats) error {
 		return fmt.Errorf("failed to create directory %s: %w", dir, err)
 	}
 
+	stats.Version = CurrentStatsVersion
+
 	data, err := json.MarshalIndent(stats, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal stats: %w", err)
 	}
 
-	if err := os.WriteFile(pm.filePath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write stats file: %w", err)
+	if err := pm.writeFile(data); err != nil {
+		return err
 	}
 
-	return nil
+	// The games in the journal are in the stats file now
+	return pm.clearJournal()
 }
 
+// Load reads the stats file and replays the games journaled since it was
+// saved. When it cannot be read or parsed, the backup of the previous save is
+// loaded instead and Recovery describes what happened
 func (pm *PersistenceManager) Load() (*GameStats, error) {
+	stats, err := pm.loadSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pm.replayJournal(stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// loadSnapshot reads the stats file as last saved, or its backup
+func (pm *PersistenceManager) loadSnapshot() (*GameStats, error) {
 	if !pm.Exists() {
 		return &GameStats{
 			DailyStats: make(map[string]DailyStats),
 		}, nil
 	}
 
-	data, err := os.ReadFile(pm.filePath)
+	stats, err := readStatsFile(pm.filePath)
+	if err == nil {
+		return stats, nil
+	}
+
+	pm.damaged = true
+	backup, backupErr := readStatsFile(pm.BackupPath())
+	if backupErr != nil {
+		return nil, err
+	}
+
+	pm.recovery = &Recovery{Backup: pm.BackupPath(), Cause: err}
+	if info, err := os.Stat(pm.BackupPath()); err == nil {
+		pm.recovery.SavedAt = info.ModTime()
+	}
+	return backup, nil
+}
+
+// readStatsFile reads and upgrades the statistics in a stats file
+func readStatsFile(path string) (*GameStats, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read stats file: %w", err)
 	}
@@ -88,43 +127,109 @@ func (pm *PersistenceManager) Load() (*GameStats, error) {
 		stats.DailyStats = make(map[string]DailyStats)
 	}
 
+	stats.migrate()
+
 	return &stats, nil
 }
 
+// migrate upgrades statistics loaded from an older file format
+func (gs *GameStats) migrate() {
+	if gs.Version < 1 {
+		// Unversioned files recorded doors 1-indexed
+		for i := range gs.GameHistory {
+			record := &gs.GameHistory[i]
+			record.InitialChoice--
+			record.FinalChoice--
+			record.CarPosition--
+			record.HostOpenedDoor--
+		}
+	}
+
+	if len(gs.CarDraws) == 0 {
+		// Files from before the fairness audit start it from their history
+		for _, record := range gs.GameHistory[max(len(gs.GameHistory)-FairnessWindow, 0):] {
+			gs.CarDraws = append(gs.CarDraws, carDrawOf(record))
+		}
+	}
+
+	gs.Version = CurrentStatsVersion
+}
+
+// Validate checks that the aggregate counters are consistent with each other
+func (gs *GameStats) Validate() error {
+	if gs.TotalGames < 0 || gs.TotalWins < 0 || gs.TotalLosses < 0 {
+		return fmt.Errorf("negative game counts (games %d, wins %d, losses %d)", gs.TotalGames, gs.TotalWins, gs.TotalLosses)
+	}
+
+	if gs.TotalWins+gs.TotalLosses != gs.TotalGames {
+		return fmt.Errorf("wins (%d) and losses (%d) do not add up to total games (%d)", gs.TotalWins, gs.TotalLosses, gs.TotalGames)
+	}
+
+	if gs.SwitchStats.GamesPlayed+gs.StayStats.GamesPlayed != gs.TotalGames {
+		return fmt.Errorf("switch (%d) and stay (%d) games do not add up to total games (%d)",
+			gs.SwitchStats.GamesPlayed, gs.StayStats.GamesPlayed, gs.TotalGames)
+	}
+
+	for name, strategy := range map[string]StrategyStats{"switch": gs.SwitchStats, "stay": gs.StayStats} {
+		if strategy.Wins+strategy.Losses != strategy.GamesPlayed {
+			return fmt.Errorf("%s wins (%d) and losses (%d) do not add up to games played (%d)",
+				name, strategy.Wins, strategy.Losses, strategy.GamesPlayed)
+		}
+	}
+
+	if len(gs.GameHistory) > gs.TotalGames {
+		return fmt.Errorf("history has %d games but only %d were counted", len(gs.GameHistory), gs.TotalGames)
+	}
+
+	return nil
+}
+
 func (pm *PersistenceManager) Exists() bool {
 	_, err := os.Stat(pm.filePath)
 	return err == nil
 }
 
 func (pm *PersistenceManager) Delete() error {
-	if !pm.Exists() {
-		return nil
+	if err := os.Remove(pm.filePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete stats file: %w", err)
 	}
 
-	if err := os.Remove(pm.filePath); err != nil {
-		return fmt.Errorf("failed to delete stats file: %w", err)
+	// The backup would bring the deleted statistics back on the next load
+	if err := os.Remove(pm.BackupPath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete stats backup: %w", err)
 	}
 
-	return nil
+	return pm.clearJournal()
 }
 
 func (pm *PersistenceManager) GetFilePath() string {
 	return pm.filePath
 }
 
-func (pm *PersistenceManager) GetFileSize() (int64, error) {
-	if !pm.Exists() {
-		return 0, nil
-	}
+// Location returns the path of the stats file
+func (pm *PersistenceManager) Location() string {
+	return pm.filePath
+}
 
-	info, err := os.Stat(pm.filePath)
-	if err != nil {
-		return 0, fmt.Errorf("failed to get file info: %w", err)
+// GetFileSize returns the size of the stats file and its journal
+func (pm *PersistenceManager) GetFileSize() (int64, error) {
+	var size int64
+	for _, path := range []string{pm.filePath, pm.JournalPath()} {
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to get file info: %w", err)
+		}
+		size += info.Size()
 	}
 
-	return info.Size(), nil
+	return size, nil
 }
 
+// Backup copies the statistics to backupPath, with the games in the journal
+// folded in so the backup is a stats file on its own
 func (pm *PersistenceManager) Backup(backupPath string) error {
 	if !pm.Exists() {
 		return fmt.Errorf("stats file does not exist")
@@ -135,6 +240,16 @@ func (pm *PersistenceManager) Backup(backupPath string) error {
 		return fmt.Errorf("failed to read stats file: %w", err)
 	}
 
+	if _, err := os.Stat(pm.JournalPath()); err == nil {
+		stats, err := pm.Load()
+		if err != nil {
+			return err
+		}
+		if data, err = json.MarshalIndent(stats, "", "  "); err != nil {
+			return fmt.Errorf("failed to marshal stats: %w", err)
+		}
+	}
+
 	backupDir := filepath.Dir(backupPath)
 	if err := os.MkdirAll(backupDir, 0755); err != nil {
 		return fmt.Errorf("failed to create backup directory: %w", err)
@@ -167,21 +282,51 @@ func (pm *PersistenceManager) Restore(backupPath string) error {
 		return fmt.Errorf("failed to create directory: %w", err)
 	}
 
-	if err := os.WriteFile(pm.filePath, data, 0644); err != nil {
+	if err := pm.writeFile(data); err != nil {
 		return fmt.Errorf("failed to restore stats file: %w", err)
 	}
 
-	return nil
+	// Games journaled since the backup are not part of it
+	return pm.clearJournal()
 }
 
+// RecordHook is called after each game is recorded and persisted
+type RecordHook func(record GameRecord, stats *GameStats) error
+
 type StatsManager struct {
-	collector   *Collector
-	persistence *PersistenceManager
+	collector      *Collector
+	persistence    StatsStore
+	goals          *GoalManager
+	archive        *Archive // Games trimmed from the history; nil without a stats file
+	hooks          []RecordHook
+	completedGoals []Goal
+	readOnly       bool // Track games in memory only, never writing to disk
+	recordDraws    bool // Keep each game's random draws, whatever replaces the statistics
 }
 
 func NewStatsManager(customPath ...string) *StatsManager {
-	persistence := NewPersistenceManager(customPath...)
+	return newStatsManager(false, NewPersistenceManager(customPath...))
+}
+
+// NewReadOnlyStatsManager creates a stats manager that loads existing statistics
+// but never writes them; games played are only tracked for the session
+func NewReadOnlyStatsManager(customPath ...string) *StatsManager {
+	return newStatsManager(true, NewPersistenceManager(customPath...))
+}
 
+// NewSessionStatsManager creates a stats manager that starts empty and never
+// reads or writes statistics anywhere; games played last only for the session
+func NewSessionStatsManager() *StatsManager {
+	return newStatsManager(true, NewMemoryStore())
+}
+
+// NewStatsManagerWithStore creates a stats manager that keeps its statistics
+// in the given store
+func NewStatsManagerWithStore(store StatsStore) *StatsManager {
+	return newStatsManager(false, store)
+}
+
+func newStatsManager(readOnly bool, persistence StatsStore) *StatsManager {
 	stats, err := persistence.Load()
 	if err != nil {
 		// Create fresh stats if loading fails
@@ -189,28 +334,150 @@ func NewStatsManager(customPath ...string) *StatsManager {
 			DailyStats: make(map[string]DailyStats),
 		}
 		// Try to save the fresh stats to ensure the file system is writable
-		if saveErr := persistence.Save(stats); saveErr != nil {
-			// If we can't save, at least log the issue (in a real app, you'd use proper logging)
-			// For now, we'll continue with in-memory stats
+		if !readOnly {
+			if saveErr := persistence.Save(stats); saveErr != nil {
+				// If we can't save, at least log the issue (in a real app, you'd use proper logging)
+				// For now, we'll continue with in-memory stats
+			}
 		}
 	}
 
 	collector := &Collector{stats: stats}
 
-	return &StatsManager{
+	// Goals and the archive are kept next to a stats file; other stores keep
+	// goals for the session only and drop trimmed games
+	var goals *GoalManager
+	var archive *Archive
+	if file, ok := persistence.(FileStore); ok {
+		archive = NewArchive(filepath.Join(filepath.Dir(file.GetFilePath()), ArchiveDirName))
+		goals = NewGoalManager(filepath.Join(filepath.Dir(file.GetFilePath()), DefaultGoalsFileName))
+		if err := goals.Load(); err != nil {
+			// Start with no goals rather than failing; the file is rewritten on the next change
+			goals = NewGoalManager(goals.GetFilePath())
+		}
+		goals.readOnly = readOnly
+	} else {
+		goals = NewGoalManager("")
+		goals.readOnly = true
+	}
+
+	sm := &StatsManager{
 		collector:   collector,
 		persistence: persistence,
+		goals:       goals,
+		archive:     archive,
+		readOnly:    readOnly,
 	}
+	sm.AddRecordHook(sm.evaluateGoals)
+
+	return sm
 }
 
 func (sm *StatsManager) RecordGame(result *game.GameResult) error {
-	if err := sm.collector.RecordGame(result); err != nil {
+	return sm.recordGame(result, recordOptions{})
+}
+
+// recordGame records a finished game, saves and runs the record hooks
+func (sm *StatsManager) recordGame(result *game.GameResult, options recordOptions) error {
+	if err := sm.collector.recordGame(result, options); err != nil {
+		return err
+	}
+
+	if err := sm.saveLatest(); err != nil {
 		return err
 	}
 
+	return sm.runRecordHooks()
+}
+
+// save archives the games trimmed from the history and persists the current
+// statistics, unless the manager is read-only
+func (sm *StatsManager) save() error {
+	if sm.readOnly {
+		return nil
+	}
+	if err := sm.archiveTrimmed(); err != nil {
+		return err
+	}
 	return sm.persistence.Save(sm.collector.GetStats())
 }
 
+// saveLatest persists the game just recorded, appending it alone when the
+// store keeps a journal, unless the manager is read-only
+func (sm *StatsManager) saveLatest() error {
+	stats := sm.collector.GetStats()
+	journal, ok := sm.persistence.(JournalStore)
+	if sm.readOnly || !ok || len(stats.GameHistory) == 0 {
+		return sm.save()
+	}
+	if err := sm.archiveTrimmed(); err != nil {
+		return err
+	}
+	return journal.Append(stats.GameHistory[len(stats.GameHistory)-1], stats)
+}
+
+// IsReadOnly returns true if statistics changes are kept in memory only
+func (sm *StatsManager) IsReadOnly() bool {
+	return sm.readOnly
+}
+
+// SetRecordDraws sets whether games are recorded with the random numbers
+// drawn in them, so they can be verified later with GameRecord.VerifyDraws
+func (sm *StatsManager) SetRecordDraws(on bool) {
+	sm.recordDraws = on
+	sm.collector.recordDraws = on
+}
+
+// useStats replaces the statistics games are recorded into
+func (sm *StatsManager) useStats(stats *GameStats) {
+	sm.collector = &Collector{stats: stats, recordDraws: sm.recordDraws}
+}
+
+// AddRecordHook registers a hook that runs after every recorded game
+func (sm *StatsManager) AddRecordHook(hook RecordHook) {
+	sm.hooks = append(sm.hooks, hook)
+}
+
+// runRecordHooks calls every registered hook with the most recent record
+func (sm *StatsManager) runRecordHooks() error {
+	stats := sm.collector.GetStats()
+	if len(stats.GameHistory) == 0 {
+		return nil
+	}
+	record := stats.GameHistory[len(stats.GameHistory)-1]
+
+	var firstErr error
+	for _, hook := range sm.hooks {
+		if err := hook(record, stats); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// evaluateGoals is the built-in record hook that tracks goal completion
+func (sm *StatsManager) evaluateGoals(record GameRecord, stats *GameStats) error {
+	completed, err := sm.goals.Evaluate(stats)
+	sm.completedGoals = append(sm.completedGoals, completed...)
+	if err != nil {
+		return fmt.Errorf("failed to save goals: %w", err)
+	}
+	return nil
+}
+
+// Goals returns the goal manager
+func (sm *StatsManager) Goals() *GoalManager {
+	return sm.goals
+}
+
+// TakeCompletedGoals returns goals completed since the last call and clears the queue
+func (sm *StatsManager) TakeCompletedGoals() []Goal {
+	completed := sm.completedGoals
+	sm.completedGoals = nil
+	return completed
+}
+
 func (sm *StatsManager) GetStats() *GameStats {
 	return sm.collector.GetStats()
 }
@@ -223,21 +490,44 @@ func (sm *StatsManager) GetFilteredGames(filter StatsFilter) []GameRecord {
 	return sm.collector.GetFilteredGames(filter)
 }
 
+// GetFilteredStats recalculates the statistics from the games matching the
+// filter, so the totals, streaks and daily statistics cover only those games
+func (sm *StatsManager) GetFilteredStats(filter StatsFilter) *GameStats {
+	return StatsOf(sm.GetFilteredGames(filter))
+}
+
 func (sm *StatsManager) GetStatsFilePath() string {
-	return sm.persistence.filePath
+	return sm.persistence.Location()
+}
+
+// Store returns the store the statistics are kept in
+func (sm *StatsManager) Store() StatsStore {
+	return sm.persistence
 }
 
 func (sm *StatsManager) Reset() error {
 	sm.collector.Reset()
-	return sm.persistence.Save(sm.collector.GetStats())
+	return sm.save()
 }
 
 func (sm *StatsManager) Backup(backupPath string) error {
-	return sm.persistence.Backup(backupPath)
+	file, ok := sm.persistence.(FileStore)
+	if !ok {
+		return fmt.Errorf("%w: backups", ErrStoreUnsupported)
+	}
+	return file.Backup(backupPath)
 }
 
 func (sm *StatsManager) Restore(backupPath string) error {
-	if err := sm.persistence.Restore(backupPath); err != nil {
+	if sm.readOnly {
+		return ErrReadOnly
+	}
+
+	file, ok := sm.persistence.(FileStore)
+	if !ok {
+		return fmt.Errorf("%w: restoring backups", ErrStoreUnsupported)
+	}
+	if err := file.Restore(backupPath); err != nil {
 		return err
 	}
 
@@ -246,14 +536,32 @@ func (sm *StatsManager) Restore(backupPath string) error {
 		return err
 	}
 
-	sm.collector = &Collector{stats: stats}
+	sm.useStats(stats)
+	return nil
+}
+
+// Reload replaces the in-memory statistics with the contents of the stats file,
+// picking up games recorded by other processes
+func (sm *StatsManager) Reload() error {
+	stats, err := sm.persistence.Load()
+	if err != nil {
+		return err
+	}
+
+	sm.useStats(stats)
 	return nil
 }
 
 func (sm *StatsManager) GetFilePath() string {
-	return sm.persistence.GetFilePath()
+	return sm.persistence.Location()
 }
 
+// GetFileSize returns the size of the stats file, or 0 for stores that don't
+// keep one
 func (sm *StatsManager) GetFileSize() (int64, error) {
-	return sm.persistence.GetFileSize()
+	file, ok := sm.persistence.(FileStore)
+	if !ok {
+		return 0, nil
+	}
+	return file.GetFileSize()
 }
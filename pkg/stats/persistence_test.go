@@ -325,3 +325,97 @@ func TestStatsManagerBackupRestore(t *testing.T) {
 		t.Error("Should have 1 game after restore")
 	}
 }
+
+func TestReadOnlyStatsManager(t *testing.T) {
+	tempFile := filepath.Join(t.TempDir(), "readonly_stats.json")
+
+	sm := NewStatsManager(tempFile)
+	if err := sm.RecordGame(createTestGameResult(game.Switch, true)); err != nil {
+		t.Fatalf("Unexpected error recording game: %v", err)
+	}
+
+	readOnly := NewReadOnlyStatsManager(tempFile)
+	if !readOnly.IsReadOnly() {
+		t.Error("Manager should report read-only mode")
+	}
+
+	if err := readOnly.RecordGame(createTestGameResult(game.Stay, false)); err != nil {
+		t.Fatalf("Unexpected error recording game: %v", err)
+	}
+
+	if readOnly.GetStats().TotalGames != 2 {
+		t.Errorf("Expected 2 games in memory, got %d", readOnly.GetStats().TotalGames)
+	}
+
+	if err := readOnly.Reset(); err != nil {
+		t.Errorf("Unexpected error resetting: %v", err)
+	}
+
+	if err := readOnly.Restore(tempFile); err != ErrReadOnly {
+		t.Errorf("Expected ErrReadOnly from Restore, got %v", err)
+	}
+
+	// The file on disk is untouched
+	reloaded := NewStatsManager(tempFile)
+	if reloaded.GetStats().TotalGames != 1 {
+		t.Errorf("Expected 1 game on disk, got %d", reloaded.GetStats().TotalGames)
+	}
+}
+
+func TestGameStatsValidate(t *testing.T) {
+	collector := NewCollector()
+	collector.RecordGame(createTestGameResult(game.Switch, true))
+	collector.RecordGame(createTestGameResult(game.Stay, false))
+
+	if err := collector.GetStats().Validate(); err != nil {
+		t.Errorf("Recorded stats should be consistent: %v", err)
+	}
+
+	corrupted := *collector.GetStats()
+	corrupted.TotalWins = 5
+	if err := corrupted.Validate(); err == nil {
+		t.Error("Expected error for wins exceeding total games")
+	}
+
+	corrupted = *collector.GetStats()
+	corrupted.SwitchStats.GamesPlayed = 0
+	if err := corrupted.Validate(); err == nil {
+		t.Error("Expected error for strategy games not adding up")
+	}
+}
+
+func TestLoadMigratesOneIndexedDoors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	legacy := `{"total_games": 1, "total_losses": 1, "game_history": [
+		{"id": "a", "won": false, "initial_choice": 1, "final_choice": 1, "car_position": 3, "host_opened_door": 2}
+	]}`
+	if err := os.WriteFile(path, []byte(legacy), 0644); err != nil {
+		t.Fatalf("Failed to write legacy stats: %v", err)
+	}
+
+	pm := NewPersistenceManager(path)
+	stats, err := pm.Load()
+	if err != nil {
+		t.Fatalf("Failed to load legacy stats: %v", err)
+	}
+
+	record := stats.GameHistory[0]
+	if record.InitialChoice != 0 || record.FinalChoice != 0 || record.CarPosition != 2 || record.HostOpenedDoor != 1 {
+		t.Errorf("Expected 0-indexed doors after migration, got %+v", record)
+	}
+	if stats.Version != CurrentStatsVersion {
+		t.Errorf("Expected version %d, got %d", CurrentStatsVersion, stats.Version)
+	}
+
+	// Saved files are current and are not migrated again
+	if err := pm.Save(stats); err != nil {
+		t.Fatalf("Failed to save stats: %v", err)
+	}
+	reloaded, err := pm.Load()
+	if err != nil {
+		t.Fatalf("Failed to reload stats: %v", err)
+	}
+	if reloaded.GameHistory[0].CarPosition != 2 {
+		t.Errorf("Expected car position 2 after reload, got %d", reloaded.GameHistory[0].CarPosition)
+	}
+}
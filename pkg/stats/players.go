@@ -0,0 +1,68 @@
+package stats
+
+import (
+	"sort"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// PlayerStats breaks down the results of one player in hotseat games
+type PlayerStats struct {
+	Name        string        `json:"name"`
+	Games       int           `json:"games"`
+	Wins        int           `json:"wins"`
+	SwitchStats StrategyStats `json:"switch_stats"`
+	StayStats   StrategyStats `json:"stay_stats"`
+}
+
+// add counts one of the player's games
+func (p *PlayerStats) add(record GameRecord) {
+	p.Games++
+	if record.Won {
+		p.Wins++
+	}
+	strategy := &p.StayStats
+	if record.Strategy == game.Switch {
+		strategy = &p.SwitchStats
+	}
+	strategy.add(record.Won)
+}
+
+// WinRate returns the share of the player's games won
+func (p PlayerStats) WinRate() float64 {
+	if p.Games == 0 {
+		return 0
+	}
+	return float64(p.Wins) / float64(p.Games)
+}
+
+// StatsByPlayer lists the hotseat players' totals, the player with the most
+// games first
+func StatsByPlayer(stats *GameStats) []PlayerStats {
+	breakdown := make([]PlayerStats, 0, len(stats.Players))
+	for _, entry := range stats.Players {
+		breakdown = append(breakdown, entry)
+	}
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Games != breakdown[j].Games {
+			return breakdown[i].Games > breakdown[j].Games
+		}
+		return breakdown[i].Name < breakdown[j].Name
+	})
+	return breakdown
+}
+
+// StatsByPlayer lists the totals of the players who took turns in hotseat mode
+func (sm *StatsManager) StatsByPlayer() []PlayerStats {
+	return StatsByPlayer(sm.GetStats())
+}
+
+// RecordPlayerGame records a game played by one of the players taking turns in
+// hotseat mode. It counts towards that player's totals only: the history,
+// totals, streaks, goals and achievements stay the profile owner's
+func (sm *StatsManager) RecordPlayerGame(result *game.GameResult, player string) error {
+	if err := sm.collector.recordGame(result, recordOptions{player: player}); err != nil {
+		return err
+	}
+	return sm.save()
+}
@@ -0,0 +1,69 @@
+package stats
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestStatsByPlayer(t *testing.T) {
+	history := []GameRecord{
+		{Strategy: game.Switch, Won: true},
+		{Strategy: game.Switch, Won: true, Player: "bob"},
+		{Strategy: game.Stay, Won: false, Player: "alice"},
+		{Strategy: game.Switch, Won: true, Player: "alice"},
+		{Strategy: game.Switch, Won: false, Player: "alice"},
+	}
+
+	stats := StatsOf(history)
+	if stats.TotalGames != 1 || len(stats.GameHistory) != 1 {
+		t.Errorf("Expected only the game without a player in the profile's totals, got %d games", stats.TotalGames)
+	}
+	byPlayer := StatsByPlayer(stats)
+	if len(byPlayer) != 2 {
+		t.Fatalf("Expected 2 players, got %d", len(byPlayer))
+	}
+
+	alice, bob := byPlayer[0], byPlayer[1]
+	if alice.Name != "alice" || bob.Name != "bob" {
+		t.Fatalf("Expected the player with the most games first, got %q and %q", alice.Name, bob.Name)
+	}
+	if alice.Games != 3 || alice.Wins != 1 || alice.SwitchStats.GamesPlayed != 2 || alice.StayStats.Losses != 1 {
+		t.Errorf("Unexpected stats for alice: %+v", alice)
+	}
+	if bob.WinRate() != 1 {
+		t.Errorf("Expected bob to have won every game, got %.2f", bob.WinRate())
+	}
+}
+
+func TestRecordPlayerGame(t *testing.T) {
+	sm := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+
+	result := &game.GameResult{Strategy: game.Switch, Won: true, InitialChoice: 1, FinalChoice: 2, CarPosition: 2, HostOpenedDoor: 3, Timestamp: time.Now()}
+	if err := sm.RecordPlayerGame(result, "alice"); err != nil {
+		t.Fatal(err)
+	}
+	if err := sm.RecordGame(result); err != nil {
+		t.Fatal(err)
+	}
+
+	// The hotseat game is alice's, not the profile owner's
+	stats := sm.GetStats()
+	if len(stats.GameHistory) != 1 || stats.GameHistory[0].Player != "" {
+		t.Errorf("Expected only the owner's game in the history, got %+v", stats.GameHistory)
+	}
+	if stats.TotalGames != 1 || stats.StreakStats.CurrentWinStreak != 1 {
+		t.Errorf("Expected hotseat games left out of the totals and streaks, got %d games", stats.TotalGames)
+	}
+	if byPlayer := sm.StatsByPlayer(); len(byPlayer) != 1 || byPlayer[0].Games != 1 {
+		t.Errorf("Expected one game by alice, got %+v", byPlayer)
+	}
+
+	// Players' totals are saved, however long ago they played
+	reloaded := NewStatsManager(sm.GetStatsFilePath())
+	if byPlayer := reloaded.StatsByPlayer(); len(byPlayer) != 1 || byPlayer[0].Name != "alice" || byPlayer[0].Wins != 1 {
+		t.Errorf("Expected alice's totals after reload, got %+v", byPlayer)
+	}
+}
@@ -0,0 +1,125 @@
+package stats
+
+import (
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+const (
+	LosingStreakLength = 3   // Consecutive losses that make up a losing streak
+	MinWeaknessSamples = 5   // Games after losing streaks needed before judging behavior
+	WeakSwitchRate     = 0.5 // Switching less than this after losing streaks is a weakness
+)
+
+// SwitchRate counts how often the player switched in a set of games
+type SwitchRate struct {
+	Switched int
+	Games    int
+}
+
+// Rate returns the fraction of games where the player switched
+func (sr SwitchRate) Rate() float64 {
+	if sr.Games == 0 {
+		return 0
+	}
+	return float64(sr.Switched) / float64(sr.Games)
+}
+
+// add counts one game
+func (sr *SwitchRate) add(record GameRecord) {
+	sr.Games++
+	if record.Strategy == game.Switch {
+		sr.Switched++
+	}
+}
+
+// Weakness describes a habit of not switching after losing streaks
+type Weakness struct {
+	AfterLosses SwitchRate // Games played right after a losing streak
+	Overall     SwitchRate // All regular games
+}
+
+// PracticeComparison measures switching after losing streaks before and after practicing
+type PracticeComparison struct {
+	Before   SwitchRate // Regular games after losing streaks, before the first practice game
+	Practice SwitchRate // Practice games
+	After    SwitchRate // Regular games after losing streaks, after the first practice game
+}
+
+// Improvement returns the change in switch rate after losing streaks since practicing
+func (pc PracticeComparison) Improvement() float64 {
+	return pc.After.Rate() - pc.Before.Rate()
+}
+
+// DetectWeakness reports whether the player tends to stay after losing streaks
+func DetectWeakness(history []GameRecord) (Weakness, bool) {
+	var weakness Weakness
+
+	losses := 0
+	for _, record := range history {
+		if record.Practice {
+			continue
+		}
+
+		weakness.Overall.add(record)
+		if losses >= LosingStreakLength {
+			weakness.AfterLosses.add(record)
+		}
+
+		if record.Won {
+			losses = 0
+		} else {
+			losses++
+		}
+	}
+
+	found := weakness.AfterLosses.Games >= MinWeaknessSamples && weakness.AfterLosses.Rate() < WeakSwitchRate
+	return weakness, found
+}
+
+// ComparePractice compares behavior after losing streaks before and after the
+// first practice game; it returns false if nothing has been practiced yet
+func ComparePractice(history []GameRecord) (PracticeComparison, bool) {
+	var comparison PracticeComparison
+
+	practiced := false
+	losses := 0
+	for _, record := range history {
+		if record.Practice {
+			practiced = true
+			comparison.Practice.add(record)
+			continue
+		}
+
+		if losses >= LosingStreakLength {
+			if practiced {
+				comparison.After.add(record)
+			} else {
+				comparison.Before.add(record)
+			}
+		}
+
+		if record.Won {
+			losses = 0
+		} else {
+			losses++
+		}
+	}
+
+	return comparison, practiced
+}
+
+// RecordPracticeGame records a game played in practice mode; it counts towards
+// all statistics but is marked so practice can be compared with regular play
+func (sm *StatsManager) RecordPracticeGame(result *game.GameResult) error {
+	return sm.recordGame(result, recordOptions{practice: true})
+}
+
+// Weakness returns the player's habit of staying after losing streaks, if any
+func (sm *StatsManager) Weakness() (Weakness, bool) {
+	return DetectWeakness(sm.GetStats().GameHistory)
+}
+
+// PracticeComparison returns the before/after practice comparison, if any
+func (sm *StatsManager) PracticeComparison() (PracticeComparison, bool) {
+	return ComparePractice(sm.GetStats().GameHistory)
+}
@@ -0,0 +1,80 @@
+package stats
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// streakHistory builds a history of losing streaks each followed by one game
+// played with the given strategy
+func streakHistory(streaks int, after game.PlayerStrategy, practice bool) []GameRecord {
+	var history []GameRecord
+	for i := 0; i < streaks; i++ {
+		for j := 0; j < LosingStreakLength; j++ {
+			history = append(history, GameRecord{Strategy: game.Switch, Won: false})
+		}
+		history = append(history, GameRecord{Strategy: after, Won: true, Practice: practice})
+	}
+	return history
+}
+
+func TestDetectWeakness(t *testing.T) {
+	weakness, found := DetectWeakness(streakHistory(MinWeaknessSamples, game.Stay, false))
+	if !found {
+		t.Fatal("Expected staying after every losing streak to be a weakness")
+	}
+	if weakness.AfterLosses.Games != MinWeaknessSamples || weakness.AfterLosses.Switched != 0 {
+		t.Errorf("Unexpected games after losses: %+v", weakness.AfterLosses)
+	}
+
+	if _, found := DetectWeakness(streakHistory(MinWeaknessSamples, game.Switch, false)); found {
+		t.Error("Switching after losing streaks is not a weakness")
+	}
+
+	if _, found := DetectWeakness(streakHistory(MinWeaknessSamples-1, game.Stay, false)); found {
+		t.Error("Too few samples should not be reported as a weakness")
+	}
+}
+
+func TestComparePractice(t *testing.T) {
+	if _, practiced := ComparePractice(streakHistory(3, game.Stay, false)); practiced {
+		t.Error("Expected no comparison without practice games")
+	}
+
+	history := streakHistory(2, game.Stay, false)
+	history = append(history, GameRecord{Strategy: game.Switch, Practice: true})
+	history = append(history, streakHistory(2, game.Switch, false)...)
+
+	comparison, practiced := ComparePractice(history)
+	if !practiced {
+		t.Fatal("Expected a comparison after practicing")
+	}
+	if comparison.Before.Rate() != 0 || comparison.After.Rate() != 1 {
+		t.Errorf("Expected 0%% before and 100%% after, got %+v", comparison)
+	}
+	if comparison.Practice.Games != 1 {
+		t.Errorf("Expected 1 practice game, got %d", comparison.Practice.Games)
+	}
+	if comparison.Improvement() != 1 {
+		t.Errorf("Expected an improvement of 1, got %f", comparison.Improvement())
+	}
+}
+
+func TestRecordPracticeGame(t *testing.T) {
+	sm := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+
+	if err := sm.RecordPracticeGame(&game.GameResult{Strategy: game.Switch, Timestamp: time.Now()}); err != nil {
+		t.Fatalf("Failed to record practice game: %v", err)
+	}
+
+	stats := sm.GetStats()
+	if stats.TotalGames != 1 {
+		t.Errorf("Practice games should count towards totals, got %d games", stats.TotalGames)
+	}
+	if !stats.GameHistory[0].Practice {
+		t.Error("Expected the record to be marked as practice")
+	}
+}
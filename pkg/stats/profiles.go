@@ -0,0 +1,142 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+)
+
+const (
+	// DefaultProfile is the player profile kept in the stats file itself,
+	// used until another profile is chosen
+	DefaultProfile = "default"
+	// ProfilesDirName is the folder beside the stats file holding a folder
+	// for each of the other player profiles
+	ProfilesDirName = "monty_hall_profiles"
+	// ActiveProfileFileName is the file beside the stats file naming the
+	// profile to load at launch
+	ActiveProfileFileName = "monty_hall_active_profile"
+)
+
+// profileNamePattern keeps profile names safe to use as folder names on every
+// platform, including case-insensitive ones
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// ValidateProfileName checks that a player profile name can be used as a folder name
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use up to 32 lowercase letters, digits, - and _", name)
+	}
+	return nil
+}
+
+// ProfileManager keeps separate statistics for each player on one machine.
+// The default profile is the stats file itself; every other profile has a
+// folder beside it, so the goals, achievements and other files kept beside a
+// stats file are separate for each player too
+type ProfileManager struct {
+	statsPath string
+}
+
+// NewProfileManager creates a profile manager for the profiles kept beside a
+// stats file
+func NewProfileManager(statsPath string) *ProfileManager {
+	return &ProfileManager{statsPath: statsPath}
+}
+
+// dir returns the folder the stats file and profiles are kept in
+func (pm *ProfileManager) dir() string {
+	return filepath.Dir(pm.statsPath)
+}
+
+// Path returns the stats file of a profile
+func (pm *ProfileManager) Path(name string) string {
+	if name == DefaultProfile {
+		return pm.statsPath
+	}
+	return filepath.Join(pm.dir(), ProfilesDirName, name, DefaultStatsFileName)
+}
+
+// Profiles returns the default profile followed by the other profiles in
+// alphabetical order
+func (pm *ProfileManager) Profiles() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(pm.dir(), ProfilesDirName))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to list profiles: %w", err)
+	}
+
+	profiles := []string{DefaultProfile}
+	for _, entry := range entries {
+		if name := entry.Name(); entry.IsDir() && name != DefaultProfile && ValidateProfileName(name) == nil {
+			profiles = append(profiles, name)
+		}
+	}
+	slices.Sort(profiles[1:])
+	return profiles, nil
+}
+
+// Exists reports whether a profile has been created
+func (pm *ProfileManager) Exists(name string) bool {
+	if name == DefaultProfile {
+		return true
+	}
+	info, err := os.Stat(filepath.Dir(pm.Path(name)))
+	return err == nil && info.IsDir()
+}
+
+// Create adds a profile with no games played
+func (pm *ProfileManager) Create(name string) error {
+	if err := ValidateProfileName(name); err != nil {
+		return err
+	}
+	if pm.Exists(name) {
+		return fmt.Errorf("profile %q already exists", name)
+	}
+	if err := os.MkdirAll(filepath.Dir(pm.Path(name)), 0755); err != nil {
+		return fmt.Errorf("failed to create profile %q: %w", name, err)
+	}
+	return nil
+}
+
+// Active returns the profile named by the active profile file. It falls back
+// to the default profile when the file is missing or names a profile that no
+// longer exists
+func (pm *ProfileManager) Active() string {
+	data, err := chaos.ReadConfig(filepath.Join(pm.dir(), ActiveProfileFileName))
+	if err != nil {
+		return DefaultProfile
+	}
+
+	name := strings.TrimSpace(string(data))
+	if ValidateProfileName(name) != nil || !pm.Exists(name) {
+		return DefaultProfile
+	}
+	return name
+}
+
+// SetActive makes a profile the one loaded at the next launch
+func (pm *ProfileManager) SetActive(name string) error {
+	if !pm.Exists(name) {
+		return fmt.Errorf("profile %q does not exist", name)
+	}
+	if err := os.MkdirAll(pm.dir(), 0755); err != nil {
+		return fmt.Errorf("failed to create stats directory: %w", err)
+	}
+	if err := chaos.WriteFile(filepath.Join(pm.dir(), ActiveProfileFileName), []byte(name+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to save active profile: %w", err)
+	}
+	return nil
+}
+
+// Open creates a stats manager for a profile's statistics
+func (pm *ProfileManager) Open(name string) (*StatsManager, error) {
+	if !pm.Exists(name) {
+		return nil, fmt.Errorf("profile %q does not exist", name)
+	}
+	return NewStatsManager(pm.Path(name)), nil
+}
@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestProfileManager(t *testing.T) {
+	dir := t.TempDir()
+	statsPath := filepath.Join(dir, DefaultStatsFileName)
+	profiles := NewProfileManager(statsPath)
+
+	if names, err := profiles.Profiles(); err != nil || !slices.Equal(names, []string{DefaultProfile}) {
+		t.Fatalf("Expected only the default profile at first, got %v (%v)", names, err)
+	}
+	if profiles.Active() != DefaultProfile || profiles.Path(DefaultProfile) != statsPath {
+		t.Errorf("Expected the default profile in the stats file, got %s in %s", profiles.Active(), profiles.Path(DefaultProfile))
+	}
+
+	for _, name := range []string{"sam", "alex"} {
+		if err := profiles.Create(name); err != nil {
+			t.Fatalf("Failed to create profile %s: %v", name, err)
+		}
+	}
+	if err := profiles.Create("sam"); err == nil {
+		t.Error("Expected creating an existing profile to fail")
+	}
+	if err := profiles.Create("Sam Smith"); err == nil {
+		t.Error("Expected a name that isn't safe as a folder name to be rejected")
+	}
+	if names, _ := profiles.Profiles(); !slices.Equal(names, []string{DefaultProfile, "alex", "sam"}) {
+		t.Errorf("Expected the default profile first and the rest in order, got %v", names)
+	}
+
+	// Each profile keeps its own games
+	sam, err := profiles.Open("sam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(0)
+	g.StayWithChoice()
+	if err := sam.RecordGame(g.Result); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(profiles.Path("sam")); err != nil {
+		t.Errorf("Expected sam's games in their own stats file: %v", err)
+	}
+	if reopened, _ := profiles.Open("sam"); reopened.GetStats().TotalGames != 1 {
+		t.Error("Expected sam's games to be saved")
+	}
+	if alex, _ := profiles.Open("alex"); alex.GetStats().TotalGames != 0 {
+		t.Error("Expected alex to start with no games")
+	}
+	if _, err := profiles.Open("nobody"); err == nil {
+		t.Error("Expected opening a missing profile to fail")
+	}
+
+	if err := profiles.SetActive("sam"); err != nil {
+		t.Fatal(err)
+	}
+	if profiles.Active() != "sam" {
+		t.Errorf("Expected sam to be active, got %s", profiles.Active())
+	}
+
+	// A profile removed by hand falls back to the default
+	if err := os.RemoveAll(filepath.Dir(profiles.Path("sam"))); err != nil {
+		t.Fatal(err)
+	}
+	if profiles.Active() != DefaultProfile {
+		t.Errorf("Expected the default profile once sam's is gone, got %s", profiles.Active())
+	}
+}
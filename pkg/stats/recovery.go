@@ -0,0 +1,82 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+)
+
+// File name suffixes of the stats file's companions
+const (
+	BackupSuffix  = ".bak"     // The previous save, loaded if the stats file is damaged
+	DamagedSuffix = ".corrupt" // A stats file that could not be parsed, kept for inspection
+	tempSuffix    = ".tmp"     // A save being written
+)
+
+// Recovery describes statistics loaded from the backup because the stats
+// file could not be read
+type Recovery struct {
+	Backup  string    // The backup the statistics were loaded from
+	SavedAt time.Time // When the backup was written
+	Cause   error     // Why the stats file could not be read
+}
+
+// BackupPath returns the backup of the previous save, beside the stats file
+func (pm *PersistenceManager) BackupPath() string {
+	return pm.filePath + BackupSuffix
+}
+
+// Recovery returns how the statistics were recovered by Load, or nil if the
+// stats file was read as it is
+func (pm *PersistenceManager) Recovery() *Recovery {
+	return pm.recovery
+}
+
+// writeFile replaces the stats file with data without ever leaving it half
+// written: data goes to a temporary file that is then renamed over the stats
+// file, after the previous version is copied to the backup the same way. A
+// stats file that could not be parsed is kept aside instead, so it never
+// replaces a good backup
+func (pm *PersistenceManager) writeFile(data []byte) error {
+	if previous, err := os.ReadFile(pm.filePath); err == nil {
+		if pm.damaged {
+			if err := os.Rename(pm.filePath, pm.filePath+DamagedSuffix); err != nil {
+				return fmt.Errorf("failed to keep damaged stats file: %w", err)
+			}
+		} else if err := replaceFile(pm.BackupPath(), previous); err != nil {
+			return fmt.Errorf("failed to back up stats file: %w", err)
+		}
+	}
+	pm.damaged = false
+
+	if err := replaceFile(pm.filePath, data); err != nil {
+		return fmt.Errorf("failed to write stats file: %w", err)
+	}
+	return nil
+}
+
+// replaceFile writes data to a temporary file beside path and renames it over
+// path, so path holds either its old or its new contents, never a mix
+func replaceFile(path string, data []byte) error {
+	tempPath := path + tempSuffix
+	if err := chaos.WriteFile(tempPath, data, 0644); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return err
+	}
+	return nil
+}
+
+// Recovery returns how the statistics were recovered from a backup when they
+// were loaded, or nil if they loaded as saved or the store keeps no backups
+func (sm *StatsManager) Recovery() *Recovery {
+	if store, ok := sm.persistence.(interface{ Recovery() *Recovery }); ok {
+		return store.Recovery()
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestStatsWritesKeepBackup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultStatsFileName)
+	pm := NewPersistenceManager(path)
+
+	for games := 1; games <= 2; games++ {
+		if err := pm.Save(&GameStats{TotalGames: games, TotalWins: games}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := os.Stat(path + tempSuffix); !os.IsNotExist(err) {
+		t.Error("Expected no temporary file left behind")
+	}
+	backup, err := readStatsFile(pm.BackupPath())
+	if err != nil || backup.TotalGames != 1 {
+		t.Fatalf("Expected the previous save as the backup, got %+v, %v", backup, err)
+	}
+
+	if err := pm.Delete(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(pm.BackupPath()); !os.IsNotExist(err) {
+		t.Error("Expected deleting the statistics to remove the backup too")
+	}
+}
+
+func TestStatsRecoverFromBackup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultStatsFileName)
+	sm := NewStatsManager(path)
+	result := &game.GameResult{Strategy: game.Switch, Won: true, NumDoors: 3, InitialChoice: 0, FinalChoice: 1, CarPosition: 1, HostOpenedDoor: 2, Timestamp: time.Now()}
+	for range 2 {
+		if err := sm.RecordGame(result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Fold the journaled game into the stats file, keeping the first as the backup
+	if err := sm.save(); err != nil {
+		t.Fatal(err)
+	}
+	if sm.Recovery() != nil {
+		t.Error("Expected no recovery for statistics loaded as saved")
+	}
+
+	// A crash mid-write leaves the stats file truncated
+	if err := os.WriteFile(path, []byte(`{"total_games": 2, "game_hist`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sm = NewStatsManager(path)
+	recovery := sm.Recovery()
+	if recovery == nil || recovery.Backup != path+BackupSuffix {
+		t.Fatalf("Expected recovery from the backup of a damaged file, got %+v", recovery)
+	}
+	if games := sm.GetStats().TotalGames; games != 1 {
+		t.Errorf("Expected the game saved before the last, got %d games", games)
+	}
+
+	// The next save keeps the damaged file aside rather than as the backup
+	if err := sm.RecordGame(result); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path + DamagedSuffix); err != nil {
+		t.Errorf("Expected the damaged file kept for inspection: %v", err)
+	}
+	if backup, err := readStatsFile(path + BackupSuffix); err != nil || backup.TotalGames != 1 {
+		t.Errorf("Expected the good backup kept, got %+v, %v", backup, err)
+	}
+	if saved, err := readStatsFile(path); err != nil || saved.TotalGames != 2 {
+		t.Errorf("Expected the recovered statistics saved with the new game, got %+v, %v", saved, err)
+	}
+}
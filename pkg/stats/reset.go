@@ -0,0 +1,145 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// ResetBackupsKept is how many backups of the stats file resets and merges
+// keep; older ones are removed
+const ResetBackupsKept = 5
+
+// ResetScope is the part of the statistics a reset clears
+type ResetScope int
+
+const (
+	// ResetAll clears everything, as if no games had been played
+	ResetAll ResetScope = iota
+	// ResetHistory clears the individual games but keeps the totals,
+	// streaks and daily statistics, like starting a new season
+	ResetHistory
+	// ResetStreaks clears the current and longest streaks
+	ResetStreaks
+	// ResetDaily clears the statistics kept per day
+	ResetDaily
+)
+
+// ResetScopes returns every scope in the order they are offered
+func ResetScopes() []ResetScope {
+	return []ResetScope{ResetAll, ResetHistory, ResetStreaks, ResetDaily}
+}
+
+// String returns the name shown to players
+func (s ResetScope) String() string {
+	switch s {
+	case ResetAll:
+		return "Everything"
+	case ResetHistory:
+		return "Game history"
+	case ResetStreaks:
+		return "Streaks"
+	case ResetDaily:
+		return "Daily statistics"
+	default:
+		return "Unknown"
+	}
+}
+
+// Description explains what a reset of the scope clears and keeps
+func (s ResetScope) Description() string {
+	switch s {
+	case ResetAll:
+		return "Delete all games, totals, streaks and daily statistics"
+	case ResetHistory:
+		return "Delete the individual games but keep totals, streaks and daily statistics"
+	case ResetStreaks:
+		return "Set the current and longest streaks back to zero"
+	case ResetDaily:
+		return "Delete the statistics kept for each day"
+	default:
+		return ""
+	}
+}
+
+// reset clears the scope from the statistics
+func (c *Collector) reset(scope ResetScope) error {
+	switch scope {
+	case ResetAll:
+		c.Reset()
+	case ResetHistory:
+		// The totals were added up as games were recorded, so they stand on their own
+		c.stats.GameHistory = nil
+	case ResetStreaks:
+		c.stats.StreakStats = StreakStats{}
+	case ResetDaily:
+		c.stats.DailyStats = make(map[string]DailyStats)
+	default:
+		return fmt.Errorf("unknown reset scope: %d", scope)
+	}
+	return nil
+}
+
+// ResetWithBackup backs up the stats file, clears the scope and saves. It
+// returns the backup's path, or "" when there was no file to back up; read-only
+// statistics are only cleared for the session
+func (sm *StatsManager) ResetWithBackup(scope ResetScope) (string, error) {
+	backup, err := sm.backupStatsFile()
+	if err != nil {
+		return "", err
+	}
+
+	if err := sm.collector.reset(scope); err != nil {
+		return backup, err
+	}
+	return backup, sm.save()
+}
+
+// backupStatsFile copies the stats file beside itself before a change that
+// can't be undone, keeping the newest ResetBackupsKept backups. It returns
+// the backup's path, or "" for read-only statistics, stores without a file
+// and a file not written yet
+func (sm *StatsManager) backupStatsFile() (string, error) {
+	file, ok := sm.persistence.(FileStore)
+	if !ok || sm.readOnly {
+		return "", nil
+	}
+	if !fileExists(file.GetFilePath()) {
+		return "", nil
+	}
+
+	backup := file.GetFilePath() + ".backup." + time.Now().Format("2006-01-02_15-04-05")
+	for n := 2; fileExists(backup); n++ {
+		// Keep an earlier backup taken in the same second
+		backup = fmt.Sprintf("%s.backup.%s-%d", file.GetFilePath(), time.Now().Format("2006-01-02_15-04-05"), n)
+	}
+	if err := file.Backup(backup); err != nil {
+		return "", err
+	}
+	return backup, removeOldResetBackups(file.GetFilePath(), ResetBackupsKept)
+}
+
+// fileExists reports whether there is a file at path
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// removeOldResetBackups removes all but the newest keep backups of the file
+func removeOldResetBackups(path string, keep int) error {
+	backups, err := filepath.Glob(path + ".backup.*")
+	if err != nil {
+		return err
+	}
+
+	// Timestamps in the names sort chronologically
+	slices.Sort(backups)
+	for _, backup := range backups[:max(len(backups)-keep, 0)] {
+		if err := os.Remove(backup); err != nil {
+			return fmt.Errorf("failed to remove old backup: %w", err)
+		}
+	}
+	return nil
+}
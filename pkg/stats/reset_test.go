@@ -0,0 +1,121 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestResetWithBackup(t *testing.T) {
+	tests := []struct {
+		scope ResetScope
+		check func(t *testing.T, stats *GameStats)
+	}{
+		{ResetAll, func(t *testing.T, stats *GameStats) {
+			if stats.TotalGames != 0 || len(stats.GameHistory) != 0 || len(stats.DailyStats) != 0 || stats.StreakStats.LongestWinStreak != 0 {
+				t.Errorf("Expected everything cleared, got %+v", stats)
+			}
+		}},
+		{ResetHistory, func(t *testing.T, stats *GameStats) {
+			if len(stats.GameHistory) != 0 {
+				t.Errorf("Expected the history cleared, got %d games", len(stats.GameHistory))
+			}
+			if stats.TotalGames != 3 || stats.SwitchStats.Wins != 2 || len(stats.DailyStats) != 1 || stats.StreakStats.LongestWinStreak != 2 {
+				t.Errorf("Expected the totals, daily statistics and streaks kept, got %+v", stats)
+			}
+		}},
+		{ResetStreaks, func(t *testing.T, stats *GameStats) {
+			if stats.StreakStats != (StreakStats{}) {
+				t.Errorf("Expected the streaks cleared, got %+v", stats.StreakStats)
+			}
+			if stats.TotalGames != 3 || len(stats.GameHistory) != 3 {
+				t.Errorf("Expected the games kept, got %+v", stats)
+			}
+		}},
+		{ResetDaily, func(t *testing.T, stats *GameStats) {
+			if len(stats.DailyStats) != 0 || stats.DailyStats == nil {
+				t.Errorf("Expected empty daily statistics, got %v", stats.DailyStats)
+			}
+			if stats.TotalGames != 3 || len(stats.GameHistory) != 3 {
+				t.Errorf("Expected the games kept, got %+v", stats)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scope.String(), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "stats.json")
+			sm := NewStatsManager(path)
+			for i, won := range []bool{true, true, false} {
+				err := sm.RecordGame(&game.GameResult{
+					Won:           won,
+					Strategy:      game.Switch,
+					InitialChoice: 1,
+					FinalChoice:   2,
+					CarPosition:   2,
+					Timestamp:     time.Date(2026, 3, 14, 9, i, 0, 0, time.UTC),
+				})
+				if err != nil {
+					t.Fatalf("Failed to record game: %v", err)
+				}
+			}
+
+			backup, err := sm.ResetWithBackup(tt.scope)
+			if err != nil {
+				t.Fatalf("ResetWithBackup() error = %v", err)
+			}
+			tt.check(t, sm.GetStats())
+			tt.check(t, NewStatsManager(path).GetStats())
+
+			// The backup holds the statistics from before the reset
+			if backup == "" {
+				t.Fatal("Expected a backup")
+			}
+			if got := NewStatsManager(backup).GetStats().TotalGames; got != 3 {
+				t.Errorf("Expected 3 games in the backup, got %d", got)
+			}
+		})
+	}
+}
+
+func TestResetBackupsRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+	for i := range ResetBackupsKept + 2 {
+		name := fmt.Sprintf("%s.backup.2026-01-0%d_12-00-00", path, i+1)
+		if err := os.WriteFile(name, []byte("{}"), 0644); err != nil {
+			t.Fatalf("Failed to write backup: %v", err)
+		}
+	}
+
+	if err := removeOldResetBackups(path, ResetBackupsKept); err != nil {
+		t.Fatalf("removeOldResetBackups() error = %v", err)
+	}
+	backups, _ := filepath.Glob(path + ".backup.*")
+	if len(backups) != ResetBackupsKept {
+		t.Fatalf("Expected %d backups kept, got %v", ResetBackupsKept, backups)
+	}
+	if _, err := os.Stat(path + ".backup.2026-01-01_12-00-00"); err == nil {
+		t.Error("Expected the oldest backup removed")
+	}
+}
+
+func TestResetBackupsKeepEarlierOnes(t *testing.T) {
+	sm := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	sm.RecordGame(&game.GameResult{Won: true, Strategy: game.Switch, InitialChoice: 1, FinalChoice: 2, CarPosition: 2})
+
+	first, err := sm.ResetWithBackup(ResetStreaks)
+	if err != nil {
+		t.Fatalf("ResetWithBackup() error = %v", err)
+	}
+	second, err := sm.ResetWithBackup(ResetAll)
+	if err != nil {
+		t.Fatalf("ResetWithBackup() error = %v", err)
+	}
+	if first == second {
+		t.Errorf("Expected a new backup for each reset, got %s twice", first)
+	}
+}
@@ -0,0 +1,29 @@
+package stats
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownSchema is returned when asking for a schema that does not exist
+var ErrUnknownSchema = errors.New("unknown schema")
+
+//go:embed schemas/*.schema.json
+var schemaFiles embed.FS
+
+// SchemaNames returns the names of the published JSON schemas
+func SchemaNames() []string {
+	return []string{"stats", "export"}
+}
+
+// Schema returns the JSON Schema document for the stats file ("stats") or the
+// JSON export format ("export")
+func Schema(name string) ([]byte, error) {
+	for _, known := range SchemaNames() {
+		if name == known {
+			return schemaFiles.ReadFile("schemas/" + name + ".schema.json")
+		}
+	}
+	return nil, fmt.Errorf("%w: %q (available: stats, export)", ErrUnknownSchema, name)
+}
@@ -0,0 +1,146 @@
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// schemaDocs holds the parsed schemas so cross-document references resolve
+type schemaDocs map[string]map[string]interface{}
+
+// resolve follows a "$ref" such as "#/$defs/door" or "stats.schema.json#/$defs/door"
+func (docs schemaDocs) resolve(t *testing.T, current, ref string) (string, map[string]interface{}) {
+	t.Helper()
+
+	doc, pointer, _ := strings.Cut(ref, "#")
+	if doc == "" {
+		doc = current
+	} else {
+		doc = strings.TrimSuffix(doc, ".schema.json")
+	}
+
+	node := docs[doc]
+	for _, part := range strings.Split(strings.Trim(pointer, "/"), "/") {
+		next, ok := node[part].(map[string]interface{})
+		if !ok {
+			t.Fatalf("Unresolvable reference %q in %s schema", ref, current)
+		}
+		node = next
+	}
+	return doc, node
+}
+
+// checkDocument verifies every field in value is described by the schema
+func (docs schemaDocs) checkDocument(t *testing.T, doc, path string, schema map[string]interface{}, value interface{}) {
+	t.Helper()
+
+	if ref, ok := schema["$ref"].(string); ok {
+		doc, schema = docs.resolve(t, doc, ref)
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		properties, _ := schema["properties"].(map[string]interface{})
+		additional, _ := schema["additionalProperties"].(map[string]interface{})
+		for key, field := range v {
+			if fieldSchema, ok := properties[key].(map[string]interface{}); ok {
+				docs.checkDocument(t, doc, path+"."+key, fieldSchema, field)
+			} else if additional != nil {
+				docs.checkDocument(t, doc, path+"."+key, additional, field)
+			} else if schema["additionalProperties"] == false {
+				t.Errorf("%s.%s is not described by the %s schema", path, key, doc)
+			}
+		}
+	case []interface{}:
+		if items, ok := schema["items"].(map[string]interface{}); ok {
+			for _, item := range v {
+				docs.checkDocument(t, doc, path+"[]", items, item)
+			}
+		}
+	}
+}
+
+// loadSchemas parses every published schema
+func loadSchemas(t *testing.T) schemaDocs {
+	t.Helper()
+
+	docs := schemaDocs{}
+	for _, name := range SchemaNames() {
+		data, err := Schema(name)
+		if err != nil {
+			t.Fatalf("Failed to read %s schema: %v", name, err)
+		}
+
+		var doc map[string]interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			t.Fatalf("%s schema is not valid JSON: %v", name, err)
+		}
+		docs[name] = doc
+	}
+	return docs
+}
+
+// readJSON decodes a JSON file into generic values
+func readJSON(t *testing.T, path string) interface{} {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatalf("Failed to parse %s: %v", path, err)
+	}
+	return value
+}
+
+func TestSchemaUnknownName(t *testing.T) {
+	if _, err := Schema("nope"); !errors.Is(err, ErrUnknownSchema) {
+		t.Errorf("Expected ErrUnknownSchema, got %v", err)
+	}
+}
+
+func TestSchemasDescribeFiles(t *testing.T) {
+	docs := loadSchemas(t)
+	dir := t.TempDir()
+	statsPath := filepath.Join(dir, "stats.json")
+
+	sm := NewStatsManager(statsPath)
+	sm.SetRecordDraws(true)
+	for i, strategy := range []game.PlayerStrategy{game.Switch, game.Stay, game.Switch} {
+		err := sm.RecordGame(&game.GameResult{
+			Won:            i%2 == 0,
+			Strategy:       strategy,
+			InitialChoice:  1,
+			FinalChoice:    2,
+			CarPosition:    2,
+			HostOpenedDoor: 3,
+			GameDuration:   time.Second,
+			Timestamp:      time.Now(),
+			Draws:          []game.Draw{{Use: game.DrawCar, N: 3, Value: 1}},
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	docs.checkDocument(t, "stats", "stats", docs["stats"], readJSON(t, statsPath))
+
+	exportPath := filepath.Join(dir, "export.json")
+	options := DefaultExportOptions()
+	options.Filename = exportPath
+	if err := sm.ExportStats(options); err != nil {
+		t.Fatalf("Failed to export stats: %v", err)
+	}
+
+	docs.checkDocument(t, "export", "export", docs["export"], readJSON(t, exportPath))
+}
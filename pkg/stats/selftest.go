@@ -0,0 +1,148 @@
+package stats
+
+import (
+	"fmt"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// DefaultSelfTestGames is how many games the fairness self-test plays unless
+// told otherwise: enough for a bias of a percentage point to stand out
+const DefaultSelfTestGames = 100000
+
+// SpreadTest counts how often each outcome came up and tests the counts
+// against an even spread
+type SpreadTest struct {
+	Counts    []int
+	Total     int
+	Expected  float64 // Count each outcome should reach on average
+	ChiSquare float64 // Pearson's chi-square statistic of the counts
+	PValue    float64 // Chance of a spread at least this uneven from a fair draw
+}
+
+// Suspicious reports whether the spread is unusual enough to look into
+func (t SpreadTest) Suspicious() bool {
+	return t.Total > 0 && t.PValue < FairnessSignificance
+}
+
+// testSpread fills in the statistics of the counted outcomes
+func (t *SpreadTest) testSpread() {
+	if t.Total == 0 {
+		t.PValue = 1
+		return
+	}
+	t.Expected, t.ChiSquare, t.PValue = evenSpread(t.Counts, t.Total)
+}
+
+// SelfTestReport is the outcome of the fairness self-test, which plays many
+// games through the real game and host code and checks that nothing but
+// chance decides where the car is and which doors the host opens
+type SelfTestReport struct {
+	NumDoors int
+	Games    int
+
+	// Cars counts the games with the car behind each 0-indexed door
+	Cars SpreadTest
+
+	// HostChoices counts, in the games where the contestant picked the car
+	// and the host could leave any other door closed, which one it left,
+	// numbered among the doors the contestant did not pick
+	HostChoices SpreadTest
+
+	// RuleBreaks counts the games where the host opened the contestant's
+	// door or revealed the car, which a fair host never does
+	RuleBreaks int
+
+	// SwitchWins counts the games switching would have won
+	SwitchWins int
+}
+
+// SwitchWinRate returns the share of games switching would have won
+func (r SelfTestReport) SwitchWinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.SwitchWins) / float64(r.Games)
+}
+
+// Fair reports whether the host kept to the rules and neither spread is
+// suspicious
+func (r SelfTestReport) Fair() bool {
+	return r.RuleBreaks == 0 && !r.Cars.Suspicious() && !r.HostChoices.Suspicious()
+}
+
+// RunSelfTest plays games with numDoors doors, drawing from src, and tests
+// the car placements and the host's free choices against even spreads. The
+// contestant picks each door in turn, so any bias found comes from the game
+// and not from the picks. A nil src uses the secure randomness of real games
+func RunSelfTest(src game.RandomSource, numDoors, games int) (SelfTestReport, error) {
+	if err := game.ValidateNumDoors(numDoors); err != nil {
+		return SelfTestReport{}, err
+	}
+	if games <= 0 {
+		return SelfTestReport{}, fmt.Errorf("the self-test needs at least one game, got %d", games)
+	}
+	if src == nil {
+		src = game.SecureSource()
+	}
+
+	report := SelfTestReport{
+		NumDoors:    numDoors,
+		Games:       games,
+		Cars:        SpreadTest{Counts: make([]int, numDoors)},
+		HostChoices: SpreadTest{Counts: make([]int, numDoors-1)},
+	}
+	for i := range games {
+		g, err := game.NewGameWithSource(src, numDoors)
+		if err != nil {
+			return SelfTestReport{}, err
+		}
+		pick := i % numDoors
+		if err := g.MakeInitialChoice(pick); err != nil {
+			return SelfTestReport{}, fmt.Errorf("game %d: %w", i+1, err)
+		}
+
+		report.Cars.Counts[g.CarPosition]++
+		report.Cars.Total++
+		if g.CarPosition != pick {
+			report.SwitchWins++
+		}
+
+		for _, door := range g.HostOpenedDoors {
+			if door == pick || door == g.CarPosition {
+				report.RuleBreaks++
+				break
+			}
+		}
+
+		if g.CarPosition == pick {
+			if closed, ok := closedOther(g, pick); ok {
+				report.HostChoices.Counts[closed]++
+				report.HostChoices.Total++
+			}
+		}
+	}
+
+	report.Cars.testSpread()
+	report.HostChoices.testSpread()
+	return report, nil
+}
+
+// closedOther returns which of the doors the contestant did not pick the host
+// left closed, numbered among those doors, and false if it left more than one
+func closedOther(g *game.Game, pick int) (int, bool) {
+	closed, other := -1, 0
+	for i, door := range g.Doors {
+		if i == pick {
+			continue
+		}
+		if !door.IsOpen() {
+			if closed != -1 {
+				return 0, false
+			}
+			closed = other
+		}
+		other++
+	}
+	return closed, closed != -1
+}
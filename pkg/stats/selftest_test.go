@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// zeroSource always draws 0, putting the car behind the first door and
+// making the host always leave the first door it may choose closed
+type zeroSource struct{}
+
+func (zeroSource) Intn(int) int { return 0 }
+
+func TestRunSelfTest(t *testing.T) {
+	report, err := RunSelfTest(game.NewSeededSource(42), 3, 30000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Cars.Total != 30000 || report.HostChoices.Total == 0 {
+		t.Fatalf("Expected every car placement and some free host choices counted, got %+v", report)
+	}
+	if !report.Fair() || report.RuleBreaks != 0 {
+		t.Errorf("Expected a seeded game to look fair, got %+v", report)
+	}
+	if rate := report.SwitchWinRate(); rate < 0.65 || rate > 0.68 {
+		t.Errorf("Expected switching to win about 2/3 of games, got %.3f", rate)
+	}
+
+	rigged, err := RunSelfTest(zeroSource{}, 4, 4000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rigged.Cars.Suspicious() || !rigged.HostChoices.Suspicious() || rigged.Fair() {
+		t.Errorf("Expected a source that always draws 0 to be caught, got %+v", rigged)
+	}
+	if rigged.HostChoices.Counts[0] != rigged.HostChoices.Total {
+		t.Errorf("Expected the host to always leave the first other door closed, got %v", rigged.HostChoices.Counts)
+	}
+
+	if _, err := RunSelfTest(nil, 3, 0); err == nil {
+		t.Error("Expected an error for a self-test without games")
+	}
+}
@@ -0,0 +1,92 @@
+package stats
+
+import "fmt"
+
+// Aggregates are the lifetime numbers a session is measured against
+type Aggregates struct {
+	Games            int
+	Wins             int
+	Switch           StrategyStats
+	Stay             StrategyStats
+	LongestWinStreak int
+}
+
+// Aggregates returns the lifetime numbers of the statistics
+func (gs *GameStats) Aggregates() Aggregates {
+	return Aggregates{
+		Games:            gs.TotalGames,
+		Wins:             gs.TotalWins,
+		Switch:           gs.SwitchStats,
+		Stay:             gs.StayStats,
+		LongestWinStreak: gs.StreakStats.LongestWinStreak,
+	}
+}
+
+// SessionDiff is how a session moved the lifetime numbers, from a snapshot
+// taken when it started to the numbers at its end
+type SessionDiff struct {
+	Before Aggregates
+	After  Aggregates
+}
+
+// Games returns how many games the session added
+func (d SessionDiff) Games() int {
+	return d.After.Games - d.Before.Games
+}
+
+// WasReset reports whether the statistics were reset during the session, so
+// the numbers cannot be compared
+func (d SessionDiff) WasReset() bool {
+	return d.After.Games < d.Before.Games
+}
+
+// Lines describes the changes, such as "+12 games (134 in all)", "Win rate
+// 51.2% → 51.6% (+0.4%)" and "New longest win streak: 7 (was 5)". Strategies
+// the session didn't play are left out
+func (d SessionDiff) Lines() []string {
+	if d.WasReset() {
+		return []string{fmt.Sprintf("Statistics were reset; %d games since", d.After.Games)}
+	}
+	if d.Games() == 0 {
+		return nil
+	}
+
+	games := "games"
+	if d.Games() == 1 {
+		games = "game"
+	}
+	lines := []string{fmt.Sprintf("+%d %s (%d in all)", d.Games(), games, d.After.Games)}
+	lines = append(lines, rateChange("Win rate", d.Before.Wins, d.Before.Games, d.After.Wins, d.After.Games))
+	for _, strategy := range []struct {
+		name          string
+		before, after StrategyStats
+	}{
+		{"Switch win rate", d.Before.Switch, d.After.Switch},
+		{"Stay win rate", d.Before.Stay, d.After.Stay},
+	} {
+		if strategy.after.GamesPlayed != strategy.before.GamesPlayed {
+			lines = append(lines, rateChange(strategy.name, strategy.before.Wins, strategy.before.GamesPlayed,
+				strategy.after.Wins, strategy.after.GamesPlayed))
+		}
+	}
+
+	if d.After.LongestWinStreak > d.Before.LongestWinStreak {
+		if d.Before.LongestWinStreak == 0 {
+			lines = append(lines, fmt.Sprintf("New longest win streak: %d", d.After.LongestWinStreak))
+		} else {
+			lines = append(lines, fmt.Sprintf("New longest win streak: %d (was %d)", d.After.LongestWinStreak, d.Before.LongestWinStreak))
+		}
+	}
+	return lines
+}
+
+// rateChange describes a win rate before and after, such as "Win rate 51.2%
+// → 51.6% (+0.4%)", or just the new rate when there was none before
+func rateChange(label string, winsBefore, gamesBefore, winsAfter, gamesAfter int) string {
+	after := float64(winsAfter) / float64(gamesAfter) * 100
+	if gamesBefore == 0 {
+		return fmt.Sprintf("%s %.1f%%", label, after)
+	}
+	before := float64(winsBefore) / float64(gamesBefore) * 100
+	return fmt.Sprintf("%s %.1f%% → %.1f%% (%+.1f%%)", label, before, after, after-before)
+}
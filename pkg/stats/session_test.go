@@ -0,0 +1,45 @@
+package stats
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSessionDiff(t *testing.T) {
+	before := Aggregates{
+		Games:            10,
+		Wins:             5,
+		Switch:           StrategyStats{GamesPlayed: 5, Wins: 3},
+		Stay:             StrategyStats{GamesPlayed: 5, Wins: 2},
+		LongestWinStreak: 2,
+	}
+	after := before
+	after.Games, after.Wins = 12, 7
+	after.Switch = StrategyStats{GamesPlayed: 7, Wins: 5}
+	after.LongestWinStreak = 3
+
+	want := []string{
+		"+2 games (12 in all)",
+		"Win rate 50.0% → 58.3% (+8.3%)",
+		"Switch win rate 60.0% → 71.4% (+11.4%)",
+		"New longest win streak: 3 (was 2)",
+	}
+	if lines := (SessionDiff{Before: before, After: after}).Lines(); !slices.Equal(lines, want) {
+		t.Errorf("Expected %q, got %q", want, lines)
+	}
+
+	// A first session has no rates to compare with
+	first := SessionDiff{After: Aggregates{Games: 1, Stay: StrategyStats{GamesPlayed: 1}}}
+	want = []string{"+1 game (1 in all)", "Win rate 0.0%", "Stay win rate 0.0%"}
+	if lines := first.Lines(); !slices.Equal(lines, want) {
+		t.Errorf("Expected %q, got %q", want, lines)
+	}
+
+	if lines := (SessionDiff{Before: before, After: before}).Lines(); lines != nil {
+		t.Errorf("Expected nothing for a session without games, got %q", lines)
+	}
+	reset := SessionDiff{Before: before, After: Aggregates{Games: 2}}
+	if !reset.WasReset() || len(reset.Lines()) != 1 {
+		t.Errorf("Expected a reset to be reported, got %q", reset.Lines())
+	}
+}
@@ -0,0 +1,134 @@
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"text/tabwriter"
+)
+
+// DefaultConfidence is the confidence level used for simulation intervals
+const DefaultConfidence = 0.95
+
+// SimulationResult is the aggregate outcome of one policy
+type SimulationResult struct {
+	Policy   ReplayPolicy `json:"-"`
+	Strategy string       `json:"strategy"`
+	Games    int          `json:"games"`
+	Wins     int          `json:"wins"`
+	WinRate  float64      `json:"win_rate"`
+	Expected float64      `json:"expected_win_rate"`
+	Lower    float64      `json:"ci_lower"`
+	Upper    float64      `json:"ci_upper"`
+}
+
+// SimulationReport collects the results of a batch simulation
+type SimulationReport struct {
+	NumDoors   int                `json:"num_doors"`
+	Seed       int64              `json:"seed"`
+	Confidence float64            `json:"confidence"`
+	Results    []SimulationResult `json:"results"`
+}
+
+// ParseReplayPolicies parses a strategy name; "all" selects every policy
+func ParseReplayPolicies(name string) ([]ReplayPolicy, error) {
+	switch strings.ToLower(name) {
+	case "switch":
+		return []ReplayPolicy{PolicyAlwaysSwitch}, nil
+	case "stay":
+		return []ReplayPolicy{PolicyAlwaysStay}, nil
+	case "random":
+		return []ReplayPolicy{PolicyRandom}, nil
+	case "all":
+		return ReplayPolicies(), nil
+	default:
+		return nil, fmt.Errorf("unknown strategy: %s", name)
+	}
+}
+
+// ExpectedWinRate returns the theoretical win rate of the policy with numDoors doors
+func (p ReplayPolicy) ExpectedWinRate(numDoors int) float64 {
+	switch p {
+	case PolicyAlwaysSwitch:
+		return float64(numDoors-1) / float64(numDoors)
+	case PolicyAlwaysStay:
+		return 1 / float64(numDoors)
+	default:
+		return 0.5 // Stays and switches equally often
+	}
+}
+
+// WilsonInterval returns the Wilson score interval for a win rate, which stays
+// within 0-1 and behaves well for rates near either end
+func WilsonInterval(wins, games int, z float64) (float64, float64) {
+	if games == 0 {
+		return 0, 0
+	}
+
+	n := float64(games)
+	p := float64(wins) / n
+	denominator := 1 + z*z/n
+	center := (p + z*z/(2*n)) / denominator
+	margin := z * math.Sqrt(p*(1-p)/n+z*z/(4*n*n)) / denominator
+
+	return math.Max(0, center-margin), math.Min(1, center+margin)
+}
+
+// ZScore returns the two-sided normal critical value for a confidence level
+func ZScore(confidence float64) float64 {
+	return math.Sqrt2 * math.Erfinv(confidence)
+}
+
+// Write writes the report in the given format
+func (r *SimulationReport) Write(w io.Writer, format ExportFormat) error {
+	switch format {
+	case ExportJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	case ExportCSV:
+		return r.writeCSV(w)
+	case ExportText:
+		return r.writeText(w)
+	default:
+		return fmt.Errorf("unsupported format: %v", format)
+	}
+}
+
+// writeCSV writes one row per strategy
+func (r *SimulationReport) writeCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"Strategy", "Doors", "Games", "Wins", "Win Rate", "Expected Win Rate", "CI Lower", "CI Upper", "Confidence"})
+	for _, result := range r.Results {
+		writer.Write([]string{
+			result.Strategy,
+			fmt.Sprintf("%d", r.NumDoors),
+			fmt.Sprintf("%d", result.Games),
+			fmt.Sprintf("%d", result.Wins),
+			fmt.Sprintf("%.4f", result.WinRate),
+			fmt.Sprintf("%.4f", result.Expected),
+			fmt.Sprintf("%.4f", result.Lower),
+			fmt.Sprintf("%.4f", result.Upper),
+			fmt.Sprintf("%g", r.Confidence),
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeText writes a table for reading in a terminal
+func (r *SimulationReport) writeText(w io.Writer) error {
+	fmt.Fprintf(w, "Monty Hall simulation: %d doors, seed %d\n\n", r.NumDoors, r.Seed)
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "STRATEGY\tGAMES\tWINS\tWIN RATE\t%g%% CI\tEXPECTED\n", r.Confidence*100)
+	for _, result := range r.Results {
+		fmt.Fprintf(table, "%s\t%d\t%d\t%.2f%%\t%.2f%% - %.2f%%\t%.2f%%\n",
+			result.Strategy, result.Games, result.Wins, result.WinRate*100,
+			result.Lower*100, result.Upper*100, result.Expected*100)
+	}
+	return table.Flush()
+}
@@ -0,0 +1,62 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseReplayPolicies(t *testing.T) {
+	if policies, err := ParseReplayPolicies("all"); err != nil || len(policies) != 3 {
+		t.Errorf("Expected every policy for all, got %v (%v)", policies, err)
+	}
+	if _, err := ParseReplayPolicies("sometimes"); err == nil {
+		t.Error("Expected an error for an unknown strategy")
+	}
+}
+
+func TestWilsonInterval(t *testing.T) {
+	lower, upper := WilsonInterval(50, 100, ZScore(0.95))
+	if lower < 0.40 || lower > 0.41 || upper < 0.59 || upper > 0.60 {
+		t.Errorf("Expected about 0.404-0.596 for 50/100, got %.3f-%.3f", lower, upper)
+	}
+
+	if lower, upper := WilsonInterval(0, 10, ZScore(0.95)); lower != 0 || upper <= 0 {
+		t.Errorf("Expected the interval for no wins to start at 0, got %.3f-%.3f", lower, upper)
+	}
+}
+
+func TestSimulationReportFormats(t *testing.T) {
+	report := &SimulationReport{NumDoors: 10, Seed: 1, Confidence: 0.99}
+	for _, policy := range ReplayPolicies() {
+		report.Results = append(report.Results, SimulationResult{
+			Policy:   policy,
+			Strategy: policy.String(),
+			Games:    100,
+			Wins:     50,
+			WinRate:  0.5,
+			Expected: policy.ExpectedWinRate(10),
+		})
+	}
+
+	var text, csvOut, jsonOut bytes.Buffer
+	report.Write(&text, ExportText)
+	report.Write(&csvOut, ExportCSV)
+	report.Write(&jsonOut, ExportJSON)
+
+	if !strings.Contains(text.String(), "99% CI") || !strings.Contains(text.String(), "Always switch") {
+		t.Errorf("Unexpected text output:\n%s", text.String())
+	}
+	if lines := strings.Split(strings.TrimSpace(csvOut.String()), "\n"); len(lines) != 4 {
+		t.Errorf("Expected a header and 3 CSV rows, got %d lines", len(lines))
+	}
+
+	var decoded SimulationReport
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if decoded.NumDoors != 10 || len(decoded.Results) != 3 {
+		t.Errorf("Unexpected JSON report: %+v", decoded)
+	}
+}
@@ -0,0 +1,155 @@
+package stats
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Built-in store backends
+const (
+	StoreJSON   = "json"   // A JSON file, the default
+	StoreMemory = "memory" // Kept for the session only
+)
+
+var (
+	ErrUnknownStore     = errors.New("unknown statistics backend")
+	ErrStoreUnsupported = errors.New("not supported by the statistics backend")
+)
+
+// StatsStore loads and saves statistics. PersistenceManager keeps them in a
+// JSON file; other backends, such as SQLite or a remote service, register a
+// factory with RegisterStore
+type StatsStore interface {
+	Load() (*GameStats, error)
+	Save(stats *GameStats) error
+	Delete() error
+	// Location describes where the statistics are kept, e.g. a file path
+	Location() string
+}
+
+// FileStore is a store backed by a local file, which also supports backups.
+// Goals are kept in a file beside it
+type FileStore interface {
+	StatsStore
+	GetFilePath() string
+	GetFileSize() (int64, error)
+	Backup(backupPath string) error
+	Restore(backupPath string) error
+}
+
+// StoreFactory opens a store at a backend-specific location; an empty
+// location means the backend's default
+type StoreFactory func(location string) (StatsStore, error)
+
+var (
+	storesMu sync.RWMutex
+	stores   = map[string]StoreFactory{
+		StoreJSON: func(location string) (StatsStore, error) {
+			return NewPersistenceManager(location), nil
+		},
+		StoreMemory: func(string) (StatsStore, error) {
+			return NewMemoryStore(), nil
+		},
+	}
+)
+
+// RegisterStore makes a backend available to OpenStore under the given name,
+// replacing any backend already registered with it
+func RegisterStore(backend string, factory StoreFactory) {
+	storesMu.Lock()
+	defer storesMu.Unlock()
+	stores[backend] = factory
+}
+
+// StoreBackends returns the names of the registered backends
+func StoreBackends() []string {
+	storesMu.RLock()
+	defer storesMu.RUnlock()
+	return slices.Sorted(maps.Keys(stores))
+}
+
+// OpenStore opens the named backend at the location; an empty backend is the
+// JSON file store
+func OpenStore(backend, location string) (StatsStore, error) {
+	if backend == "" {
+		backend = StoreJSON
+	}
+
+	storesMu.RLock()
+	factory, ok := stores[backend]
+	storesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %q (available: %s)", ErrUnknownStore, backend, strings.Join(StoreBackends(), ", "))
+	}
+
+	return factory(location)
+}
+
+// MemoryStore keeps statistics in memory, for tests and sessions that should
+// leave nothing behind
+type MemoryStore struct {
+	mu   sync.Mutex
+	data []byte // Saved statistics as JSON, so callers never share them
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Load returns a copy of the saved statistics, or empty statistics if none were saved
+func (ms *MemoryStore) Load() (*GameStats, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.data == nil {
+		return &GameStats{
+			DailyStats: make(map[string]DailyStats),
+		}, nil
+	}
+
+	var stats GameStats
+	if err := json.Unmarshal(ms.data, &stats); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stats: %w", err)
+	}
+	if stats.DailyStats == nil {
+		stats.DailyStats = make(map[string]DailyStats)
+	}
+	return &stats, nil
+}
+
+// Save replaces the saved statistics with a copy of stats
+func (ms *MemoryStore) Save(stats *GameStats) error {
+	if stats == nil {
+		return ErrNilStats
+	}
+
+	stats.Version = CurrentStatsVersion
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return fmt.Errorf("failed to marshal stats: %w", err)
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.data = data
+	return nil
+}
+
+// Delete discards the saved statistics
+func (ms *MemoryStore) Delete() error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	ms.data = nil
+	return nil
+}
+
+// Location returns "memory"
+func (ms *MemoryStore) Location() string {
+	return StoreMemory
+}
@@ -0,0 +1,65 @@
+package stats
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestOpenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "stats.json")
+
+	store, err := OpenStore("", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := store.(FileStore); !ok || store.Location() != path {
+		t.Errorf("Expected the default backend to be the JSON file at %s, got %T at %s", path, store, store.Location())
+	}
+
+	if _, err := OpenStore("floppy", ""); !errors.Is(err, ErrUnknownStore) {
+		t.Errorf("Expected ErrUnknownStore, got %v", err)
+	}
+
+	RegisterStore("test", func(string) (StatsStore, error) {
+		return NewMemoryStore(), nil
+	})
+	if _, err := OpenStore("test", ""); err != nil {
+		t.Errorf("Expected a registered backend to open, got %v", err)
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	sm := NewStatsManagerWithStore(NewMemoryStore())
+	sm.RecordGame(syncTestResult(0, game.Switch, true))
+
+	stats, err := sm.Store().Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TotalGames != 1 || len(stats.GameHistory) != 1 {
+		t.Fatalf("Expected the saved game, got %d games", stats.TotalGames)
+	}
+
+	// Loaded statistics are copies
+	stats.TotalGames = 99
+	if reloaded, _ := sm.Store().Load(); reloaded.TotalGames != 1 {
+		t.Error("Changing loaded statistics should not change the store")
+	}
+
+	if err := sm.Backup(filepath.Join(t.TempDir(), "backup.json")); !errors.Is(err, ErrStoreUnsupported) {
+		t.Errorf("Expected backups to be unsupported, got %v", err)
+	}
+	if sm.GetStatsFilePath() != StoreMemory {
+		t.Errorf("Expected the location to be %q, got %q", StoreMemory, sm.GetStatsFilePath())
+	}
+
+	if err := sm.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if stats, _ := sm.Store().Load(); stats.TotalGames != 0 {
+		t.Error("Expected reset to clear the store")
+	}
+}
@@ -0,0 +1,229 @@
+package stats
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// SyncFileExt is the extension of the per-device record files in a vault
+const SyncFileExt = ".jsonl"
+
+// ErrInvalidDevice is returned for a device name that can't name a record file
+var ErrInvalidDevice = errors.New("invalid sync device name")
+
+// Vault is a shared folder, e.g. one synced with Dropbox or Syncthing, that
+// lets several devices merge their statistics without a server. Every device
+// only ever appends to its own record file, one JSON game record per line, so
+// the sync tool never sees two devices change the same file
+type Vault struct {
+	dir    string
+	device string
+}
+
+// NewVault opens the vault in dir for the named device
+func NewVault(dir, device string) (*Vault, error) {
+	device = strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || r == '.' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '-'
+	}, device)
+	if strings.Trim(device, "-_.") == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidDevice, device)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create sync directory %s: %w", dir, err)
+	}
+
+	return &Vault{dir: dir, device: device}, nil
+}
+
+// Path returns the record file of this device
+func (v *Vault) Path() string {
+	return filepath.Join(v.dir, v.device+SyncFileExt)
+}
+
+// Append adds records to this device's record file
+func (v *Vault) Append(records ...GameRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return fmt.Errorf("failed to marshal game record: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(v.Path(), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open sync file: %w", err)
+	}
+	if _, err := file.Write(buf.Bytes()); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write sync file: %w", err)
+	}
+	return file.Close()
+}
+
+// Records returns the games of every device in the vault, without duplicates,
+// ordered by when they were played. Lines that can't be parsed are skipped: a
+// file still being synced may end mid-line, and is read in full next time
+func (v *Vault) Records() ([]GameRecord, error) {
+	paths, err := filepath.Glob(filepath.Join(v.dir, "*"+SyncFileExt))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sync files: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var records []GameRecord
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sync file: %w", err)
+		}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var record GameRecord
+			if err := json.Unmarshal(scanner.Bytes(), &record); err != nil || record.ID == "" || seen[record.ID] {
+				continue
+			}
+			seen[record.ID] = true
+			records = append(records, record)
+		}
+		err = scanner.Err()
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sync file %s: %w", filepath.Base(path), err)
+		}
+	}
+
+	sortRecords(records)
+	return records, nil
+}
+
+// sortRecords orders records by when they were played, breaking ties by ID so
+// every device ends up with the same order
+func sortRecords(records []GameRecord) {
+	slices.SortStableFunc(records, func(a, b GameRecord) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	})
+}
+
+// MergeRecords returns the statistics with the records they don't have yet
+// added, and how many were added. Merging is conflict-free: games are matched
+// by ID, so merging the same records again or in another order changes
+// nothing, and the statistics are recalculated from the combined history.
+// When the history has been trimmed, records older than the oldest game kept
+// are assumed to be counted already and are skipped
+func MergeRecords(stats *GameStats, records []GameRecord) (*GameStats, int) {
+	known := make(map[string]bool, len(stats.GameHistory))
+	for _, record := range stats.GameHistory {
+		known[record.ID] = true
+	}
+	trimmed := len(stats.GameHistory) < stats.TotalGames
+
+	var added []GameRecord
+	for _, record := range records {
+		if known[record.ID] || record.ID == "" {
+			continue
+		}
+		if trimmed && (len(stats.GameHistory) == 0 || record.Timestamp.Before(stats.GameHistory[0].Timestamp)) {
+			continue
+		}
+		known[record.ID] = true
+		added = append(added, record)
+	}
+	if len(added) == 0 {
+		return stats, 0
+	}
+
+	if trimmed {
+		// The aggregates include games no longer in the history, so the new
+		// games are added to them rather than recalculated
+		collector := &Collector{stats: stats}
+		sortRecords(added)
+		for _, record := range added {
+			collector.addRecord(record)
+		}
+		sortRecords(stats.GameHistory)
+		return stats, len(added)
+	}
+
+	history := append(slices.Clone(stats.GameHistory), added...)
+	sortRecords(history)
+
+	collector := NewCollector()
+	collector.stats.Version = stats.Version
+	collector.stats.Players = stats.Players
+	for _, record := range history {
+		collector.addRecord(record)
+	}
+	return collector.stats, len(added)
+}
+
+// SyncResult reports what enabling sync did
+type SyncResult struct {
+	Merged    int // Games from other devices added to the statistics
+	Published int // Local games added to this device's record file
+}
+
+// EnableSync merges the games in the vault into the statistics, adds local
+// games the vault is missing to this device's record file, and appends every
+// game recorded from now on. Read-only statistics are merged but never written
+func (sm *StatsManager) EnableSync(vault *Vault) (SyncResult, error) {
+	var result SyncResult
+
+	records, err := vault.Records()
+	if err != nil {
+		return result, err
+	}
+
+	if !sm.readOnly {
+		inVault := make(map[string]bool, len(records))
+		for _, record := range records {
+			inVault[record.ID] = true
+		}
+
+		var missing []GameRecord
+		for _, record := range sm.collector.GetStats().GameHistory {
+			if !inVault[record.ID] {
+				missing = append(missing, record)
+			}
+		}
+		if err := vault.Append(missing...); err != nil {
+			return result, err
+		}
+		result.Published = len(missing)
+
+		sm.AddRecordHook(func(record GameRecord, stats *GameStats) error {
+			if err := vault.Append(record); err != nil {
+				return fmt.Errorf("failed to sync game: %w", err)
+			}
+			return nil
+		})
+	}
+
+	stats, merged := MergeRecords(sm.collector.GetStats(), records)
+	result.Merged = merged
+	if merged == 0 {
+		return result, nil
+	}
+
+	sm.useStats(stats)
+	return result, sm.save()
+}
@@ -0,0 +1,197 @@
+package stats
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// syncTestResult returns a finished game played at the given minute
+func syncTestResult(minute int, strategy game.PlayerStrategy, won bool) *game.GameResult {
+	return &game.GameResult{
+		Won:            won,
+		Strategy:       strategy,
+		InitialChoice:  1,
+		FinalChoice:    1,
+		CarPosition:    1,
+		HostOpenedDoor: 2,
+		Timestamp:      time.Date(2026, 3, 1, 12, minute, 0, 0, time.UTC),
+		NumDoors:       game.NumDoors,
+	}
+}
+
+func TestVaultMergesDevices(t *testing.T) {
+	vaultDir := t.TempDir()
+
+	laptop := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	laptop.RecordGame(syncTestResult(0, game.Switch, true))
+	desktop := NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	desktop.RecordGame(syncTestResult(1, game.Stay, false))
+
+	laptopVault, err := NewVault(vaultDir, "laptop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	desktopVault, err := NewVault(vaultDir, "desktop")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The laptop publishes its game; nothing to merge yet
+	result, err := laptop.EnableSync(laptopVault)
+	if err != nil || result != (SyncResult{Published: 1}) {
+		t.Fatalf("Expected the laptop to publish 1 game, got %+v, %v", result, err)
+	}
+
+	// The desktop publishes its game and merges the laptop's
+	result, err = desktop.EnableSync(desktopVault)
+	if err != nil || result != (SyncResult{Merged: 1, Published: 1}) {
+		t.Fatalf("Expected the desktop to publish and merge 1 game, got %+v, %v", result, err)
+	}
+
+	// A game recorded after sync is on is appended to the vault
+	desktop.RecordGame(syncTestResult(2, game.Switch, true))
+
+	laptop = NewStatsManager(laptop.GetFilePath())
+	result, err = laptop.EnableSync(laptopVault)
+	if err != nil || result != (SyncResult{Merged: 2}) {
+		t.Fatalf("Expected the laptop to merge 2 games, got %+v, %v", result, err)
+	}
+
+	for name, sm := range map[string]*StatsManager{"laptop": laptop, "desktop": desktop} {
+		stats := sm.GetStats()
+		if stats.TotalGames != 3 || stats.SwitchStats.Wins != 2 || stats.StayStats.Losses != 1 {
+			t.Errorf("%s: expected 3 merged games, got %+v", name, stats)
+		}
+		if err := stats.Validate(); err != nil {
+			t.Errorf("%s: merged stats are inconsistent: %v", name, err)
+		}
+		for i, record := range stats.GameHistory {
+			if record.Timestamp.Minute() != i {
+				t.Errorf("%s: history should be in play order, game %d was played at minute %d", name, i, record.Timestamp.Minute())
+			}
+		}
+	}
+
+	// Merged stats are saved
+	reloaded := NewStatsManager(laptop.GetFilePath())
+	if reloaded.GetStats().TotalGames != 3 {
+		t.Errorf("Merged stats should be saved, got %d games", reloaded.GetStats().TotalGames)
+	}
+}
+
+func TestMergeRecordsIsConflictFree(t *testing.T) {
+	c := NewCollector()
+	for i := range 4 {
+		c.RecordGame(syncTestResult(i, game.PlayerStrategy(i%2), i%3 == 0))
+	}
+	records := c.GetStats().GameHistory
+
+	a, added := MergeRecords(&GameStats{DailyStats: make(map[string]DailyStats)}, records[:3])
+	a, _ = MergeRecords(a, records)
+	if added != 3 {
+		t.Errorf("Expected 3 records added, got %d", added)
+	}
+
+	reversed := []GameRecord{records[3], records[1], records[2], records[0]}
+	b, _ := MergeRecords(&GameStats{DailyStats: make(map[string]DailyStats)}, reversed)
+
+	if _, added := MergeRecords(b, records); added != 0 {
+		t.Errorf("Merging the same records again should add nothing, got %d", added)
+	}
+
+	for name, stats := range map[string]*GameStats{"incremental": a, "reversed": b} {
+		if stats.TotalGames != 4 || stats.TotalWins != c.GetStats().TotalWins {
+			t.Errorf("%s: expected 4 games and %d wins, got %d and %d", name, c.GetStats().TotalWins, stats.TotalGames, stats.TotalWins)
+		}
+		if stats.StreakStats != c.GetStats().StreakStats {
+			t.Errorf("%s: streaks should match playing in order, got %+v", name, stats.StreakStats)
+		}
+	}
+}
+
+func TestMergeRecordsSkipsTrimmedGames(t *testing.T) {
+	c := NewCollector()
+	for i := range 3 {
+		c.RecordGame(syncTestResult(i, game.Switch, true))
+	}
+	old := c.GetStats().GameHistory[0]
+
+	// The oldest game was trimmed from the history but is still counted
+	c.GetStats().GameHistory = c.GetStats().GameHistory[1:]
+	newer := c.GetStats().GameHistory[1]
+	newer.ID = "from-another-device"
+	newer.Timestamp = newer.Timestamp.Add(time.Second)
+
+	stats, added := MergeRecords(c.GetStats(), []GameRecord{old, newer})
+	if added != 1 || stats.TotalGames != 4 || len(stats.GameHistory) != 3 {
+		t.Errorf("Only the newer game should be added, got %d added, %d games", added, stats.TotalGames)
+	}
+}
+
+func TestVaultSkipsPartialLines(t *testing.T) {
+	vault, err := NewVault(t.TempDir(), "phone")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewCollector()
+	c.RecordGame(syncTestResult(0, game.Switch, true))
+	record := c.GetStats().GameHistory[0]
+	if err := vault.Append(record, record); err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := os.OpenFile(vault.Path(), os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString(`{"id":"still-syncing","tim`)
+	file.Close()
+
+	records, err := vault.Records()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].ID != record.ID {
+		t.Errorf("Expected the duplicate and partial lines to be skipped, got %+v", records)
+	}
+}
+
+func TestNewVaultDeviceName(t *testing.T) {
+	dir := t.TempDir()
+
+	vault, err := NewVault(dir, "Sam's MacBook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := filepath.Base(vault.Path()); got != "Sam-s-MacBook.jsonl" {
+		t.Errorf("Device name should be made safe for a file name, got %s", got)
+	}
+
+	if _, err := NewVault(dir, "../"); !errors.Is(err, ErrInvalidDevice) {
+		t.Errorf("Expected ErrInvalidDevice, got %v", err)
+	}
+}
+
+func TestReadOnlySyncDoesNotWrite(t *testing.T) {
+	vault, err := NewVault(t.TempDir(), "laptop")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sm := NewReadOnlyStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	sm.RecordGame(syncTestResult(0, game.Switch, true))
+	if _, err := sm.EnableSync(vault); err != nil {
+		t.Fatal(err)
+	}
+	sm.RecordGame(syncTestResult(1, game.Switch, true))
+
+	if _, err := os.Stat(vault.Path()); !os.IsNotExist(err) {
+		t.Error("Read-only statistics should not be written to the vault")
+	}
+}
@@ -6,19 +6,29 @@ import (
 	"github.com/westhuis/monty-hall/pkg/game"
 )
 
+// CurrentStatsVersion is the stats file format written by this version.
+// Version 1 records doors 0-indexed; files without a version recorded them 1-indexed.
+const CurrentStatsVersion = 1
+
 type GameStats struct {
-	TotalGames      int                   `json:"total_games"`
-	TotalWins       int                   `json:"total_wins"`
-	TotalLosses     int                   `json:"total_losses"`
-	SwitchStats     StrategyStats         `json:"switch_stats"`
-	StayStats       StrategyStats         `json:"stay_stats"`
-	AverageGameTime time.Duration         `json:"average_game_time"`
-	TotalGameTime   time.Duration         `json:"total_game_time"`
-	FirstGameTime   *time.Time            `json:"first_game_time,omitempty"`
-	LastGameTime    *time.Time            `json:"last_game_time,omitempty"`
-	GameHistory     []GameRecord          `json:"game_history"`
-	DailyStats      map[string]DailyStats `json:"daily_stats"`
-	StreakStats     StreakStats           `json:"streak_stats"`
+	Version         int                    `json:"version,omitempty"`
+	TotalGames      int                    `json:"total_games"`
+	TotalWins       int                    `json:"total_wins"`
+	TotalLosses     int                    `json:"total_losses"`
+	SwitchStats     StrategyStats          `json:"switch_stats"`
+	StayStats       StrategyStats          `json:"stay_stats"`
+	AverageGameTime time.Duration          `json:"average_game_time"`
+	TotalGameTime   time.Duration          `json:"total_game_time"`
+	FirstGameTime   *time.Time             `json:"first_game_time,omitempty"`
+	LastGameTime    *time.Time             `json:"last_game_time,omitempty"`
+	GameHistory     []GameRecord           `json:"game_history"`
+	DailyStats      map[string]DailyStats  `json:"daily_stats"`
+	StreakStats     StreakStats            `json:"streak_stats"`
+	CarDraws        []CarDraw              `json:"car_draws,omitempty"`   // Latest car placements, for the fairness audit
+	JournalSeq      int                    `json:"journal_seq,omitempty"` // Last journaled game these statistics include
+	Players         map[string]PlayerStats `json:"players,omitempty"`     // Hotseat players' totals, kept apart from the profile's
+
+	trimmed []GameRecord // Games trimmed from the history, waiting to be archived
 }
 
 type StrategyStats struct {
@@ -40,6 +50,41 @@ type GameRecord struct {
 	GameDuration   time.Duration       `json:"game_duration"`
 	DayOfWeek      string              `json:"day_of_week"`
 	HourOfDay      int                 `json:"hour_of_day"`
+	Practice       bool                `json:"practice,omitempty"`
+	NumDoors       int                 `json:"num_doors,omitempty"`     // 0 for the standard three doors
+	HostBehavior   string              `json:"host_behavior,omitempty"` // Empty for the classic host
+	DoorsOpened    int                 `json:"doors_opened,omitempty"`  // 0 when the host opened all doors but one
+	CarRevealed    bool                `json:"car_revealed,omitempty"`
+	StrategySource string              `json:"strategy_source,omitempty"` // "default" when the configured strategy chose; empty for the player
+	Player         string              `json:"player,omitempty"`          // Hotseat player who played; empty for the usual single player
+	Ladder         bool                `json:"ladder,omitempty"`          // Played on the door-count ladder
+	Draws          []game.Draw         `json:"draws,omitempty"`           // Random numbers drawn in the game, when recorded
+	Seed           *int64              `json:"seed,omitempty"`            // Seed that replays a seeded game, such as a daily challenge round
+}
+
+// Doors returns how many doors the game was played with
+func (r GameRecord) Doors() int {
+	if r.NumDoors == 0 {
+		return game.NumDoors
+	}
+	return r.NumDoors
+}
+
+// Opened returns how many doors the host opened in the game
+func (r GameRecord) Opened() int {
+	if r.DoorsOpened == 0 {
+		return r.Doors() - 2
+	}
+	return r.DoorsOpened
+}
+
+// Host returns how the host opened doors in the game
+func (r GameRecord) Host() game.HostBehavior {
+	behavior, err := game.ParseHostBehavior(r.HostBehavior)
+	if err != nil {
+		return game.HostClassic
+	}
+	return behavior
 }
 
 type DailyStats struct {
@@ -0,0 +1,155 @@
+package stats
+
+import (
+	"fmt"
+	mathrand "math/rand"
+)
+
+// ReplayPolicy is an alternate way of playing used to replay recorded games
+type ReplayPolicy int
+
+const (
+	PolicyAlwaysSwitch ReplayPolicy = iota
+	PolicyAlwaysStay
+	PolicyRandom
+)
+
+// String returns the display name of the policy
+func (p ReplayPolicy) String() string {
+	switch p {
+	case PolicyAlwaysSwitch:
+		return "Always switch"
+	case PolicyAlwaysStay:
+		return "Always stay"
+	case PolicyRandom:
+		return "Random"
+	default:
+		return "Unknown"
+	}
+}
+
+// ReplayPolicies returns the policies compared in a what-if report, in display order
+func ReplayPolicies() []ReplayPolicy {
+	return []ReplayPolicy{PolicyAlwaysSwitch, PolicyAlwaysStay, PolicyRandom}
+}
+
+// PolicyOutcome is how a policy would have done over the replayed games
+type PolicyOutcome struct {
+	Policy ReplayPolicy
+	Wins   int
+	Games  int
+}
+
+// WinRate returns the fraction of replayed games the policy would have won
+func (o PolicyOutcome) WinRate() float64 {
+	if o.Games == 0 {
+		return 0
+	}
+	return float64(o.Wins) / float64(o.Games)
+}
+
+// WhatIfReport compares the recorded outcomes with alternate policies
+type WhatIfReport struct {
+	Games      int // Games replayed
+	Skipped    int // Records whose door positions are missing or inconsistent, or where the car was revealed
+	ActualWins int
+	Outcomes   []PolicyOutcome
+}
+
+// ActualWinRate returns the fraction of replayed games actually won
+func (r *WhatIfReport) ActualWinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.ActualWins) / float64(r.Games)
+}
+
+// Difference returns how many more games the policy would have won than were
+// actually won; negative when the actual play did better
+func (r *WhatIfReport) Difference(outcome PolicyOutcome) int {
+	return outcome.Wins - r.ActualWins
+}
+
+// ReplayHistory replays recorded games under each policy, keeping the recorded
+// car position and initial pick; seed makes the random policy reproducible
+func ReplayHistory(history []GameRecord, seed int64) *WhatIfReport {
+	rng := mathrand.New(mathrand.NewSource(seed))
+
+	report := &WhatIfReport{}
+	for _, policy := range ReplayPolicies() {
+		report.Outcomes = append(report.Outcomes, PolicyOutcome{Policy: policy})
+	}
+
+	for _, record := range history {
+		if err := record.checkReplayable(); err != nil {
+			report.Skipped++
+			continue
+		}
+
+		report.Games++
+		if record.Won {
+			report.ActualWins++
+		}
+
+		for i := range report.Outcomes {
+			outcome := &report.Outcomes[i]
+			outcome.Games++
+			if record.winsWith(outcome.Policy, rng) {
+				outcome.Wins++
+			}
+		}
+	}
+
+	return report
+}
+
+// WhatIf replays the recorded history under alternate policies
+func (sm *StatsManager) WhatIf(seed int64) *WhatIfReport {
+	return ReplayHistory(sm.GetStats().GameHistory, seed)
+}
+
+// checkReplayable verifies the recorded doors describe a valid, consistent game
+func (r GameRecord) checkReplayable() error {
+	if r.CarRevealed {
+		return fmt.Errorf("host revealed the car")
+	}
+
+	if r.Opened() != r.Doors()-2 {
+		return fmt.Errorf("host left %d doors to switch to", r.Doors()-1-r.Opened())
+	}
+
+	for name, door := range map[string]int{
+		"car position":     r.CarPosition,
+		"initial choice":   r.InitialChoice,
+		"final choice":     r.FinalChoice,
+		"host opened door": r.HostOpenedDoor,
+	} {
+		if door < 0 || door >= r.Doors() {
+			return fmt.Errorf("%s %d out of range", name, door)
+		}
+	}
+
+	if r.HostOpenedDoor == r.CarPosition || r.HostOpenedDoor == r.InitialChoice {
+		return fmt.Errorf("host opened door %d cannot be the car or the initial choice", r.HostOpenedDoor)
+	}
+
+	if r.Won != (r.FinalChoice == r.CarPosition) {
+		return fmt.Errorf("recorded outcome does not match the car position")
+	}
+
+	return nil
+}
+
+// winsWith reports whether the game would have been won under the policy
+func (r GameRecord) winsWith(policy ReplayPolicy, rng *mathrand.Rand) bool {
+	switchDoors := policy == PolicyAlwaysSwitch
+	if policy == PolicyRandom {
+		switchDoors = rng.Intn(2) == 1
+	}
+
+	// With one goat revealed, switching wins exactly when the first pick was wrong
+	if switchDoors {
+		return r.InitialChoice != r.CarPosition
+	}
+	return r.InitialChoice == r.CarPosition
+}
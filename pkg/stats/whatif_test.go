@@ -0,0 +1,58 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestReplayHistory(t *testing.T) {
+	history := []GameRecord{
+		// Stayed on the car
+		{Strategy: game.Stay, Won: true, InitialChoice: 0, FinalChoice: 0, CarPosition: 0, HostOpenedDoor: 1},
+		// Stayed on a goat
+		{Strategy: game.Stay, Won: false, InitialChoice: 0, FinalChoice: 0, CarPosition: 2, HostOpenedDoor: 1},
+		// Switched from a goat to the car
+		{Strategy: game.Switch, Won: true, InitialChoice: 1, FinalChoice: 0, CarPosition: 0, HostOpenedDoor: 2},
+		// Host opened the car door, which cannot happen
+		{Strategy: game.Stay, Won: false, InitialChoice: 0, FinalChoice: 0, CarPosition: 1, HostOpenedDoor: 1},
+		// Outcome does not match the doors
+		{Strategy: game.Stay, Won: true, InitialChoice: 0, FinalChoice: 0, CarPosition: 2, HostOpenedDoor: 1},
+	}
+
+	report := ReplayHistory(history, 1)
+
+	if report.Games != 3 || report.Skipped != 2 {
+		t.Fatalf("Expected 3 games and 2 skipped, got %d and %d", report.Games, report.Skipped)
+	}
+	if report.ActualWins != 2 {
+		t.Errorf("Expected 2 actual wins, got %d", report.ActualWins)
+	}
+
+	expected := map[ReplayPolicy]int{PolicyAlwaysSwitch: 2, PolicyAlwaysStay: 1}
+	for _, outcome := range report.Outcomes {
+		if outcome.Games != 3 {
+			t.Errorf("%s: expected 3 games, got %d", outcome.Policy, outcome.Games)
+		}
+		if wins, ok := expected[outcome.Policy]; ok && outcome.Wins != wins {
+			t.Errorf("%s: expected %d wins, got %d", outcome.Policy, wins, outcome.Wins)
+		}
+	}
+
+	if ReplayHistory(history, 7).Outcomes[2] != ReplayHistory(history, 7).Outcomes[2] {
+		t.Error("The random policy should be reproducible for a given seed")
+	}
+}
+
+func TestReplayHistoryEmpty(t *testing.T) {
+	report := ReplayHistory(nil, 1)
+
+	if report.Games != 0 || report.ActualWinRate() != 0 {
+		t.Errorf("Expected an empty report, got %+v", report)
+	}
+	for _, outcome := range report.Outcomes {
+		if outcome.WinRate() != 0 {
+			t.Errorf("%s: expected 0 win rate with no games", outcome.Policy)
+		}
+	}
+}
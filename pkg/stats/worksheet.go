@@ -0,0 +1,311 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// WorksheetGames is the number of games in each play task of a worksheet
+const WorksheetGames = 10
+
+var (
+	ErrWorksheetComplete = errors.New("worksheet already complete")
+	ErrNotAnswerTask     = errors.New("the current task is not a question")
+)
+
+// WorksheetTaskKind is a kind of worksheet task
+type WorksheetTaskKind int
+
+const (
+	TaskAlwaysStay   WorksheetTaskKind = iota // Play games, staying every time
+	TaskAlwaysSwitch                          // Play games, switching every time
+	TaskCompare                               // Say which strategy won more often
+)
+
+// WorksheetTask is one step of a worksheet. Play tasks count the games played
+// with their strategy; the compare task is complete once answered
+type WorksheetTask struct {
+	Kind    WorksheetTaskKind
+	Games   int                // Games to play, for play tasks
+	Results []*game.GameResult // Games counted towards the task
+	Answer  *game.PlayerStrategy
+}
+
+// Prompt returns the instruction shown to the student
+func (t *WorksheetTask) Prompt() string {
+	switch t.Kind {
+	case TaskAlwaysStay:
+		return fmt.Sprintf("Play %d games, always staying. Record your win rate.", t.Games)
+	case TaskAlwaysSwitch:
+		return fmt.Sprintf("Now play %d games, always switching.", t.Games)
+	case TaskCompare:
+		return "Compare: which strategy won more often in your games?"
+	default:
+		return "Unknown task"
+	}
+}
+
+// Strategy returns the strategy a play task asks for
+func (t *WorksheetTask) Strategy() (game.PlayerStrategy, bool) {
+	switch t.Kind {
+	case TaskAlwaysStay:
+		return game.Stay, true
+	case TaskAlwaysSwitch:
+		return game.Switch, true
+	default:
+		return 0, false
+	}
+}
+
+// Wins returns how many of the counted games were won
+func (t *WorksheetTask) Wins() int {
+	wins := 0
+	for _, result := range t.Results {
+		if result.Won {
+			wins++
+		}
+	}
+	return wins
+}
+
+// WinRate returns the fraction of the counted games that were won
+func (t *WorksheetTask) WinRate() float64 {
+	if len(t.Results) == 0 {
+		return 0
+	}
+	return float64(t.Wins()) / float64(len(t.Results))
+}
+
+// IsComplete returns true once the task is done
+func (t *WorksheetTask) IsComplete() bool {
+	if t.Kind == TaskCompare {
+		return t.Answer != nil
+	}
+	return len(t.Results) >= t.Games
+}
+
+// Worksheet is a guided classroom experiment: a sequence of tasks whose
+// completion is tracked from the games played, ending in a filled-in
+// worksheet the student can hand in
+type Worksheet struct {
+	Tasks       []*WorksheetTask
+	StartedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// NewWorksheet creates the stay, switch and compare experiment
+func NewWorksheet(startedAt time.Time) *Worksheet {
+	return &Worksheet{
+		Tasks: []*WorksheetTask{
+			{Kind: TaskAlwaysStay, Games: WorksheetGames},
+			{Kind: TaskAlwaysSwitch, Games: WorksheetGames},
+			{Kind: TaskCompare},
+		},
+		StartedAt: startedAt,
+	}
+}
+
+// Current returns the first unfinished task and its index
+func (w *Worksheet) Current() (*WorksheetTask, int, bool) {
+	for i, task := range w.Tasks {
+		if !task.IsComplete() {
+			return task, i, true
+		}
+	}
+	return nil, len(w.Tasks), false
+}
+
+// IsComplete returns true once every task is done
+func (w *Worksheet) IsComplete() bool {
+	_, _, ok := w.Current()
+	return !ok
+}
+
+// Record counts a finished game towards the current task and reports whether
+// it counted: games played with the other strategy don't
+func (w *Worksheet) Record(result *game.GameResult) bool {
+	task, _, ok := w.Current()
+	if !ok || result == nil {
+		return false
+	}
+
+	strategy, play := task.Strategy()
+	if !play || result.Strategy != strategy {
+		return false
+	}
+
+	task.Results = append(task.Results, result)
+	w.checkComplete(result.Timestamp)
+	return true
+}
+
+// Answer records the student's answer to the compare task
+func (w *Worksheet) Answer(strategy game.PlayerStrategy, at time.Time) error {
+	task, _, ok := w.Current()
+	if !ok {
+		return ErrWorksheetComplete
+	}
+	if task.Kind != TaskCompare {
+		return ErrNotAnswerTask
+	}
+
+	task.Answer = &strategy
+	w.checkComplete(at)
+	return nil
+}
+
+// checkComplete marks the worksheet complete once its last task is done
+func (w *Worksheet) checkComplete(at time.Time) {
+	if w.CompletedAt == nil && w.IsComplete() {
+		w.CompletedAt = &at
+	}
+}
+
+// task returns the first task of the given kind
+func (w *Worksheet) task(kind WorksheetTaskKind) *WorksheetTask {
+	for _, task := range w.Tasks {
+		if task.Kind == kind {
+			return task
+		}
+	}
+	return &WorksheetTask{Kind: kind}
+}
+
+// Conclusion describes how the student's answer compares with their results
+func (w *Worksheet) Conclusion() string {
+	stay, switched := w.task(TaskAlwaysStay), w.task(TaskAlwaysSwitch)
+	answer := w.task(TaskCompare).Answer
+	if answer == nil {
+		return "Not answered yet"
+	}
+
+	var winner string
+	switch {
+	case switched.WinRate() > stay.WinRate():
+		winner = "switching"
+	case stay.WinRate() > switched.WinRate():
+		winner = "staying"
+	default:
+		return "Both strategies won equally often in these games; play more to see a difference"
+	}
+
+	if (*answer == game.Switch) == (winner == "switching") {
+		return fmt.Sprintf("Correct: %s won more often in your games", winner)
+	}
+	return fmt.Sprintf("Check again: %s won more often in your games", winner)
+}
+
+// Markdown renders the filled-in worksheet as Markdown
+func (w *Worksheet) Markdown() string {
+	return w.render(true)
+}
+
+// Text renders the filled-in worksheet as plain text
+func (w *Worksheet) Text() string {
+	return w.render(false)
+}
+
+// Export writes the filled-in worksheet to a new file in dir, as Markdown or
+// plain text, and returns its path
+func (w *Worksheet) Export(dir string, markdown bool, at time.Time) (string, error) {
+	content, ext := w.Text(), ".txt"
+	if markdown {
+		content, ext = w.Markdown(), ".md"
+	}
+
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return "", fmt.Errorf("failed to create directory %s: %w", dir, err)
+		}
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("monty-hall-worksheet_%s%s", at.Format("2006-01-02_15-04-05"), ext))
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("failed to write worksheet: %w", err)
+	}
+	return path, nil
+}
+
+// render writes the worksheet with or without Markdown formatting
+func (w *Worksheet) render(markdown bool) string {
+	var b strings.Builder
+
+	heading := func(level int, text string) {
+		if markdown {
+			fmt.Fprintf(&b, "%s %s\n\n", strings.Repeat("#", level), text)
+			return
+		}
+		underline := "="
+		if level > 1 {
+			underline = "-"
+		}
+		fmt.Fprintf(&b, "%s\n%s\n\n", text, strings.Repeat(underline, len(text)))
+	}
+
+	heading(1, "Monty Hall Experiment Worksheet")
+	fmt.Fprintf(&b, "Name: ______________________\n\n")
+	fmt.Fprintf(&b, "Started: %s\n", w.StartedAt.Format("2006-01-02 15:04"))
+	if w.CompletedAt != nil {
+		fmt.Fprintf(&b, "Completed: %s\n", w.CompletedAt.Format("2006-01-02 15:04"))
+	} else {
+		fmt.Fprintf(&b, "Completed: not yet\n")
+	}
+	b.WriteString("\n")
+
+	for i, task := range w.Tasks {
+		heading(2, fmt.Sprintf("Task %d: %s", i+1, task.Prompt()))
+
+		if task.Kind == TaskCompare {
+			stay, switched := w.task(TaskAlwaysStay), w.task(TaskAlwaysSwitch)
+			fmt.Fprintf(&b, "Staying won %d of %d games (%.0f%%).\n", stay.Wins(), len(stay.Results), stay.WinRate()*100)
+			fmt.Fprintf(&b, "Switching won %d of %d games (%.0f%%).\n\n", switched.Wins(), len(switched.Results), switched.WinRate()*100)
+
+			answer := "not answered"
+			if task.Answer != nil {
+				answer = "staying"
+				if *task.Answer == game.Switch {
+					answer = "switching"
+				}
+			}
+			fmt.Fprintf(&b, "My answer: %s\n", answer)
+			fmt.Fprintf(&b, "%s.\n\n", w.Conclusion())
+			b.WriteString("In theory staying wins 1/3 of the time and switching 2/3: the host's\n")
+			b.WriteString("reveal moves the 2/3 chance of the other doors onto the door left closed.\n\n")
+			continue
+		}
+
+		fmt.Fprintf(&b, "Played %d of %d games. Won %d (win rate %.0f%%).\n\n", len(task.Results), task.Games, task.Wins(), task.WinRate()*100)
+		if len(task.Results) == 0 {
+			continue
+		}
+
+		if markdown {
+			b.WriteString("| Game | First pick | Host opened | Final pick | Car | Result |\n")
+			b.WriteString("|-----:|-----------:|------------:|-----------:|----:|--------|\n")
+		} else {
+			fmt.Fprintf(&b, "%-6s %-11s %-12s %-11s %-4s %s\n", "Game", "First pick", "Host opened", "Final pick", "Car", "Result")
+		}
+		for n, result := range task.Results {
+			outcome := "Lost"
+			if result.Won {
+				outcome = "Won"
+			}
+			if markdown {
+				fmt.Fprintf(&b, "| %d | %d | %d | %d | %d | %s |\n", n+1,
+					result.InitialChoice, result.HostOpenedDoor, result.FinalChoice, result.CarPosition, outcome)
+			} else {
+				fmt.Fprintf(&b, "%-6d %-11d %-12d %-11d %-4d %s\n", n+1,
+					result.InitialChoice, result.HostOpenedDoor, result.FinalChoice, result.CarPosition, outcome)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n") + "\n"
+}
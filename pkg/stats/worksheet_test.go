@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// worksheetResult returns a finished three-door game where the player picked
+// door 1 and the host opened door 2
+func worksheetResult(strategy game.PlayerStrategy, won bool) *game.GameResult {
+	final := 1
+	if strategy == game.Switch {
+		final = 3
+	}
+	car := final
+	if !won {
+		car = 4 - final // The other closed door
+	}
+	return &game.GameResult{
+		Won:            won,
+		Strategy:       strategy,
+		InitialChoice:  1,
+		FinalChoice:    final,
+		CarPosition:    car,
+		HostOpenedDoor: 2,
+		Timestamp:      time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+		NumDoors:       game.NumDoors,
+	}
+}
+
+func TestWorksheetTracksTasks(t *testing.T) {
+	w := NewWorksheet(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+
+	if w.Record(worksheetResult(game.Switch, true)) {
+		t.Error("A switch should not count towards the staying task")
+	}
+	if err := w.Answer(game.Switch, time.Now()); err != ErrNotAnswerTask {
+		t.Errorf("Expected ErrNotAnswerTask before the games are played, got %v", err)
+	}
+
+	for i := range WorksheetGames {
+		if !w.Record(worksheetResult(game.Stay, i < 3)) {
+			t.Fatalf("Stay game %d should count", i+1)
+		}
+	}
+	for i := range WorksheetGames {
+		if !w.Record(worksheetResult(game.Switch, i < 7)) {
+			t.Fatalf("Switch game %d should count", i+1)
+		}
+	}
+
+	task, index, ok := w.Current()
+	if !ok || index != 2 || task.Kind != TaskCompare {
+		t.Fatalf("Expected the compare task next, got %d", index)
+	}
+	if w.Record(worksheetResult(game.Switch, true)) {
+		t.Error("Games should not count once the play tasks are done")
+	}
+
+	if err := w.Answer(game.Switch, time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if !w.IsComplete() || w.CompletedAt == nil {
+		t.Fatal("Answering the last task should complete the worksheet")
+	}
+	if !strings.HasPrefix(w.Conclusion(), "Correct: switching") {
+		t.Errorf("Unexpected conclusion %q", w.Conclusion())
+	}
+	if err := w.Answer(game.Stay, time.Now()); err != ErrWorksheetComplete {
+		t.Errorf("Expected ErrWorksheetComplete, got %v", err)
+	}
+}
+
+func TestWorksheetExport(t *testing.T) {
+	w := NewWorksheet(time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC))
+	w.Record(worksheetResult(game.Stay, false))
+
+	markdown := w.Markdown()
+	for _, want := range []string{
+		"# Monty Hall Experiment Worksheet",
+		"Completed: not yet",
+		"## Task 1: Play 10 games, always staying",
+		"Played 1 of 10 games. Won 0 (win rate 0%).",
+		"| 1 | 1 | 2 | 1 | 3 | Lost |",
+		"My answer: not answered",
+	} {
+		if !strings.Contains(markdown, want) {
+			t.Errorf("Markdown should contain %q:\n%s", want, markdown)
+		}
+	}
+
+	text := w.Text()
+	if strings.Contains(text, "#") || strings.Contains(text, "|") || !strings.Contains(text, "Monty Hall Experiment Worksheet\n===") {
+		t.Errorf("Text export should not use Markdown:\n%s", text)
+	}
+
+	dir := t.TempDir()
+	path, err := w.Export(dir, true, time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(path, "monty-hall-worksheet_2026-03-01_10-00-00.md") {
+		t.Errorf("Unexpected export path %s", path)
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != markdown {
+		t.Errorf("Exported file should contain the Markdown worksheet, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package termimage
+
+import (
+	"fmt"
+	"math"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/gomonobold"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// DefaultFontSize is the font size in points, rendered at 72 DPI so points equal pixels
+const DefaultFontSize = 16
+
+// fontFamily is the CSS font stack used in SVG output; Go Mono matches the PNG metrics
+const fontFamily = "'Go Mono', 'DejaVu Sans Mono', Menlo, Consolas, monospace"
+
+// Metrics describe the cell grid derived from the font, shared by SVG and PNG
+// output so both images have the same geometry
+type Metrics struct {
+	FontSize   float64
+	CellWidth  float64 // Advance of one narrow character
+	CellHeight float64 // Line height
+	Ascent     float64 // Baseline offset from the top of a cell
+}
+
+// Fonts holds the regular and bold faces used to draw cells
+type Fonts struct {
+	Regular font.Face
+	Bold    font.Face
+	Metrics Metrics
+}
+
+// LoadFonts loads Go Mono at the given size and measures its cell grid
+func LoadFonts(size float64) (*Fonts, error) {
+	if size <= 0 {
+		size = DefaultFontSize
+	}
+
+	regular, err := newFace(gomono.TTF, size)
+	if err != nil {
+		return nil, fmt.Errorf("load regular font: %w", err)
+	}
+	bold, err := newFace(gomonobold.TTF, size)
+	if err != nil {
+		return nil, fmt.Errorf("load bold font: %w", err)
+	}
+
+	advance, _ := regular.GlyphAdvance('M')
+	faceMetrics := regular.Metrics()
+
+	return &Fonts{
+		Regular: regular,
+		Bold:    bold,
+		Metrics: Metrics{
+			FontSize:   size,
+			CellWidth:  fixedToFloat(advance),
+			CellHeight: math.Ceil(fixedToFloat(faceMetrics.Height)),
+			Ascent:     math.Ceil(fixedToFloat(faceMetrics.Ascent)),
+		},
+	}, nil
+}
+
+// newFace parses a TrueType font into a face at the given size
+func newFace(ttf []byte, size float64) (font.Face, error) {
+	parsed, err := opentype.Parse(ttf)
+	if err != nil {
+		return nil, err
+	}
+	return opentype.NewFace(parsed, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+}
+
+// face returns the face for a cell
+func (f *Fonts) face(bold bool) font.Face {
+	if bold {
+		return f.Bold
+	}
+	return f.Regular
+}
+
+// hasGlyphs reports whether the font can draw every rune in text
+func (f *Fonts) hasGlyphs(text string) bool {
+	for _, r := range text {
+		if _, ok := f.Regular.GlyphAdvance(r); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// fixedToFloat converts a 26.6 fixed-point value to pixels
+func fixedToFloat(v fixed.Int26_6) float64 {
+	return float64(v) / 64
+}
@@ -0,0 +1,166 @@
+package termimage
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/math/fixed"
+)
+
+// Options control image output
+type Options struct {
+	FontSize float64
+	Padding  int // Pixels around the screen
+
+	// Fallback replaces characters the font cannot draw, such as emoji, in PNG
+	// output. SVG output leaves them to the viewer's fonts
+	Fallback func(string) string
+}
+
+// boxEdges describes which edges of a cell a box-drawing character connects to
+type boxEdges struct {
+	left, right, up, down int // 0 = none, 1 = light, 2 = heavy, 3 = double
+}
+
+// boxDrawing lists the box-drawing characters drawn as lines rather than
+// glyphs, so borders join seamlessly across cells
+var boxDrawing = map[rune]boxEdges{
+	'─': {1, 1, 0, 0}, '│': {0, 0, 1, 1}, '━': {2, 2, 0, 0}, '┃': {0, 0, 2, 2},
+	'┌': {0, 1, 0, 1}, '┐': {1, 0, 0, 1}, '└': {0, 1, 1, 0}, '┘': {1, 0, 1, 0},
+	'╭': {0, 1, 0, 1}, '╮': {1, 0, 0, 1}, '╰': {0, 1, 1, 0}, '╯': {1, 0, 1, 0},
+	'┏': {0, 2, 0, 2}, '┓': {2, 0, 0, 2}, '┗': {0, 2, 2, 0}, '┛': {2, 0, 2, 0},
+	'├': {0, 1, 1, 1}, '┤': {1, 0, 1, 1}, '┬': {1, 1, 0, 1}, '┴': {1, 1, 1, 0}, '┼': {1, 1, 1, 1},
+	'═': {3, 3, 0, 0}, '║': {0, 0, 3, 3},
+	'╔': {0, 3, 0, 3}, '╗': {3, 0, 0, 3}, '╚': {0, 3, 3, 0}, '╝': {3, 0, 3, 0},
+}
+
+// Size returns the image size in pixels for a screen
+func (s *Screen) Size(metrics Metrics, padding int) (int, int) {
+	width := int(math.Ceil(float64(s.Columns)*metrics.CellWidth)) + 2*padding
+	height := int(math.Ceil(float64(len(s.Rows))*metrics.CellHeight)) + 2*padding
+	return width, height
+}
+
+// RenderPNG draws the screen with Go Mono and writes it as a PNG
+func RenderPNG(w io.Writer, screen *Screen, background color.RGBA, options Options) error {
+	fonts, err := LoadFonts(options.FontSize)
+	if err != nil {
+		return err
+	}
+	return png.Encode(w, screen.Image(fonts, background, options))
+}
+
+// Image draws the screen onto a new image
+func (s *Screen) Image(fonts *Fonts, background color.RGBA, options Options) *image.RGBA {
+	metrics := fonts.Metrics
+	width, height := s.Size(metrics, options.Padding)
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	pad := float64(options.Padding)
+	for y, row := range s.Rows {
+		top := pad + float64(y)*metrics.CellHeight
+		for x, cell := range row {
+			if cell.Width == 0 {
+				continue // Drawn with the wide character before it
+			}
+
+			left := pad + float64(x)*metrics.CellWidth
+			rect := image.Rect(
+				int(math.Round(left)), int(math.Round(top)),
+				int(math.Round(left+float64(cell.Width)*metrics.CellWidth)), int(math.Round(top+metrics.CellHeight)),
+			)
+			if cell.Background != background {
+				draw.Draw(img, rect, image.NewUniform(cell.Background), image.Point{}, draw.Src)
+			}
+
+			s.drawCell(img, fonts, cell, rect, left, top, options)
+		}
+	}
+
+	return img
+}
+
+// drawCell draws one character, using lines for box drawing and the fallback for missing glyphs
+func (s *Screen) drawCell(img *image.RGBA, fonts *Fonts, cell Cell, rect image.Rectangle, left, top float64, options Options) {
+	if cell.Text == " " || cell.Text == "" {
+		return
+	}
+
+	runes := []rune(cell.Text)
+	if edges, ok := boxDrawing[runes[0]]; ok && len(runes) == 1 {
+		drawBox(img, rect, edges, cell.Foreground, cell.Bold)
+		return
+	}
+
+	text := cell.Text
+	if !fonts.hasGlyphs(text) {
+		if options.Fallback == nil {
+			return
+		}
+		text = options.Fallback(text)
+		if !fonts.hasGlyphs(text) {
+			return
+		}
+	}
+
+	drawer := font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(cell.Foreground),
+		Face: fonts.face(cell.Bold),
+		Dot:  fixed.P(int(math.Round(left)), int(math.Round(top+fonts.Metrics.Ascent))),
+	}
+	drawer.DrawString(text)
+}
+
+// drawBox draws the lines of a box-drawing character from the cell center to its edges
+func drawBox(img *image.RGBA, rect image.Rectangle, edges boxEdges, c color.RGBA, bold bool) {
+	centerX := (rect.Min.X + rect.Max.X) / 2
+	centerY := (rect.Min.Y + rect.Max.Y) / 2
+	src := image.NewUniform(c)
+
+	thickness := func(weight int) int {
+		if weight == 2 || bold {
+			return 2
+		}
+		return 1
+	}
+
+	horizontal := func(x0, x1, weight int) {
+		if weight == 0 {
+			return
+		}
+		offsets := []int{0}
+		if weight == 3 {
+			offsets = []int{-2, 2}
+		}
+		for _, offset := range offsets {
+			y := centerY + offset
+			draw.Draw(img, image.Rect(x0, y, x1, y+thickness(weight)), src, image.Point{}, draw.Src)
+		}
+	}
+	vertical := func(y0, y1, weight int) {
+		if weight == 0 {
+			return
+		}
+		offsets := []int{0}
+		if weight == 3 {
+			offsets = []int{-2, 2}
+		}
+		for _, offset := range offsets {
+			x := centerX + offset
+			draw.Draw(img, image.Rect(x, y0, x+thickness(weight), y1), src, image.Point{}, draw.Src)
+		}
+	}
+
+	// Each half-line runs past the center so corners close
+	horizontal(rect.Min.X, centerX+thickness(edges.left), edges.left)
+	horizontal(centerX, rect.Max.X, edges.right)
+	vertical(rect.Min.Y, centerY+thickness(edges.up), edges.up)
+	vertical(centerY, rect.Max.Y, edges.down)
+}
@@ -0,0 +1,249 @@
+// Package termimage renders styled terminal output into SVG and PNG images
+package termimage
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Cell is one terminal cell. Wide characters occupy two cells; the second is
+// a continuation cell with an empty Text
+type Cell struct {
+	Text       string // Character and any combining marks
+	Width      int    // 1 or 2 for the first cell of a character, 0 for continuation cells
+	Foreground color.RGBA
+	Background color.RGBA
+	Bold       bool
+}
+
+// Screen is a grid of cells parsed from ANSI-styled text
+type Screen struct {
+	Columns int
+	Rows    [][]Cell
+}
+
+// style is the current SGR state while parsing
+type style struct {
+	foreground color.RGBA
+	background color.RGBA
+	bold       bool
+}
+
+// Parse interprets SGR color and bold sequences in text, as produced by
+// lipgloss. Other escape sequences are skipped; lines are padded to the
+// widest line with the default colors
+func Parse(text string, foreground, background color.RGBA) *Screen {
+	defaults := style{foreground: foreground, background: background}
+	current := defaults
+	screen := &Screen{}
+
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		var row []Cell
+		runes := []rune(strings.TrimSuffix(line, "\r"))
+
+		for i := 0; i < len(runes); i++ {
+			r := runes[i]
+
+			if r == 0x1b {
+				i = parseEscape(runes, i, &current, defaults)
+				continue
+			}
+
+			width := runewidth.RuneWidth(r)
+			if width == 0 {
+				// Combining marks and variation selectors join the previous character
+				if last := lastCharacter(row); last != nil && r >= ' ' {
+					last.Text += string(r)
+				}
+				continue
+			}
+
+			row = append(row, Cell{Text: string(r), Width: width, Foreground: current.foreground, Background: current.background, Bold: current.bold})
+			if width == 2 {
+				row = append(row, Cell{Foreground: current.foreground, Background: current.background})
+			}
+		}
+
+		screen.Rows = append(screen.Rows, row)
+		screen.Columns = max(screen.Columns, len(row))
+	}
+
+	for i, row := range screen.Rows {
+		for len(row) < screen.Columns {
+			row = append(row, Cell{Text: " ", Width: 1, Foreground: foreground, Background: background})
+		}
+		screen.Rows[i] = row
+	}
+
+	return screen
+}
+
+// lastCharacter returns the last non-continuation cell in a row
+func lastCharacter(row []Cell) *Cell {
+	for i := len(row) - 1; i >= 0; i-- {
+		if row[i].Width > 0 {
+			return &row[i]
+		}
+	}
+	return nil
+}
+
+// parseEscape consumes the escape sequence starting at runes[start], applying
+// SGR parameters to the current style, and returns the index of its last rune
+func parseEscape(runes []rune, start int, current *style, defaults style) int {
+	if start+1 >= len(runes) {
+		return start
+	}
+
+	switch runes[start+1] {
+	case '[': // CSI: parameters, then a final byte in @-~
+		end := start + 2
+		for end < len(runes) && (runes[end] < '@' || runes[end] > '~') {
+			end++
+		}
+		if end < len(runes) && runes[end] == 'm' {
+			applySGR(string(runes[start+2:end]), current, defaults)
+		}
+		return min(end, len(runes)-1)
+
+	case ']': // OSC, such as hyperlinks: ends with BEL or ESC \
+		for end := start + 2; end < len(runes); end++ {
+			if runes[end] == 0x07 {
+				return end
+			}
+			if runes[end] == 0x1b && end+1 < len(runes) && runes[end+1] == '\\' {
+				return end + 1
+			}
+		}
+		return len(runes) - 1
+
+	default:
+		return start + 1
+	}
+}
+
+// applySGR applies "Select Graphic Rendition" parameters such as "1;38;2;255;0;0"
+func applySGR(params string, current *style, defaults style) {
+	if params == "" {
+		*current = defaults
+		return
+	}
+
+	codes := strings.Split(params, ";")
+	for i := 0; i < len(codes); i++ {
+		code, err := strconv.Atoi(codes[i])
+		if err != nil {
+			continue
+		}
+
+		switch {
+		case code == 0:
+			*current = defaults
+		case code == 1:
+			current.bold = true
+		case code == 22:
+			current.bold = false
+		case code == 39:
+			current.foreground = defaults.foreground
+		case code == 49:
+			current.background = defaults.background
+		case code >= 30 && code <= 37:
+			current.foreground = ansiColor(code - 30)
+		case code >= 90 && code <= 97:
+			current.foreground = ansiColor(code - 90 + 8)
+		case code >= 40 && code <= 47:
+			current.background = ansiColor(code - 40)
+		case code >= 100 && code <= 107:
+			current.background = ansiColor(code - 100 + 8)
+		case code == 38 || code == 48:
+			c, consumed, ok := extendedColor(codes[i+1:])
+			i += consumed
+			if !ok {
+				continue
+			}
+			if code == 38 {
+				current.foreground = c
+			} else {
+				current.background = c
+			}
+		}
+	}
+}
+
+// extendedColor parses the 5;n (256-color) or 2;r;g;b (true color) forms
+func extendedColor(params []string) (color.RGBA, int, bool) {
+	value := func(i int) int {
+		if i >= len(params) {
+			return -1
+		}
+		n, err := strconv.Atoi(params[i])
+		if err != nil || n < 0 || n > 255 {
+			return -1
+		}
+		return n
+	}
+
+	switch value(0) {
+	case 5:
+		if n := value(1); n >= 0 {
+			return xterm256(n), 2, true
+		}
+		return color.RGBA{}, len(params), false
+	case 2:
+		r, g, b := value(1), value(2), value(3)
+		if r < 0 || g < 0 || b < 0 {
+			return color.RGBA{}, len(params), false
+		}
+		return color.RGBA{uint8(r), uint8(g), uint8(b), 255}, 4, true
+	default:
+		return color.RGBA{}, len(params), false
+	}
+}
+
+// ansiPalette is the xterm default palette for the 16 basic colors
+var ansiPalette = [16]color.RGBA{
+	{0, 0, 0, 255}, {205, 0, 0, 255}, {0, 205, 0, 255}, {205, 205, 0, 255},
+	{0, 0, 238, 255}, {205, 0, 205, 255}, {0, 205, 205, 255}, {229, 229, 229, 255},
+	{127, 127, 127, 255}, {255, 0, 0, 255}, {0, 255, 0, 255}, {255, 255, 0, 255},
+	{92, 92, 255, 255}, {255, 0, 255, 255}, {0, 255, 255, 255}, {255, 255, 255, 255},
+}
+
+func ansiColor(n int) color.RGBA {
+	return ansiPalette[n]
+}
+
+// xterm256 returns the color for an index in the xterm 256-color palette
+func xterm256(n int) color.RGBA {
+	switch {
+	case n < 16:
+		return ansiPalette[n]
+	case n < 232:
+		n -= 16
+		level := func(v int) uint8 {
+			if v == 0 {
+				return 0
+			}
+			return uint8(55 + v*40)
+		}
+		return color.RGBA{level(n / 36), level(n / 6 % 6), level(n % 6), 255}
+	default:
+		gray := uint8(8 + (n-232)*10)
+		return color.RGBA{gray, gray, gray, 255}
+	}
+}
+
+// ParseHexColor parses a #RRGGBB color, returning ok=false for anything else
+func ParseHexColor(hex string) (color.RGBA, bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return color.RGBA{}, false
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+	return color.RGBA{uint8(value >> 16), uint8(value >> 8), uint8(value), 255}, true
+}
@@ -0,0 +1,107 @@
+package termimage
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image/color"
+	"io"
+	"strings"
+)
+
+// RenderSVG writes the screen as an SVG image. Text stays selectable; each run
+// of text is stretched to its cells with textLength so the grid holds even
+// when the viewer substitutes another monospace font
+func RenderSVG(w io.Writer, screen *Screen, background color.RGBA, options Options) error {
+	fonts, err := LoadFonts(options.FontSize)
+	if err != nil {
+		return err
+	}
+	metrics := fonts.Metrics
+	width, height := screen.Size(metrics, options.Padding)
+	pad := float64(options.Padding)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", hex(background))
+
+	// Backgrounds, merged across cells of the same color
+	for y, row := range screen.Rows {
+		for start := 0; start < len(row); {
+			end := start + 1
+			for end < len(row) && row[end].Background == row[start].Background {
+				end++
+			}
+			if row[start].Background != background {
+				fmt.Fprintf(&b, `<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="%s"/>`+"\n",
+					pad+float64(start)*metrics.CellWidth, pad+float64(y)*metrics.CellHeight,
+					float64(end-start)*metrics.CellWidth, metrics.CellHeight, hex(row[start].Background))
+			}
+			start = end
+		}
+	}
+
+	fmt.Fprintf(&b, `<g font-family="%s" font-size="%g" xml:space="preserve">`+"\n", fontFamily, metrics.FontSize)
+	for y, row := range screen.Rows {
+		baseline := pad + float64(y)*metrics.CellHeight + metrics.Ascent
+		for _, run := range textRuns(row) {
+			if strings.TrimSpace(run.text) == "" {
+				continue
+			}
+			weight := ""
+			if run.bold {
+				weight = ` font-weight="bold"`
+			}
+			fmt.Fprintf(&b, `<text x="%.2f" y="%.2f" fill="%s"%s textLength="%.2f" lengthAdjust="spacingAndGlyphs">`,
+				pad+float64(run.column)*metrics.CellWidth, baseline, hex(run.foreground), weight,
+				float64(run.cells)*metrics.CellWidth)
+			xml.EscapeText(&b, []byte(run.text))
+			b.WriteString("</text>\n")
+		}
+	}
+	b.WriteString("</g>\n</svg>\n")
+
+	_, err = w.Write(b.Bytes())
+	return err
+}
+
+// textRun is a span of cells drawn as one text element
+type textRun struct {
+	column     int
+	cells      int
+	text       string
+	foreground color.RGBA
+	bold       bool
+	wide       bool // A single wide character
+}
+
+// textRuns splits a row into runs of the same style; wide characters get a
+// run of their own so their two-cell width is kept exactly
+func textRuns(row []Cell) []textRun {
+	var runs []textRun
+	var current *textRun
+
+	for x, cell := range row {
+		if cell.Width == 0 {
+			continue
+		}
+
+		if cell.Width == 1 && current != nil && current.cells == x-current.column &&
+			current.foreground == cell.Foreground && current.bold == cell.Bold && !current.wide {
+			current.text += cell.Text
+			current.cells++
+			continue
+		}
+
+		runs = append(runs, textRun{column: x, cells: cell.Width, text: cell.Text, foreground: cell.Foreground, bold: cell.Bold, wide: cell.Width == 2})
+		current = &runs[len(runs)-1]
+	}
+
+	return runs
+}
+
+// hex formats a color as #rrggbb
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -0,0 +1,115 @@
+package termimage
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+var (
+	white = color.RGBA{255, 255, 255, 255}
+	black = color.RGBA{0, 0, 0, 255}
+)
+
+func TestParseSGR(t *testing.T) {
+	screen := Parse("\x1b[1;38;2;255;0;0;48;5;21mA\x1b[0mb\x1b[91mc\x1b[39md", white, black)
+
+	if screen.Columns != 4 || len(screen.Rows) != 1 {
+		t.Fatalf("Expected a 4x1 screen, got %dx%d", screen.Columns, len(screen.Rows))
+	}
+	row := screen.Rows[0]
+
+	if row[0].Text != "A" || !row[0].Bold || row[0].Foreground != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("Expected bold red A, got %+v", row[0])
+	}
+	if row[0].Background != (color.RGBA{0, 0, 255, 255}) {
+		t.Errorf("Expected 256-color 21 to be blue, got %v", row[0].Background)
+	}
+	if row[1].Bold || row[1].Foreground != white || row[1].Background != black {
+		t.Errorf("Expected reset to restore defaults, got %+v", row[1])
+	}
+	if row[2].Foreground != ansiPalette[9] {
+		t.Errorf("Expected bright red, got %v", row[2].Foreground)
+	}
+	if row[3].Foreground != white {
+		t.Errorf("Expected 39 to restore the default foreground, got %v", row[3].Foreground)
+	}
+}
+
+func TestParseWideAndPadding(t *testing.T) {
+	screen := Parse("🚗x\x1b]8;;https://example.com\x07\nab\x1b[2K", white, black)
+
+	if screen.Columns != 3 {
+		t.Fatalf("Expected 3 columns, got %d", screen.Columns)
+	}
+	first := screen.Rows[0]
+	if first[0].Text != "🚗" || first[0].Width != 2 || first[1].Width != 0 || first[2].Text != "x" {
+		t.Errorf("Expected a wide car followed by a continuation cell, got %+v", first)
+	}
+	second := screen.Rows[1]
+	if len(second) != 3 || second[2].Text != " " {
+		t.Errorf("Expected the short row to be padded, got %+v", second)
+	}
+}
+
+func TestRenderPNG(t *testing.T) {
+	screen := Parse("┌─┐\n└─┘", white, black)
+
+	var buf bytes.Buffer
+	if err := RenderPNG(&buf, screen, black, Options{Padding: 4}); err != nil {
+		t.Fatalf("RenderPNG failed: %v", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("Output is not a PNG: %v", err)
+	}
+
+	fonts, err := LoadFonts(0)
+	if err != nil {
+		t.Fatalf("LoadFonts failed: %v", err)
+	}
+	width, height := screen.Size(fonts.Metrics, 4)
+	if img.Bounds().Dx() != width || img.Bounds().Dy() != height {
+		t.Errorf("Expected %dx%d image, got %v", width, height, img.Bounds())
+	}
+
+	// The top border runs through the middle of the first row
+	y := 4 + int(fonts.Metrics.CellHeight)/2
+	x := width / 2
+	r, g, b, _ := img.At(x, y).RGBA()
+	if r>>8 != 255 || g>>8 != 255 || b>>8 != 255 {
+		t.Errorf("Expected a border pixel at (%d, %d), got %v", x, y, img.At(x, y))
+	}
+}
+
+func TestRenderSVG(t *testing.T) {
+	screen := Parse("\x1b[1;32mYou <won>\x1b[0m 🚗", white, black)
+
+	var buf bytes.Buffer
+	if err := RenderSVG(&buf, screen, black, Options{}); err != nil {
+		t.Fatalf("RenderSVG failed: %v", err)
+	}
+	svg := buf.String()
+
+	for _, want := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg"`,
+		`fill="#00cd00" font-weight="bold"`,
+		"You &lt;won&gt;",
+		"🚗</text>",
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("Expected SVG to contain %q:\n%s", want, svg)
+		}
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	if c, ok := ParseHexColor("#1A1A1A"); !ok || c != (color.RGBA{26, 26, 26, 255}) {
+		t.Errorf("Expected #1A1A1A to parse, got %v %v", c, ok)
+	}
+	if _, ok := ParseHexColor("red"); ok {
+		t.Error("Expected a color name to be rejected")
+	}
+}
@@ -0,0 +1,172 @@
+// Package tournament pits bots against each other on identical seeded deals
+package tournament
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/server"
+)
+
+// Turn is what a bot sees when it has to pick a door: the game as returned by
+// the API, in phase server.PhaseInitialChoice or server.PhaseFinalChoice.
+// Doors are numbered from 1
+type Turn = server.GameState
+
+// Strategy is a bot that plays Monty Hall. Choose is called twice per game,
+// once for the first pick and once for the final choice, and returns one of
+// turn.Available. Randomness should come from rng, so tournaments replay
+type Strategy interface {
+	Name() string
+	Choose(turn Turn, rng *mathrand.Rand) (int, error)
+}
+
+var ErrUnknownBot = errors.New("unknown bot")
+
+// strategyFunc adapts a function to the Strategy interface
+type strategyFunc struct {
+	name   string
+	choose func(turn Turn, rng *mathrand.Rand) int
+}
+
+func (s strategyFunc) Name() string { return s.name }
+
+func (s strategyFunc) Choose(turn Turn, rng *mathrand.Rand) (int, error) {
+	return s.choose(turn, rng), nil
+}
+
+// NewStrategy creates a bot from a function that picks a door
+func NewStrategy(name string, choose func(turn Turn, rng *mathrand.Rand) int) Strategy {
+	return strategyFunc{name: name, choose: choose}
+}
+
+// randomDoor returns one of the available doors
+func randomDoor(turn Turn, rng *mathrand.Rand) int {
+	return turn.Available[rng.Intn(len(turn.Available))]
+}
+
+// otherDoor returns a closed door other than the one picked first
+func otherDoor(turn Turn, rng *mathrand.Rand) int {
+	var others []int
+	for _, door := range turn.Available {
+		if door != turn.Selected {
+			others = append(others, door)
+		}
+	}
+	if len(others) == 0 {
+		return turn.Selected
+	}
+	return others[rng.Intn(len(others))]
+}
+
+var (
+	botsMu sync.RWMutex
+	bots   = map[string]Strategy{}
+)
+
+func init() {
+	Register(NewStrategy("switch", func(turn Turn, rng *mathrand.Rand) int {
+		if turn.Phase == server.PhaseInitialChoice {
+			return randomDoor(turn, rng)
+		}
+		return otherDoor(turn, rng)
+	}))
+	Register(NewStrategy("stay", func(turn Turn, rng *mathrand.Rand) int {
+		if turn.Phase == server.PhaseInitialChoice {
+			return randomDoor(turn, rng)
+		}
+		return turn.Selected
+	}))
+	Register(NewStrategy("random", randomDoor))
+}
+
+// Register makes a bot available to Lookup by its name, replacing any bot
+// already registered with it
+func Register(strategy Strategy) {
+	botsMu.Lock()
+	defer botsMu.Unlock()
+	bots[strategy.Name()] = strategy
+}
+
+// Names returns the names of the registered bots
+func Names() []string {
+	botsMu.RLock()
+	defer botsMu.RUnlock()
+	names := make([]string, 0, len(bots))
+	for name := range bots {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Lookup returns the registered bot with the given name, or an API bot for
+// an http:// or https:// URL
+func Lookup(name string) (Strategy, error) {
+	if strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") {
+		return NewAPIBot(name), nil
+	}
+
+	botsMu.RLock()
+	strategy, ok := bots[name]
+	botsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %q (available: %s, or a URL)", ErrUnknownBot, name, strings.Join(Names(), ", "))
+	}
+	return strategy, nil
+}
+
+// apiBotTimeout is how long an API bot may take to answer
+const apiBotTimeout = 5 * time.Second
+
+// APIBot is a bot running as a web service. Each turn is POSTed to its URL as
+// the game JSON the server returns, and it answers with {"door": n}
+type APIBot struct {
+	URL        string
+	HTTPClient *http.Client
+}
+
+// NewAPIBot creates a bot played by the service at url
+func NewAPIBot(url string) *APIBot {
+	return &APIBot{
+		URL:        url,
+		HTTPClient: &http.Client{Timeout: apiBotTimeout},
+	}
+}
+
+// Name returns the bot's URL
+func (b *APIBot) Name() string {
+	return b.URL
+}
+
+// Choose asks the service for a door
+func (b *APIBot) Choose(turn Turn, _ *mathrand.Rand) (int, error) {
+	body, err := json.Marshal(turn)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode turn: %w", err)
+	}
+
+	resp, err := b.HTTPClient.Post(b.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("bot %s: %w", b.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("bot %s returned %s", b.URL, resp.Status)
+	}
+
+	var choice server.ChooseRequest
+	if err := json.NewDecoder(resp.Body).Decode(&choice); err != nil {
+		return 0, fmt.Errorf("bot %s sent an invalid answer: %w", b.URL, err)
+	}
+	return choice.Door, nil
+}
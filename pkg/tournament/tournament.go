@@ -0,0 +1,252 @@
+package tournament
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	mathrand "math/rand"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/server"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// Chart size in the text report
+const (
+	chartWidth  = 60
+	chartHeight = 9 // Odd, so the middle row is 50%
+)
+
+// Options configures a tournament
+type Options struct {
+	Bots       []Strategy
+	Games      int // Games each bot plays, on the same deals
+	NumDoors   int
+	Seed       int64
+	Confidence float64 // Confidence level for the win rate intervals, e.g. 0.95
+}
+
+// Entry is one bot's result
+type Entry struct {
+	Rank     int     `json:"rank"`
+	Bot      string  `json:"bot"`
+	Games    int     `json:"games"`
+	Wins     int     `json:"wins"`
+	Switches int     `json:"switches"` // Games where the bot changed doors
+	WinRate  float64 `json:"win_rate"`
+	Lower    float64 `json:"ci_lower"`
+	Upper    float64 `json:"ci_upper"`
+
+	Convergence []float64 `json:"-"` // Cumulative win rate after each game
+}
+
+// Report is the ranked result of a tournament
+type Report struct {
+	NumDoors   int     `json:"num_doors"`
+	Games      int     `json:"games"`
+	Seed       int64   `json:"seed"`
+	Confidence float64 `json:"confidence"`
+	Entries    []Entry `json:"entries"`
+}
+
+// Run plays every bot on the same sequence of seeded deals, so differences
+// in their results come from their choices alone, and ranks them by wins
+func Run(options Options) (*Report, error) {
+	if len(options.Bots) == 0 {
+		return nil, fmt.Errorf("a tournament needs at least one bot")
+	}
+	if options.Games <= 0 {
+		return nil, fmt.Errorf("number of games must be positive, got %d", options.Games)
+	}
+	if err := game.ValidateNumDoors(options.NumDoors); err != nil {
+		return nil, err
+	}
+	if options.Confidence <= 0 || options.Confidence >= 1 {
+		return nil, fmt.Errorf("confidence must be between 0 and 1, got %g", options.Confidence)
+	}
+
+	// Every bot plays deal i with the same seeds: the same car, the same
+	// random numbers for the bot and, for the same first pick, the same doors
+	// opened by the host
+	dealRng := mathrand.New(mathrand.NewSource(options.Seed))
+	deals := make([]deal, options.Games)
+	for i := range deals {
+		deals[i] = deal{game: dealRng.Int63(), bot: dealRng.Int63()}
+	}
+
+	report := &Report{
+		NumDoors:   options.NumDoors,
+		Games:      options.Games,
+		Seed:       options.Seed,
+		Confidence: options.Confidence,
+	}
+	z := math.Sqrt2 * math.Erfinv(options.Confidence)
+
+	for _, bot := range options.Bots {
+		entry := Entry{Bot: bot.Name(), Games: options.Games}
+
+		for i, deal := range deals {
+			result, err := deal.play(bot, options.NumDoors)
+			if err != nil {
+				return nil, fmt.Errorf("%s, game %d: %w", bot.Name(), i+1, err)
+			}
+			if result.Won {
+				entry.Wins++
+			}
+			if result.Strategy == game.Switch {
+				entry.Switches++
+			}
+			entry.Convergence = append(entry.Convergence, float64(entry.Wins)/float64(i+1))
+		}
+
+		entry.WinRate = float64(entry.Wins) / float64(entry.Games)
+		entry.Lower, entry.Upper = stats.WilsonInterval(entry.Wins, entry.Games, z)
+		report.Entries = append(report.Entries, entry)
+	}
+
+	slices.SortStableFunc(report.Entries, func(a, b Entry) int {
+		return cmp.Compare(b.Wins, a.Wins)
+	})
+	for i := range report.Entries {
+		report.Entries[i].Rank = i + 1
+		if i > 0 && report.Entries[i].Wins == report.Entries[i-1].Wins {
+			report.Entries[i].Rank = report.Entries[i-1].Rank
+		}
+	}
+
+	return report, nil
+}
+
+// deal holds the seeds of one tournament game
+type deal struct {
+	game int64 // Seed of the car placement and host choices
+	bot  int64 // Seed of the random numbers given to the bot
+}
+
+// play plays the deal with the bot
+func (d deal) play(bot Strategy, numDoors int) (*game.GameResult, error) {
+	g, err := game.NewSeededGameWithDoors(d.game, numDoors)
+	if err != nil {
+		return nil, err
+	}
+
+	rng := mathrand.New(mathrand.NewSource(d.bot))
+	for !g.IsGameOver() {
+		door, err := bot.Choose(server.StateOf("", g), rng)
+		if err != nil {
+			return nil, err
+		}
+		if g.Phase == game.InitialChoice {
+			err = g.MakeInitialChoice(door - 1)
+		} else {
+			err = g.MakeFinalChoice(door - 1)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("bot chose door %d: %w", door, err)
+		}
+	}
+	return g.Result, nil
+}
+
+// Write writes the report in the given format
+func (r *Report) Write(w io.Writer, format stats.ExportFormat) error {
+	switch format {
+	case stats.ExportJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(r)
+	case stats.ExportCSV:
+		return r.writeCSV(w)
+	case stats.ExportText:
+		return r.writeText(w)
+	default:
+		return fmt.Errorf("unsupported format: %v", format)
+	}
+}
+
+// writeCSV writes one row per bot
+func (r *Report) writeCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"Rank", "Bot", "Doors", "Games", "Wins", "Switches", "Win Rate", "CI Lower", "CI Upper", "Confidence", "Seed"})
+	for _, entry := range r.Entries {
+		writer.Write([]string{
+			fmt.Sprintf("%d", entry.Rank),
+			entry.Bot,
+			fmt.Sprintf("%d", r.NumDoors),
+			fmt.Sprintf("%d", entry.Games),
+			fmt.Sprintf("%d", entry.Wins),
+			fmt.Sprintf("%d", entry.Switches),
+			fmt.Sprintf("%.4f", entry.WinRate),
+			fmt.Sprintf("%.4f", entry.Lower),
+			fmt.Sprintf("%.4f", entry.Upper),
+			fmt.Sprintf("%g", r.Confidence),
+			fmt.Sprintf("%d", r.Seed),
+		})
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeText writes the ranking and a convergence chart for each bot
+func (r *Report) writeText(w io.Writer) error {
+	fmt.Fprintf(w, "Monty Hall tournament: %d games on the same deals, %d doors, seed %d\n\n", r.Games, r.NumDoors, r.Seed)
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(table, "RANK\tBOT\tWINS\tWIN RATE\t%g%% CI\tSWITCHED\n", r.Confidence*100)
+	for _, entry := range r.Entries {
+		fmt.Fprintf(table, "%d\t%s\t%d\t%.2f%%\t%.2f%% - %.2f%%\t%.0f%%\n",
+			entry.Rank, entry.Bot, entry.Wins, entry.WinRate*100,
+			entry.Lower*100, entry.Upper*100, float64(entry.Switches)/float64(entry.Games)*100)
+	}
+	if err := table.Flush(); err != nil {
+		return err
+	}
+
+	for _, entry := range r.Entries {
+		fmt.Fprintf(w, "\n%s: cumulative win rate\n", entry.Bot)
+		io.WriteString(w, convergenceChart(entry.Convergence, chartWidth, chartHeight))
+	}
+	return nil
+}
+
+// convergenceChart plots cumulative win rates from 0% to 100%, one column per
+// sample of the games
+func convergenceChart(rates []float64, width, height int) string {
+	if len(rates) == 0 {
+		return ""
+	}
+	width = min(width, len(rates))
+
+	rows := make([][]rune, height)
+	for i := range rows {
+		rows[i] = []rune(strings.Repeat(" ", width))
+	}
+	for x := range width {
+		rate := rates[(x+1)*len(rates)/width-1]
+		y := int(math.Round((1 - rate) * float64(height-1)))
+		rows[y][x] = '•'
+	}
+
+	var b strings.Builder
+	for i, row := range rows {
+		label := "    "
+		switch i {
+		case 0:
+			label = "100%"
+		case (height - 1) / 2:
+			label = " 50%"
+		case height - 1:
+			label = "  0%"
+		}
+		fmt.Fprintf(&b, "%s │%s\n", label, string(row))
+	}
+	fmt.Fprintf(&b, "     └%s\n", strings.Repeat("─", width))
+	fmt.Fprintf(&b, "      game 1%*s\n", width-6, fmt.Sprintf("game %d", len(rates)))
+	return b.String()
+}
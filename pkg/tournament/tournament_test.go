@@ -0,0 +1,88 @@
+package tournament
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/server"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// lookupAll returns the registered bots with the given names
+func lookupAll(t *testing.T, names ...string) []Strategy {
+	t.Helper()
+
+	var bots []Strategy
+	for _, name := range names {
+		bot, err := Lookup(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bots = append(bots, bot)
+	}
+	return bots
+}
+
+func TestTournamentUsesIdenticalDeals(t *testing.T) {
+	report, err := Run(Options{
+		Bots:       lookupAll(t, "stay", "switch"),
+		Games:      500,
+		NumDoors:   3,
+		Seed:       42,
+		Confidence: stats.DefaultConfidence,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	switched, stayed := report.Entries[0], report.Entries[1]
+	if switched.Bot != "switch" || switched.Rank != 1 || stayed.Rank != 2 {
+		t.Fatalf("Expected switch to rank first, got %+v", report.Entries)
+	}
+
+	// With three doors and the same first picks, switching wins exactly the
+	// deals staying loses
+	if switched.Wins+stayed.Wins != 500 {
+		t.Errorf("Expected the bots to play the same deals, got %d + %d wins", switched.Wins, stayed.Wins)
+	}
+	if switched.Switches != 500 || stayed.Switches != 0 {
+		t.Errorf("Unexpected switch counts %d and %d", switched.Switches, stayed.Switches)
+	}
+	if len(switched.Convergence) != 500 || switched.Convergence[499] != switched.WinRate {
+		t.Error("Expected the convergence to end at the final win rate")
+	}
+
+	again, _ := Run(Options{Bots: lookupAll(t, "stay", "switch"), Games: 500, NumDoors: 3, Seed: 42, Confidence: stats.DefaultConfidence})
+	if again.Entries[0].Wins != switched.Wins {
+		t.Error("Expected the same seed to replay the same tournament")
+	}
+}
+
+func TestAPIBot(t *testing.T) {
+	// An API bot that always picks the highest available door
+	bot := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var turn Turn
+		if err := json.NewDecoder(r.Body).Decode(&turn); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(server.ChooseRequest{Door: turn.Available[len(turn.Available)-1]})
+	}))
+	defer bot.Close()
+
+	bots := append(lookupAll(t, "random"), NewAPIBot(bot.URL))
+	report, err := Run(Options{Bots: bots, Games: 20, NumDoors: 4, Seed: 1, Confidence: stats.DefaultConfidence})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(report.Entries))
+	}
+
+	if _, err := Lookup("nobody"); !errors.Is(err, ErrUnknownBot) {
+		t.Errorf("Expected ErrUnknownBot, got %v", err)
+	}
+}
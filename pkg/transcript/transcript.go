@@ -0,0 +1,119 @@
+// Package transcript records one run of the app as it happens: every game,
+// each choice in it and how far into the game it was made, so the session can
+// be read back or saved as text. Unlike statistics, nothing is aggregated and
+// nothing is kept after the app exits unless the transcript is saved
+package transcript
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+)
+
+// Kind classifies an entry
+type Kind string
+
+const (
+	KindStart  Kind = "start"  // A game began
+	KindChoice Kind = "choice" // The player chose a door, first or final
+	KindHost   Kind = "host"   // The host opened doors
+	KindResult Kind = "result" // The game ended
+)
+
+// Entry is one step of a game
+type Entry struct {
+	Time    time.Time     // Wall clock when it happened
+	Game    int           // Game number in the session, from 1
+	Elapsed time.Duration // Time into the game, leaving out pauses
+	Kind    Kind
+	Text    string
+}
+
+// String formats the entry as a transcript line
+func (e Entry) String() string {
+	return fmt.Sprintf("%s  Game %d  %6s  %s", e.Time.Format(time.TimeOnly), e.Game, formatElapsed(e.Elapsed), e.Text)
+}
+
+// formatElapsed formats time into a game to a tenth of a second
+func formatElapsed(d time.Duration) string {
+	return fmt.Sprintf("+%.1fs", d.Seconds())
+}
+
+// Transcript is the entries of one session, oldest first
+type Transcript struct {
+	Started time.Time
+	entries []Entry
+	games   int
+}
+
+// New starts an empty transcript for a session that began at started
+func New(started time.Time) *Transcript {
+	return &Transcript{Started: started}
+}
+
+// StartGame numbers a new game and records its start
+func (t *Transcript) StartGame(at time.Time, text string) {
+	t.games++
+	t.entries = append(t.entries, Entry{Time: at, Game: t.games, Kind: KindStart, Text: text})
+}
+
+// Add records a step of the current game
+func (t *Transcript) Add(at time.Time, elapsed time.Duration, kind Kind, text string) {
+	t.entries = append(t.entries, Entry{Time: at, Game: t.games, Elapsed: elapsed, Kind: kind, Text: text})
+}
+
+// Entries returns the recorded entries, oldest first
+func (t *Transcript) Entries() []Entry {
+	return slices.Clone(t.entries)
+}
+
+// Len returns how many entries are recorded
+func (t *Transcript) Len() int {
+	return len(t.entries)
+}
+
+// Games returns how many games were started
+func (t *Transcript) Games() int {
+	return t.games
+}
+
+// WriteText writes the transcript as plain text, one entry per line under a
+// short header
+func (t *Transcript) WriteText(w io.Writer) error {
+	var b strings.Builder
+	games := "games"
+	if t.games == 1 {
+		games = "game"
+	}
+	b.WriteString("Monty Hall session transcript\n")
+	fmt.Fprintf(&b, "Session started %s, %d %s\n\n", t.Started.Format(time.DateTime), t.games, games)
+	for _, entry := range t.entries {
+		b.WriteString(entry.String() + "\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// Save writes the transcript as text to path
+func (t *Transcript) Save(path string) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	var b strings.Builder
+	if err := t.WriteText(&b); err != nil {
+		return fmt.Errorf("failed to format transcript: %w", err)
+	}
+
+	if err := chaos.WriteFile(path, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write transcript: %w", err)
+	}
+	return nil
+}
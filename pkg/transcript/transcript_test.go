@@ -0,0 +1,51 @@
+package transcript
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+
+func TestTranscriptNumbersGames(t *testing.T) {
+	tr := New(start)
+	tr.StartGame(start, "Started with 3 doors")
+	tr.Add(start.Add(2*time.Second), 2100*time.Millisecond, KindChoice, "Chose door 2")
+	tr.StartGame(start.Add(time.Minute), "Started with 3 doors")
+	tr.Add(start.Add(time.Minute+time.Second), time.Second, KindChoice, "Chose door 1")
+
+	entries := tr.Entries()
+	if tr.Games() != 2 || len(entries) != 4 {
+		t.Fatalf("Expected 2 games in 4 entries, got %d in %d", tr.Games(), len(entries))
+	}
+	if entries[1].Game != 1 || entries[3].Game != 2 || entries[3].Kind != KindChoice {
+		t.Errorf("Expected each step numbered with its game, got %+v", entries)
+	}
+	if line := entries[1].String(); line != "10:00:02  Game 1   +2.1s  Chose door 2" {
+		t.Errorf("Unexpected entry line %q", line)
+	}
+}
+
+func TestTranscriptSave(t *testing.T) {
+	tr := New(start)
+	tr.StartGame(start, "Started with 3 doors")
+
+	path := filepath.Join(t.TempDir(), "exports", "transcript.txt")
+	if err := tr.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := string(data)
+	if !strings.HasPrefix(text, "Monty Hall session transcript\nSession started 2026-01-02 10:00:00, 1 game\n\n") {
+		t.Errorf("Expected a header naming the session, got %q", text)
+	}
+	if !strings.Contains(text, "Game 1") || !strings.HasSuffix(text, "Started with 3 doors\n") {
+		t.Errorf("Expected the entry on its own line, got %q", text)
+	}
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+const (
+	// KeyAbort abandons the game in progress without recording a result
+	KeyAbort = "x"
+	// KeyUndo takes back the initial choice until the host opens doors
+	KeyUndo = "u"
+)
+
+// canAbort reports whether the game in progress can be abandoned. Daily
+// challenge games are shared, so they are played through
+func (m *Model) canAbort() bool {
+	if m.Game == nil || m.IsRevealing || m.Daily != nil {
+		return false
+	}
+	switch m.Game.Phase {
+	case game.InitialChoice, game.HostReveal, game.FinalChoice:
+		return true
+	}
+	return false
+}
+
+// canUndo reports whether the initial choice can be taken back: only while
+// the host pauses before opening doors, since the doors they open tell where
+// the car isn't. Daily challenge games must replay from their seed, so they
+// allow no undo
+func (m *Model) canUndo() bool {
+	if m.Game == nil || m.IsRevealing || m.Daily != nil {
+		return false
+	}
+	return m.Game.Phase == game.HostReveal && len(m.Game.HostOpenedDoors) == 0
+}
+
+// confirmAbort asks before abandoning the game in progress
+func (m *Model) confirmAbort() (tea.Model, tea.Cmd) {
+	if !m.canAbort() {
+		return m, nil
+	}
+
+	dialog := NewConfirmDialog("Abandon this game?", []string{"No result will be recorded"}, func() tea.Cmd {
+		m.abortGame()
+		return nil
+	})
+	dialog.ConfirmLabel = "Abandon"
+	dialog.CancelLabel = "Keep playing"
+	m.Dialog = dialog
+	return m, nil
+}
+
+// abortGame abandons the game in progress and deals a new one in its place
+func (m *Model) abortGame() {
+	if err := m.Game.Abort(); err != nil {
+		m.ErrorMessage = err.Error()
+		return
+	}
+	m.AutoChoiceFor = nil
+
+	// The new game replaces this one before the transcript sees the abort
+	m.recordTranscript()
+	m.startNewGame()
+	m.SuccessMessage = "Game abandoned; no result was recorded"
+}
+
+// undoInitialChoice takes back the initial choice, leaving the cursor on the
+// door that was picked
+func (m *Model) undoInitialChoice() (tea.Model, tea.Cmd) {
+	if !m.canUndo() {
+		return m, nil
+	}
+
+	door := m.Game.PlayerInitialChoice
+	if err := m.Game.UndoInitialChoice(); err != nil {
+		m.ErrorMessage = err.Error()
+		return m, nil
+	}
+	m.AutoChoiceFor = nil
+	m.stopAnimations()
+	m.DoorCursor = door
+	return m, nil
+}
+
+// renderAbortHint names the undo and abandon keys while they apply, or
+// leaves an empty line
+func (m *Model) renderAbortHint() string {
+	var hint string
+	switch {
+	case m.canUndo():
+		hint = fmt.Sprintf("Press '%s' to take back your first pick or '%s' to abandon the game", KeyUndo, KeyAbort)
+	case m.canAbort():
+		hint = fmt.Sprintf("Press '%s' to abandon the game", KeyAbort)
+	default:
+		return ""
+	}
+	return Center(MutedStyle.Render(hint), m.Width, 1)
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/transcript"
+)
+
+func TestAbortGame(t *testing.T) {
+	freezeUI(t)
+	m := newSnapshotModel(t)
+	m.CurrentView = GameView
+	m.Game = game.NewSeededGame(1)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	played := m.StatsManager.GetStats().TotalGames
+
+	abandoned := m.Game
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyAbort)})
+	if m.Dialog == nil {
+		t.Fatal("Expected abandoning a game to ask first")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.Game != abandoned || m.Game.Phase != game.FinalChoice {
+		t.Fatal("Expected cancelling to keep playing the same game")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyAbort)})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if abandoned.Phase != game.Aborted || abandoned.Result != nil {
+		t.Errorf("Expected the game abandoned without a result, phase %v", abandoned.Phase)
+	}
+	if m.Game == abandoned || m.Game.Phase != game.InitialChoice {
+		t.Error("Expected a new game in place of the abandoned one")
+	}
+	if got := m.StatsManager.GetStats().TotalGames; got != played {
+		t.Errorf("Expected no result recorded, games played went from %d to %d", played, got)
+	}
+
+	entries := m.Transcript.Entries()
+	if last := entries[len(entries)-2]; last.Kind != transcript.KindResult || last.Text != "Abandoned without a result" {
+		t.Errorf("Expected the transcript to record the abandoned game, got %+v", last)
+	}
+
+	// A finished game has nothing to abandon
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("1")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyAbort)})
+	if m.Dialog != nil {
+		t.Error("Expected no way to abandon a finished game")
+	}
+}
+
+func TestUndoInitialChoice(t *testing.T) {
+	freezeUI(t)
+	m := newSnapshotModel(t)
+	m.ShowAnimations = true
+	m.CurrentView = GameView
+	m.Game = game.NewSeededGame(1)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(plainText(m.View()), "take back your first pick") {
+		t.Error("Expected the host's pause to offer taking back the first pick")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyUndo)})
+	if m.Game.Phase != game.InitialChoice || m.Game.PlayerInitialChoice != -1 {
+		t.Fatalf("Expected the game back at the initial choice, phase %v", m.Game.Phase)
+	}
+	if m.DoorCursor != 1 {
+		t.Errorf("Expected the cursor left on door 2, got door %d", m.DoorCursor+1)
+	}
+
+	// The pause for the pick taken back no longer opens doors
+	stale := m.HostRevealSeq
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(HostRevealMsg{Seq: stale})
+	if m.Game.Phase != game.HostReveal || m.Game.PlayerInitialChoice != 2 {
+		t.Fatalf("Expected a new first pick waiting on the host, got phase %v, door %d", m.Game.Phase, m.Game.PlayerInitialChoice+1)
+	}
+
+	// Once the host has opened a door, it would give the car away
+	m.Update(HostRevealMsg{Seq: m.HostRevealSeq})
+	if len(m.Game.HostOpenedDoors) == 0 {
+		t.Fatal("Expected the host to open doors after the pause")
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyUndo)})
+	if m.Game.Phase != game.FinalChoice || m.Game.PlayerInitialChoice != 2 {
+		t.Errorf("Expected no take-back after the host's reveal, got phase %v", m.Game.Phase)
+	}
+	if strings.Contains(plainText(m.View()), "take back") {
+		t.Error("Expected no take-back offered after the host's reveal")
+	}
+
+	var texts []string
+	for _, entry := range m.Transcript.Entries() {
+		texts = append(texts, entry.Text)
+	}
+	if texts[1] != "Chose door 2" || texts[2] != "Took back the first pick" || texts[3] != "Chose door 3" {
+		t.Errorf("Expected the transcript to record the take-back, got %q", texts)
+	}
+
+	// During the reveal the final choice stands too
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyUndo)})
+	if !m.IsRevealing || m.Game.Phase != game.GameOver {
+		t.Errorf("Expected no take-back during the reveal, got phase %v", m.Game.Phase)
+	}
+}
+
+func TestAbortAndUndoUnavailableInDaily(t *testing.T) {
+	freezeUI(t)
+	m := newSnapshotModel(t)
+	m.CurrentView = GameView
+	m.Daily = game.NewDailyChallenge(now())
+	m.Game = m.Daily.NextGame()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyAbort)})
+	if m.Dialog != nil {
+		t.Error("Expected daily challenge games to be played through")
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyUndo)})
+	if m.Game.Phase != game.FinalChoice {
+		t.Error("Expected no take-back in a daily challenge")
+	}
+}
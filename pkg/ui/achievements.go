@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/achievements"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// ToastDuration is how long a toast notification stays on screen
+const ToastDuration = 4 * time.Second
+
+// Toast is a short notification shown over the top of every view
+type Toast struct {
+	Text      string
+	Until     time.Time
+	scheduled bool // Its expiry timer has been started
+}
+
+// ToastExpiredMsg is sent when a toast may have timed out
+type ToastExpiredMsg struct{}
+
+// newAchievementTracker loads the achievements kept beside the statistics
+// file and tracks them as games are recorded. Stores without a file keep
+// achievements for the session only
+func newAchievementTracker(statsManager *stats.StatsManager) *achievements.Tracker {
+	tracker := achievements.NewTracker("")
+	if file, ok := statsManager.Store().(stats.FileStore); ok {
+		path := filepath.Join(filepath.Dir(file.GetFilePath()), achievements.DefaultFileName)
+		if statsManager.IsReadOnly() {
+			tracker = achievements.NewReadOnlyTracker(path)
+		} else {
+			tracker = achievements.NewTracker(path)
+		}
+	}
+	if err := tracker.Load(); err != nil {
+		// Start with nothing unlocked rather than failing; the file is rewritten on the next unlock
+		tracker = achievements.NewTracker(tracker.GetFilePath())
+	}
+
+	// Games played before achievements existed unlock them without fanfare
+	tracker.Evaluate(statsManager.GetStats().GameHistory)
+	tracker.TakeUnlocked()
+
+	statsManager.AddRecordHook(tracker.Hook())
+	return tracker
+}
+
+// announceAchievements shows a toast for achievements unlocked since the last
+// call. They wait while a reveal is running so they don't spoil the outcome
+func (m *Model) announceAchievements() {
+	if m.Achievements == nil || m.IsRevealing {
+		return
+	}
+
+	unlocked := m.Achievements.TakeUnlocked()
+	for _, achievement := range unlocked {
+		m.logEvent(eventlog.KindAchievement, fmt.Sprintf("%s Achievement unlocked: %s - %s", achievement.Icon, achievement.Name, achievement.Description))
+	}
+
+	switch len(unlocked) {
+	case 0:
+		return
+	case 1:
+		m.showToast(fmt.Sprintf("%s Achievement unlocked: %s - %s", unlocked[0].Icon, unlocked[0].Name, unlocked[0].Description))
+	default:
+		names := make([]string, len(unlocked))
+		for i, achievement := range unlocked {
+			names[i] = achievement.Icon + " " + achievement.Name
+		}
+		m.showToast(fmt.Sprintf("%d achievements unlocked: %s", len(unlocked), strings.Join(names, ", ")))
+	}
+}
+
+// showToast shows a notification for ToastDuration
+func (m *Model) showToast(text string) {
+	m.Toast = &Toast{Text: text, Until: now().Add(ToastDuration)}
+}
+
+// scheduleToast starts the expiry timer of a newly shown toast
+func (m *Model) scheduleToast() tea.Cmd {
+	if m.Toast == nil || m.Toast.scheduled {
+		return nil
+	}
+	m.Toast.scheduled = true
+	return tea.Tick(m.Toast.Until.Sub(now()), func(time.Time) tea.Msg {
+		return ToastExpiredMsg{}
+	})
+}
+
+// expireToast hides the toast once its time is up
+func (m *Model) expireToast() {
+	if m.Toast != nil && !now().Before(m.Toast.Until) {
+		m.Toast = nil
+	}
+}
+
+// withToast shows the toast on a line above the view
+func (m *Model) withToast(view string) string {
+	if m.Toast == nil || !now().Before(m.Toast.Until) {
+		return view
+	}
+	return CenterHorizontal(ToastStyle.Render(" "+m.Toast.Text+" "), m.Width) + "\n" + view
+}
+
+// renderAchievementsPage renders the statistics page listing every
+// achievement and when it was unlocked
+func (m *Model) renderAchievementsPage(content []string) []string {
+	statuses := m.Achievements.Statuses()
+	unlocked := 0
+	for _, status := range statuses {
+		if status.UnlockedAt != nil {
+			unlocked++
+		}
+	}
+
+	content = append(content, Center(StatsHeaderStyle.Render("🏅 ACHIEVEMENTS"), m.Width, 1))
+	content = append(content, Center(MutedStyle.Render(fmt.Sprintf("%d of %d unlocked", unlocked, len(statuses))), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	var rows []string
+	for _, status := range statuses {
+		rows = append(rows, NewAchievementBadge(status).Render())
+	}
+	content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, rows...), m.Width, 1))
+
+	content = append(content, m.statsFooter(false))
+
+	return content
+}
+
+// AchievementBadge component shows an achievement, dimmed while locked
+type AchievementBadge struct {
+	Status achievements.Status
+}
+
+// NewAchievementBadge creates a new achievement badge component
+func NewAchievementBadge(status achievements.Status) *AchievementBadge {
+	return &AchievementBadge{Status: status}
+}
+
+// Render renders the achievement badge
+func (b *AchievementBadge) Render() string {
+	if b.Status.UnlockedAt == nil {
+		title := MutedStyle.Render("🔒 " + b.Status.Name)
+		return lipgloss.JoinVertical(lipgloss.Left, title, "   "+MutedStyle.Render(b.Status.Description))
+	}
+
+	title := lipgloss.NewStyle().Foreground(SecondaryColor).Bold(true).Render(b.Status.Icon + " " + b.Status.Name)
+	detail := fmt.Sprintf("%s · unlocked %s", b.Status.Description, b.Status.UnlockedAt.Format("2006-01-02"))
+	return lipgloss.JoinVertical(lipgloss.Left, title, "   "+StatsLabelStyle.Render(detail))
+}
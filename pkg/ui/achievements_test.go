@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestAchievementToast(t *testing.T) {
+	clock := freezeUI(t)
+
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(g.CarPosition)
+	g.StayWithChoice()
+	if err := model.StatsManager.RecordGame(g.Result); err != nil {
+		t.Fatalf("Failed to record game: %v", err)
+	}
+
+	// The toast waits for the reveal to finish
+	model.IsRevealing = true
+	model.Update(ToastExpiredMsg{})
+	if model.Toast != nil {
+		t.Fatal("Expected no toast during the reveal")
+	}
+
+	model.IsRevealing = false
+	_, cmd := model.Update(ToastExpiredMsg{})
+	if model.Toast == nil || cmd == nil {
+		t.Fatal("Expected a toast with an expiry timer after the reveal")
+	}
+	if view := model.View(); !strings.Contains(view, "Achievement unlocked: First Win") {
+		t.Errorf("Expected the toast in the view:\n%s", view)
+	}
+
+	clock.Advance(ToastDuration)
+	model.Update(ToastExpiredMsg{})
+	if model.Toast != nil || strings.Contains(model.View(), "Achievement unlocked") {
+		t.Error("Expected the toast to disappear after ToastDuration")
+	}
+
+	if matches, _ := filepath.Glob(filepath.Join(dir, "monty_hall_achievements.json")); len(matches) != 1 {
+		t.Error("Expected achievements to be saved beside the statistics file")
+	}
+}
@@ -0,0 +1,135 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// analyticsBarWidth is the width of the longest weekday bar
+const analyticsBarWidth = 20
+
+// weekdayOrder lists the weekdays from Monday, as the analytics page shows them
+var weekdayOrder = []time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+}
+
+// renderAnalyticsPage renders the statistics page breaking games down by the
+// weekday and hour they were played
+func (m *Model) renderAnalyticsPage(content []string) []string {
+	gameStats := m.viewedStats()
+	times := stats.PlayTimesOf(gameStats.GameHistory)
+
+	content = append(content, Center(StatsHeaderStyle.Render("📅 WHEN YOU PLAY"), m.Width, 1))
+	content = append(content, Center(MutedStyle.Render(fmt.Sprintf("Your %d games by weekday and hour", len(gameStats.GameHistory))), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	rows := make([]string, len(weekdayOrder))
+	grid := make([][]int, len(weekdayOrder))
+	for i, day := range weekdayOrder {
+		rows[i] = day.String()[:3]
+		grid[i] = make([]int, 24)
+		for hour, slot := range times.Grid[day] {
+			grid[i][hour] = slot.Games
+		}
+	}
+	content = append(content, Center(NewHeatmap(rows, grid).Render(), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	mostGames := 1
+	for _, day := range times.ByWeekday {
+		mostGames = max(mostGames, day.Games)
+	}
+	var weekdays []string
+	for _, day := range weekdayOrder {
+		slot := times.ByWeekday[day]
+		filled := slot.Games * analyticsBarWidth / mostGames
+		bar := strings.Repeat("█", filled) + strings.Repeat("░", analyticsBarWidth-filled)
+		line := fmt.Sprintf("%s %s %4d games", day.String()[:3], lipgloss.NewStyle().Foreground(PrimaryColor).Render(bar), slot.Games)
+		if slot.Games > 0 {
+			line += fmt.Sprintf("  %5.1f%% won", slot.WinRate()*100)
+		}
+		weekdays = append(weekdays, StatsLabelStyle.Render(line))
+	}
+	content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, weekdays...), m.Width, 1))
+
+	content = append(content, Spacer(1))
+	weekday, hour := times.Busiest()
+	highlights := []string{fmt.Sprintf("Most played: %ss at %02d:00", weekday, hour)}
+	if best, ok := times.BestWeekday(); ok {
+		highlights = append(highlights, fmt.Sprintf("best day: %s (%.0f%%)", best, times.ByWeekday[best].WinRate()*100))
+	}
+	if best, ok := times.BestHour(); ok {
+		highlights = append(highlights, fmt.Sprintf("best hour: %02d:00 (%.0f%%)", best, times.ByHour[best].WinRate()*100))
+	}
+	content = append(content, Center(SuccessStyle.Render(strings.Join(highlights, " • ")), m.Width, 1))
+	content = append(content, Center(MutedStyle.Render("The doors don't know what time it is: any differences in win rate are chance."), m.Width, 1))
+
+	content = append(content, m.statsFooter(true))
+
+	return content
+}
+
+// heatmapShades are the cells of a heatmap, from no games to the most
+var heatmapShades = []string{"··", "░░", "▒▒", "▓▓", "██"}
+
+// Heatmap component shows counts over a grid of rows and the 24 hours of the
+// day, shading each cell by its count
+type Heatmap struct {
+	Rows   []string // Row labels, three characters wide
+	Values [][]int  // Counts by row, then hour
+}
+
+// NewHeatmap creates a new heatmap component
+func NewHeatmap(rows []string, values [][]int) *Heatmap {
+	return &Heatmap{Rows: rows, Values: values}
+}
+
+// Render renders the heatmap, with the hours marked every six across the top
+// and a legend below
+func (h *Heatmap) Render() string {
+	most := 0
+	for _, row := range h.Values {
+		for _, value := range row {
+			most = max(most, value)
+		}
+	}
+
+	axisStyle := lipgloss.NewStyle().Foreground(MutedColor)
+	cellStyle := lipgloss.NewStyle().Foreground(PrimaryColor)
+
+	var axis strings.Builder
+	axis.WriteString("    ")
+	for hour := 0; hour < 24; hour += 6 {
+		axis.WriteString(fmt.Sprintf("%-12s", fmt.Sprintf("%02d", hour)))
+	}
+	lines := []string{axisStyle.Render(axis.String())}
+
+	for i, label := range h.Rows {
+		var line strings.Builder
+		line.WriteString(StatsLabelStyle.Render(fmt.Sprintf("%-3s ", label)))
+		for _, value := range h.Values[i] {
+			shade := 0
+			if value > 0 {
+				// Any games at all get at least the lightest shade
+				shade = 1 + (value-1)*(len(heatmapShades)-2)/max(most-1, 1)
+			}
+			style := cellStyle
+			if shade == 0 {
+				style = axisStyle
+			}
+			line.WriteString(style.Render(heatmapShades[shade]))
+		}
+		lines = append(lines, line.String())
+	}
+
+	legend := fmt.Sprintf("    %s none  %s fewer  %s more  %s most (%d games)",
+		axisStyle.Render(heatmapShades[0]), cellStyle.Render(heatmapShades[1]),
+		cellStyle.Render(heatmapShades[3]), cellStyle.Render(heatmapShades[4]), most)
+	lines = append(lines, MutedStyle.Render(legend))
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeatmap(t *testing.T) {
+	values := [][]int{make([]int, 24), make([]int, 24)}
+	values[0][9] = 1
+	values[1][21] = 4
+	lines := strings.Split(NewHeatmap([]string{"Mon", "Tue"}, values).Render(), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Expected an axis, two rows and a legend, got %d lines", len(lines))
+	}
+
+	// Count columns, not bytes, to find each cell
+	mon := []rune(plainText(lines[1]))
+	tue := []rune(plainText(lines[2]))
+	if string(mon[:4]) != "Mon " || string(mon[4+9*2:4+10*2]) != "░░" {
+		t.Errorf("Expected the lightest shade at 09:00 on Monday, got %q", string(mon))
+	}
+	if string(tue[4+21*2:4+22*2]) != "██" {
+		t.Errorf("Expected the darkest shade at 21:00 on Tuesday, got %q", string(tue))
+	}
+	if !strings.Contains(lines[3], "most (4 games)") {
+		t.Errorf("Expected the legend to give the most games, got %q", lines[3])
+	}
+}
@@ -48,6 +48,59 @@ var (
 	}
 )
 
+// Frame rates used by the built-in animations
+const (
+	DefaultFrameRate  = 60 // Frames per second for animations that do not set their own
+	DoorOpenFrameRate = 30
+	PulseFrameRate    = 10 // Slow pulses look the same at a low rate
+	ParticleFrameRate = 30
+	DefaultMaxFPS     = 30 // Global cap on animation ticks
+)
+
+// AnimationSpeed is how fast the reveal and the animations play, following
+// the ui.animation_speed setting
+type AnimationSpeed int
+
+const (
+	SpeedInstant AnimationSpeed = iota // Results at once, without pauses or animations
+	SpeedSlow
+	SpeedNormal
+	SpeedFast
+)
+
+// Timings at normal speed, scaled by the animation speed
+const (
+	RevealDelay      = 2 * time.Second        // Pause before the result is shown
+	HostRevealDelay  = time.Second            // Pause before the host opens doors, while the first pick can be taken back
+	DoorOpenDuration = 800 * time.Millisecond // Door opening animation
+	PulseDuration    = time.Second            // One beat of the winning door's pulse
+)
+
+// Scale returns how long something lasting d at normal speed takes at this speed
+func (s AnimationSpeed) Scale(d time.Duration) time.Duration {
+	switch s {
+	case SpeedInstant:
+		return 0
+	case SpeedSlow:
+		return d * 3 / 2
+	case SpeedFast:
+		return d / 2
+	}
+	return d
+}
+
+// FrameRate returns the frame rate of an animation running at fps at normal
+// speed, so that it shows the same frames at any speed
+func (s AnimationSpeed) FrameRate(fps int) int {
+	switch s {
+	case SpeedSlow:
+		return fps * 2 / 3
+	case SpeedFast:
+		return fps * 2
+	}
+	return fps
+}
+
 // AnimationState represents the current state of an animation
 type AnimationState int
 
@@ -70,25 +123,41 @@ type Animation struct {
 	OnComplete func()
 	Loop       bool
 	Reverse    bool
+	FrameRate  int // Updates per second this animation needs
 }
 
 // NewAnimation creates a new animation with the given parameters
 func NewAnimation(id string, duration time.Duration, easing EasingFunction) *Animation {
 	return &Animation{
-		ID:       id,
-		Duration: duration,
-		Easing:   easing,
-		State:    AnimationStopped,
-		Progress: 0.0,
-		Loop:     false,
-		Reverse:  false,
+		ID:        id,
+		Duration:  duration,
+		Easing:    easing,
+		State:     AnimationStopped,
+		Progress:  0.0,
+		Loop:      false,
+		Reverse:   false,
+		FrameRate: DefaultFrameRate,
 	}
 }
 
+// frameInterval returns the time between updates this animation needs
+func (a *Animation) frameInterval() time.Duration {
+	if a.FrameRate <= 0 {
+		return time.Second / DefaultFrameRate
+	}
+	return time.Second / time.Duration(a.FrameRate)
+}
+
+// SetSpeed scales the animation's duration and tick cadence to the speed
+func (a *Animation) SetSpeed(speed AnimationSpeed) {
+	a.Duration = speed.Scale(a.Duration)
+	a.FrameRate = speed.FrameRate(a.FrameRate)
+}
+
 // Start begins the animation
 func (a *Animation) Start() {
 	a.State = AnimationRunning
-	a.StartTime = time.Now()
+	a.StartTime = now()
 	a.Progress = 0.0
 }
 
@@ -105,7 +174,7 @@ func (a *Animation) Resume() {
 		a.State = AnimationRunning
 		// Adjust start time to account for pause duration
 		elapsed := time.Duration(a.Progress * float64(a.Duration))
-		a.StartTime = time.Now().Add(-elapsed)
+		a.StartTime = now().Add(-elapsed)
 	}
 }
 
@@ -121,7 +190,7 @@ func (a *Animation) Update() bool {
 		return false
 	}
 
-	elapsed := time.Since(a.StartTime)
+	elapsed := now().Sub(a.StartTime)
 	rawProgress := float64(elapsed) / float64(a.Duration)
 
 	if rawProgress >= 1.0 {
@@ -172,9 +241,11 @@ func (a *Animation) IsComplete() bool {
 
 // AnimationManager manages multiple animations
 type AnimationManager struct {
-	animations map[string]*Animation
-	ticker     *time.Ticker
-	running    bool
+	animations    map[string]*Animation
+	ticker        *time.Ticker
+	running       bool
+	maxFPS        int  // Global cap on ticks per second
+	tickScheduled bool // A tick is in flight; further requests are coalesced into it
 }
 
 // NewAnimationManager creates a new animation manager
@@ -182,7 +253,16 @@ func NewAnimationManager() *AnimationManager {
 	return &AnimationManager{
 		animations: make(map[string]*Animation),
 		running:    false,
+		maxFPS:     DefaultMaxFPS,
+	}
+}
+
+// SetMaxFPS sets the global cap on animation ticks per second
+func (am *AnimationManager) SetMaxFPS(fps int) {
+	if fps <= 0 {
+		fps = DefaultMaxFPS
 	}
+	am.maxFPS = fps
 }
 
 // AddAnimation adds an animation to the manager
@@ -215,14 +295,20 @@ func (am *AnimationManager) StopAnimation(id string) {
 	}
 }
 
-// StopAllAnimations stops all running animations
-func (am *AnimationManager) StopAllAnimations() {
-	for _, anim := range am.animations {
+// StopAll stops every animation and removes it from the manager
+func (am *AnimationManager) StopAll() {
+	for id, anim := range am.animations {
 		anim.Stop()
+		delete(am.animations, id)
 	}
 	am.stop()
 }
 
+// AnimationCount returns the number of animations held by the manager
+func (am *AnimationManager) AnimationCount() int {
+	return len(am.animations)
+}
+
 // Update updates all running animations
 func (am *AnimationManager) Update() tea.Cmd {
 	if !am.running {
@@ -230,9 +316,12 @@ func (am *AnimationManager) Update() tea.Cmd {
 	}
 
 	hasRunning := false
-	for _, anim := range am.animations {
+	for id, anim := range am.animations {
 		if anim.Update() {
 			hasRunning = true
+		} else if anim.IsComplete() {
+			// Finished animations are not restarted, so drop them
+			delete(am.animations, id)
 		}
 	}
 
@@ -241,12 +330,43 @@ func (am *AnimationManager) Update() tea.Cmd {
 		return nil
 	}
 
+	// Only one tick is in flight at a time, however many animations asked for one
+	if am.tickScheduled {
+		return nil
+	}
+	am.tickScheduled = true
+
 	// Return a command to trigger the next update
-	return tea.Tick(time.Millisecond*16, func(t time.Time) tea.Msg {
+	return tea.Tick(am.tickInterval(), func(t time.Time) tea.Msg {
 		return AnimationTickMsg{Time: t}
 	})
 }
 
+// HandleTick processes an animation tick and schedules the next one if needed
+func (am *AnimationManager) HandleTick() tea.Cmd {
+	am.tickScheduled = false
+	return am.Update()
+}
+
+// tickInterval returns the interval needed by the fastest running animation,
+// limited by the global FPS cap
+func (am *AnimationManager) tickInterval() time.Duration {
+	var interval time.Duration
+	for _, anim := range am.animations {
+		if anim.IsRunning() && (interval == 0 || anim.frameInterval() < interval) {
+			interval = anim.frameInterval()
+		}
+	}
+	if interval == 0 {
+		interval = time.Second / DefaultFrameRate
+	}
+
+	if minInterval := time.Second / time.Duration(am.maxFPS); interval < minInterval {
+		interval = minInterval
+	}
+	return interval
+}
+
 // ensureRunning starts the animation loop if not already running
 func (am *AnimationManager) ensureRunning() {
 	if !am.running {
@@ -280,35 +400,31 @@ type DoorOpenAnimation struct {
 	DoorIndex int
 	Frames    []string
 	Colors    []lipgloss.Color
+	Shakes    []int // Columns each frame jolts the door sideways
 }
 
-// NewDoorOpenAnimation creates a new door opening animation
+// NewDoorOpenAnimation creates a new door opening animation using the default pack
 func NewDoorOpenAnimation(doorIndex int) *DoorOpenAnimation {
-	frames := []string{
-		"🚪", // Closed
-		"🔓", // Unlocking
-		"📂", // Opening
-		"✨", // Revealing
-	}
+	return NewDoorOpenAnimationFromPack(doorIndex, defaultAnimationPack())
+}
 
-	colors := []lipgloss.Color{
-		DoorColor,
-		WarningColor,
-		PrimaryColor,
-		SecondaryColor,
-	}
+// NewDoorOpenAnimationFromPack creates a door opening animation with the door's frames from the pack
+func NewDoorOpenAnimationFromPack(doorIndex int, pack *AnimationPack) *DoorOpenAnimation {
+	frames, colors := pack.DoorFrames(doorIndex)
 
 	anim := NewAnimation(
 		"door_open_"+string(rune(doorIndex+'0')),
-		time.Millisecond*800,
+		DoorOpenDuration,
 		EaseInOut,
 	)
+	anim.FrameRate = DoorOpenFrameRate
 
 	return &DoorOpenAnimation{
 		Animation: anim,
 		DoorIndex: doorIndex,
 		Frames:    frames,
 		Colors:    colors,
+		Shakes:    pack.DoorShakes(doorIndex),
 	}
 }
 
@@ -318,11 +434,7 @@ func (doa *DoorOpenAnimation) GetCurrentFrame() (string, lipgloss.Color) {
 		return "🚪", DoorColor
 	}
 
-	frameIndex := int(doa.Progress * float64(len(doa.Frames)-1))
-	if frameIndex >= len(doa.Frames) {
-		frameIndex = len(doa.Frames) - 1
-	}
-
+	frameIndex := doa.frameIndex()
 	frame := doa.Frames[frameIndex]
 	color := DoorColor
 	if frameIndex < len(doa.Colors) {
@@ -332,24 +444,44 @@ func (doa *DoorOpenAnimation) GetCurrentFrame() (string, lipgloss.Color) {
 	return frame, color
 }
 
+// GetCurrentShake returns how far the current frame jolts the door sideways
+func (doa *DoorOpenAnimation) GetCurrentShake() int {
+	if frameIndex := doa.frameIndex(); frameIndex < len(doa.Shakes) {
+		return doa.Shakes[frameIndex]
+	}
+	return 0
+}
+
+// frameIndex returns the frame shown at the animation's progress
+func (doa *DoorOpenAnimation) frameIndex() int {
+	frameIndex := int(doa.Progress * float64(len(doa.Frames)-1))
+	if frameIndex >= len(doa.Frames) {
+		frameIndex = len(doa.Frames) - 1
+	}
+	return frameIndex
+}
+
 // PulseAnimation creates a pulsing effect
 type PulseAnimation struct {
 	*Animation
 	BaseStyle  lipgloss.Style
 	PulseColor lipgloss.Color
 	Intensity  float64
+	Effects    EffectsIntensity // Below full, the style holds steady instead of pulsing
 }
 
 // NewPulseAnimation creates a new pulse animation
 func NewPulseAnimation(id string, baseStyle lipgloss.Style, pulseColor lipgloss.Color) *PulseAnimation {
-	anim := NewAnimation(id, time.Millisecond*1000, EaseInOut)
+	anim := NewAnimation(id, PulseDuration, EaseInOut)
 	anim.Loop = true
+	anim.FrameRate = PulseFrameRate
 
 	return &PulseAnimation{
 		Animation:  anim,
 		BaseStyle:  baseStyle,
 		PulseColor: pulseColor,
 		Intensity:  0.3,
+		Effects:    EffectsFull,
 	}
 }
 
@@ -362,10 +494,7 @@ func (pa *PulseAnimation) GetCurrentStyle() lipgloss.Style {
 	}
 
 	// For simplicity, just toggle bold on/off for pulse effect
-	if intensity > 0.5 {
-		return pa.BaseStyle.Copy().Bold(true)
-	}
-	return pa.BaseStyle
+	return PulseStyle(pa.BaseStyle, intensity, pa.Effects)
 }
 
 // TypewriterAnimation creates a typewriter text effect
@@ -427,8 +556,13 @@ func NewParticleSystem(width, height int) *ParticleSystem {
 
 // AddWinningParticles adds celebration particles for winning
 func (ps *ParticleSystem) AddWinningParticles(centerX, centerY int) {
-	sparkles := []string{"✨", "⭐", "💫", "🌟", "✦", "✧", "🎉", "🎊"}
-	colors := []lipgloss.Color{CarColor, SparkleColor, GlowColor, SecondaryColor}
+	ps.AddCelebrationParticles(centerX, centerY, defaultAnimationPack())
+}
+
+// AddCelebrationParticles adds celebration particles in the pack's style
+func (ps *ParticleSystem) AddCelebrationParticles(centerX, centerY int, pack *AnimationPack) {
+	sparkles := pack.Celebration.Glyphs
+	colors := pack.CelebrationColors()
 
 	for i := 0; i < 20; i++ {
 		particle := Particle{
@@ -0,0 +1,229 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestAnimationManagerTickInterval(t *testing.T) {
+	am := NewAnimationManager()
+	am.SetMaxFPS(60)
+
+	pulse := NewPulseAnimation("pulse", lipgloss.NewStyle(), CarColor)
+	am.AddAnimation(pulse.Animation)
+	am.StartAnimation(pulse.ID)
+
+	if interval := am.tickInterval(); interval != time.Second/PulseFrameRate {
+		t.Errorf("Pulse alone should tick at %d fps, got interval %v", PulseFrameRate, interval)
+	}
+
+	door := NewDoorOpenAnimation(0)
+	am.AddAnimation(door.Animation)
+	am.StartAnimation(door.ID)
+
+	if interval := am.tickInterval(); interval != time.Second/DoorOpenFrameRate {
+		t.Errorf("Fastest animation should set the rate, got interval %v", interval)
+	}
+
+	am.SetMaxFPS(5)
+	if interval := am.tickInterval(); interval != time.Second/5 {
+		t.Errorf("Global FPS cap should limit the rate, got interval %v", interval)
+	}
+}
+
+func TestAnimationManagerCoalescesTicks(t *testing.T) {
+	am := NewAnimationManager()
+
+	first := NewAnimation("first", time.Second, EaseLinear)
+	second := NewAnimation("second", time.Second, EaseLinear)
+	am.AddAnimation(first)
+	am.AddAnimation(second)
+
+	am.StartAnimation(first.ID)
+	if cmd := am.Update(); cmd == nil {
+		t.Fatal("Starting an animation should schedule a tick")
+	}
+
+	am.StartAnimation(second.ID)
+	if cmd := am.Update(); cmd != nil {
+		t.Error("A second request should be coalesced into the pending tick")
+	}
+
+	if cmd := am.HandleTick(); cmd == nil {
+		t.Error("Handling a tick should schedule the next one while animations run")
+	}
+}
+
+func TestAnimationManagerRemovesCompletedAnimations(t *testing.T) {
+	am := NewAnimationManager()
+
+	anim := NewAnimation("short", time.Millisecond, EaseLinear)
+	am.AddAnimation(anim)
+	am.StartAnimation(anim.ID)
+
+	time.Sleep(5 * time.Millisecond)
+	am.HandleTick()
+
+	if am.AnimationCount() != 0 {
+		t.Errorf("Completed animation should be removed, %d remain", am.AnimationCount())
+	}
+}
+
+func TestAnimationManagerStopAll(t *testing.T) {
+	am := NewAnimationManager()
+
+	pulse := NewPulseAnimation("pulse", lipgloss.NewStyle(), CarColor)
+	am.AddAnimation(pulse.Animation)
+	am.StartAnimation(pulse.ID)
+
+	am.StopAll()
+
+	if am.AnimationCount() != 0 || am.HasRunningAnimations() {
+		t.Error("StopAll should stop and remove every animation, including looping pulses")
+	}
+}
+
+func TestAnimationsDoNotLeakAcrossGames(t *testing.T) {
+	model := NewModel()
+
+	for i := 0; i < 3; i++ {
+		model.startNewGame()
+		model.CurrentView = GameView
+
+		model.startDoorOpenAnimation(0)
+		model.startWinningAnimation()
+		if model.AnimationManager.AnimationCount() == 0 {
+			t.Fatal("Expected animations to be running during the game")
+		}
+	}
+
+	model.startNewGame()
+	if count := model.AnimationManager.AnimationCount(); count != 0 {
+		t.Errorf("Expected no animations after starting a new game, got %d", count)
+	}
+	if len(model.DoorAnimations) != 0 {
+		t.Errorf("Expected no door animations after starting a new game, got %d", len(model.DoorAnimations))
+	}
+
+	model.startDoorOpenAnimation(1)
+	model.returnToMainMenu()
+	if model.AnimationManager.AnimationCount() != 0 || len(model.DoorAnimations) != 0 {
+		t.Error("Returning to the main menu should stop all animations")
+	}
+}
+
+func TestReducedMotion(t *testing.T) {
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	cfg := configManager.Get().Clone()
+	cfg.UI.ShowAnimations = true
+	cfg.UI.ReducedMotion = true
+	cfg.UI.EffectsIntensity = "full"
+	if err := configManager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+	model := newModelWithStats(configManager, stats.NewReadOnlyStatsManager())
+
+	if model.ShowAnimations || model.Effects != EffectsSubtle {
+		t.Fatalf("Expected no animations and subtle effects, got animations %v, effects %v", model.ShowAnimations, model.Effects)
+	}
+
+	// The result is shown without the dramatic pause
+	model.Game = game.NewSeededGame(1)
+	model.CurrentView = GameView
+	model.Game.MakeInitialChoice(model.Game.CarPosition)
+	model.Game.StayWithChoice()
+	if cmd := model.startRevealDelay(); cmd != nil || model.IsRevealing || !model.ShowResult {
+		t.Error("Expected the result to be shown at once")
+	}
+	if hasBlink(model.View()) {
+		t.Error("Expected nothing to blink on the result screen")
+	}
+}
+
+func TestAnimationSpeed(t *testing.T) {
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	setSpeed := func(speed int) *Model {
+		cfg := configManager.Get().Clone()
+		cfg.UI.ShowAnimations = true
+		cfg.UI.AnimationSpeed = speed
+		if err := configManager.Update(cfg); err != nil {
+			t.Fatal(err)
+		}
+		model := newModelWithStats(configManager, stats.NewReadOnlyStatsManager())
+		model.Game = game.NewSeededGame(1)
+		model.CurrentView = GameView
+		return model
+	}
+
+	fast := setSpeed(3)
+	fast.startDoorOpenAnimation(1)
+	door := fast.DoorAnimations[1]
+	if door.Duration != DoorOpenDuration/2 || door.FrameRate != DoorOpenFrameRate*2 {
+		t.Errorf("Expected a fast door to open in half the time at twice the frame rate, got %v at %d fps", door.Duration, door.FrameRate)
+	}
+
+	slow := setSpeed(1)
+	slow.startDoorOpenAnimation(1)
+	if door := slow.DoorAnimations[1]; door.Duration != DoorOpenDuration*3/2 {
+		t.Errorf("Expected a slow door to take half as long again, got %v", door.Duration)
+	}
+
+	// At instant speed nothing animates and the result is shown at once
+	instant := setSpeed(0)
+	if instant.ShowAnimations {
+		t.Error("Expected no animations at instant speed")
+	}
+	instant.Game.MakeInitialChoice(instant.Game.CarPosition)
+	instant.Game.StayWithChoice()
+	if cmd := instant.startRevealDelay(); cmd != nil || instant.IsRevealing || !instant.ShowResult {
+		t.Error("Expected the result to be shown at once at instant speed")
+	}
+}
+
+func TestCelebrationParticles(t *testing.T) {
+	clock := freezeUI(t)
+	newWin := func(reducedMotion bool) *Model {
+		configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+		cfg := configManager.Get().Clone()
+		cfg.UI.ShowAnimations = true
+		cfg.UI.ReducedMotion = reducedMotion
+		if err := configManager.Update(cfg); err != nil {
+			t.Fatal(err)
+		}
+		model := newModelWithStats(configManager, stats.NewReadOnlyStatsManager())
+		model.Width, model.Height = 80, 60
+		model.Game = game.NewSeededGame(1)
+		model.CurrentView = GameView
+		model.Game.MakeInitialChoice(model.Game.CarPosition)
+		model.Game.StayWithChoice()
+		model.startRevealDelay()
+		model.Update(RevealDelayMsg{})
+		return model
+	}
+
+	model := newWin(false)
+	if model.Celebration == nil {
+		t.Fatal("Expected a celebration after a win")
+	}
+	plain := plainText(model.View())
+	if !model.Celebration.spawned || !model.Celebration.Particles.HasParticles() {
+		t.Fatalf("Expected particles on the car's door, got:\n%s", plain)
+	}
+
+	// The particles fade out once the celebration is over
+	clock.Advance(CelebrationDuration + time.Second)
+	model.Update(AnimationTickMsg{Time: clock.Now()})
+	if model.Celebration != nil {
+		t.Error("Expected the celebration to end once the particles are gone")
+	}
+
+	if calm := newWin(true); calm.Celebration != nil {
+		t.Error("Expected no particles with reduced motion")
+	}
+}
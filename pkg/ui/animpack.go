@@ -0,0 +1,275 @@
+package ui
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/config"
+)
+
+// DefaultAnimationPack is the pack used when none is configured or the configured one is missing
+const DefaultAnimationPack = "classic"
+
+// MaxDoorShake is the furthest, in columns, a frame may jolt a door sideways
+const MaxDoorShake = 1
+
+// AnimationPacksDirName is the directory under the config directory searched for external packs
+const AnimationPacksDirName = "animation-packs"
+
+//go:embed animpacks/*.json
+var embeddedAnimationPacks embed.FS
+
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// AnimationFrame is one step of a door opening animation
+type AnimationFrame struct {
+	Glyph string `json:"glyph"`
+	Color string `json:"color"`           // "#RRGGBB" or a theme color name such as "door" or "primary"
+	Shake int    `json:"shake,omitempty"` // Columns the door art jolts sideways, -MaxDoorShake to MaxDoorShake
+}
+
+// CelebrationStyle describes the particles and banner shown for a win
+type CelebrationStyle struct {
+	Banner string   `json:"banner"` // Shown on both sides of the win message
+	Glyphs []string `json:"glyphs"`
+	Colors []string `json:"colors"`
+}
+
+// AnimationPack bundles door opening frames and a win celebration. Each entry
+// in DoorOpen is a frame sequence; doors take them in turn, so a pack can give
+// every door its own style
+type AnimationPack struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	DoorOpen    [][]AnimationFrame `json:"door_open"`
+	Celebration CelebrationStyle   `json:"celebration"`
+	Source      string             `json:"-"` // "built-in" or the pack's file path
+}
+
+// themeColors maps the color names packs may use to the active theme
+func themeColors() map[string]lipgloss.Color {
+	return map[string]lipgloss.Color{
+		"door":      DoorColor,
+		"car":       CarColor,
+		"primary":   PrimaryColor,
+		"secondary": SecondaryColor,
+		"accent":    AccentColor,
+		"warning":   WarningColor,
+		"muted":     MutedColor,
+		"sparkle":   SparkleColor,
+		"glow":      GlowColor,
+	}
+}
+
+// resolvePackColor turns a pack color into a lipgloss color
+func resolvePackColor(color string) (lipgloss.Color, error) {
+	if hexColorPattern.MatchString(color) {
+		return lipgloss.Color(color), nil
+	}
+	if themed, ok := themeColors()[color]; ok {
+		return themed, nil
+	}
+	return "", fmt.Errorf("unknown color %q", color)
+}
+
+// Validate checks that the pack has frames and that every color resolves
+func (p *AnimationPack) Validate() error {
+	if p.Name == "" {
+		return errors.New("pack has no name")
+	}
+	if len(p.DoorOpen) == 0 {
+		return errors.New("pack has no door opening frames")
+	}
+
+	for i, frames := range p.DoorOpen {
+		if len(frames) == 0 {
+			return fmt.Errorf("door style %d has no frames", i+1)
+		}
+		for j, frame := range frames {
+			if frame.Glyph == "" {
+				return fmt.Errorf("door style %d frame %d has no glyph", i+1, j+1)
+			}
+			if _, err := resolvePackColor(frame.Color); err != nil {
+				return fmt.Errorf("door style %d frame %d: %w", i+1, j+1, err)
+			}
+			if frame.Shake < -MaxDoorShake || frame.Shake > MaxDoorShake {
+				return fmt.Errorf("door style %d frame %d shakes %d columns; at most %d", i+1, j+1, frame.Shake, MaxDoorShake)
+			}
+		}
+	}
+
+	if len(p.Celebration.Glyphs) == 0 || len(p.Celebration.Colors) == 0 {
+		return errors.New("celebration needs at least one glyph and one color")
+	}
+	for _, color := range p.Celebration.Colors {
+		if _, err := resolvePackColor(color); err != nil {
+			return fmt.Errorf("celebration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DoorFrames returns the frames and colors used to open the given door
+func (p *AnimationPack) DoorFrames(doorIndex int) ([]string, []lipgloss.Color) {
+	style := p.DoorOpen[doorIndex%len(p.DoorOpen)]
+
+	frames := make([]string, len(style))
+	colors := make([]lipgloss.Color, len(style))
+	for i, frame := range style {
+		frames[i] = frame.Glyph
+		colors[i], _ = resolvePackColor(frame.Color) // Validated on load
+	}
+	return frames, colors
+}
+
+// DoorShakes returns how far each frame used to open the given door jolts it sideways
+func (p *AnimationPack) DoorShakes(doorIndex int) []int {
+	style := p.DoorOpen[doorIndex%len(p.DoorOpen)]
+
+	shakes := make([]int, len(style))
+	for i, frame := range style {
+		shakes[i] = frame.Shake
+	}
+	return shakes
+}
+
+// CelebrationColors returns the celebration particle colors
+func (p *AnimationPack) CelebrationColors() []lipgloss.Color {
+	colors := make([]lipgloss.Color, len(p.Celebration.Colors))
+	for i, color := range p.Celebration.Colors {
+		colors[i], _ = resolvePackColor(color)
+	}
+	return colors
+}
+
+// parseAnimationPack decodes and validates a pack
+func parseAnimationPack(data []byte, source string) (*AnimationPack, error) {
+	var pack AnimationPack
+	if err := json.Unmarshal(data, &pack); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	if err := pack.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+	pack.Source = source
+	return &pack, nil
+}
+
+// BuiltinAnimationPacks returns the packs embedded in the binary
+func BuiltinAnimationPacks() []*AnimationPack {
+	entries, _ := fs.ReadDir(embeddedAnimationPacks, "animpacks")
+
+	var packs []*AnimationPack
+	for _, entry := range entries {
+		data, err := embeddedAnimationPacks.ReadFile("animpacks/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		if pack, err := parseAnimationPack(data, "built-in"); err == nil {
+			packs = append(packs, pack)
+		}
+	}
+	return packs
+}
+
+// LoadAnimationPacks returns the built-in packs plus any *.json packs in dir,
+// sorted by name. External packs replace built-in packs with the same name.
+// Packs that fail to load are reported in the error; the others are still returned
+func LoadAnimationPacks(dir string) ([]*AnimationPack, error) {
+	byName := make(map[string]*AnimationPack)
+	for _, pack := range BuiltinAnimationPacks() {
+		byName[pack.Name] = pack
+	}
+
+	var errs []error
+	var paths []string
+	if dir != "" {
+		paths, _ = filepath.Glob(filepath.Join(dir, "*.json"))
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		pack, err := parseAnimationPack(data, path)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		byName[pack.Name] = pack
+	}
+
+	packs := make([]*AnimationPack, 0, len(byName))
+	for _, pack := range byName {
+		packs = append(packs, pack)
+	}
+	sort.Slice(packs, func(i, j int) bool { return packs[i].Name < packs[j].Name })
+
+	return packs, errors.Join(errs...)
+}
+
+// FindAnimationPack returns the pack with the given name, falling back to the default pack
+func FindAnimationPack(packs []*AnimationPack, name string) (*AnimationPack, bool) {
+	var fallback *AnimationPack
+	for _, pack := range packs {
+		if strings.EqualFold(pack.Name, name) {
+			return pack, true
+		}
+		if pack.Name == DefaultAnimationPack {
+			fallback = pack
+		}
+	}
+	return fallback, false
+}
+
+// defaultAnimationPack returns the built-in default pack
+func defaultAnimationPack() *AnimationPack {
+	pack, _ := FindAnimationPack(BuiltinAnimationPacks(), DefaultAnimationPack)
+	return pack
+}
+
+// loadAnimationPacks loads the built-in packs and the packs in the config directory
+func (m *Model) loadAnimationPacks() {
+	dir := ""
+	if configDir, err := config.GetConfigDir(); err == nil {
+		dir = filepath.Join(configDir, AnimationPacksDirName)
+	}
+
+	packs, err := LoadAnimationPacks(dir)
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "load animation packs"))
+	}
+	m.AnimationPacks = packs
+}
+
+// animationPack returns the active pack, switching when the configured pack changes
+func (m *Model) animationPack() *AnimationPack {
+	name := DefaultAnimationPack
+	if m.ConfigManager != nil {
+		name = m.ConfigManager.Get().UI.AnimationPack
+	}
+
+	if m.AnimationPack == nil || name != m.animationPackFor {
+		pack, found := FindAnimationPack(m.AnimationPacks, name)
+		if !found && name != DefaultAnimationPack {
+			m.ErrorMessage = fmt.Sprintf("Animation pack %q not found, using %q", name, DefaultAnimationPack)
+		}
+		if pack == nil {
+			pack = defaultAnimationPack()
+		}
+		m.AnimationPack = pack
+		m.animationPackFor = name
+	}
+	return m.AnimationPack
+}
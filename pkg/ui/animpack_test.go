@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestBuiltinAnimationPacks(t *testing.T) {
+	packs := BuiltinAnimationPacks()
+	for _, name := range []string{"carnival", "classic", "minimal", "shake"} {
+		if _, found := FindAnimationPack(packs, name); !found {
+			t.Errorf("Expected built-in pack %q", name)
+		}
+	}
+
+	carnival, _ := FindAnimationPack(packs, "carnival")
+	first, _ := carnival.DoorFrames(0)
+	second, _ := carnival.DoorFrames(1)
+	fourth, _ := carnival.DoorFrames(3)
+	if first[1] == second[1] {
+		t.Error("Expected carnival doors to open in different styles")
+	}
+	if fourth[1] != first[1] {
+		t.Error("Expected door styles to repeat once every style is used")
+	}
+}
+
+func TestLoadAnimationPacksFromDirectory(t *testing.T) {
+	dir := t.TempDir()
+
+	custom := `{"name": "classic", "door_open": [[{"glyph": "#", "color": "#123456"}]],
+		"celebration": {"glyphs": ["!"], "colors": ["car"]}}`
+	broken := `{"name": "broken", "door_open": [[{"glyph": "#", "color": "chartreuse"}]],
+		"celebration": {"glyphs": ["!"], "colors": ["car"]}}`
+	os.WriteFile(filepath.Join(dir, "custom.json"), []byte(custom), 0644)
+	os.WriteFile(filepath.Join(dir, "broken.json"), []byte(broken), 0644)
+
+	packs, err := LoadAnimationPacks(dir)
+	if err == nil || !strings.Contains(err.Error(), "chartreuse") {
+		t.Errorf("Expected an error for the broken pack, got %v", err)
+	}
+
+	classic, _ := FindAnimationPack(packs, "classic")
+	frames, colors := classic.DoorFrames(0)
+	if frames[0] != "#" || colors[0] != lipgloss.Color("#123456") {
+		t.Errorf("Expected the external pack to replace the built-in classic pack, got %v %v", frames, colors)
+	}
+	if _, found := FindAnimationPack(packs, "broken"); found {
+		t.Error("Invalid packs should not be loaded")
+	}
+}
+
+func TestDoorAnimationUsesConfiguredPack(t *testing.T) {
+	manager := config.NewManagerWithDefaults("")
+	cfg := manager.Get()
+	cfg.UI.AnimationPack = "minimal"
+	if err := manager.Apply(cfg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	model := newModelWithStats(manager, stats.NewReadOnlyStatsManager())
+	model.ShowAnimations = true
+	model.startDoorOpenAnimation(0)
+
+	if frame, _ := model.DoorAnimations[0].GetCurrentFrame(); frame != "[|]" {
+		t.Errorf("Expected the minimal pack's first frame, got %q", frame)
+	}
+
+	cfg.UI.AnimationPack = "missing"
+	manager.Apply(cfg)
+	if pack := model.animationPack(); pack.Name != DefaultAnimationPack || model.ErrorMessage == "" {
+		t.Errorf("Expected a missing pack to fall back to %q with a message, got %q", DefaultAnimationPack, pack.Name)
+	}
+}
+
+func TestShakingDoor(t *testing.T) {
+	shake, _ := FindAnimationPack(BuiltinAnimationPacks(), "shake")
+	anim := NewDoorOpenAnimationFromPack(0, shake)
+	if anim.GetCurrentShake() == 0 {
+		t.Fatal("Expected the shake pack to start with the door jolted sideways")
+	}
+	for _, frame := range anim.Frames {
+		if lipgloss.Width(frame) != 1 {
+			t.Errorf("Expected single-column glyphs, got %q", frame)
+		}
+	}
+
+	// A shake moves the door art without changing the door's width
+	door := NewDoorComponent(1, game.NewDoor(0, 0, game.Goat), false, false)
+	still := door.RenderWithAnimation("|", DoorColor, true)
+	door.Shake = -1
+	left := door.RenderWithAnimation("|", DoorColor, true)
+	door.Shake = 1
+	right := door.RenderWithAnimation("|", DoorColor, true)
+	if left == right || left == still {
+		t.Error("Expected shaking to move the door art")
+	}
+	if lipgloss.Width(left) != lipgloss.Width(still) || lipgloss.Width(right) != lipgloss.Width(still) {
+		t.Errorf("Expected shaking to keep the door %d columns wide", lipgloss.Width(still))
+	}
+
+	bad := `{"name": "quake", "door_open": [[{"glyph": "#", "color": "door", "shake": 3}]],
+		"celebration": {"glyphs": ["!"], "colors": ["car"]}}`
+	if _, err := parseAnimationPack([]byte(bad), "quake.json"); err == nil || !strings.Contains(err.Error(), "shakes 3 columns") {
+		t.Errorf("Expected an error for a shake beyond %d columns, got %v", MaxDoorShake, err)
+	}
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// asciiReplacements maps symbols used by the UI to ASCII of the same display width
+var asciiReplacements = map[rune]string{
+	'█': "#", '▓': "#", '▒': ":", '░': ".", '▀': "#", '▄': "#",
+	'←': "<", '→': ">", '↑': "^", '↓': "v", '▶': ">", '◀': "<",
+	'•': "*", '●': "*", '★': "*", '✦': "*", '✧': "*", '…': ".",
+	'═': "=", '║': "|", '─': "-", '━': "-", '│': "|", '┃': "|",
+	'✅': "OK", '❌': "X ", '⚠': "!", '🏆': "**", '🎉': "**",
+	'\ufe0f': "", '\u200d': "", // Emoji variation selector and joiner
+}
+
+// ToASCII transliterates rendered output to plain ASCII for terminals that cannot
+// display Unicode, keeping each replacement the same width so layouts hold
+func ToASCII(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range s {
+		if r < 128 {
+			b.WriteRune(r)
+			continue
+		}
+
+		if replacement, ok := asciiReplacements[r]; ok {
+			b.WriteString(replacement)
+			continue
+		}
+
+		// Remaining box-drawing characters are corners and junctions
+		if r >= 0x2500 && r <= 0x257F {
+			b.WriteByte('+')
+			continue
+		}
+
+		// Emoji and other symbols become bullets; zero-width modifiers are dropped
+		switch runewidth.RuneWidth(r) {
+		case 0:
+		case 1:
+			b.WriteByte('*')
+		default:
+			b.WriteString("* ")
+		}
+	}
+
+	return b.String()
+}
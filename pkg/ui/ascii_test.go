@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/mattn/go-runewidth"
+)
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"plain text", "plain text"},
+		{"╭──╮\n│ok│\n╰──╯", "+--+\n|ok|\n+--+"},
+		{"██░░ 50%", "##.. 50%"},
+		{"←→ Navigate", "<> Navigate"},
+		{"✅ Saved", "OK Saved"},
+		{"⚠️ Warning", "! Warning"},
+		{"🎲 Roll", "*  Roll"},
+	}
+
+	for _, test := range tests {
+		if result := ToASCII(test.input); result != test.expected {
+			t.Errorf("ToASCII(%q) = %q, expected %q", test.input, result, test.expected)
+		}
+	}
+}
+
+func TestToASCIIPreservesWidth(t *testing.T) {
+	model := NewModel()
+	model.ASCIIOnly = true
+
+	view := model.View()
+	for _, r := range view {
+		if r >= 128 {
+			t.Fatalf("ASCII-only view contains non-ASCII rune %q", r)
+		}
+	}
+
+	model.ASCIIOnly = false
+	unicodeView := model.View()
+	if runewidth.StringWidth(ToASCII(unicodeView)) != runewidth.StringWidth(unicodeView) {
+		t.Error("ASCII transliteration should keep the display width of the view")
+	}
+}
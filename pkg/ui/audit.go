@@ -0,0 +1,545 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// Accessibility thresholds, following WCAG 2.2 level AA
+const (
+	MinTextContrast      = 4.5 // Normal text
+	MinNonTextContrast   = 3.0 // Borders and other UI components
+	MinHitTargetWidth    = 3   // Columns; roughly 24px at common terminal font sizes
+	MinHitTargetHeight   = 2   // Rows
+	auditSeed            = 1   // Fixed so audited game screens are reproducible
+	auditSelectionCursor = 1   // Alternate cursor position compared against the default
+)
+
+// AuditCheck is the outcome of a single accessibility check
+type AuditCheck struct {
+	Category string
+	Subject  string
+	Passed   bool
+	Detail   string
+}
+
+// AuditScreen is a view rendered as plain text, as a screen reader would see it
+type AuditScreen struct {
+	Name string
+	Text string
+}
+
+// AccessibilityReport collects the screens and checks from an accessibility audit
+type AccessibilityReport struct {
+	Generated   time.Time
+	ColorScheme string
+	Width       int
+	Height      int
+	Screens     []AuditScreen
+	Checks      []AuditCheck
+}
+
+// auditState sets up a model to show one view or state
+type auditState struct {
+	name  string
+	setup func(m *Model)
+}
+
+// contrastPair is a foreground/background combination used by the theme
+type contrastPair struct {
+	name       string
+	foreground lipgloss.Color
+	background lipgloss.Color
+	minimum    float64
+}
+
+// RunAccessibilityAudit renders every view at the given size with the active
+// configuration and checks contrast, selection cues, mouse hit targets and
+// reduced motion
+func RunAccessibilityAudit(configManager *config.Manager, width, height int) *AccessibilityReport {
+	report := &AccessibilityReport{
+		Generated:   time.Now(),
+		ColorScheme: configManager.Get().UI.ColorScheme,
+		Width:       width,
+		Height:      height,
+	}
+
+	newAuditModel := func() *Model {
+		m := newModelWithStats(configManager, stats.NewReadOnlyStatsManager())
+		m.Width = width
+		m.Height = height
+		m.ShowAnimations = false
+		return m
+	}
+
+	for _, state := range auditStates() {
+		m := newAuditModel()
+		state.setup(m)
+		report.Screens = append(report.Screens, AuditScreen{Name: state.name, Text: plainText(m.View())})
+	}
+
+	report.checkContrast()
+	report.checkSelectionCues(newAuditModel)
+	report.checkHitTargets(newAuditModel())
+	report.checkMotion(func() *Model {
+		m := newAuditModel()
+		cfg := configManager.Get().Clone()
+		cfg.UI.ReducedMotion = true
+		m.applySettings(cfg)
+		return m
+	})
+
+	return report
+}
+
+// auditStates lists the views and states included in the audit
+func auditStates() []auditState {
+	inGame := func(m *Model) {
+		m.Game = game.NewSeededGame(auditSeed)
+		m.CurrentView = GameView
+	}
+	atFinalChoice := func(m *Model) {
+		inGame(m)
+		m.Game.MakeInitialChoice(0)
+	}
+	atGameOver := func(m *Model) {
+		atFinalChoice(m)
+		m.Game.StayWithChoice()
+		m.ShowResult = true
+	}
+
+	return []auditState{
+		{"Main menu", func(m *Model) {}},
+		{"Help", func(m *Model) { m.ShowHelp = true }},
+		{"Settings", func(m *Model) { m.openSettings() }},
+		{"Theme gallery", func(m *Model) {
+			m.openSettings()
+			m.openThemeGallery()
+		}},
+		{"Game: choose a door", inGame},
+		{"Game: switch or stay", atFinalChoice},
+		{"Game: confirm choice", func(m *Model) {
+			atFinalChoice(m)
+			m.ConfirmChoices = true
+			m.switchChoice()
+		}},
+		{"Game: Bayes' theorem", func(m *Model) {
+			atFinalChoice(m)
+			m.openEducation()
+			m.BayesStep = BayesPosterior
+		}},
+		{"Game: result", atGameOver},
+		{"Game: decision tree", func(m *Model) {
+			atGameOver(m)
+			m.openEducation()
+			m.ShowDecisionTree = true
+		}},
+		{"Game: new game options", func(m *Model) {
+			atGameOver(m)
+			m.openNewGamePrompt()
+		}},
+		{"Daily challenge", func(m *Model) {
+			m.Daily = game.NewDailyChallenge(now())
+			m.startNewGame()
+			m.CurrentView = GameView
+		}},
+		{"Practice", func(m *Model) { m.startPractice() }},
+		{"Simulation", func(m *Model) {
+			m.startAutoPlay()
+			m.AutoPlay.Speed = turboAutoPlaySpeed
+			for range 5 {
+				m.AutoPlay.step()
+			}
+		}},
+		{"Tutorial", func(m *Model) { m.startTutorial() }},
+		{"Tutorial: conditional probability", func(m *Model) {
+			m.startTutorial()
+			m.Tutorial.Game.MakeInitialChoice(tutorialPickDoor)
+			m.Tutorial.Step = TutorialProbability
+		}},
+		{"Worksheet", func(m *Model) {
+			m.openWorksheet()
+			for i := range stats.WorksheetGames + 3 {
+				g := game.NewSeededGame(auditSeed + int64(i))
+				g.MakeInitialChoice(0)
+				if i < stats.WorksheetGames {
+					g.StayWithChoice()
+				} else {
+					g.SwitchChoice()
+				}
+				m.Worksheet.Record(g.Result)
+			}
+		}},
+		{"Glossary", func(m *Model) {
+			m.openGlossary("bayes-theorem")
+			m.Glossary.Link = 0
+		}},
+		{"Glossary: search", func(m *Model) {
+			m.openGlossary("")
+			m.Glossary.Searching = true
+			m.Glossary.Query = "prob"
+			m.Glossary.search()
+		}},
+		{"Statistics", func(m *Model) { m.CurrentView = StatsView }},
+		{"Statistics: convergence chart", func(m *Model) {
+			for i := range 40 {
+				g := game.NewSeededGame(auditSeed + int64(i))
+				g.MakeInitialChoice(0)
+				if i%2 == 0 {
+					g.SwitchChoice()
+				} else {
+					g.StayWithChoice()
+				}
+				m.StatsManager.RecordGame(g.Result)
+			}
+			m.CurrentView = StatsView
+			m.StatsPage = StatsPageConvergence
+		}},
+		{"Statistics: play times", func(m *Model) {
+			for i := range 30 {
+				g := game.NewSeededGame(auditSeed + int64(i))
+				g.MakeInitialChoice(0)
+				g.SwitchChoice()
+				g.Result.Timestamp = now().Add(-time.Duration(i*7) * time.Hour)
+				m.StatsManager.RecordGame(g.Result)
+			}
+			m.CurrentView = StatsView
+			m.StatsPage = StatsPageAnalytics
+		}},
+		{"Statistics: achievements", func(m *Model) {
+			for i := range 12 {
+				g := game.NewSeededGame(auditSeed + int64(i))
+				g.MakeInitialChoice(0)
+				g.SwitchChoice()
+				g.Result.Timestamp = now()
+				m.StatsManager.RecordGame(g.Result)
+			}
+			m.CurrentView = StatsView
+			m.StatsPage = StatsPageAchievements
+		}},
+		{"Statistics: fairness audit", func(m *Model) {
+			for i := range 60 {
+				g := game.NewSeededGame(auditSeed + int64(i))
+				g.MakeInitialChoice(0)
+				g.SwitchChoice()
+				m.StatsManager.RecordGame(g.Result)
+			}
+			m.CurrentView = StatsView
+			m.StatsPage = StatsPageFairness
+		}},
+		{"Achievement unlocked", func(m *Model) {
+			g := game.NewSeededGame(auditSeed)
+			g.MakeInitialChoice(g.CarPosition)
+			g.StayWithChoice()
+			g.Result.Timestamp = now()
+			m.StatsManager.RecordGame(g.Result)
+			m.announceAchievements()
+		}},
+		{"Event log", func(m *Model) {
+			m.Events = eventlog.NewLog("")
+			m.logEvent(eventlog.KindSuccess, "Statistics exported to: monty_hall_stats.csv")
+			m.logEvent(eventlog.KindAchievement, "🚗 Achievement unlocked: First Win - Win your first game")
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(errors.New("permission denied"), "save statistics"))
+			m.logMessages()
+			m.ErrorMessage = ""
+			m.openEventLog()
+		}},
+		{"Statistics: reset confirmation", func(m *Model) {
+			m.CurrentView = StatsView
+			m.confirmResetStats()
+		}},
+		{"Statistics: what if?", func(m *Model) { m.openWhatIf() }},
+		{"Goals", func(m *Model) { m.CurrentView = GoalsView }},
+		{"Goals: new goal", func(m *Model) {
+			m.CurrentView = GoalsView
+			m.GoalDraft = NewGoalDraft()
+		}},
+		{"Confirmation dialog", func(m *Model) {
+			m.Dialog = NewConfirmDialog("Apply settings?", []string{"ui.show_animations: true → false"}, nil)
+		}},
+	}
+}
+
+// checkContrast checks the theme's color combinations against WCAG ratios
+func (r *AccessibilityReport) checkContrast() {
+	selectedBackground := SelectedMenuButtonStyle.GetBackground().(lipgloss.Color)
+
+	pairs := []contrastPair{
+		{"Text", TextColor, BackgroundColor, MinTextContrast},
+		{"Primary", PrimaryColor, BackgroundColor, MinTextContrast},
+		{"Secondary", SecondaryColor, BackgroundColor, MinTextContrast},
+		{"Accent", AccentColor, BackgroundColor, MinTextContrast},
+		{"Warning", WarningColor, BackgroundColor, MinTextContrast},
+		{"Muted", MutedColor, BackgroundColor, MinTextContrast},
+		{"Car", CarColor, BackgroundColor, MinTextContrast},
+		{"Selected menu item", PrimaryColor, selectedBackground, MinTextContrast},
+		{"Door border", DoorColor, BackgroundColor, MinNonTextContrast},
+	}
+
+	for _, pair := range pairs {
+		check := AuditCheck{Category: "Contrast", Subject: pair.name}
+
+		ratio, err := contrastRatio(pair.foreground, pair.background)
+		if err != nil {
+			check.Detail = err.Error()
+		} else {
+			check.Passed = ratio >= pair.minimum
+			check.Detail = fmt.Sprintf("%s on %s is %.2f:1 (minimum %.1f:1)",
+				pair.foreground, pair.background, ratio, pair.minimum)
+		}
+		r.Checks = append(r.Checks, check)
+	}
+}
+
+// checkSelectionCues verifies that moving the cursor changes the plain text, so
+// the selection is visible without color
+func (r *AccessibilityReport) checkSelectionCues(newModel func() *Model) {
+	cues := []struct {
+		subject   string
+		setup     func(m *Model)
+		setCursor func(m *Model, cursor int)
+	}{
+		{"Main menu",
+			func(m *Model) {},
+			func(m *Model, cursor int) { m.MenuCursor = cursor }},
+		{"Doors",
+			func(m *Model) {
+				m.Game = game.NewSeededGame(auditSeed)
+				m.CurrentView = GameView
+			},
+			func(m *Model, cursor int) { m.DoorCursor = cursor }},
+		{"New game options",
+			func(m *Model) {
+				m.Game = game.NewSeededGame(auditSeed)
+				m.Game.MakeInitialChoice(0)
+				m.Game.StayWithChoice()
+				m.CurrentView = GameView
+				m.ShowResult = true
+				m.openNewGamePrompt()
+			},
+			func(m *Model, cursor int) { m.NewGamePrompt.Cursor = cursor }},
+		{"Confirmation dialog buttons",
+			func(m *Model) { m.Dialog = NewConfirmDialog("Apply settings?", nil, nil) },
+			func(m *Model, cursor int) { m.Dialog.Cursor = cursor }},
+	}
+
+	for _, cue := range cues {
+		m := newModel()
+		cue.setup(m)
+
+		cue.setCursor(m, 0)
+		first := plainText(m.View())
+		cue.setCursor(m, auditSelectionCursor)
+		second := plainText(m.View())
+
+		check := AuditCheck{Category: "Selection cue", Subject: cue.subject, Passed: first != second}
+		if check.Passed {
+			check.Detail = "selection is marked with text or symbols"
+		} else {
+			check.Detail = "selection is shown by color alone; add a marker such as ▶"
+		}
+		r.Checks = append(r.Checks, check)
+	}
+}
+
+// checkHitTargets verifies clickable components are large enough to hit with a mouse
+func (r *AccessibilityReport) checkHitTargets(m *Model) {
+	type hitTarget struct {
+		name     string
+		rendered string
+	}
+	var targets []hitTarget
+
+	for _, option := range m.menuOptions() {
+		targets = append(targets, hitTarget{"Menu button: " + option.Label, NewMenuButton(option.Label, false).Render()})
+	}
+
+	for i, door := range game.NewSeededGame(auditSeed).Doors {
+		targets = append(targets, hitTarget{fmt.Sprintf("Door %d", i+1),
+			NewResponsiveDoorComponent(i+1, door, false, false, m.Width).Render()})
+	}
+
+	for _, target := range targets {
+		width, height := lipgloss.Size(target.rendered)
+		r.Checks = append(r.Checks, AuditCheck{
+			Category: "Hit target",
+			Subject:  target.name,
+			Passed:   width >= MinHitTargetWidth && height >= MinHitTargetHeight,
+			Detail: fmt.Sprintf("%dx%d cells (minimum %dx%d)",
+				width, height, MinHitTargetWidth, MinHitTargetHeight),
+		})
+	}
+}
+
+// checkMotion verifies that with reduced motion on, a won game shows its
+// result at once, starts no animations and renders nothing blinking
+func (r *AccessibilityReport) checkMotion(newModel func() *Model) {
+	m := newModel()
+	m.Game = game.NewSeededGame(auditSeed)
+	m.CurrentView = GameView
+	m.Game.MakeInitialChoice(m.Game.CarPosition)
+	m.Game.StayWithChoice()
+	cmd := m.startRevealDelay()
+
+	check := AuditCheck{Category: "Motion", Subject: "Result reveal", Passed: cmd == nil && !m.IsRevealing && m.ShowResult}
+	if check.Passed {
+		check.Detail = "the result is shown at once"
+	} else {
+		check.Detail = "the result waits for a dramatic pause"
+	}
+	r.Checks = append(r.Checks, check)
+
+	m.startDoorOpenAnimation(m.Game.CarPosition)
+	m.startWinningAnimation()
+	check = AuditCheck{Category: "Motion", Subject: "Animations", Passed: !m.isAnimationRunning()}
+	if check.Passed {
+		check.Detail = "doors open and wins are celebrated without animation"
+	} else {
+		check.Detail = "animations run despite reduced motion"
+	}
+	r.Checks = append(r.Checks, check)
+
+	check = AuditCheck{Category: "Motion", Subject: "Blinking", Passed: !hasBlink(m.View())}
+	if check.Passed {
+		check.Detail = "the result screen has no blinking text"
+	} else {
+		check.Detail = "the result screen blinks; use a static indicator instead"
+	}
+	r.Checks = append(r.Checks, check)
+}
+
+// hasBlink reports whether rendered output turns on blinking text
+func hasBlink(s string) bool {
+	for {
+		start := strings.Index(s, "\x1b[")
+		if start < 0 {
+			return false
+		}
+		s = s[start+2:]
+		end := strings.IndexAny(s, "\x1bm")
+		if end < 0 {
+			return false
+		}
+		if s[end] != 'm' {
+			continue
+		}
+
+		params := strings.Split(s[:end], ";")
+		for i := 0; i < len(params); i++ {
+			switch params[i] {
+			case "5", "6":
+				return true
+			case "38", "48", "58":
+				// Extended colors carry their own numbers, which may include 5
+				if i+1 < len(params) && params[i+1] == "5" {
+					i += 2
+				} else if i+1 < len(params) && params[i+1] == "2" {
+					i += 4
+				}
+			}
+		}
+		s = s[end+1:]
+	}
+}
+
+// Failures returns the number of checks that did not pass
+func (r *AccessibilityReport) Failures() int {
+	failures := 0
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failures++
+		}
+	}
+	return failures
+}
+
+// WriteTo writes the report as plain text
+func (r *AccessibilityReport) WriteTo(w io.Writer) (int64, error) {
+	var b strings.Builder
+
+	fmt.Fprintln(&b, "Monty Hall accessibility report")
+	fmt.Fprintf(&b, "Generated: %s\n", r.Generated.Format(time.RFC3339))
+	fmt.Fprintf(&b, "Color scheme: %s\n", r.ColorScheme)
+	fmt.Fprintf(&b, "Terminal size: %dx%d\n", r.Width, r.Height)
+	fmt.Fprintf(&b, "Checks: %d, failed: %d\n", len(r.Checks), r.Failures())
+
+	category := ""
+	for _, check := range r.Checks {
+		if check.Category != category {
+			category = check.Category
+			fmt.Fprintf(&b, "\n## %s\n\n", category)
+		}
+
+		status := "PASS"
+		if !check.Passed {
+			status = "FAIL"
+		}
+		fmt.Fprintf(&b, "[%s] %s: %s\n", status, check.Subject, check.Detail)
+	}
+
+	fmt.Fprintln(&b, "\n## Screens")
+	for _, screen := range r.Screens {
+		fmt.Fprintf(&b, "\n### %s\n\n", screen.Name)
+		for _, line := range strings.Split(screen.Text, "\n") {
+			fmt.Fprintln(&b, strings.TrimRight(line, " "))
+		}
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// plainText strips styling from rendered output
+func plainText(s string) string {
+	return ansi.Strip(s)
+}
+
+// contrastRatio returns the WCAG contrast ratio between two hex colors
+func contrastRatio(foreground, background lipgloss.Color) (float64, error) {
+	fg, err := relativeLuminance(foreground)
+	if err != nil {
+		return 0, err
+	}
+	bg, err := relativeLuminance(background)
+	if err != nil {
+		return 0, err
+	}
+
+	lighter, darker := math.Max(fg, bg), math.Min(fg, bg)
+	return (lighter + 0.05) / (darker + 0.05), nil
+}
+
+// relativeLuminance returns the WCAG relative luminance of a #RRGGBB color
+func relativeLuminance(color lipgloss.Color) (float64, error) {
+	hex := strings.TrimPrefix(string(color), "#")
+	if len(hex) != 6 {
+		return 0, fmt.Errorf("unsupported color %q", string(color))
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported color %q", string(color))
+	}
+
+	channel := func(shift uint) float64 {
+		c := float64((value>>shift)&0xFF) / 255
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*channel(16) + 0.7152*channel(8) + 0.0722*channel(0), nil
+}
@@ -0,0 +1,91 @@
+package ui
+
+import (
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/config"
+)
+
+func TestContrastRatio(t *testing.T) {
+	tests := []struct {
+		foreground, background lipgloss.Color
+		expected               float64
+	}{
+		{"#FFFFFF", "#000000", 21},
+		{"#000000", "#FFFFFF", 21},
+		{"#777777", "#777777", 1},
+	}
+
+	for _, test := range tests {
+		ratio, err := contrastRatio(test.foreground, test.background)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if math.Abs(ratio-test.expected) > 0.01 {
+			t.Errorf("contrastRatio(%s, %s) = %.2f, expected %.2f", test.foreground, test.background, ratio, test.expected)
+		}
+	}
+
+	if _, err := contrastRatio("12", "#000000"); err == nil {
+		t.Error("Expected error for a non-hex color")
+	}
+}
+
+func TestAccessibilityAudit(t *testing.T) {
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	report := RunAccessibilityAudit(configManager, 80, 24)
+
+	if len(report.Screens) != len(auditStates()) {
+		t.Errorf("Expected %d screens, got %d", len(auditStates()), len(report.Screens))
+	}
+	for _, screen := range report.Screens {
+		if strings.Contains(screen.Text, "\x1b[") {
+			t.Errorf("Screen %q should be plain text", screen.Name)
+		}
+	}
+
+	found := false
+	for _, check := range report.Checks {
+		if check.Category == "Selection cue" && check.Subject == "Doors" {
+			found = true
+			if !check.Passed {
+				t.Errorf("Door cursor should have a non-color cue: %s", check.Detail)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected a selection cue check for the doors")
+	}
+	for _, check := range report.Checks {
+		if check.Category == "Motion" && !check.Passed {
+			t.Errorf("Reduced motion check %q failed: %s", check.Subject, check.Detail)
+		}
+	}
+
+	var b strings.Builder
+	if _, err := report.WriteTo(&b); err != nil {
+		t.Fatalf("Unexpected error writing report: %v", err)
+	}
+	if !strings.Contains(b.String(), "### Main menu") {
+		t.Error("Report should include the rendered main menu")
+	}
+}
+
+func TestHasBlink(t *testing.T) {
+	for text, want := range map[string]bool{
+		"plain":                       false,
+		"\x1b[5mslow\x1b[0m":          true,
+		"\x1b[1;5;31mbold\x1b[0m":     true,
+		"\x1b[38;5;196mcolor\x1b[0m":  false,
+		"\x1b[38;2;5;5;5mrgb\x1b[0m":  false,
+		"\x1b]0;title 5m\x07\x1b[1mx": false,
+	} {
+		if got := hasBlink(text); got != want {
+			t.Errorf("hasBlink(%q) = %v, expected %v", text, got, want)
+		}
+	}
+}
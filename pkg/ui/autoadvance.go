@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultAutoAdvanceDelay is how long a result is shown before the next game
+// when game.auto_advance_delay is not set
+const defaultAutoAdvanceDelay = 5 * time.Second
+
+// AutoAdvanceTickMsg counts down to the next game in auto-advance mode
+type AutoAdvanceTickMsg struct {
+	Seq int
+}
+
+// canAutoAdvance reports whether the game view shows a result that Enter
+// would move on from. Finished daily challenges, practice sessions and
+// worksheets wait for the player, as do open prompts
+func (m *Model) canAutoAdvance() bool {
+	switch {
+	case m.CurrentView != GameView || m.ShowHelp || m.Dialog != nil || m.NewGamePrompt != nil:
+		return false
+	case m.Game == nil || !m.Game.IsGameOver() || !m.ShowResult || m.IsRevealing:
+		return false
+	case m.Daily != nil && m.Daily.IsComplete():
+		return false
+	case m.Practice != nil && m.Practice.IsComplete():
+		return false
+	case m.Worksheet != nil && !m.worksheetPlaying():
+		return false
+	}
+	return true
+}
+
+// startAutoAdvance begins the countdown to the next game once a result is shown
+func (m *Model) startAutoAdvance() {
+	if !m.AutoAdvance || !m.canAutoAdvance() {
+		return
+	}
+	m.AutoAdvanceSeq++
+	m.AutoAdvanceAt = now().Add(m.AutoAdvanceDelay)
+	m.autoAdvanceScheduled = false
+}
+
+// cancelAutoAdvance stops the countdown, if any
+func (m *Model) cancelAutoAdvance() {
+	m.AutoAdvanceAt = time.Time{}
+}
+
+// scheduleAutoAdvance ticks the countdown on each whole second left, so the
+// seconds shown stay current
+func (m *Model) scheduleAutoAdvance() tea.Cmd {
+	if m.AutoAdvanceAt.IsZero() || m.autoAdvanceScheduled {
+		return nil
+	}
+	m.autoAdvanceScheduled = true
+
+	wait := time.Duration(0)
+	if remaining := m.AutoAdvanceAt.Sub(now()); remaining > 0 {
+		wait = remaining % time.Second
+		if wait == 0 {
+			wait = time.Second
+		}
+	}
+	seq := m.AutoAdvanceSeq
+	return tea.Tick(wait, func(time.Time) tea.Msg {
+		return AutoAdvanceTickMsg{Seq: seq}
+	})
+}
+
+// handleAutoAdvanceTick starts the next game when the countdown runs out
+func (m *Model) handleAutoAdvanceTick(msg AutoAdvanceTickMsg) {
+	// Ignore ticks from a countdown that was cancelled or restarted
+	if msg.Seq != m.AutoAdvanceSeq || m.AutoAdvanceAt.IsZero() {
+		return
+	}
+	m.autoAdvanceScheduled = false
+
+	if !m.canAutoAdvance() {
+		m.cancelAutoAdvance()
+		return
+	}
+	if now().Before(m.AutoAdvanceAt) {
+		return
+	}
+	m.cancelAutoAdvance()
+	m.startNewGame()
+}
+
+// renderAutoAdvanceStatus shows the countdown to the next game
+func (m *Model) renderAutoAdvanceStatus() string {
+	if m.AutoAdvanceAt.IsZero() {
+		return ""
+	}
+	seconds := int((m.AutoAdvanceAt.Sub(now()) + time.Second - 1) / time.Second)
+	status := fmt.Sprintf("⏩ Next game in %ds  •  any key cancels", max(0, seconds))
+	return Center(lipgloss.NewStyle().Foreground(SecondaryColor).Render(status), m.Width, 1)
+}
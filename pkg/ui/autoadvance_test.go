@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestAutoAdvance(t *testing.T) {
+	clock := freezeUI(t)
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	cfg := configManager.Get()
+	cfg.Game.AutoAdvance = true
+	cfg.Game.AutoAdvanceDelay = 3
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+	model.CurrentView = GameView
+
+	finish := func() {
+		model.Game = game.NewSeededGame(1)
+		model.Game.MakeInitialChoice(0)
+		model.Game.StayWithChoice()
+		model.startRevealDelay()
+		model.Update(RevealDelayMsg{})
+	}
+
+	// The result shows a countdown that starts the next game when it runs out
+	finish()
+	played := model.Game
+	if model.AutoAdvanceAt.IsZero() {
+		t.Fatal("Expected a countdown once the result is shown")
+	}
+	if view := plainText(model.renderGame()); !strings.Contains(view, "Next game in 3s") {
+		t.Errorf("Expected the countdown in the game view, got:\n%s", view)
+	}
+	clock.Advance(time.Second)
+	model.Update(AutoAdvanceTickMsg{Seq: model.AutoAdvanceSeq})
+	if model.Game != played || !strings.Contains(plainText(model.renderGame()), "Next game in 2s") {
+		t.Fatal("Expected the countdown to tick down")
+	}
+	clock.Advance(2 * time.Second)
+	model.Update(AutoAdvanceTickMsg{Seq: model.AutoAdvanceSeq})
+	if model.Game == played || model.Game.Phase != game.InitialChoice || !model.AutoAdvanceAt.IsZero() {
+		t.Fatal("Expected a new game once the countdown ran out")
+	}
+
+	// Any key cancels the countdown without acting on the key
+	finish()
+	played = model.Game
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	clock.Advance(5 * time.Second)
+	model.Update(AutoAdvanceTickMsg{Seq: model.AutoAdvanceSeq})
+	if model.Game != played || !model.AutoAdvanceAt.IsZero() {
+		t.Error("Expected a key press to cancel the countdown")
+	}
+
+	// A finished practice session waits for the player
+	finish()
+	model.cancelAutoAdvance()
+	model.Practice = &PracticeSession{Played: PracticeRounds}
+	model.startAutoAdvance()
+	if !model.AutoAdvanceAt.IsZero() {
+		t.Error("Expected no countdown after a finished practice session")
+	}
+}
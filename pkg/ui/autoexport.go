@@ -0,0 +1,100 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// autoExportConfig returns the statistics settings when automatic exports
+// are on, with defaults in place of unset values. Safe mode, no-persist mode
+// and demo profiles never write them
+func (m *Model) autoExportConfig() (config.StatsConfig, bool) {
+	if m.ConfigManager == nil || m.SafeMode || m.NoPersist || m.DemoDir != "" {
+		return config.StatsConfig{}, false
+	}
+
+	cfg := m.ConfigManager.Get().Stats
+	defaults := config.DefaultConfig().Stats
+	if cfg.AutoExportEvery == 0 {
+		cfg.AutoExportEvery = defaults.AutoExportEvery
+	}
+	if cfg.AutoExportKeep == 0 {
+		cfg.AutoExportKeep = defaults.AutoExportKeep
+	}
+	if cfg.ExportDirectory == "" {
+		cfg.ExportDirectory = defaults.ExportDirectory
+	}
+	return cfg, cfg.AutoExport
+}
+
+// countAutoExportGame is a record hook counting the games played since the
+// last automatic export
+func (m *Model) countAutoExportGame(stats.GameRecord, *stats.GameStats) error {
+	m.autoExportGames++
+	return nil
+}
+
+// prepareAutoExport renders an automatic export of the current statistics
+func (m *Model) prepareAutoExport(cfg config.StatsConfig) (*stats.AutoExport, error) {
+	options := stats.DefaultExportOptions()
+	options.Format = cfg.ExportFormat
+	options.Locale = cfg.ExportLocale()
+	return m.StatsManager.PrepareAutoExport(cfg.ExportDirectory, options, cfg.AutoExportKeep)
+}
+
+// autoExport starts an automatic export once enough games have been played
+// since the last one. The statistics are rendered here, as they may change
+// with the next game, and written in the background. Like achievements, it
+// waits while a reveal is running
+func (m *Model) autoExport() tea.Cmd {
+	cfg, ok := m.autoExportConfig()
+	if !ok || m.IsRevealing || m.autoExportGames < cfg.AutoExportEvery {
+		return nil
+	}
+
+	m.autoExportGames = 0
+	export, err := m.prepareAutoExport(cfg)
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "auto-export statistics"))
+		return nil
+	}
+	return func() tea.Msg {
+		err := export.Write()
+		return AutoExportMsg{Filename: export.Filename, Err: err}
+	}
+}
+
+// finishAutoExport reports a background export without interrupting play
+func (m *Model) finishAutoExport(msg AutoExportMsg) {
+	if msg.Err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(msg.Err, "auto-export statistics"))
+		return
+	}
+	m.showToast(fmt.Sprintf("💾 Statistics auto-exported to %s", filepath.Base(msg.Filename)))
+	m.logEvent(eventlog.KindSuccess, fmt.Sprintf("Statistics auto-exported to: %s", msg.Filename))
+}
+
+// FinishAutoExport writes a last automatic export when the app exits, if
+// games were played since the previous one. It returns the file written, or
+// an empty string when there was nothing to export
+func (m *Model) FinishAutoExport() (string, error) {
+	cfg, ok := m.autoExportConfig()
+	if !ok || m.autoExportGames == 0 {
+		return "", nil
+	}
+
+	m.autoExportGames = 0
+	export, err := m.prepareAutoExport(cfg)
+	if err != nil {
+		return "", err
+	}
+	if err := export.Write(); err != nil {
+		return "", err
+	}
+	return export.Filename, nil
+}
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestAutoExport(t *testing.T) {
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	cfg := configManager.Get()
+	cfg.Stats.AutoExport = true
+	cfg.Stats.AutoExportEvery = 2
+	cfg.Stats.AutoExportKeep = 1
+	cfg.Stats.ExportFormat = stats.ExportCSV
+	cfg.Stats.ExportDirectory = filepath.Join(dir, "exports")
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+
+	play := func() {
+		g := game.NewSeededGame(1)
+		g.MakeInitialChoice(0)
+		g.StayWithChoice()
+		if err := model.StatsManager.RecordGame(g.Result); err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	play()
+	if cmd := model.autoExport(); cmd != nil {
+		t.Fatal("No export should start before enough games are played")
+	}
+
+	play()
+	cmd := model.autoExport()
+	if cmd == nil {
+		t.Fatal("An export should start after the configured number of games")
+	}
+	msg, ok := cmd().(AutoExportMsg)
+	if !ok || msg.Err != nil {
+		t.Fatalf("Expected a written export, got %+v", msg)
+	}
+	if filepath.Dir(msg.Filename) != cfg.Stats.ExportDirectory || !strings.HasSuffix(msg.Filename, ".csv") {
+		t.Errorf("Expected a CSV in the export directory, got %s", msg.Filename)
+	}
+
+	model.Update(msg)
+	if model.Toast == nil || !strings.Contains(model.Toast.Text, "auto-exported") {
+		t.Error("A finished export should be announced without interrupting play")
+	}
+	if events := model.Events.Events(); len(events) == 0 || !strings.Contains(events[0].Message, msg.Filename) {
+		t.Error("A finished export should be logged")
+	}
+
+	// Nothing new to export on exit, until another game is played
+	if path, err := model.FinishAutoExport(); err != nil || path != "" {
+		t.Errorf("Expected no export on exit without new games, got %q, %v", path, err)
+	}
+	play()
+	path, err := model.FinishAutoExport()
+	if err != nil || path == "" {
+		t.Fatalf("Expected an export on exit, got %q, %v", path, err)
+	}
+
+	exports, _ := filepath.Glob(filepath.Join(cfg.Stats.ExportDirectory, stats.AutoExportPrefix+"*"))
+	if len(exports) != 1 || exports[0] != path {
+		t.Errorf("Only the newest export should be kept, got %v", exports)
+	}
+}
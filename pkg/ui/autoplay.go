@@ -0,0 +1,478 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"math"
+	mathrand "math/rand"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/simulation"
+)
+
+// autoPlayMaxSamples is how many chart points are kept; when full, every
+// other point is dropped and samples are taken half as often
+const autoPlayMaxSamples = 240
+
+// autoPlayRunGames is how many games per strategy the Max speed plays in one
+// simulation run before starting the next
+const autoPlayRunGames = 1_000_000
+
+// autoPlaySpeed is a playback speed of the simulation view
+type autoPlaySpeed struct {
+	name         string
+	interval     time.Duration
+	gamesPerTick int  // Whole games played per tick; 0 steps through each game
+	runner       bool // Games are played in the background by a simulation runner
+}
+
+var autoPlaySpeeds = []autoPlaySpeed{
+	{"Slow", 700 * time.Millisecond, 0, false},
+	{"Normal", 250 * time.Millisecond, 0, false},
+	{"Fast", 100 * time.Millisecond, 1, false},
+	{"Turbo", 50 * time.Millisecond, 100, false},
+	{"Max", 0, 0, true},
+}
+
+// defaultAutoPlaySpeed is the speed the simulation view starts at
+const defaultAutoPlaySpeed = 1
+
+// turboAutoPlaySpeed is the fastest speed that plays games on tick
+const turboAutoPlaySpeed = 3
+
+// autoPlayTally counts the games played with one strategy
+type autoPlayTally struct {
+	Games int
+	Wins  int
+}
+
+// Rate returns the win rate, or 0 before any games
+func (t autoPlayTally) Rate() float64 {
+	if t.Games == 0 {
+		return 0
+	}
+	return float64(t.Wins) / float64(t.Games)
+}
+
+// AutoPlay plays games on its own in the simulation view, alternating between
+// a registered player (switching by default) and one that always stays, and
+// keeps the win rate history for the chart
+type AutoPlay struct {
+	Game        *game.Game // Game being shown
+	NumDoors    int
+	Behavior    game.HostBehavior
+	HostOpens   int // Doors the host opens; 0 opens all but one
+	Speed       int // Index into autoPlaySpeeds
+	Paused      bool
+	Seq         int // Incremented whenever ticking restarts, so stale ticks are dropped
+	Played      int
+	CarRevealed int    // Games where the host revealed the car; not counted for either player
+	Player      string // Name of the registered player compared with staying
+	Challenger  autoPlayTally
+	Stay        autoPlayTally
+
+	// Chart history, one point per sampleEvery games
+	ChallengerHistory []float64
+	StayHistory       []float64
+	sampleEvery       int
+	sinceSample       int
+
+	players [2]game.Player // The challenger, then the player that stays
+	rng     *mathrand.Rand
+
+	// Background run at the Max speed
+	cancel  context.CancelFunc
+	updates <-chan simulation.Update
+	run     []simulation.Tally // Totals of the run already counted
+}
+
+// defaultAutoPlayPlayer is the player the simulation view compares with staying
+const defaultAutoPlayPlayer = "switch"
+
+// newAutoPlay creates a simulation that draws every game from the seed,
+// comparing the named player with staying
+func newAutoPlay(seed int64, numDoors int, behavior game.HostBehavior, opens int, player string) *AutoPlay {
+	challenger, err := game.NewPlayer(player)
+	if err != nil {
+		player = defaultAutoPlayPlayer
+		challenger, _ = game.NewPlayer(player)
+	}
+	stay, _ := game.NewPlayer("stay")
+
+	a := &AutoPlay{
+		NumDoors:    numDoors,
+		Behavior:    behavior,
+		HostOpens:   opens,
+		Speed:       defaultAutoPlaySpeed,
+		Player:      player,
+		sampleEvery: 1,
+		players:     [2]game.Player{challenger, stay},
+		rng:         mathrand.New(mathrand.NewSource(seed)),
+	}
+	a.Game = a.newGame()
+	return a
+}
+
+// turn returns which player plays the current game: the challenger in
+// every other game, starting with the first
+func (a *AutoPlay) turn() int {
+	return a.Played % 2
+}
+
+// newGame creates the next game to play
+func (a *AutoPlay) newGame() *game.Game {
+	g, err := game.NewGameWithRand(a.rng, a.NumDoors)
+	if err != nil {
+		g, _ = game.NewGameWithRand(a.rng, game.NumDoors)
+	}
+	g.Host.Behavior = a.Behavior
+	if game.ValidateHostOpens(g.NumDoors(), a.HostOpens) == nil {
+		g.Host.Opens = a.HostOpens
+	}
+	return g
+}
+
+// step advances the simulation by one tick: either one stage of the game on
+// screen, or a batch of whole games at the faster speeds
+func (a *AutoPlay) step() error {
+	if games := autoPlaySpeeds[a.Speed].gamesPerTick; games > 0 {
+		for range games {
+			g := a.newGame()
+			if err := game.PlayGame(g, a.players[a.turn()], a.rng); err != nil {
+				return err
+			}
+			a.record(g)
+			a.Game = g
+		}
+		return nil
+	}
+
+	switch a.Game.Phase {
+	case game.InitialChoice:
+		return a.Game.MakeInitialChoice(a.players[a.turn()].ChooseInitialDoor(a.Game, a.rng))
+	case game.FinalChoice:
+		if err := game.FinishGame(a.Game, a.players[a.turn()], a.rng); err != nil {
+			return err
+		}
+		a.record(a.Game)
+		return nil
+	default:
+		a.Game = a.newGame()
+		return nil
+	}
+}
+
+// record counts a finished game for the player whose turn it was
+func (a *AutoPlay) record(g *game.Game) {
+	tally := &a.Challenger
+	if a.turn() == 1 {
+		tally = &a.Stay
+	}
+	a.Played++
+	if g.Result.CarRevealed {
+		a.CarRevealed++
+	} else {
+		tally.Games++
+		if g.Result.Won {
+			tally.Wins++
+		}
+	}
+
+	a.sample()
+}
+
+// sample adds a chart point when one is due
+func (a *AutoPlay) sample() {
+	if a.Challenger.Games == 0 || a.Stay.Games == 0 {
+		return
+	}
+
+	a.sinceSample++
+	if a.sinceSample < a.sampleEvery {
+		return
+	}
+	a.sinceSample = 0
+
+	if len(a.ChallengerHistory) == autoPlayMaxSamples {
+		a.ChallengerHistory = halveSamples(a.ChallengerHistory)
+		a.StayHistory = halveSamples(a.StayHistory)
+		a.sampleEvery *= 2
+	}
+	a.ChallengerHistory = append(a.ChallengerHistory, a.Challenger.Rate())
+	a.StayHistory = append(a.StayHistory, a.Stay.Rate())
+}
+
+// halveSamples keeps every other sample
+func halveSamples(samples []float64) []float64 {
+	kept := samples[:0]
+	for i := 1; i < len(samples); i += 2 {
+		kept = append(kept, samples[i])
+	}
+	return kept
+}
+
+// tick schedules the next simulation step, or starts a background run at
+// the Max speed
+func (a *AutoPlay) tick() tea.Cmd {
+	if autoPlaySpeeds[a.Speed].runner {
+		return a.startRun()
+	}
+
+	seq := a.Seq
+	return tea.Tick(autoPlaySpeeds[a.Speed].interval, func(time.Time) tea.Msg {
+		return AutoPlayTickMsg{Seq: seq}
+	})
+}
+
+// restart drops any pending tick or run and, unless paused, schedules a new one
+func (a *AutoPlay) restart() tea.Cmd {
+	a.stop()
+	a.Seq++
+	if a.Paused {
+		return nil
+	}
+	return a.tick()
+}
+
+// startRun plays the challenger's and staying games on every CPU in the
+// background, drawing the run's seed from the simulation's own
+func (a *AutoPlay) startRun() tea.Cmd {
+	runner, err := simulation.NewRunner(simulation.Config{
+		Players:   []string{a.Player, "stay"},
+		Games:     autoPlayRunGames,
+		NumDoors:  a.NumDoors,
+		Host:      a.Behavior,
+		HostOpens: a.HostOpens,
+		Seed:      a.rng.Int63(),
+	})
+	if err != nil {
+		seq := a.Seq
+		return func() tea.Msg { return AutoPlayRunMsg{Seq: seq, Update: simulation.Update{Err: err}} }
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	a.cancel = cancel
+	a.updates = runner.Start(ctx)
+	a.run = make([]simulation.Tally, 2)
+	return a.nextUpdate()
+}
+
+// nextUpdate waits for the background run's next update
+func (a *AutoPlay) nextUpdate() tea.Cmd {
+	seq, updates := a.Seq, a.updates
+	return func() tea.Msg {
+		update, ok := <-updates
+		return AutoPlayRunMsg{Seq: seq, Update: update, Closed: !ok}
+	}
+}
+
+// stop cancels the background run, if any
+func (a *AutoPlay) stop() {
+	if a.cancel != nil {
+		a.cancel()
+		a.cancel, a.updates = nil, nil
+	}
+}
+
+// addRun counts the games a run update adds since the last one
+func (a *AutoPlay) addRun(update simulation.Update) {
+	for i, tally := range update.Tallies {
+		games := tally.Games - a.run[i].Games
+		carRevealed := tally.CarRevealed - a.run[i].CarRevealed
+		wins := tally.Wins - a.run[i].Wins
+
+		target := &a.Challenger
+		if i == 1 {
+			target = &a.Stay
+		}
+		target.Games += games - carRevealed
+		target.Wins += wins
+		a.CarRevealed += carRevealed
+		a.Played += games
+	}
+	a.run = update.Tallies
+	a.sample()
+}
+
+// startAutoPlay opens the simulation view with the configured doors and host
+func (m *Model) startAutoPlay() tea.Cmd {
+	m.stopAnimations()
+	m.AutoPlay = newAutoPlay(now().UnixNano(), m.NumDoors, m.HostBehavior, m.HostOpens, defaultAutoPlayPlayer)
+	m.CurrentView = SimulationView
+	return m.AutoPlay.tick()
+}
+
+// handleAutoPlayTick advances the simulation while it is shown and running
+func (m *Model) handleAutoPlayTick(msg AutoPlayTickMsg) (tea.Model, tea.Cmd) {
+	a := m.AutoPlay
+	if a == nil || m.CurrentView != SimulationView || a.Paused || msg.Seq != a.Seq {
+		return m, nil
+	}
+
+	if err := a.step(); err != nil {
+		a.Paused = true
+		m.ErrorMessage = fmt.Sprintf("Simulation stopped: %v", err)
+		return m, nil
+	}
+	return m, a.tick()
+}
+
+// handleAutoPlayRun counts a background run's progress, starting the next
+// run when one finishes
+func (m *Model) handleAutoPlayRun(msg AutoPlayRunMsg) (tea.Model, tea.Cmd) {
+	a := m.AutoPlay
+	if a == nil || msg.Seq != a.Seq {
+		return m, nil
+	}
+	if m.CurrentView != SimulationView || a.Paused {
+		a.stop()
+		return m, nil
+	}
+
+	switch {
+	case msg.Update.Err != nil:
+		a.stop()
+		a.Paused = true
+		m.ErrorMessage = fmt.Sprintf("Simulation stopped: %v", msg.Update.Err)
+		return m, nil
+	case msg.Closed:
+		return m, nil
+	}
+
+	a.addRun(msg.Update)
+	if msg.Update.Done {
+		a.stop()
+		return m, a.startRun()
+	}
+	return m, a.nextUpdate()
+}
+
+// restartAutoPlay starts the simulation over with the named player, keeping
+// the speed and whether it is paused
+func (m *Model) restartAutoPlay(player string) tea.Cmd {
+	a := m.AutoPlay
+	a.stop()
+	m.AutoPlay = newAutoPlay(now().UnixNano(), a.NumDoors, a.Behavior, a.HostOpens, player)
+	m.AutoPlay.Speed, m.AutoPlay.Paused, m.AutoPlay.Seq = a.Speed, a.Paused, a.Seq
+	return m.AutoPlay.restart()
+}
+
+// playerLabel returns a player's name for display
+func playerLabel(name string) string {
+	if name == "" {
+		return name
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// handleAutoPlayKeys processes simulation view input
+func (m *Model) handleAutoPlayKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	a := m.AutoPlay
+	if a == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeySpace, " ", "p":
+		a.Paused = !a.Paused
+		return m, a.restart()
+
+	case KeyLeft, "h", "-":
+		if a.Speed > 0 {
+			a.Speed--
+			return m, a.restart()
+		}
+
+	case KeyRight, "l", "+":
+		if a.Speed < len(autoPlaySpeeds)-1 {
+			a.Speed++
+			return m, a.restart()
+		}
+
+	case KeyR:
+		return m, m.restartAutoPlay(a.Player)
+
+	case "s":
+		// Play the next registered player against staying
+		names := game.PlayerNames()
+		next := names[(slices.Index(names, a.Player)+1)%len(names)]
+		return m, m.restartAutoPlay(next)
+	}
+
+	return m, nil
+}
+
+// renderAutoPlay renders the simulation view
+func (m *Model) renderAutoPlay() string {
+	a := m.AutoPlay
+	if a == nil {
+		return ""
+	}
+
+	state := "Running"
+	if a.Paused {
+		state = "Paused"
+	}
+	status := fmt.Sprintf("Game %d  •  Speed: %s  •  %s", a.Played, autoPlaySpeeds[a.Speed].name, state)
+
+	var content []string
+	content = append(content, HeaderStyle.Render("SIMULATION"))
+	content = append(content, Center(SubtitleStyle.Render(status), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	g := a.Game
+	pick, showAll := -1, false
+	switch g.Phase {
+	case game.FinalChoice:
+		pick = g.PlayerInitialChoice
+	case game.GameOver:
+		pick, showAll = g.PlayerFinalChoice, true
+	}
+	content = append(content, SafeCenter(RenderDoorsGrid(g.Doors, pick, -1, showAll, m.Width-4), m.Width))
+	content = append(content, Spacer(1))
+
+	// Players with no known win rate get no theory line
+	opened := a.Game.Host.DoorsToOpen(a.Game.NumDoors())
+	expectedStay, _ := a.Behavior.ExpectedWinRatesOpening(a.NumDoors, opened)
+	expected := math.NaN()
+	theory := fmt.Sprintf("┈ theory stay %.1f%%", expectedStay*100)
+	if rater, ok := a.players[0].(game.ExpectedWinRater); ok {
+		expected = rater.ExpectedWinRate(a.NumDoors, opened, a.Behavior)
+		theory = fmt.Sprintf("┈ theory %.1f%% / %.1f%%", expected*100, expectedStay*100)
+	}
+	chartWidth := min(max(m.Width-16, 20), autoPlayMaxSamples)
+	chart := NewConvergenceChart(a.ChallengerHistory, a.StayHistory, expected, expectedStay, chartWidth, 11)
+	content = append(content, Center(chart.Render(), m.Width, 1))
+
+	legend := fmt.Sprintf("%s %s %.1f%% of %d   %s Stay %.1f%% of %d   %s",
+		lipgloss.NewStyle().Foreground(SecondaryColor).Render("●"), playerLabel(a.Player), a.Challenger.Rate()*100, a.Challenger.Games,
+		lipgloss.NewStyle().Foreground(AccentColor).Render("○"), a.Stay.Rate()*100, a.Stay.Games,
+		MutedStyle.Render(theory))
+	content = append(content, Center(StatsLabelStyle.Render(legend), m.Width, 1))
+	if a.CarRevealed > 0 {
+		content = append(content, Center(MutedStyle.Render(fmt.Sprintf("%d games where the host revealed the car are not counted", a.CarRevealed)), m.Width, 1))
+	}
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+
+	pause := "Pause"
+	if a.Paused {
+		pause = "Resume"
+	}
+	content = append(content, RenderFooter([]KeyBinding{
+		{"Space", pause},
+		{"←→", "Speed"},
+		{"s", "Player"},
+		{"r", "Restart"},
+		{"ESC/q", "Main menu"},
+	}))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
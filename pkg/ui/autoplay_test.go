@@ -0,0 +1,172 @@
+package ui
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestAutoPlayStepsThroughEachGame(t *testing.T) {
+	a := newAutoPlay(1, game.NumDoors, game.HostClassic, 0, defaultAutoPlayPlayer)
+
+	wantPhases := []game.GamePhase{game.FinalChoice, game.GameOver, game.InitialChoice}
+	for i, want := range wantPhases {
+		if err := a.step(); err != nil {
+			t.Fatalf("Step %d failed: %v", i, err)
+		}
+		if a.Game.Phase != want {
+			t.Errorf("Step %d: expected phase %v, got %v", i, want, a.Game.Phase)
+		}
+	}
+
+	if a.Played != 1 || a.Challenger.Games != 1 || a.Stay.Games != 0 {
+		t.Errorf("First game should switch, got %+v switch %+v stay", a.Challenger, a.Stay)
+	}
+}
+
+func TestAutoPlayConverges(t *testing.T) {
+	a := newAutoPlay(1, game.NumDoors, game.HostClassic, 0, defaultAutoPlayPlayer)
+	a.Speed = turboAutoPlaySpeed
+
+	for range 100 {
+		if err := a.step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if a.Played != 100*autoPlaySpeeds[a.Speed].gamesPerTick {
+		t.Errorf("Expected %d games, got %d", 100*autoPlaySpeeds[a.Speed].gamesPerTick, a.Played)
+	}
+	if math.Abs(a.Challenger.Rate()-2.0/3) > 0.03 || math.Abs(a.Stay.Rate()-1.0/3) > 0.03 {
+		t.Errorf("Rates should approach theory: switch %.3f, stay %.3f", a.Challenger.Rate(), a.Stay.Rate())
+	}
+	if len(a.ChallengerHistory) > autoPlayMaxSamples || len(a.ChallengerHistory) != len(a.StayHistory) {
+		t.Errorf("History should be capped at %d samples, got %d and %d", autoPlayMaxSamples, len(a.ChallengerHistory), len(a.StayHistory))
+	}
+	if last := a.ChallengerHistory[len(a.ChallengerHistory)-1]; math.Abs(last-a.Challenger.Rate()) > 0.01 {
+		t.Errorf("Latest sample %.3f should be close to the current rate %.3f", last, a.Challenger.Rate())
+	}
+}
+
+func TestAutoPlayPauseDropsTicks(t *testing.T) {
+	model := NewModel()
+	model.startAutoPlay()
+	a := model.AutoPlay
+
+	tick := AutoPlayTickMsg{Seq: a.Seq}
+	model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	if !a.Paused {
+		t.Fatal("Space should pause the simulation")
+	}
+
+	model.Update(tick)
+	if a.Game.Phase != game.InitialChoice {
+		t.Error("Ticks should be ignored while paused")
+	}
+
+	_, cmd := model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	if a.Paused || cmd == nil {
+		t.Fatal("Space should resume the simulation and schedule a tick")
+	}
+
+	model.Update(tick)
+	if a.Game.Phase != game.InitialChoice {
+		t.Error("Ticks scheduled before pausing should be ignored")
+	}
+
+	model.Update(AutoPlayTickMsg{Seq: a.Seq})
+	if a.Game.Phase != game.FinalChoice {
+		t.Error("Current tick should advance the simulation")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	model.Update(AutoPlayTickMsg{Seq: a.Seq})
+	if a.Game.Phase != game.FinalChoice {
+		t.Error("Simulation should stop when the view is left")
+	}
+}
+
+func TestAutoPlayMaxSpeed(t *testing.T) {
+	model := NewModel()
+	model.startAutoPlay()
+	a := model.AutoPlay
+	a.Speed = len(autoPlaySpeeds) - 1
+
+	cmd := a.restart()
+	for range 5 {
+		msg, ok := cmd().(AutoPlayRunMsg)
+		if !ok {
+			t.Fatal("Expected the Max speed to report a background run")
+		}
+		_, cmd = model.Update(msg)
+	}
+
+	if a.Played == 0 || a.Challenger.Games+a.Stay.Games+a.CarRevealed != a.Played {
+		t.Errorf("Expected the run's games to be counted, got %d played, %+v switch %+v stay", a.Played, a.Challenger, a.Stay)
+	}
+	if len(a.ChallengerHistory) == 0 {
+		t.Error("Expected run updates to be charted")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	if a.cancel != nil {
+		t.Error("Pausing should cancel the background run")
+	}
+	played := a.Played
+	if msg, ok := cmd().(AutoPlayRunMsg); ok {
+		model.Update(msg)
+	}
+	if a.Played != played {
+		t.Error("Updates from a canceled run should be ignored")
+	}
+}
+
+func TestAutoPlayPlayers(t *testing.T) {
+	model := NewModel()
+	model.startAutoPlay()
+	if model.AutoPlay.Player != defaultAutoPlayPlayer {
+		t.Fatalf("Expected the simulation to start with %s, got %s", defaultAutoPlayPlayer, model.AutoPlay.Player)
+	}
+
+	// s cycles through the registered players, starting the tallies over
+	names := game.PlayerNames()
+	model.AutoPlay.Played = 10
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	a := model.AutoPlay
+	if want := names[(slices.Index(names, defaultAutoPlayPlayer)+1)%len(names)]; a.Player != want || a.Played != 0 {
+		t.Errorf("Expected a fresh simulation of %s, got %s with %d games", want, a.Player, a.Played)
+	}
+
+	// The streak player has no known win rate, so only staying has a theory line
+	model.AutoPlay = newAutoPlay(1, game.NumDoors, game.HostClassic, 0, "switch-after-streak")
+	model.AutoPlay.Speed = turboAutoPlaySpeed
+	for range 20 {
+		model.AutoPlay.step()
+	}
+	view := plainText(model.View())
+	if !strings.Contains(view, "Switch-after-streak") || !strings.Contains(view, "theory stay 33.3%") {
+		t.Errorf("Expected the streak player in the legend without a theory rate:\n%s", view)
+	}
+}
+
+func TestConvergenceChart(t *testing.T) {
+	chart := NewConvergenceChart([]float64{1, 0.5}, []float64{0, 0.5}, 2.0/3, 1.0/3, 10, 11)
+	lines := strings.Split(chart.Render(), "\n")
+
+	if len(lines) != 12 {
+		t.Fatalf("Expected 11 rows and an axis, got %d lines", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "100%│●") || !strings.HasPrefix(lines[10], "  0%│○") {
+		t.Errorf("First samples should be plotted at 100%% and 0%%:\n%s", chart.Render())
+	}
+	if !strings.Contains(lines[5], " 50%│ ●") {
+		t.Errorf("Switch should be drawn over stay where they meet:\n%s", chart.Render())
+	}
+	if !strings.Contains(lines[3], "┈┈┈") || !strings.Contains(lines[7], "┈┈┈") {
+		t.Errorf("Theory lines should be drawn at 2/3 and 1/3:\n%s", chart.Render())
+	}
+}
@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"path/filepath"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/uistate"
+)
+
+// KeybindingsVersion is raised whenever an update changes the key bindings,
+// so the Help menu item is badged until help is opened again
+const KeybindingsVersion = 2
+
+// Views with last-seen markers
+const (
+	seenStatistics = "statistics"
+	seenGoals      = "goals"
+	seenHelp       = "help"
+)
+
+// menuBadge marks menu items with something new to see
+const menuBadge = " •"
+
+// newUIState loads the UI state kept beside the statistics file. Views never
+// seen before are marked seen now, so a first run starts without badges, and
+// players without statistics start onboarding.
+// Stores without a file keep the state for the session only
+func newUIState(statsManager *stats.StatsManager) *uistate.State {
+	state := uistate.NewState("")
+	if file, ok := statsManager.Store().(stats.FileStore); ok {
+		path := filepath.Join(filepath.Dir(file.GetFilePath()), uistate.DefaultFileName)
+		if statsManager.IsReadOnly() {
+			state = uistate.NewReadOnlyState(path)
+		} else {
+			state = uistate.NewState(path)
+		}
+	}
+	if err := state.Load(); err != nil {
+		// Start afresh rather than failing; the file is rewritten when a view is seen
+		state = uistate.NewState(state.GetFilePath())
+	}
+
+	for _, view := range []string{seenStatistics, seenGoals, seenHelp} {
+		if _, ok := state.LastSeen(view); !ok {
+			// Badges are a convenience, so failing to save the baseline is not reported
+			_ = state.MarkSeen(view, now(), seenVersion(view))
+		}
+	}
+	startOnboarding(state, statsManager)
+	return state
+}
+
+// hasNewStatistics reports whether achievements were unlocked since the
+// statistics were last seen
+func (m *Model) hasNewStatistics() bool {
+	if m.UIState == nil || m.Achievements == nil {
+		return false
+	}
+	marker, _ := m.UIState.LastSeen(seenStatistics)
+	for _, status := range m.Achievements.Statuses() {
+		if status.UnlockedAt != nil && status.UnlockedAt.After(marker.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNewGoals reports whether goals were completed since the goals were last seen
+func (m *Model) hasNewGoals() bool {
+	if m.UIState == nil || m.StatsManager == nil {
+		return false
+	}
+	marker, _ := m.UIState.LastSeen(seenGoals)
+	for _, goal := range m.StatsManager.Goals().Goals() {
+		if goal.CompletedAt != nil && goal.CompletedAt.After(marker.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNewHelp reports whether the key bindings changed since help was last seen
+func (m *Model) hasNewHelp() bool {
+	if m.UIState == nil {
+		return false
+	}
+	marker, _ := m.UIState.LastSeen(seenHelp)
+	return marker.Version < KeybindingsVersion
+}
+
+// markViewsSeen clears the badge of the view being shown. Markers are only
+// written while a badge is pending, so browsing a view writes nothing
+func (m *Model) markViewsSeen() {
+	if m.UIState == nil {
+		return
+	}
+
+	var view string
+	switch {
+	case m.ShowHelp && m.hasNewHelp():
+		view = seenHelp
+	case m.ShowHelp:
+		return
+	case m.CurrentView == StatsView && m.hasNewStatistics():
+		view = seenStatistics
+	case m.CurrentView == GoalsView && m.hasNewGoals():
+		view = seenGoals
+	default:
+		return
+	}
+	// Like the event log, the markers are a convenience, so failing to
+	// write them is not reported
+	_ = m.UIState.MarkSeen(view, now(), seenVersion(view))
+}
+
+// seenVersion returns the content version recorded when a view is seen
+func seenVersion(view string) int {
+	if view == seenHelp {
+		return KeybindingsVersion
+	}
+	return 0
+}
@@ -0,0 +1,93 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/uistate"
+)
+
+func TestMenuBadges(t *testing.T) {
+	clock := freezeUI(t)
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	newModel := func() *Model {
+		model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+		model.Width, model.Height = 100, 50
+		return model
+	}
+
+	// A first run starts without badges
+	model := newModel()
+	menu := plainText(model.renderMainMenu())
+	for _, label := range []string{"View Statistics", "Goals", "Help"} {
+		if strings.Contains(menu, label+menuBadge) {
+			t.Fatalf("Expected no badges on a first run, got:\n%s", menu)
+		}
+	}
+
+	// An unlocked achievement badges the statistics until they are viewed
+	clock.Advance(time.Minute)
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(g.CarPosition)
+	g.StayWithChoice()
+	g.Result.Timestamp = clock.Now()
+	if err := model.StatsManager.RecordGame(g.Result); err != nil {
+		t.Fatalf("Failed to record game: %v", err)
+	}
+	if menu := plainText(model.renderMainMenu()); !strings.Contains(menu, "View Statistics"+menuBadge) {
+		t.Fatalf("Expected a badge on the statistics, got:\n%s", menu)
+	}
+
+	// The badge survives a restart until the view is opened
+	model = newModel()
+	if !model.hasNewStatistics() {
+		t.Fatal("Expected the badge to be kept between runs")
+	}
+	clock.Advance(time.Minute)
+	model.menuOptions()[1].Action()
+	model.Update(nil)
+	if model.hasNewStatistics() || newModel().hasNewStatistics() {
+		t.Error("Expected viewing the statistics to clear the badge")
+	}
+
+	// Help is badged when the key bindings changed since it was last opened
+	path := filepath.Join(dir, uistate.DefaultFileName)
+	state := uistate.NewState(path)
+	if err := state.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.MarkSeen(seenHelp, clock.Now(), KeybindingsVersion-1); err != nil {
+		t.Fatal(err)
+	}
+	model = newModel()
+	if menu := plainText(model.renderMainMenu()); !strings.Contains(menu, "Help"+menuBadge) {
+		t.Fatalf("Expected a badge on help after the key bindings changed, got:\n%s", menu)
+	}
+	model.ShowHelp = true
+	model.Update(nil)
+	if model.hasNewHelp() {
+		t.Error("Expected opening help to clear its badge")
+	}
+}
+
+func TestMenuBadgesReadOnly(t *testing.T) {
+	freezeUI(t)
+	dir := t.TempDir()
+	statsManager := stats.NewReadOnlyStatsManager(filepath.Join(dir, "stats.json"))
+	model := newModelWithStats(config.NewManagerWithDefaults(filepath.Join(dir, "config.json")), statsManager)
+
+	// Read-only statistics keep the markers in memory
+	if model.hasNewHelp() {
+		t.Error("Expected no badge on a first read-only run")
+	}
+	if _, err := os.Stat(filepath.Join(dir, uistate.DefaultFileName)); !os.IsNotExist(err) {
+		t.Errorf("Expected no UI state file for read-only statistics, got %v", err)
+	}
+}
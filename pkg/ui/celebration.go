@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// CelebrationDuration is how long the particles of a win live at normal speed
+const CelebrationDuration = 2 * time.Second
+
+// celebrationID is the ID of the animation that keeps ticks coming while the
+// particles live
+const celebrationID = "celebration"
+
+// Celebration is the burst of particles from the car's door after a win
+type Celebration struct {
+	Particles *ParticleSystem
+	Door      int // Door the particles burst from
+
+	spawned bool      // The particles have been placed on the door
+	last    time.Time // When the particles last moved
+}
+
+// startCelebration bursts particles from the car's door, unless motion is off
+func (m *Model) startCelebration() {
+	if !m.ShowAnimations || m.ReducedMotion || m.AnimationManager == nil || m.Game == nil {
+		return
+	}
+
+	m.Celebration = &Celebration{
+		Particles: NewParticleSystem(m.Width, m.Height),
+		Door:      m.Game.CarPosition,
+	}
+
+	anim := NewAnimation(celebrationID, CelebrationDuration, EaseLinear)
+	anim.FrameRate = ParticleFrameRate
+	anim.SetSpeed(m.AnimationSpeed)
+	m.AnimationManager.AddAnimation(anim)
+	m.AnimationManager.StartAnimation(celebrationID)
+}
+
+// advanceCelebration moves the particles on by the time since they last
+// moved, at the animation speed, and ends the celebration once they are gone
+func (m *Model) advanceCelebration() {
+	c := m.Celebration
+	if c == nil || !c.spawned {
+		return
+	}
+
+	current := now()
+	elapsed := current.Sub(c.last)
+	c.last = current
+	if scaled := m.AnimationSpeed.Scale(elapsed); scaled > 0 {
+		// At fast speed the particles cover twice the distance in the same time
+		rate := float64(elapsed) / float64(scaled)
+		c.Particles.Update(elapsed.Seconds() * rate)
+	}
+
+	if anim := m.AnimationManager.GetAnimation(celebrationID); anim == nil || !c.Particles.HasParticles() {
+		m.Celebration = nil
+	}
+}
+
+// withCelebration composites the particles over the game view. The door's
+// place on screen is only known once the view is drawn, so the particles are
+// placed on the first view after the win, using its zones
+func (m *Model) withCelebration(view string) string {
+	c := m.Celebration
+	if c == nil || m.ReducedMotion || m.CurrentView != GameView || m.Dialog != nil || m.ShowHelp {
+		return view
+	}
+
+	if !c.spawned {
+		spans := m.zones[doorZone(c.Door)]
+		if len(spans) == 0 {
+			return view
+		}
+		span := spans[len(spans)/2]
+		c.Particles.AddCelebrationParticles((span.X0+span.X1)/2, span.Y, m.animationPack())
+		c.spawned = true
+		c.last = now()
+	}
+
+	// Zones count from the top of the screen, which loses the top lines of
+	// a view taller than the terminal
+	lines := strings.Split(view, "\n")
+	offset := max(len(lines)-m.Height, 0)
+
+	for y, row := range c.Particles.Render() {
+		if y+offset >= len(lines) {
+			break
+		}
+		line := lines[y+offset]
+		for x, cell := range row {
+			if cell == " " {
+				continue
+			}
+			if m.ASCIIOnly {
+				cell = ToASCII(cell)
+			}
+			line = overlayCell(line, x, cell)
+		}
+		lines[y+offset] = line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// overlayCell draws cell over the line at column x, padding short lines
+func overlayCell(line string, x int, cell string) string {
+	if width := ansi.StringWidth(line); width < x {
+		line += strings.Repeat(" ", x-width)
+	}
+	return ansi.Truncate(line, x, "") + cell + ansi.TruncateLeft(line, x+ansi.StringWidth(cell), "")
+}
@@ -0,0 +1,136 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/client"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+const (
+	// classroomTimeout is how long reporting a game may take
+	classroomTimeout = 5 * time.Second
+	// classroomRetryDelay is how long reporting waits after the instructor's
+	// server could not be reached
+	classroomRetryDelay = 10 * time.Second
+)
+
+// ClassroomLink reports each game played to an instructor's server, which
+// adds it to the class-wide win rates
+type ClassroomLink struct {
+	Client   *client.Client
+	URL      string
+	Student  string
+	Reported int    // Games the server has counted
+	Err      string // Why the last report failed; empty once reports get through
+
+	pending []stats.GameRecord // Games waiting to be reported
+	sending bool               // A report is on its way
+	retryAt time.Time          // When to try again after a failed report
+}
+
+// ClassroomReportMsg is sent when a report to the instructor's server is done
+type ClassroomReportMsg struct {
+	Sent   int
+	Unsent []stats.GameRecord // Games to report again
+	Err    error
+}
+
+// JoinClassroom reports the games played from now on to the instructor's
+// server at url under the student's name
+func (m *Model) JoinClassroom(url, student string) error {
+	student = strings.TrimSpace(student)
+	if student == "" {
+		return errors.New("a student name is needed to join a classroom")
+	}
+	if !strings.Contains(url, "://") {
+		url = "http://" + url
+	}
+
+	m.Classroom = &ClassroomLink{
+		Client:  client.New(url),
+		URL:     strings.TrimRight(url, "/"),
+		Student: student,
+	}
+	return nil
+}
+
+// queueClassResult is a record hook queueing each game for the classroom
+func (m *Model) queueClassResult(record stats.GameRecord, _ *stats.GameStats) error {
+	if m.Classroom != nil {
+		m.Classroom.pending = append(m.Classroom.pending, record)
+	}
+	return nil
+}
+
+// reportClassResults sends the games waiting to be reported in the
+// background, one report at a time
+func (m *Model) reportClassResults() tea.Cmd {
+	link := m.Classroom
+	if link == nil || link.sending || len(link.pending) == 0 || now().Before(link.retryAt) {
+		return nil
+	}
+
+	records := link.pending
+	link.pending = nil
+	link.sending = true
+	return func() tea.Msg {
+		for i, record := range records {
+			ctx, cancel := context.WithTimeout(context.Background(), classroomTimeout)
+			err := link.Client.ReportClassResult(ctx, link.Student, record)
+			cancel()
+			if err != nil {
+				return ClassroomReportMsg{Sent: i, Unsent: records[i:], Err: err}
+			}
+		}
+		return ClassroomReportMsg{Sent: len(records)}
+	}
+}
+
+// finishClassReport counts the games reported. Games that could not be sent
+// are tried again later, and only the first failure in a row is shown
+func (m *Model) finishClassReport(msg ClassroomReportMsg) {
+	link := m.Classroom
+	if link == nil {
+		return
+	}
+
+	link.sending = false
+	link.Reported += msg.Sent
+	if msg.Err == nil {
+		link.Err = ""
+		return
+	}
+
+	link.pending = append(msg.Unsent, link.pending...)
+	link.retryAt = now().Add(classroomRetryDelay)
+	if link.Err == "" {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(msg.Err, "report to the classroom"))
+	}
+	link.Err = msg.Err.Error()
+}
+
+// renderClassroomStatus says where the student's games are reported
+func (m *Model) renderClassroomStatus() string {
+	link := m.Classroom
+	if link == nil {
+		return ""
+	}
+
+	if link.Err != "" {
+		waiting := len(link.pending)
+		return lipgloss.NewStyle().Foreground(WarningColor).Render(
+			fmt.Sprintf("Classroom: can't reach %s; %d games waiting to be reported", link.URL, waiting))
+	}
+	games := fmt.Sprintf("%d games", link.Reported)
+	if link.Reported == 1 {
+		games = "1 game"
+	}
+	return MutedStyle.Render(fmt.Sprintf("Classroom: playing as %s, %s reported to %s", link.Student, games, link.URL))
+}
@@ -0,0 +1,111 @@
+package ui
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/server"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestClassroomReportsGames(t *testing.T) {
+	freezeUI(t)
+	handler := server.New(stats.NewStatsManager(filepath.Join(t.TempDir(), "server.json")))
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	m := newSnapshotModel(t)
+	if err := m.JoinClassroom(srv.URL, " "); err == nil {
+		t.Error("Expected joining without a student name to fail")
+	}
+	if err := m.JoinClassroom(srv.URL, "alice"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every recorded game is queued for the class
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(0)
+	g.StayWithChoice()
+	if err := m.StatsManager.RecordGame(g.Result); err != nil {
+		t.Fatal(err)
+	}
+	report := m.reportClassResults()
+	if report == nil {
+		t.Fatal("Expected the game to be reported")
+	}
+	m.queueClassResult(stats.GameRecord{Strategy: game.Switch}, nil)
+	if m.reportClassResults() != nil {
+		t.Error("Expected one report on its way at a time")
+	}
+	m.Update(report())
+	if m.Classroom.Reported != 1 || handler.Classroom().Summary().Games != 1 {
+		t.Errorf("Expected the game counted by the class, reported %d", m.Classroom.Reported)
+	}
+	if status := plainText(m.renderClassroomStatus()); !strings.Contains(status, "playing as alice, 1 game reported") {
+		t.Errorf("Expected the main menu to say where games go, got %q", status)
+	}
+}
+
+func TestClassroomRetriesUnreachableServer(t *testing.T) {
+	clock := freezeUI(t)
+	srv := httptest.NewServer(server.New(stats.NewStatsManager(filepath.Join(t.TempDir(), "server.json"))))
+	url := srv.URL
+	srv.Close()
+
+	m := newSnapshotModel(t)
+	if err := m.JoinClassroom(url, "bob"); err != nil {
+		t.Fatal(err)
+	}
+	m.queueClassResult(stats.GameRecord{Strategy: game.Switch}, nil)
+	m.Update(m.reportClassResults()())
+	if m.Classroom.Err == "" || m.ErrorMessage == "" || len(m.Classroom.pending) != 1 {
+		t.Fatalf("Expected the failure shown and the game kept, pending %d", len(m.Classroom.pending))
+	}
+	if m.reportClassResults() != nil {
+		t.Error("Expected no retry straight away")
+	}
+
+	clock.Advance(classroomRetryDelay + time.Second)
+	if m.reportClassResults() == nil {
+		t.Error("Expected a retry once the delay has passed")
+	}
+}
+
+func TestDashboard(t *testing.T) {
+	freezeUI(t)
+	class := server.NewClassroom()
+	d := NewDashboard(class, "http://teacher:8080")
+	d.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	if view := plainText(d.View()); !strings.Contains(view, "--classroom http://teacher:8080") || !strings.Contains(view, "Waiting for the first game") {
+		t.Errorf("Expected the join command and no games yet, got:\n%s", view)
+	}
+
+	for _, result := range []server.ClassResult{
+		{Student: "alice", Game: stats.GameRecord{Strategy: game.Switch, Won: true}},
+		{Student: "alice", Game: stats.GameRecord{Strategy: game.Switch}},
+		{Student: "bob", Game: stats.GameRecord{Strategy: game.Stay}},
+	} {
+		if err := class.Add(result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, cmd := d.Update(DashboardTickMsg{})
+	if cmd == nil {
+		t.Error("Expected the dashboard to keep redrawing")
+	}
+	view := plainText(d.View())
+	for _, want := range []string{"2 students • 3 games", "Switch 50.0% of 2", "Stay 0.0% of 1", "alice", "bob"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q on the dashboard, got:\n%s", want, view)
+		}
+	}
+
+	if _, cmd := d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}); cmd == nil {
+		t.Error("Expected q to close the dashboard")
+	}
+}
@@ -0,0 +1,8 @@
+package ui
+
+import "time"
+
+// now is the clock read by animations, the reveal delay and the daily
+// challenge. Snapshot tests replace it with a fixed clock so View output is
+// reproducible
+var now = time.Now
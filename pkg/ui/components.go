@@ -2,11 +2,14 @@ package ui
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/mattn/go-runewidth"
 	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/stats/analysis"
 )
 
 // Door component with enhanced ASCII art (Phase 3)
@@ -18,6 +21,7 @@ type DoorComponent struct {
 	Cursor   bool
 	Width    int
 	Height   int
+	Shake    int // Columns the door art jolts sideways while it opens
 }
 
 // NewDoorComponent creates a new door component
@@ -96,6 +100,8 @@ func (d *DoorComponent) RenderWithAnimation(animFrame string, animColor lipgloss
 	if isAnimating {
 		// Use animation-specific styling
 		style = DoorOpeningStyle.BorderForeground(animColor).UnsetWidth().UnsetHeight()
+		// Shaking moves padding from one side to the other, so the door keeps its width
+		style = style.PaddingLeft(1 + d.Shake).PaddingRight(1 - d.Shake)
 	} else if d.Cursor || d.Selected {
 		// Cursor or selected door gets highlighted border
 		style = SelectedDoorStyle.UnsetWidth().UnsetHeight()
@@ -153,6 +159,9 @@ func (d *DoorComponent) renderClosedDoor() string {
 │%s│
 │%s│
 %s`, topLine, doorLabel, numberLabel, emptyLine1, knobLine, emptyLine2, emptyLine, closedLabel, bottomLine)
+	if LargeText {
+		doorArt = d.renderLargeDoor([]string{emptyLine, emptyLine1, knobLine, emptyLine2, emptyLine}, "CLOSED")
+	}
 
 	// Add status indicator
 	statusWidth := d.Width
@@ -242,6 +251,9 @@ func (d *DoorComponent) renderCarDoor() string {
 │%s│
 │%s│
 %s`, topLine, doorLabel, numberLabel, carLines[0], carLines[1], carLines[2], carLines[3], carLines[4], bottomLine)
+	if LargeText {
+		doorArt = d.renderLargeDoor(carLines, "CAR")
+	}
 
 	// Add status indicator (same height as other doors)
 	statusWidth := d.Width
@@ -305,6 +317,9 @@ func (d *DoorComponent) renderGoatDoor() string {
 │%s│
 │%s│
 %s`, topLine, doorLabel, numberLabel, goatLines[0], goatLines[1], goatLines[2], emptyLine, goatLabel, bottomLine)
+	if LargeText {
+		doorArt = d.renderLargeDoor(append([]string{emptyLine}, goatLines...), "GOAT")
+	}
 
 	// Add status indicator (same pattern as other doors)
 	statusWidth := d.Width
@@ -322,7 +337,7 @@ func (d *DoorComponent) renderGoatDoor() string {
 	return doorArt
 }
 
-// DoorsRow renders all three doors in a row
+// RenderDoorsRow renders the doors side by side
 func RenderDoorsRow(doors []*game.Door, playerChoice, hostOpened, cursor int, showAll bool) string {
 	var doorComponents []string
 
@@ -347,7 +362,7 @@ func RenderDoorsRow(doors []*game.Door, playerChoice, hostOpened, cursor int, sh
 		}
 
 		doorComp := NewDoorComponent(i+1, displayDoor, selected, isCursor)
-		doorComponents = append(doorComponents, doorComp.Render())
+		doorComponents = append(doorComponents, markZone(doorZone(i), doorComp.Render()))
 	}
 
 	// Join doors horizontally with center alignment to prevent collapse
@@ -380,6 +395,11 @@ func (p *ProgressBar) Render() string {
 
 	percentage := float64(p.Current) / float64(p.Total)
 	filled := int(percentage * float64(p.Width))
+	if filled > p.Width {
+		filled = p.Width
+	} else if filled < 0 {
+		filled = 0
+	}
 
 	bar := strings.Repeat("█", filled) + strings.Repeat("░", p.Width-filled)
 
@@ -430,7 +450,7 @@ func (s *StatsCard) Render() string {
 	cardStyle := lipgloss.NewStyle().
 		Width(20).
 		Height(6).
-		BorderStyle(lipgloss.RoundedBorder()).
+		BorderStyle(FrameBorder).
 		BorderForeground(s.Color).
 		Padding(1).
 		Align(lipgloss.Center, lipgloss.Center)
@@ -444,6 +464,243 @@ func (s *StatsCard) Render() string {
 	return cardStyle.Render(content)
 }
 
+// ConvergenceChart plots the switch and stay win rates as games are played,
+// with dotted lines at the rates theory predicts
+type ConvergenceChart struct {
+	Switch         []float64 // Switch win rate at each sample, 0-1; NaN leaves a gap
+	Stay           []float64 // Stay win rate at each sample, 0-1; NaN leaves a gap
+	ExpectedSwitch float64   // Rates theory predicts; NaN draws no line
+	ExpectedStay   float64
+	Width          int // Plot columns, excluding the axis
+	Height         int // Plot rows
+}
+
+// NewConvergenceChart creates a new convergence chart
+func NewConvergenceChart(switchRates, stayRates []float64, expectedSwitch, expectedStay float64, width, height int) *ConvergenceChart {
+	return &ConvergenceChart{
+		Switch:         switchRates,
+		Stay:           stayRates,
+		ExpectedSwitch: expectedSwitch,
+		ExpectedStay:   expectedStay,
+		Width:          width,
+		Height:         height,
+	}
+}
+
+// Render renders the chart. Switch is drawn with ● and stay with ○, so the
+// lines can be told apart without color
+func (c *ConvergenceChart) Render() string {
+	if c.Width <= 0 || c.Height <= 1 {
+		return ""
+	}
+
+	type cell struct {
+		char  string
+		color lipgloss.Color
+	}
+	grid := make([][]cell, c.Height)
+	for row := range grid {
+		grid[row] = make([]cell, c.Width)
+		for col := range grid[row] {
+			grid[row][col] = cell{" ", MutedColor}
+		}
+	}
+
+	rowFor := func(rate float64) int {
+		return int(math.Round((1 - rate) * float64(c.Height-1)))
+	}
+	for _, expected := range []float64{c.ExpectedSwitch, c.ExpectedStay} {
+		if math.IsNaN(expected) {
+			continue
+		}
+		for col := range grid[rowFor(expected)] {
+			grid[rowFor(expected)][col] = cell{"┈", MutedColor}
+		}
+	}
+
+	plot := func(rates []float64, char string, color lipgloss.Color) {
+		for i, rate := range rates {
+			if math.IsNaN(rate) {
+				continue
+			}
+			col := i
+			if len(rates) > c.Width {
+				col = i * c.Width / len(rates)
+			}
+			if col < c.Width {
+				grid[rowFor(rate)][col] = cell{char, color}
+			}
+		}
+	}
+	plot(c.Stay, "○", AccentColor)
+	plot(c.Switch, "●", SecondaryColor)
+
+	axisStyle := lipgloss.NewStyle().Foreground(MutedColor)
+	lines := make([]string, 0, c.Height+1)
+	for row, cells := range grid {
+		label := "    "
+		switch row {
+		case 0:
+			label = "100%"
+		case (c.Height - 1) / 2:
+			label = fmt.Sprintf("%3.0f%%", 100*(1-float64(row)/float64(c.Height-1)))
+		case c.Height - 1:
+			label = "  0%"
+		}
+
+		var line strings.Builder
+		line.WriteString(axisStyle.Render(label + "│"))
+		for _, cell := range cells {
+			line.WriteString(lipgloss.NewStyle().Foreground(cell.color).Render(cell.char))
+		}
+		lines = append(lines, line.String())
+	}
+	lines = append(lines, axisStyle.Render("    └"+strings.Repeat("─", c.Width)))
+
+	return strings.Join(lines, "\n")
+}
+
+// DecisionTree shows every way a game can go, from the player's first pick
+// through the host's reveal to staying or switching, with the chance of each
+// branch. The path a finished game took is highlighted
+type DecisionTree struct {
+	NumDoors int
+	Opens    int // Doors the host opens
+	Behavior game.HostBehavior
+	Path     *game.GameResult // Finished game to highlight, nil for none
+}
+
+// decisionNode is a branch of the decision tree
+type decisionNode struct {
+	label    string
+	chance   string // Chance of the branch, or of winning for a final choice
+	onPath   bool
+	children []decisionNode
+}
+
+// decisionTreeLabelWidth is the column the branch chances are aligned to
+const decisionTreeLabelWidth = 34
+
+// NewDecisionTree creates a decision tree for games set up like g, with the
+// path g took highlighted once it is over
+func NewDecisionTree(g *game.Game) *DecisionTree {
+	return &DecisionTree{
+		NumDoors: g.NumDoors(),
+		Opens:    g.Host.DoorsToOpen(g.NumDoors()),
+		Behavior: g.Host.Behavior,
+		Path:     g.Result,
+	}
+}
+
+// WinRates returns the chance that staying and switching win, over all games
+// including those in which the host reveals the car
+func (t *DecisionTree) WinRates() (stay, switchDoors float64) {
+	pickedGoat := float64(t.NumDoors-1) / float64(t.NumDoors)
+	closed := float64(t.NumDoors - 1 - t.Opens) // Other doors left to switch to
+	return 1 / float64(t.NumDoors), pickedGoat * (1 - t.carShownChance()) / closed
+}
+
+// carShownChance returns the chance the host reveals the car when the player
+// picked a goat; only a falling host can
+func (t *DecisionTree) carShownChance() float64 {
+	if t.Behavior != game.HostFall {
+		return 0
+	}
+	return float64(t.Opens) / float64(t.NumDoors-1)
+}
+
+// Render renders the tree. The highlighted path is also marked with ◀, so it
+// can be followed without color
+func (t *DecisionTree) Render() string {
+	path := t.Path != nil
+	pickedCar := path && t.Path.InitialChoice == t.Path.CarPosition
+	switched := path && t.Path.Strategy == game.Switch
+	carShown := path && t.Path.CarRevealed
+
+	goats := fmt.Sprintf("Host opens %d goats", t.Opens)
+	if t.Opens == 1 {
+		goats = "Host opens 1 goat"
+	}
+	closed := t.NumDoors - 1 - t.Opens
+	choices := func(onPath bool, stay, switchDoors float64) []decisionNode {
+		return []decisionNode{
+			{label: "You stay", chance: "wins " + analysis.Fraction(stay), onPath: onPath && !switched},
+			{label: "You switch", chance: "wins " + analysis.Fraction(switchDoors), onPath: onPath && switched},
+		}
+	}
+
+	pickCar := decisionNode{
+		label:  "Car behind your door",
+		chance: analysis.Fraction(1 / float64(t.NumDoors)),
+		onPath: pickedCar,
+		children: []decisionNode{{
+			label:    goats,
+			chance:   analysis.Fraction(1),
+			onPath:   pickedCar,
+			children: choices(pickedCar, 1, 0),
+		}},
+	}
+
+	pickedGoat := path && !pickedCar
+	shown := t.carShownChance()
+	pickGoat := decisionNode{
+		label:  "Goat behind your door",
+		chance: analysis.Fraction(float64(t.NumDoors-1) / float64(t.NumDoors)),
+		onPath: pickedGoat,
+	}
+	if shown < 1 {
+		pickGoat.children = append(pickGoat.children, decisionNode{
+			label:    goats,
+			chance:   analysis.Fraction(1 - shown),
+			onPath:   pickedGoat && !carShown,
+			children: choices(pickedGoat && !carShown, 0, 1/float64(closed)),
+		})
+	}
+	if shown > 0 {
+		pickGoat.children = append(pickGoat.children, decisionNode{
+			label:    "Host shows the car",
+			chance:   analysis.Fraction(shown),
+			onPath:   carShown,
+			children: choices(carShown, 0, 0),
+		})
+	}
+
+	lines := []string{StatsLabelStyle.Render("You pick a door")}
+	for i, node := range []decisionNode{pickCar, pickGoat} {
+		lines = t.renderNode(lines, node, "", i == 1)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// renderNode appends a branch and the branches under it to lines
+func (t *DecisionTree) renderNode(lines []string, node decisionNode, prefix string, last bool) []string {
+	branch, indent := "├─ ", "│  "
+	if last {
+		branch, indent = "└─ ", "   "
+	}
+
+	label := prefix + branch + node.label + " "
+	if width := runewidth.StringWidth(label); width < decisionTreeLabelWidth {
+		label += strings.Repeat(".", decisionTreeLabelWidth-width)
+	}
+	line := label + " " + node.chance
+
+	style := StatsLabelStyle
+	switch {
+	case node.onPath:
+		style = lipgloss.NewStyle().Foreground(AccentColor).Bold(true)
+		line += " ◀"
+	case t.Path != nil:
+		style = MutedStyle
+	}
+	lines = append(lines, style.Render(line))
+
+	for i, child := range node.children {
+		lines = t.renderNode(lines, child, prefix+indent, i == len(node.children)-1)
+	}
+	return lines
+}
+
 // GamePhaseIndicator shows the current game phase
 type GamePhaseIndicator struct {
 	Phase       game.GamePhase
@@ -456,6 +713,8 @@ func NewGamePhaseIndicator(phase game.GamePhase) *GamePhaseIndicator {
 	switch phase {
 	case game.InitialChoice:
 		description = "Choose your door"
+	case game.HostReveal:
+		description = "The host is opening a door"
 	case game.FinalChoice:
 		description = "Switch or stay?"
 	case game.GameOver:
@@ -475,7 +734,7 @@ func (g *GamePhaseIndicator) Render() string {
 	phaseStyle := GetPhaseStyle(g.Description)
 
 	indicator := lipgloss.NewStyle().
-		BorderStyle(lipgloss.RoundedBorder()).
+		BorderStyle(FrameBorder).
 		BorderForeground(phaseStyle.GetForeground()).
 		Padding(0, 2).
 		Margin(1, 0)
@@ -515,7 +774,7 @@ func (h *HelpBox) Render() string {
 
 	boxStyle := lipgloss.NewStyle().
 		Width(h.Width).
-		BorderStyle(lipgloss.RoundedBorder()).
+		BorderStyle(FrameBorder).
 		BorderForeground(PrimaryColor).
 		Padding(1, 2)
 
@@ -586,6 +845,10 @@ type KeyBinding struct {
 
 // Footer component with key bindings in order
 func RenderFooter(bindings []KeyBinding) string {
+	if LargeText {
+		bindings = shortenFooter(bindings)
+	}
+
 	var items []string
 
 	for _, binding := range bindings {
@@ -597,6 +860,9 @@ func RenderFooter(bindings []KeyBinding) string {
 			Foreground(MutedColor)
 
 		item := fmt.Sprintf("%s %s", keyStyle.Render(binding.Key), descStyle.Render(binding.Desc))
+		if _, ok := footerKey(binding.Key); ok {
+			item = markZone(keyZone(binding.Key), item)
+		}
 		items = append(items, item)
 	}
 
@@ -608,7 +874,7 @@ func RenderFooter(bindings []KeyBinding) string {
 		MarginTop(2).
 		Padding(1, 0).
 		BorderTop(true).
-		BorderStyle(lipgloss.NormalBorder()).
+		BorderStyle(RuleBorder).
 		BorderForeground(BorderColor)
 
 	return footerStyle.Render(content)
@@ -625,6 +891,14 @@ func (d *DoorComponent) renderAnimatedDoor(animFrame string) string {
 │         │
 │   ...   │
 └─────────┘`, d.Number, animFrame)
+	if LargeText {
+		doorArt = d.renderLargeDoor([]string{
+			d.centerText("", d.Width-2),
+			d.centerText(animFrame, d.Width-2),
+			d.centerText("", d.Width-2),
+			d.centerText("...", d.Width-2),
+		}, "OPENING")
+	}
 
 	if d.Cursor {
 		doorArt += "\n ▶ SELECT ◀"
@@ -637,7 +911,8 @@ func (d *DoorComponent) renderAnimatedDoor(animFrame string) string {
 	return doorArt
 }
 
-// RenderDoorsRowWithAnimation renders doors with animation support (Phase 4)
+// RenderDoorsRowWithAnimation renders the doors side by side, drawing the doors
+// the model is animating with the animation's current frame
 func RenderDoorsRowWithAnimation(doors []*game.Door, playerChoice, hostOpened, cursor int, showAll bool, model *Model) string {
 	var doorComponents []string
 
@@ -669,13 +944,12 @@ func RenderDoorsRowWithAnimation(doors []*game.Door, playerChoice, hostOpened, c
 			isAnimating := model.DoorAnimations[i] != nil && model.DoorAnimations[i].IsRunning()
 
 			if isAnimating {
-				doorComponents = append(doorComponents, doorComp.RenderWithAnimation(animFrame, animColor, true))
-			} else {
-				doorComponents = append(doorComponents, doorComp.Render())
+				doorComp.Shake = model.DoorAnimations[i].GetCurrentShake()
+				doorComponents = append(doorComponents, markZone(doorZone(i), doorComp.RenderWithAnimation(animFrame, animColor, true)))
+				continue
 			}
-		} else {
-			doorComponents = append(doorComponents, doorComp.Render())
 		}
+		doorComponents = append(doorComponents, markZone(doorZone(i), doorComp.Render()))
 	}
 
 	// Join doors horizontally with center alignment to prevent collapse
@@ -730,12 +1004,65 @@ func RenderDoorsRowResponsive(doors []*game.Door, playerChoice, hostOpened, curs
 	return lipgloss.JoinHorizontal(lipgloss.Center, spacedComponents...)
 }
 
+// DoorsGridColumns returns how many door cells fit on one row of the doors grid
+func DoorsGridColumns(numDoors, width int) int {
+	cellWidth := len(fmt.Sprintf("%d", numDoors)) + 2
+	return max(1, (width+1)/(cellWidth+1))
+}
+
+// RenderDoorsGrid renders doors as compact one-line cells wrapped to the given
+// width, for games with too many doors to draw side by side. Closed doors show
+// their number, open doors show C for the car or G for a goat; the player's
+// pick is wrapped in parentheses and the cursor in ▶ ◀
+func RenderDoorsGrid(doors []*game.Door, playerChoice, cursor int, showAll bool, width int) string {
+	labelWidth := len(fmt.Sprintf("%d", len(doors)))
+	perRow := DoorsGridColumns(len(doors), width)
+
+	var rows []string
+	var cells []string
+	for i, door := range doors {
+		label := fmt.Sprintf("%*d", labelWidth, i+1)
+		style := lipgloss.NewStyle().Foreground(DoorColor)
+		if door.IsOpen() || showAll {
+			if door.HasCar() {
+				label = fmt.Sprintf("%*s", labelWidth, "C")
+				style = lipgloss.NewStyle().Foreground(CarColor).Bold(true)
+			} else {
+				label = fmt.Sprintf("%*s", labelWidth, "G")
+				style = MutedStyle
+			}
+		}
+
+		open, close := "[", "]"
+		switch {
+		case i == cursor:
+			open, close = "▶", "◀"
+			style = style.Foreground(SelectedColor).Bold(true)
+		case i == playerChoice:
+			open, close = "(", ")"
+			style = style.Foreground(SelectedColor)
+		}
+
+		cells = append(cells, markZone(doorZone(i), style.Render(open+label+close)))
+		if len(cells) == perRow || i == len(doors)-1 {
+			rows = append(rows, strings.Join(cells, " "))
+			cells = nil
+		}
+	}
+
+	legend := MutedStyle.Render("C car · G goat · ( ) your pick · ▶ ◀ cursor")
+	grid := lipgloss.JoinVertical(lipgloss.Left, rows...)
+	return lipgloss.JoinVertical(lipgloss.Center, grid, "", legend)
+}
+
 // ResetConfirmationPopover component for confirming statistics reset
 type ResetConfirmationPopover struct {
 	ConfirmationNumbers [4]int
 	UserInputNumbers    [4]int
 	CurrentInputIndex   int
 	Width               int
+	Scope               stats.ResetScope // What the reset clears
+	ChoosingScope       bool             // Show the scopes to pick from instead of the numbers
 }
 
 // NewResetConfirmationPopover creates a new reset confirmation popover
@@ -785,7 +1112,7 @@ func (r *ResetConfirmationPopover) Render() string {
 
 	boxStyle := lipgloss.NewStyle().
 		Width(r.Width).
-		BorderStyle(lipgloss.RoundedBorder()).
+		BorderStyle(FrameBorder).
 		BorderForeground(WarningColor).
 		Padding(2, 3).
 		Align(lipgloss.Center)
@@ -828,7 +1155,28 @@ func (r *ResetConfirmationPopover) Render() string {
 
 	var lines []string
 	lines = append(lines, titleStyle.Render("⚠️  RESET STATISTICS  ⚠️"))
-	lines = append(lines, warningStyle.Render("This will permanently delete all game data!"))
+	if r.ChoosingScope {
+		lines = append(lines, instructionStyle.Render("What do you want to reset?"))
+		var scopes []string
+		for _, scope := range stats.ResetScopes() {
+			if scope == r.Scope {
+				scopes = append(scopes, numbersStyle.UnsetMarginBottom().Render("▶ "+scope.String()))
+			} else {
+				scopes = append(scopes, instructionStyle.UnsetMarginBottom().Render("  "+scope.String()))
+			}
+		}
+		lines = append(lines, lipgloss.NewStyle().MarginBottom(1).Render(lipgloss.JoinVertical(lipgloss.Left, scopes...)))
+		lines = append(lines, instructionStyle.Render(r.Scope.Description()))
+		lines = append(lines, footerStyle.Render("Use ↑↓ to choose, Enter to continue, ESC to cancel"))
+		return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Center, lines...))
+	}
+
+	if r.Scope == stats.ResetAll {
+		lines = append(lines, warningStyle.Render("This will permanently delete all game data!"))
+	} else {
+		lines = append(lines, warningStyle.Render(r.Scope.Description()+"."))
+	}
+	lines = append(lines, MutedStyle.Render("A backup of the stats file is saved first."))
 	lines = append(lines, instructionStyle.Render("To confirm, enter these 4 numbers:"))
 	lines = append(lines, numbersStyle.Render(confirmationText))
 	lines = append(lines, instructionStyle.Render("Your input:"))
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbletea"
+)
+
+// PromptConfigImport previews the settings in a config file and asks the user
+// to confirm the changes before applying them
+func (m *Model) PromptConfigImport(path string) {
+	if m.ConfigManager == nil {
+		m.ErrorMessage = "Settings are not available"
+		return
+	}
+
+	cfg, changes, err := m.ConfigManager.PreviewFile(path)
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "import settings"))
+		return
+	}
+
+	name := filepath.Base(path)
+	if len(changes) == 0 {
+		m.SuccessMessage = fmt.Sprintf("Settings in %s already match your current settings", name)
+		return
+	}
+
+	lines := make([]string, len(changes))
+	for i, change := range changes {
+		lines[i] = change.String()
+	}
+
+	m.Dialog = NewConfirmDialog(fmt.Sprintf("Apply %d setting changes from %s?", len(changes), name), lines, func() tea.Cmd {
+		// Keep the current settings so the import can be undone with --restore-config
+		backup, err := m.ConfigManager.Backup()
+		if err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "back up settings"))
+			return nil
+		}
+		if err := m.ConfigManager.Update(cfg); err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "import settings"))
+			return nil
+		}
+		m.SuccessMessage = fmt.Sprintf("Settings imported from %s; previous settings backed up to %s", name, filepath.Base(backup))
+		m.offerOpenFolder(backup)
+		return nil
+	})
+}
+
+// PromptConfigRestore offers to restore the most recent settings backup
+func (m *Model) PromptConfigRestore() {
+	if m.ConfigManager == nil {
+		m.ErrorMessage = "Settings are not available"
+		return
+	}
+
+	backups, err := m.ConfigManager.ListBackups()
+	if err == nil && len(backups) == 0 {
+		err = errors.New("no settings backups found")
+	}
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "restore settings"))
+		return
+	}
+
+	m.PromptConfigImport(backups[0])
+}
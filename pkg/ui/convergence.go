@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// Pages of the statistics view
+const (
+	StatsPageOverview = iota
+	StatsPageConvergence
+	StatsPageAnalytics
+	StatsPageAchievements
+	StatsPageFairness
+	statsPageCount
+)
+
+// statsPageBinding returns the footer hint for paging through statistics
+func (m *Model) statsPageBinding() KeyBinding {
+	return KeyBinding{"←→", fmt.Sprintf("Page %d/%d", m.StatsPage+1, m.MaxStatsPages)}
+}
+
+// statsFooter renders the footer of a statistics page, offering the time
+// range on pages that follow it. Export and reset are left out when nothing
+// is saved
+func (m *Model) statsFooter(withRange bool) string {
+	bindings := []KeyBinding{m.statsPageBinding()}
+	if withRange {
+		bindings = append(bindings, KeyBinding{"f", "Range"})
+	}
+	if !m.NoPersist {
+		bindings = append(bindings, KeyBinding{"e", "Export"})
+	}
+	bindings = append(bindings, KeyBinding{"w", "What if?"})
+	if !m.NoPersist {
+		bindings = append(bindings, KeyBinding{"r", "Reset"})
+	}
+	return RenderFooter(append(bindings, KeyBinding{"ESC/q", "Return"}))
+}
+
+// renderConvergencePage renders the statistics page charting how the win rates
+// of both strategies approach theory as games are played
+func (m *Model) renderConvergencePage(content []string) []string {
+	gameStats := m.viewedStats()
+	convergence := stats.ConvergenceOf(gameStats.GameHistory)
+
+	content = append(content, Center(StatsHeaderStyle.Render("📉 WIN RATE CONVERGENCE"), m.Width, 1))
+	content = append(content, Center(MutedStyle.Render(fmt.Sprintf("Cumulative win rate over your last %d games", len(convergence.Switch))), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	chartWidth := max(m.Width-16, 20)
+	chart := NewConvergenceChart(convergence.Switch, convergence.Stay, convergence.ExpectedSwitch, convergence.ExpectedStay, chartWidth, 11)
+	content = append(content, Center(chart.Render(), m.Width, 1))
+
+	legend := fmt.Sprintf("%s Switch %.1f%%   %s Stay %.1f%%   %s",
+		lipgloss.NewStyle().Foreground(SecondaryColor).Render("●"), gameStats.SwitchStats.WinRate*100,
+		lipgloss.NewStyle().Foreground(AccentColor).Render("○"), gameStats.StayStats.WinRate*100,
+		MutedStyle.Render(fmt.Sprintf("┈ theory %.1f%% / %.1f%%", convergence.ExpectedSwitch*100, convergence.ExpectedStay*100)))
+	content = append(content, Center(StatsLabelStyle.Render(legend), m.Width, 1))
+
+	if hint := m.renderGlossaryHint(); hint != "" {
+		content = append(content, hint)
+	}
+	content = append(content, m.statsFooter(true))
+
+	return content
+}
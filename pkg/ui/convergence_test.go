@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestConvergencePage(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.CurrentView = StatsView
+	model.Width = 80
+	model.Height = 40
+
+	// A switch that wins, then a stay that loses
+	for _, strategy := range []game.PlayerStrategy{game.Switch, game.Stay} {
+		finalChoice := 2
+		if strategy == game.Stay {
+			finalChoice = 1
+		}
+		err := model.StatsManager.RecordGame(&game.GameResult{
+			Won:            strategy == game.Switch,
+			Strategy:       strategy,
+			InitialChoice:  1,
+			FinalChoice:    finalChoice,
+			CarPosition:    2,
+			HostOpenedDoor: 3,
+			Timestamp:      time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	if view := model.View(); !strings.Contains(view, "Page 1/5") || strings.Contains(view, "WIN RATE CONVERGENCE") {
+		t.Fatal("Statistics should open on the overview page")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if model.StatsPage != StatsPageConvergence {
+		t.Fatalf("Expected → to show the convergence page, got page %d", model.StatsPage)
+	}
+
+	view := model.View()
+	for _, want := range []string{"WIN RATE CONVERGENCE", "last 2 games", "100%│●", "0%│ ○", "Switch 100.0%", "Stay 0.0%", "Page 2/5"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Convergence page should contain %q:\n%s", want, view)
+		}
+	}
+
+	for range 4 {
+		model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	}
+	if model.StatsPage != StatsPageFairness {
+		t.Error("Paging should stop at the last page")
+	}
+
+	for range 4 {
+		model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	}
+	if model.StatsPage != StatsPageOverview {
+		t.Error("Expected ← to return to the overview")
+	}
+}
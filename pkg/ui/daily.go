@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// startDailyChallenge begins today's seeded challenge from the first round
+func (m *Model) startDailyChallenge() {
+	m.Daily = game.NewDailyChallenge(now())
+	m.Practice = nil
+	m.Worksheet = nil
+	m.Hotseat = nil
+	m.Ladder = nil
+	m.startNewGame()
+	m.CurrentView = GameView
+}
+
+// recordDailyRound adds the finished game to the active daily challenge
+func (m *Model) recordDailyRound() {
+	if m.Daily == nil || m.Daily.IsComplete() {
+		return
+	}
+
+	if err := m.Daily.Record(m.Game.Result); err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "record daily round"))
+	}
+}
+
+// renderDailyStatus renders the daily challenge banner shown above the doors
+func (m *Model) renderDailyStatus() string {
+	if m.Daily == nil {
+		return ""
+	}
+	round := len(m.Daily.Rounds)
+	if m.Game.Phase != game.GameOver {
+		round++
+	}
+
+	status := fmt.Sprintf("📅 Daily Challenge %s  •  Round %d/%d  •  Wins %d",
+		m.Daily.Date, round, game.DailyRounds, m.Daily.Wins())
+	return Center(lipgloss.NewStyle().Foreground(AccentColor).Bold(true).Render(status), m.Width, 1)
+}
+
+// renderDailyShareCode renders the share code once the challenge is complete
+func (m *Model) renderDailyShareCode() string {
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(AccentColor).
+		Padding(0, 2)
+
+	summary := fmt.Sprintf("You won %d of %d games today!", m.Daily.Wins(), game.DailyRounds)
+
+	return Center(boxStyle.Render(lipgloss.JoinVertical(lipgloss.Center,
+		TitleStyle.Render("DAILY CHALLENGE COMPLETE"),
+		SubtitleStyle.Render(summary),
+		StatsLabelStyle.Render("Share code: ")+SuccessStyle.Render(m.Daily.ShareCode()),
+		MutedStyle.Render("Friends can check it with: monty-hall verify <code>"),
+	)), m.Width, 1)
+}
@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/westhuis/monty-hall/pkg/server"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// dashboardRefresh is how often the classroom dashboard redraws
+const dashboardRefresh = time.Second
+
+// DashboardTickMsg redraws the classroom dashboard with the latest reports
+type DashboardTickMsg struct{}
+
+// Dashboard is the instructor's live view of the games a class reports to
+// serve mode: the class-wide win rates of each strategy converging on theory
+// as more students play, and how many games each student has played
+type Dashboard struct {
+	Class   *server.Classroom
+	JoinURL string // Address students join with
+	Width   int
+	Height  int
+}
+
+// NewDashboard creates a dashboard for the games reported to a classroom
+func NewDashboard(class *server.Classroom, joinURL string) *Dashboard {
+	return &Dashboard{Class: class, JoinURL: joinURL, Width: 80, Height: 24}
+}
+
+// Init starts redrawing the dashboard
+func (d *Dashboard) Init() tea.Cmd {
+	return d.tick()
+}
+
+// tick schedules the next redraw
+func (d *Dashboard) tick() tea.Cmd {
+	return tea.Tick(dashboardRefresh, func(time.Time) tea.Msg {
+		return DashboardTickMsg{}
+	})
+}
+
+// Update handles resizes, redraws and quitting
+func (d *Dashboard) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		d.Width, d.Height = msg.Width, msg.Height
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", KeyQ, KeyEscape:
+			return d, tea.Quit
+		}
+
+	case DashboardTickMsg:
+		return d, d.tick()
+	}
+	return d, nil
+}
+
+// View renders the class's totals, its convergence chart and its students
+func (d *Dashboard) View() string {
+	summary := d.Class.Summary()
+
+	var content []string
+	content = append(content, HeaderStyle.Render("CLASSROOM DASHBOARD"))
+	content = append(content, Center(MutedStyle.Render(fmt.Sprintf("Students join with: monty-hall --classroom %s --student NAME", d.JoinURL)), d.Width, 1))
+	content = append(content, Spacer(1))
+
+	if summary.Games == 0 {
+		content = append(content, Center(MutedStyle.Render("Waiting for the first game. Each game a student finishes is added here."), d.Width, 1))
+	} else {
+		students := fmt.Sprintf("%d students", len(summary.Students))
+		if len(summary.Students) == 1 {
+			students = "1 student"
+		}
+		content = append(content, Center(StatsLabelStyle.Render(fmt.Sprintf("%s • %d games", students, summary.Games)), d.Width, 1))
+		content = append(content, Spacer(1))
+
+		convergence := d.Class.Convergence()
+		chart := NewConvergenceChart(convergence.Switch, convergence.Stay, convergence.ExpectedSwitch, convergence.ExpectedStay, max(d.Width-16, 20), 9)
+		content = append(content, Center(chart.Render(), d.Width, 1))
+
+		legend := fmt.Sprintf("%s Switch %s   %s Stay %s   %s",
+			lipgloss.NewStyle().Foreground(SecondaryColor).Render("●"), classRate(summary.Switch),
+			lipgloss.NewStyle().Foreground(AccentColor).Render("○"), classRate(summary.Stay),
+			MutedStyle.Render(fmt.Sprintf("┈ theory %.1f%% / %.1f%%", convergence.ExpectedSwitch*100, convergence.ExpectedStay*100)))
+		content = append(content, Center(StatsLabelStyle.Render(legend), d.Width, 1))
+		content = append(content, Spacer(1))
+		content = append(content, Center(d.renderStudents(summary.Students), d.Width, 1))
+	}
+
+	content = append(content, RenderFooter([]KeyBinding{{"q", "Stop serving"}}))
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
+
+// classRate describes a strategy's class-wide win rate
+func classRate(s stats.StrategyStats) string {
+	if s.GamesPlayed == 0 {
+		return "no games"
+	}
+	return fmt.Sprintf("%.1f%% of %d", s.WinRate*100, s.GamesPlayed)
+}
+
+// renderStudents lists the students with the most games, as many as fit
+func (d *Dashboard) renderStudents(students []server.StudentSummary) string {
+	rows := []string{StatsHeaderStyle.Render(fmt.Sprintf("%-20s %7s %7s %9s", "Student", "Games", "Won", "Switched"))}
+
+	visible := max(3, d.Height-26)
+	for i, student := range students {
+		if i == visible {
+			rows = append(rows, MutedStyle.Render(fmt.Sprintf("and %d more", len(students)-visible)))
+			break
+		}
+		name := runewidth.FillRight(runewidth.Truncate(student.Name, 20, "…"), 20)
+		switched := float64(student.Switched) / float64(student.Games) * 100
+		rows = append(rows, StatsLabelStyle.Render(fmt.Sprintf("%s %7d %7d %8.0f%%", name, student.Games, student.Wins, switched)))
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, rows...)
+}
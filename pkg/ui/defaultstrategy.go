@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// defaultStrategyPause is how long the host's reveal is shown before the
+// default strategy makes the final choice
+const defaultStrategyPause = 1500 * time.Millisecond
+
+// DefaultStrategyMsg is sent when the default strategy should make the final
+// choice of Game
+type DefaultStrategyMsg struct {
+	Game *game.Game
+}
+
+// scheduleDefaultStrategy arranges for the configured strategy to make the
+// final choice after a pause. The player can still choose in the meantime.
+// Practice and worksheet games always leave the choice to the player
+func (m *Model) scheduleDefaultStrategy() tea.Cmd {
+	if m.DefaultStrategy == "" || m.DefaultStrategy == "ask" || m.Practice != nil || m.Worksheet != nil {
+		return nil
+	}
+	if m.Game == nil || m.Game.Phase != game.FinalChoice {
+		return nil
+	}
+
+	m.AutoChoiceFor = m.Game
+	g := m.Game
+	return tea.Tick(defaultStrategyPause, func(time.Time) tea.Msg {
+		return DefaultStrategyMsg{Game: g}
+	})
+}
+
+// applyDefaultStrategy makes the final choice with the configured strategy,
+// unless the player already chose or looked away
+func (m *Model) applyDefaultStrategy(msg DefaultStrategyMsg) tea.Cmd {
+	if msg.Game != m.Game || m.AutoChoiceFor != m.Game || m.Game.Phase != game.FinalChoice {
+		return nil
+	}
+	m.AutoChoiceFor = nil
+
+	// A player who is reading help, another view or a prompt chooses themselves
+	if m.CurrentView != GameView || m.ShowHelp || m.Dialog != nil {
+		return nil
+	}
+
+	var err error
+	if m.DefaultStrategy == "switch" {
+		err = m.Game.SwitchChoice()
+	} else {
+		err = m.Game.StayWithChoice()
+	}
+	if err != nil {
+		m.ErrorMessage = err.Error()
+		return nil
+	}
+
+	m.Game.Result.StrategySource = game.StrategySourceDefault
+	return m.startRevealDelay()
+}
+
+// renderDefaultStrategyStatus says which way the default strategy is about to
+// choose
+func (m *Model) renderDefaultStrategyStatus() string {
+	if m.AutoChoiceFor == nil || m.AutoChoiceFor != m.Game {
+		return ""
+	}
+	action := "Staying"
+	if m.DefaultStrategy == "switch" {
+		action = "Switching"
+	}
+	status := "🤖 " + action + " by default (game.default_strategy)  •  choose now to override"
+	return Center(lipgloss.NewStyle().Foreground(SecondaryColor).Render(status), m.Width, 1)
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestDefaultStrategy(t *testing.T) {
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	cfg := configManager.Get()
+	cfg.Game.DefaultStrategy = "switch"
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+	model.CurrentView = GameView
+	model.Game = game.NewSeededGame(1)
+
+	// The host opening doors schedules the switch, announced while the reveal shows
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	_, cmd := model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+	if cmd == nil || model.AutoChoiceFor != model.Game {
+		t.Fatal("Expected the default strategy to be scheduled after the first choice")
+	}
+	if view := plainText(model.renderGame()); !strings.Contains(view, "Switching by default") {
+		t.Errorf("Expected the pending switch to be shown, got:\n%s", view)
+	}
+
+	model.Update(DefaultStrategyMsg{Game: model.Game})
+	if model.Game.Phase != game.GameOver || model.Game.Result.Strategy != game.Switch {
+		t.Fatalf("Expected the default strategy to switch, got phase %v", model.Game.Phase)
+	}
+	records := model.StatsManager.GetStats().GameHistory
+	if len(records) != 1 || records[0].StrategySource != game.StrategySourceDefault {
+		t.Errorf("Expected the record to note the default strategy, got %+v", records)
+	}
+
+	// Choosing first overrides the default, and the record shows the player chose
+	model.startNewGame()
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+	pending := model.Game
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model.Update(DefaultStrategyMsg{Game: pending})
+	if model.Game.Result == nil || model.Game.Result.Strategy != game.Stay || model.Game.Result.StrategySource != "" {
+		t.Errorf("Expected the player's stay to stand, got %+v", model.Game.Result)
+	}
+
+	// Practice games leave the choice to the player
+	model.startNewGame()
+	model.Practice = &PracticeSession{}
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.AutoChoiceFor == model.Game {
+		t.Error("Expected no default strategy in practice games")
+	}
+}
@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// KeyDemoData is the hidden main menu key that loads demo statistics
+const KeyDemoData = "ctrl+d"
+
+// UseDemoProfile switches the session to a temporary profile holding a
+// synthetic history of stats.DemoGames games, so charts have something to
+// show in screenshots and demos. The player's own statistics are left alone;
+// the profile's directory is kept in DemoDir until the caller removes it
+func (m *Model) UseDemoProfile() error {
+	dir, err := os.MkdirTemp("", "monty-hall-demo-")
+	if err != nil {
+		return err
+	}
+
+	statsManager := stats.NewStatsManager(filepath.Join(dir, stats.DefaultStatsFileName))
+	if err := statsManager.SeedDemoData(time.Now().UnixNano(), time.Now()); err != nil {
+		os.RemoveAll(dir)
+		return err
+	}
+
+	if m.DemoDir != "" {
+		os.RemoveAll(m.DemoDir)
+	}
+	m.DemoDir = dir
+	m.useStatsManager(statsManager)
+	return nil
+}
+
+// useStatsManager plays on with another set of statistics, along with the
+// achievements, event log and view history kept beside them
+func (m *Model) useStatsManager(statsManager *stats.StatsManager) {
+	m.StatsManager = statsManager
+	if m.ConfigManager != nil {
+		statsManager.SetRecordDraws(m.ConfigManager.Get().Stats.RecordDraws)
+	}
+	m.Achievements = newAchievementTracker(statsManager)
+	m.Events = newEventLog(statsManager)
+	m.UIState = newUIState(statsManager)
+	m.loadResumableGame()
+	m.autoExportGames = 0
+	statsManager.AddRecordHook(m.countAutoExportGame)
+	statsManager.AddRecordHook(m.queueClassResult)
+}
+
+// confirmDemoData asks before replacing the session's statistics with demo data
+func (m *Model) confirmDemoData() {
+	lines := []string{
+		fmt.Sprintf("Load %d made-up games played over %d days,", stats.DemoGames, stats.DemoDays),
+		"for screenshots, demos and trying out the charts.",
+		"",
+		"Your own statistics are not changed. The demo profile lasts",
+		"until you quit; games played until then are added to it.",
+	}
+	dialog := NewConfirmDialog("Load demo data?", lines, func() tea.Cmd {
+		if err := m.UseDemoProfile(); err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "load demo data"))
+			return nil
+		}
+		m.showToast(fmt.Sprintf("🧪 Demo profile loaded with %d games", stats.DemoGames))
+		m.logEvent(eventlog.KindSuccess, fmt.Sprintf("Loaded a demo profile with %d games", stats.DemoGames))
+		return nil
+	})
+	dialog.ConfirmLabel = "Load"
+	m.Dialog = dialog
+}
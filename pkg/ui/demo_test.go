@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestDemoProfile(t *testing.T) {
+	model := NewModel()
+	own := stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.StatsManager = own
+
+	model.Update(tea.KeyMsg{Type: tea.KeyCtrlD})
+	if model.Dialog == nil {
+		t.Fatal("The demo data key should ask first")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	t.Cleanup(func() { os.RemoveAll(model.DemoDir) })
+
+	if model.DemoDir == "" || model.StatsManager == own {
+		t.Fatal("Expected the session to switch to a demo profile")
+	}
+	if got := model.StatsManager.GetStats().TotalGames; got != stats.DemoGames {
+		t.Errorf("Expected %d demo games, got %d", stats.DemoGames, got)
+	}
+	if own.GetStats().TotalGames != 0 {
+		t.Error("The player's own statistics should be left alone")
+	}
+	if _, ok := model.autoExportConfig(); ok {
+		t.Error("Demo profiles should not be auto-exported")
+	}
+}
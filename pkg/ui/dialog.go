@@ -0,0 +1,163 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// maxDialogLines limits how many body lines a dialog shows before summarizing the rest
+const maxDialogLines = 12
+
+// Dialog choices, the values of ConfirmDialog.Cursor
+const (
+	dialogConfirm = iota
+	dialogCancel
+	dialogAlternative
+)
+
+// ConfirmDialog is a modal confirm/cancel prompt shown over the current view.
+// Setting AltLabel adds a third choice between the two, picked with AltKey
+type ConfirmDialog struct {
+	Title        string
+	Lines        []string
+	ConfirmLabel string
+	CancelLabel  string
+	AltLabel     string
+	AltKey       string
+	Cursor       int // dialogConfirm, dialogCancel or dialogAlternative
+	Width        int
+	OnConfirm    func() tea.Cmd
+	OnCancel     func() tea.Cmd
+	OnAlt        func() tea.Cmd
+}
+
+// NewConfirmDialog creates a dialog that runs onConfirm when accepted
+func NewConfirmDialog(title string, lines []string, onConfirm func() tea.Cmd) *ConfirmDialog {
+	return &ConfirmDialog{
+		Title:        title,
+		Lines:        lines,
+		ConfirmLabel: "Apply",
+		CancelLabel:  "Cancel",
+		Cursor:       dialogCancel, // Default to the safe choice
+		Width:        64,
+		OnConfirm:    onConfirm,
+	}
+}
+
+// choices returns the dialog's choices in the order its buttons are shown
+func (d *ConfirmDialog) choices() []int {
+	if d.AltLabel != "" {
+		return []int{dialogConfirm, dialogAlternative, dialogCancel}
+	}
+	return []int{dialogConfirm, dialogCancel}
+}
+
+// handleDialogKeys processes input while a dialog is open
+func (m *Model) handleDialogKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	dialog := m.Dialog
+	choices := dialog.choices()
+	index := max(0, slices.Index(choices, dialog.Cursor))
+
+	switch key := msg.String(); {
+	case key == "ctrl+c":
+		return m, tea.Quit
+
+	case key == KeyLeft || key == "h":
+		dialog.Cursor = choices[(index+len(choices)-1)%len(choices)]
+
+	case key == KeyRight || key == KeyTab || key == "l":
+		dialog.Cursor = choices[(index+1)%len(choices)]
+
+	case key == "y":
+		return m, m.closeDialog(dialogConfirm)
+
+	case key == "n" || key == KeyEscape || key == KeyQ:
+		return m, m.closeDialog(dialogCancel)
+
+	case dialog.AltLabel != "" && key == dialog.AltKey:
+		return m, m.closeDialog(dialogAlternative)
+
+	case key == KeyEnter || key == KeySpace || key == " ":
+		return m, m.closeDialog(dialog.Cursor)
+	}
+
+	return m, nil
+}
+
+// closeDialog dismisses the open dialog and runs the callback of the choice
+func (m *Model) closeDialog(choice int) tea.Cmd {
+	dialog := m.Dialog
+	m.Dialog = nil
+
+	var callback func() tea.Cmd
+	switch choice {
+	case dialogConfirm:
+		callback = dialog.OnConfirm
+	case dialogAlternative:
+		callback = dialog.OnAlt
+	default:
+		callback = dialog.OnCancel
+	}
+	if callback != nil {
+		return callback()
+	}
+	return nil
+}
+
+// Render renders the dialog box
+func (d *ConfirmDialog) Render() string {
+	titleStyle := lipgloss.NewStyle().
+		Foreground(WarningColor).
+		Bold(true).
+		MarginBottom(1)
+
+	lineStyle := lipgloss.NewStyle().
+		Foreground(TextColor)
+
+	boxStyle := lipgloss.NewStyle().
+		Width(d.Width).
+		BorderStyle(FrameBorder).
+		BorderForeground(WarningColor).
+		Padding(1, 2)
+
+	var content []string
+	content = append(content, titleStyle.Render(d.Title))
+
+	lines := d.Lines
+	if len(lines) > maxDialogLines {
+		lines = lines[:maxDialogLines]
+	}
+	for _, line := range lines {
+		content = append(content, lineStyle.Render(line))
+	}
+	if hidden := len(d.Lines) - len(lines); hidden > 0 {
+		content = append(content, MutedStyle.Render(fmt.Sprintf("… and %d more", hidden)))
+	}
+
+	labels := map[int]string{
+		dialogConfirm:     d.ConfirmLabel,
+		dialogCancel:      d.CancelLabel,
+		dialogAlternative: d.AltLabel,
+	}
+	var buttons []string
+	for i, choice := range d.choices() {
+		if i > 0 {
+			buttons = append(buttons, "  ")
+		}
+		buttons = append(buttons, NewMenuButton(labels[choice], d.Cursor == choice).Render())
+	}
+
+	hint := "y confirm • n/ESC cancel • ←→ choose"
+	if d.AltLabel != "" && d.AltKey != "" {
+		hint = fmt.Sprintf("y %s • %s %s • n/ESC cancel • ←→ choose", strings.ToLower(d.ConfirmLabel), d.AltKey, strings.ToLower(d.AltLabel))
+	}
+	content = append(content, Spacer(1))
+	content = append(content, lipgloss.PlaceHorizontal(d.Width-4, lipgloss.Center, lipgloss.JoinHorizontal(lipgloss.Center, buttons...)))
+	content = append(content, lipgloss.PlaceHorizontal(d.Width-4, lipgloss.Center, MutedStyle.Render(hint)))
+
+	return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, content...))
+}
@@ -0,0 +1,297 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/platform"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestConfirmDialogConfirmAndCancel(t *testing.T) {
+	model := NewModel()
+
+	confirmed := false
+	model.Dialog = NewConfirmDialog("Apply?", []string{"a: 1 → 2"}, func() tea.Cmd {
+		confirmed = true
+		return nil
+	})
+
+	if !strings.Contains(model.View(), "Apply?") {
+		t.Error("Dialog should be rendered over the current view")
+	}
+
+	// Enter on the default (cancel) button dismisses without confirming
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if confirmed || model.Dialog != nil {
+		t.Error("Default choice should cancel the dialog")
+	}
+
+	model.Dialog = NewConfirmDialog("Apply?", nil, func() tea.Cmd {
+		confirmed = true
+		return nil
+	})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if !confirmed || model.Dialog != nil {
+		t.Error("'y' should confirm and close the dialog")
+	}
+
+	// The space bar, which arrives as " ", presses the highlighted button
+	confirmed = false
+	model.Dialog = NewConfirmDialog("Apply?", nil, func() tea.Cmd {
+		confirmed = true
+		return nil
+	})
+	model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if !confirmed || model.Dialog != nil {
+		t.Error("Space should press the highlighted confirm button")
+	}
+}
+
+func TestConfirmDialogTruncatesLongContent(t *testing.T) {
+	lines := make([]string, maxDialogLines+3)
+	for i := range lines {
+		lines[i] = "change"
+	}
+
+	view := NewConfirmDialog("Many changes", lines, nil).Render()
+	if !strings.Contains(view, "and 3 more") {
+		t.Error("Dialog should summarize lines beyond the limit")
+	}
+}
+
+func TestPromptConfigImport(t *testing.T) {
+	model := newSettingsTestModel(t)
+
+	imported := model.ConfigManager.Get()
+	imported.UI.ColorScheme = "colorblind-safe"
+	path := filepath.Join(t.TempDir(), "imported.json")
+	if err := os.WriteFile(path, []byte(imported.String()), 0644); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+
+	model.PromptConfigImport(path)
+	if model.Dialog == nil {
+		t.Fatal("Importing a different config should open a confirmation dialog")
+	}
+
+	if !strings.Contains(strings.Join(model.Dialog.Lines, "\n"), "ui.color_scheme") {
+		t.Errorf("Dialog should list the changed field, got %v", model.Dialog.Lines)
+	}
+
+	if model.ConfigManager.Get().UI.ColorScheme != "default" {
+		t.Error("Settings should not change before confirmation")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}})
+	if model.ConfigManager.Get().UI.ColorScheme != "colorblind-safe" {
+		t.Error("Confirming should apply the imported settings")
+	}
+}
+
+func TestExportOverwriteDialog(t *testing.T) {
+	model := NewModel()
+	dir := t.TempDir()
+
+	options := stats.DefaultExportOptions()
+	options.Format = stats.ExportText
+	options.Filename = filepath.Join(dir, "stats.txt")
+	if err := os.WriteFile(options.Filename, []byte("previous export"), 0644); err != nil {
+		t.Fatalf("Failed to write export: %v", err)
+	}
+
+	model.confirmExportOverwrite(options)
+	if model.Dialog == nil {
+		t.Fatal("An existing export file should open a dialog")
+	}
+	view := model.View()
+	for _, want := range []string{"Overwrite", "Rename", "Cancel", "MONTY HALL GAME STATISTICS REPORT", "stats-2.txt"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Dialog should show %q", want)
+		}
+	}
+
+	// Cancel is the default, and leaves the file alone
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if data, _ := os.ReadFile(options.Filename); string(data) != "previous export" {
+		t.Error("Cancelling should keep the existing file")
+	}
+
+	// Rename writes beside the existing file
+	model.confirmExportOverwrite(options)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if _, err := os.Stat(filepath.Join(dir, "stats-2.txt")); err != nil {
+		t.Errorf("Rename should export to a free name: %v", err)
+	}
+	if data, _ := os.ReadFile(options.Filename); string(data) != "previous export" {
+		t.Error("Renaming should keep the existing file")
+	}
+
+	// Overwrite, chosen with the arrow keys
+	model.confirmExportOverwrite(options)
+	model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	model.Update(tea.KeyMsg{Type: tea.KeyLeft})
+	if model.Dialog.Cursor != dialogConfirm {
+		t.Fatalf("Left twice from cancel should select overwrite, got %d", model.Dialog.Cursor)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if data, _ := os.ReadFile(options.Filename); !strings.HasPrefix(string(data), "MONTY HALL") {
+		t.Error("Overwrite should replace the existing file")
+	}
+	if !strings.Contains(model.SuccessMessage, options.Filename) {
+		t.Errorf("Expected a success message naming the file, got %q", model.SuccessMessage)
+	}
+}
+
+func TestExportDialog(t *testing.T) {
+	model := NewModel()
+	model.CurrentView = StatsView
+	dir := t.TempDir()
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	draft := model.ExportDraft
+	if draft == nil {
+		t.Fatal("Pressing e should open the export dialog")
+	}
+	if !strings.Contains(model.View(), "EXPORT STATISTICS") {
+		t.Error("The stats view should show the export dialog")
+	}
+
+	// Pick CSV, the last 7 days and no daily statistics
+	for draft.Options.Format != stats.ExportCSV {
+		model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if got := rangePresets[draft.Range].Name; got != "Last 7 days" {
+		t.Errorf("Expected the last 7 days, got %q", got)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeySpace})
+	if draft.Options.IncludeDailyStats {
+		t.Error("Space should turn off daily statistics")
+	}
+
+	// Letters are typed into the filename rather than moving the cursor
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	draft.Filename = filepath.Join(dir, "stat")
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("sx")})
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if draft.Cursor != exportFieldFilename || draft.Filename != filepath.Join(dir, "stats") {
+		t.Fatalf("Expected to edit the filename, got %q at field %d", draft.Filename, draft.Cursor)
+	}
+
+	options := draft.ExportOptions(time.Now())
+	if options.TimeRange == nil || time.Since(options.TimeRange.Start) < 7*24*time.Hour-time.Minute {
+		t.Errorf("Expected a time range of 7 days, got %+v", options.TimeRange)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.ExportDraft != nil {
+		t.Error("Exporting should close the dialog")
+	}
+	want := filepath.Join(dir, "stats.csv")
+	if _, err := os.Stat(want); err != nil {
+		t.Errorf("Expected an export at %s: %v", want, err)
+	}
+	if !strings.Contains(model.SuccessMessage, want) {
+		t.Errorf("Expected a success message naming the file, got %q", model.SuccessMessage)
+	}
+
+	// Escape closes the dialog without exporting
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.ExportDraft != nil || model.CurrentView != StatsView {
+		t.Error("Escape should only close the export dialog")
+	}
+}
+
+func TestOpenExportFolder(t *testing.T) {
+	model := NewModel()
+	model.CurrentView = StatsView
+	options := stats.DefaultExportOptions()
+	options.Filename = filepath.Join(t.TempDir(), "stats.json")
+
+	var opened []string
+	openErr := error(nil)
+	defer func(open func(string) error) { openFolder = open }(openFolder)
+	openFolder = func(path string) error {
+		opened = append(opened, path)
+		return openErr
+	}
+
+	// Without an export, o does nothing
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if len(opened) != 0 {
+		t.Fatal("Nothing should open before an export")
+	}
+
+	model.writeExport(options)
+	if !strings.Contains(model.SuccessMessage, "o opens the folder") {
+		t.Errorf("The success message should offer to open the folder, got %q", model.SuccessMessage)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if len(opened) != 1 || opened[0] != options.Filename {
+		t.Errorf("Expected the export's folder to open, got %v", opened)
+	}
+
+	// Without a desktop, the folder is named instead
+	openErr = platform.ErrNoGUI
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'o'}})
+	if !strings.Contains(model.SuccessMessage, filepath.Dir(options.Filename)) || model.ErrorMessage != "" {
+		t.Errorf("Expected the folder to be named, got %q and %q", model.SuccessMessage, model.ErrorMessage)
+	}
+}
+
+func TestConfirmChoices(t *testing.T) {
+	model := NewModel()
+	model.ConfirmChoices = true
+	model.CurrentView = GameView
+	model.Game = game.NewSeededGame(1)
+	model.DoorCursor = 1
+
+	// Enter asks before locking in the door, and a second Enter backs out
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.Dialog == nil || !strings.Contains(plainText(model.View()), "Lock in door 2?") {
+		t.Fatalf("Expected a prompt to lock in door 2, got:\n%s", plainText(model.View()))
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.Dialog != nil || model.Game.Phase != game.InitialChoice {
+		t.Fatal("An accidental Enter should leave the choice open")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+	if model.Game.Phase != game.FinalChoice || model.Game.PlayerInitialChoice != 1 {
+		t.Fatalf("Expected 'y' to lock in door 2, got phase %v", model.Game.Phase)
+	}
+
+	// Switching asks too, naming the door switched to
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if model.Dialog == nil || !strings.Contains(model.Dialog.Title, "Switch to door") {
+		t.Fatal("Expected a prompt to confirm switching")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if model.Game.Phase != game.GameOver || model.Game.Result.Strategy != game.Switch {
+		t.Errorf("Expected 'y' to switch, got phase %v", model.Game.Phase)
+	}
+
+	// Without the setting, choices are made straight away
+	model.ConfirmChoices = false
+	model.startNewGame()
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.Dialog != nil || model.Game.Phase != game.HostReveal {
+		t.Error("Expected Enter to choose the door straight away")
+	}
+}
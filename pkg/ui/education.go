@@ -0,0 +1,252 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats/analysis"
+)
+
+// KeyBayes opens the Bayesian explainer for the current game
+const KeyBayes = "b"
+
+// KeyDecisionTree switches the Bayesian explainer to the game's decision tree and back
+const KeyDecisionTree = "t"
+
+// BayesStep is a step of the walk through the Bayesian update in EducationView
+type BayesStep int
+
+const (
+	BayesPrior BayesStep = iota
+	BayesLikelihood
+	BayesPosterior
+)
+
+// bayesBarWidth is the width of each probability bar
+const bayesBarWidth = 10
+
+// openEducation shows the Bayesian update for the current game, once the
+// host has opened doors
+func (m *Model) openEducation() {
+	if m.Game == nil || len(m.Game.HostOpenedDoors) == 0 {
+		return
+	}
+	m.stopAnimations()
+	m.BayesStep = BayesPrior
+	m.ShowDecisionTree = false
+	m.CurrentView = EducationView
+}
+
+// handleEducationKeys processes input in the Bayesian explainer
+func (m *Model) handleEducationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case KeyLeft, "h":
+		if m.BayesStep > BayesPrior {
+			m.BayesStep--
+		}
+
+	case KeyRight, "l", KeySpace, " ":
+		if m.BayesStep < BayesPosterior {
+			m.BayesStep++
+		}
+
+	case KeyDecisionTree:
+		m.ShowDecisionTree = !m.ShowDecisionTree
+
+	case KeyEnter, KeyBayes:
+		m.CurrentView = GameView
+	}
+
+	return m, nil
+}
+
+// renderEducation renders the Bayesian update for the current game, step by step
+func (m *Model) renderEducation() string {
+	if m.ShowDecisionTree && m.Game != nil {
+		return m.renderDecisionTree()
+	}
+
+	var content []string
+	content = append(content, HeaderStyle.Render("BAYES' THEOREM"))
+	content = append(content, Spacer(1))
+
+	var beliefs []game.DoorBelief
+	if m.Game != nil {
+		beliefs = m.Game.BayesianUpdate()
+	}
+	if beliefs == nil {
+		content = append(content, Center(SubtitleStyle.Render("Pick a door and let the host open one to see the update."), m.Width, 1))
+		content = append(content, RenderFooter([]KeyBinding{{"Enter", "Back to game"}, {"ESC/q", "Main menu"}}))
+		return lipgloss.JoinVertical(lipgloss.Center, content...)
+	}
+
+	content = append(content, Center(SubtitleStyle.Render(m.bayesSituation()), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	// One column per step reached so far
+	headers := []string{"Prior", "Likelihood", "Posterior"}
+	columnWidth := bayesBarWidth + 7
+	header := strings.Repeat(" ", 16)
+	for _, title := range headers[:m.BayesStep+1] {
+		header += title + strings.Repeat(" ", columnWidth+2-runewidth.StringWidth(title))
+	}
+	rows := []string{StatsHeaderStyle.Render(strings.TrimRight(header, " "))}
+
+	for door, belief := range beliefs {
+		label := fmt.Sprintf("Door %d", door+1)
+		switch {
+		case door == m.Game.PlayerInitialChoice:
+			label += " yours"
+		case m.Game.Doors[door].IsOpen():
+			label += " opened"
+		}
+		row := StatsLabelStyle.Render(label + strings.Repeat(" ", 16-runewidth.StringWidth(label)))
+
+		values := []float64{belief.Prior, belief.Likelihood, belief.Posterior}
+		for step, value := range values[:m.BayesStep+1] {
+			style := lipgloss.NewStyle().Foreground(PrimaryColor)
+			if BayesStep(step) == BayesPosterior {
+				style = lipgloss.NewStyle().Foreground(AccentColor).Bold(true)
+			}
+			filled := int(value*bayesBarWidth + 0.5)
+			bar := strings.Repeat("█", filled) + strings.Repeat("░", bayesBarWidth-filled)
+			row += style.Render(bar) + MutedStyle.Render(fmt.Sprintf(" %5.1f%%  ", value*100))
+		}
+		rows = append(rows, strings.TrimRight(row, " "))
+	}
+	content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, rows...), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	for _, line := range m.bayesExplanation(beliefs) {
+		content = append(content, Center(MutedStyle.Render(line), m.Width, 1))
+	}
+	if m.BayesStep == BayesPosterior {
+		content = append(content, Spacer(1))
+		content = append(content, Center(SuccessStyle.Render(m.bayesConclusion(beliefs)), m.Width, 1))
+	}
+
+	if hint := m.renderGlossaryHint(); hint != "" {
+		content = append(content, hint)
+	}
+	content = append(content, RenderFooter([]KeyBinding{
+		{"←→", fmt.Sprintf("Step %d/3", m.BayesStep+1)},
+		{KeyDecisionTree, "Decision tree"},
+		{"Enter", "Back to game"},
+		{"ESC/q", "Main menu"},
+	}))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
+
+// renderDecisionTree renders every way the current game could go, with the
+// path it took highlighted once it is over
+func (m *Model) renderDecisionTree() string {
+	tree := NewDecisionTree(m.Game)
+	stay, switchDoors := tree.WinRates()
+
+	var content []string
+	content = append(content, HeaderStyle.Render("DECISION TREE"))
+	content = append(content, Spacer(1))
+	content = append(content, Center(SubtitleStyle.Render(fmt.Sprintf("Every way a game with %d doors and %s can go",
+		tree.NumDoors, m.Game.Host.Behavior.DisplayName())), m.Width, 1))
+	content = append(content, Spacer(1))
+	content = append(content, Center(tree.Render(), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	if tree.Path != nil {
+		content = append(content, Center(MutedStyle.Render("◀ marks the way your game went."), m.Width, 1))
+	} else {
+		content = append(content, Center(MutedStyle.Render("The way your game went is marked once it is over."), m.Width, 1))
+	}
+	content = append(content, Center(SuccessStyle.Render(fmt.Sprintf("Staying wins %s of all games; switching wins %s.",
+		analysis.Fraction(stay), analysis.Fraction(switchDoors))), m.Width, 1))
+
+	content = append(content, RenderFooter([]KeyBinding{
+		{KeyDecisionTree, "Bayes' theorem"},
+		{"Enter", "Back to game"},
+		{"ESC/q", "Main menu"},
+	}))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
+
+// bayesSituation describes what happened in the current game so far
+func (m *Model) bayesSituation() string {
+	var opened []string
+	carShown := false
+	for _, door := range m.Game.HostOpenedDoors {
+		opened = append(opened, fmt.Sprint(door+1))
+		carShown = carShown || m.Game.Doors[door].HasCar()
+	}
+
+	doors := "door " + opened[0]
+	if len(opened) > 3 {
+		doors = fmt.Sprintf("%d doors", len(opened))
+	} else if len(opened) > 1 {
+		doors = "doors " + strings.Join(opened[:len(opened)-1], ", ") + " and " + opened[len(opened)-1]
+	}
+	shown := "a goat"
+	if len(opened) > 1 {
+		shown = "goats"
+	}
+	if carShown {
+		shown = "the car"
+	}
+
+	return fmt.Sprintf("You picked door %d, then %s opened %s, showing %s.",
+		m.Game.PlayerInitialChoice+1, m.Game.Host.Behavior.DisplayName(), doors, shown)
+}
+
+// bayesExplanation explains the current step with the numbers of the current game
+func (m *Model) bayesExplanation(beliefs []game.DoorBelief) []string {
+	switch m.BayesStep {
+	case BayesPrior:
+		return []string{
+			fmt.Sprintf("Before the host acts, each of the %d doors is equally likely to hide the car:", len(beliefs)),
+			fmt.Sprintf("P(car behind a door) = %s for every door, yours included.", analysis.Fraction(beliefs[0].Prior)),
+		}
+
+	case BayesLikelihood:
+		var how string
+		switch m.Game.Host.Behavior {
+		case game.HostFall:
+			how = "This host opens doors at random, not knowing where the car is."
+		case game.HostCrawl:
+			how = "This host opens the lowest-numbered goats it can, which can give the car away."
+		default:
+			how = "The host knows where the car is and never opens your door or shows the car."
+		}
+		return []string{
+			how,
+			"Likelihood: how likely these doors were to open if the car is behind that door.",
+			"An opened door showing a goat can't hide the car, so its likelihood is 0.",
+		}
+
+	default:
+		return []string{
+			"Multiply each prior by its likelihood, then rescale so the chances add up to 1:",
+			"P(car | doors opened) = P(doors opened | car) × P(car) / P(doors opened)",
+		}
+	}
+}
+
+// bayesConclusion sums up what the posterior means for staying and switching
+func (m *Model) bayesConclusion(beliefs []game.DoorBelief) string {
+	stay := beliefs[m.Game.PlayerInitialChoice].Posterior
+	best, bestDoor := 0.0, -1
+	for door, belief := range beliefs {
+		if door != m.Game.PlayerInitialChoice && !m.Game.Doors[door].IsOpen() && belief.Posterior > best {
+			best, bestDoor = belief.Posterior, door
+		}
+	}
+
+	if bestDoor == -1 {
+		return fmt.Sprintf("Staying wins with chance %s.", analysis.Fraction(stay))
+	}
+	return fmt.Sprintf("Staying wins %s of the time; switching to door %d wins %s.",
+		analysis.Fraction(stay), bestDoor+1, analysis.Fraction(best))
+}
@@ -0,0 +1,132 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestEducationViewFollowsGame(t *testing.T) {
+	model := NewModel()
+	model.Width = 80
+	model.Height = 40
+	model.Game = game.NewSeededGame(1)
+	model.CurrentView = GameView
+
+	bayes := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyBayes)}
+	model.Update(bayes)
+	if model.CurrentView != GameView {
+		t.Fatal("There is nothing to explain before the host opens a door")
+	}
+
+	if err := model.Game.MakeInitialChoice(0); err != nil {
+		t.Fatal(err)
+	}
+	model.Update(bayes)
+	if model.CurrentView != EducationView || model.BayesStep != BayesPrior {
+		t.Fatalf("Expected b to open the prior step, got view %v step %d", model.CurrentView, model.BayesStep)
+	}
+
+	opened := model.Game.HostOpenedDoors[0] + 1
+	closed := 6 - opened - 1 // The door left to switch to
+	view := plainText(model.View())
+	for _, want := range []string{"You picked door 1", "Prior", "33.3%"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Prior step should contain %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "Posterior") {
+		t.Error("The posterior should not show before its step")
+	}
+
+	for range 3 {
+		model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	}
+	if model.BayesStep != BayesPosterior {
+		t.Fatalf("Expected to stop at the posterior, got step %d", model.BayesStep)
+	}
+	view = plainText(model.View())
+	for _, want := range []string{
+		"Posterior",
+		"66.7%",
+		fmt.Sprintf("Door %d opened", opened),
+		fmt.Sprintf("switching to door %d wins 2/3", closed),
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Posterior step should contain %q:\n%s", want, view)
+		}
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.CurrentView != GameView || model.Game.Phase != game.FinalChoice {
+		t.Error("Enter should return to the game where it was left")
+	}
+}
+
+func TestDecisionTree(t *testing.T) {
+	tests := []struct {
+		name              string
+		numDoors, opens   int
+		behavior          game.HostBehavior
+		stay, switchDoors float64
+	}{
+		{"classic", 3, 1, game.HostClassic, 1.0 / 3, 2.0 / 3},
+		{"more doors", 5, 2, game.HostClassic, 1.0 / 5, 2.0 / 5},
+		{"falling host", 5, 2, game.HostFall, 1.0 / 5, 1.0 / 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := &DecisionTree{NumDoors: tt.numDoors, Opens: tt.opens, Behavior: tt.behavior}
+			stay, switchDoors := tree.WinRates()
+			if math.Abs(stay-tt.stay) > 1e-9 || math.Abs(switchDoors-tt.switchDoors) > 1e-9 {
+				t.Errorf("Expected win rates %.3f and %.3f, got %.3f and %.3f", tt.stay, tt.switchDoors, stay, switchDoors)
+			}
+			if shown := strings.Contains(plainText(tree.Render()), "Host shows the car"); shown != (tt.behavior == game.HostFall) {
+				t.Errorf("Only a falling host should have a branch revealing the car, got %v", shown)
+			}
+		})
+	}
+
+	model := NewModel()
+	model.Width = 80
+	model.Height = 40
+	model.Game = game.NewSeededGame(1)
+	model.CurrentView = GameView
+	if err := model.Game.MakeInitialChoice(0); err != nil {
+		t.Fatal(err)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyBayes)})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyDecisionTree)})
+	view := plainText(model.View())
+	if !strings.Contains(view, "DECISION TREE") || strings.Contains(view, "◀ ") {
+		t.Errorf("Expected the tree without a path before the game is over:\n%s", view)
+	}
+
+	// The path of a finished game is marked down to the final choice
+	pickedCar := model.Game.CarPosition == 0
+	if err := model.Game.SwitchChoice(); err != nil {
+		t.Fatal(err)
+	}
+	var marked []string
+	for _, line := range strings.Split(plainText(model.View()), "\n") {
+		if strings.HasSuffix(strings.TrimSpace(line), "◀") {
+			marked = append(marked, strings.TrimSpace(line))
+		}
+	}
+	want := "Goat behind your door"
+	if pickedCar {
+		want = "Car behind your door"
+	}
+	if len(marked) != 3 || !strings.Contains(marked[0], want) || !strings.Contains(marked[2], "You switch") {
+		t.Errorf("Expected the path through %q to switching to be marked, got %q", want, marked)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyDecisionTree)})
+	if strings.Contains(plainText(model.View()), "DECISION TREE") {
+		t.Error("t should switch back to Bayes' theorem")
+	}
+}
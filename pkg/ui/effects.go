@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// EffectsIntensity scales the typographic effects used for celebrations and pulses
+type EffectsIntensity int
+
+const (
+	EffectsOff    EffectsIntensity = iota // Plain colored text
+	EffectsSubtle                         // Bold and glow colors; no blinking, rainbows or pulsing
+	EffectsFull                           // Every effect
+)
+
+// String returns the config name of the intensity
+func (e EffectsIntensity) String() string {
+	switch e {
+	case EffectsOff:
+		return "off"
+	case EffectsSubtle:
+		return "subtle"
+	default:
+		return "full"
+	}
+}
+
+// ParseEffectsIntensity parses a config name; an empty name means full effects
+func ParseEffectsIntensity(name string) (EffectsIntensity, error) {
+	switch name {
+	case "off":
+		return EffectsOff, nil
+	case "subtle":
+		return EffectsSubtle, nil
+	case "full", "":
+		return EffectsFull, nil
+	default:
+		return EffectsFull, fmt.Errorf("unknown effects intensity: %s", name)
+	}
+}
+
+// Apply removes the attributes a style may not use at this intensity: subtle
+// drops blinking, off also drops bold
+func (e EffectsIntensity) Apply(style lipgloss.Style) lipgloss.Style {
+	switch e {
+	case EffectsOff:
+		return style.Blink(false).Bold(false)
+	case EffectsSubtle:
+		return style.Blink(false)
+	default:
+		return style
+	}
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestEffectsIntensity(t *testing.T) {
+	for _, name := range []string{"off", "subtle", "full"} {
+		effects, err := ParseEffectsIntensity(name)
+		if err != nil || effects.String() != name {
+			t.Errorf("Expected %q to round-trip, got %v (%v)", name, effects, err)
+		}
+	}
+	if _, err := ParseEffectsIntensity("loud"); err == nil {
+		t.Error("Expected an unknown intensity to be rejected")
+	}
+
+	if style := EffectsSubtle.Apply(WinningStyle); style.GetBlink() || !style.GetBold() {
+		t.Error("Expected subtle effects to drop blinking and keep bold")
+	}
+	if style := EffectsOff.Apply(WinningStyle); style.GetBlink() || style.GetBold() {
+		t.Error("Expected no effects to drop blinking and bold")
+	}
+	if !EffectsFull.Apply(WinningStyle).GetBlink() {
+		t.Error("Expected full effects to keep blinking")
+	}
+}
+
+func TestWinningMessageEffects(t *testing.T) {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(profile)
+
+	// The rainbow styles every character separately
+	colors := func(s string) int { return strings.Count(s, "38;2;") }
+
+	if full := CreateWinningMessage("WIN", EffectsFull); colors(full) < 3 {
+		t.Errorf("Expected a rainbow at full effects, got %q", full)
+	}
+	subtle := CreateWinningMessage("WIN", EffectsSubtle)
+	if colors(subtle) != 1 || !strings.Contains(subtle, "1;") {
+		t.Errorf("Expected one bold color at subtle effects, got %q", subtle)
+	}
+	if off := CreateWinningMessage("WIN", EffectsOff); colors(off) != 1 || strings.Contains(off, "\x1b[1;") {
+		t.Errorf("Expected plain colored text with effects off, got %q", off)
+	}
+
+	if glow := CreateGlowEffect("TEST", 0.8, EffectsOff); glow != "TEST" {
+		t.Errorf("Expected no glow with effects off, got %q", glow)
+	}
+	if CreatePulseEffect("TEST", 0.1, EffectsSubtle) != CreatePulseEffect("TEST", 0.3, EffectsSubtle) {
+		t.Error("Expected subtle effects not to pulse")
+	}
+}
@@ -257,7 +257,7 @@ func generateInvalidInputSuggestions(err error) []string {
 
 	if strings.Contains(errorMsg, "color") {
 		suggestions = append(suggestions,
-			"Valid color schemes: 'default', 'high-contrast', 'colorblind-safe'",
+			"Valid color schemes: 'default', 'high-contrast', 'colorblind-safe', 'light', 'matrix', 'crt'",
 		)
 	}
 
@@ -6,6 +6,10 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/chaos"
+	"github.com/westhuis/monty-hall/pkg/game"
 )
 
 func TestEnhanceError(t *testing.T) {
@@ -358,3 +362,28 @@ func BenchmarkFormatErrorForDisplay(b *testing.B) {
 		FormatErrorForDisplay(err)
 	}
 }
+
+func TestChaosModeObservesShownErrors(t *testing.T) {
+	model := newSettingsTestModel(t)
+	model.openSettings()
+	model.SettingsSection = SettingsGame
+	cfg := model.ConfigManager.Get()
+	cfg.Game.NumDoors = game.MaxDoors
+	model.ConfigManager.Apply(cfg)
+
+	chaos.Enable(0.5, 1)
+	t.Cleanup(chaos.Disable)
+
+	// Stepping past the maximum number of doors fails validation
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+
+	// Errors already on screen are not counted again
+	model.Update(SuccessMsg{Message: "unrelated"})
+
+	model.Update(ErrorMsg{Error: FormatErrorForDisplay(WrapError(errors.New("write stats.json: no space left on device"), "save statistics"))})
+
+	report, _ := chaos.Summary()
+	if report.ErrorsShown != 2 || report.WithSuggestions != 1 {
+		t.Errorf("Expected 2 errors shown, 1 with suggestions; got %d and %d", report.ErrorsShown, report.WithSuggestions)
+	}
+}
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// newEventLog loads the event log kept beside the statistics file. Stores
+// without a file keep events for the session only
+func newEventLog(statsManager *stats.StatsManager) *eventlog.Log {
+	log := eventlog.NewLog("")
+	if file, ok := statsManager.Store().(stats.FileStore); ok {
+		path := filepath.Join(filepath.Dir(file.GetFilePath()), eventlog.DefaultFileName)
+		if statsManager.IsReadOnly() {
+			log = eventlog.NewReadOnlyLog(path)
+		} else {
+			log = eventlog.NewLog(path)
+		}
+	}
+	if err := log.Load(); err != nil {
+		// Start a fresh log rather than failing; the file is rewritten on the next event
+		log = eventlog.NewLog(log.GetFilePath())
+	}
+	return log
+}
+
+// logEvent adds an event to the log. The log is a convenience, so failing
+// to write it is not reported; that would only log another error
+func (m *Model) logEvent(kind eventlog.Kind, message string) {
+	if m.Events == nil {
+		return
+	}
+	m.Events.Add(eventlog.Event{Time: now(), Kind: kind, Message: message})
+}
+
+// logMessages logs error and success messages when they are first shown,
+// so every view's messages are kept without each view logging them
+func (m *Model) logMessages() {
+	if m.ErrorMessage != "" && m.ErrorMessage != m.loggedError {
+		m.logEvent(eventlog.KindError, m.ErrorMessage)
+	}
+	if m.SuccessMessage != "" && m.SuccessMessage != m.loggedSuccess {
+		m.logEvent(eventlog.KindSuccess, m.SuccessMessage)
+	}
+	m.loggedError, m.loggedSuccess = m.ErrorMessage, m.SuccessMessage
+}
+
+// openEventLog shows the event log with the newest event selected
+func (m *Model) openEventLog() {
+	m.CurrentView = EventLogView
+	m.EventCursor = 0
+}
+
+// clearEventLog asks before removing every logged event
+func (m *Model) clearEventLog() {
+	m.Dialog = NewConfirmDialog("Clear the event log?", []string{fmt.Sprintf("%d events will be removed", m.Events.Len())}, func() tea.Cmd {
+		if err := m.Events.Clear(); err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "clear event log"))
+			return nil
+		}
+		m.EventCursor = 0
+		return nil
+	})
+}
+
+// handleEventLogKeys processes event log input
+func (m *Model) handleEventLogKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.Events == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeyUp, "k":
+		if m.EventCursor > 0 {
+			m.EventCursor--
+		}
+
+	case KeyDown, "j":
+		if m.EventCursor < m.Events.Len()-1 {
+			m.EventCursor++
+		}
+
+	case "c":
+		if m.Events.Len() > 0 {
+			m.clearEventLog()
+		}
+	}
+
+	return m, nil
+}
+
+// eventIcons mark each kind of event, so they are told apart without color
+var eventIcons = map[eventlog.Kind]string{
+	eventlog.KindSuccess:     "✅",
+	eventlog.KindError:       "❌",
+	eventlog.KindAchievement: "🏅",
+}
+
+// renderEventLog renders the event log: one line per event, newest first,
+// with the selected event shown in full below
+func (m *Model) renderEventLog() string {
+	var content []string
+	content = append(content, HeaderStyle.Render("EVENT LOG"))
+
+	var events []eventlog.Event
+	if m.Events != nil {
+		events = m.Events.Events()
+	}
+
+	if len(events) == 0 {
+		content = append(content, Center(MutedStyle.Render("Nothing has happened yet. Exports, errors and achievements will be listed here."), m.Width, 1))
+	} else {
+		cursor := min(m.EventCursor, len(events)-1)
+
+		// Keep the selected event in view
+		visible := max(3, m.Height-16)
+		start := min(max(0, cursor-visible/2), max(0, len(events)-visible))
+		end := min(start+visible, len(events))
+
+		lineWidth := max(20, m.Width-8)
+		var rows []string
+		for i := start; i < end; i++ {
+			event := events[i]
+			icon := eventIcons[event.Kind]
+			summary, _, _ := strings.Cut(event.Message, "\n")
+			summary = strings.TrimPrefix(summary, icon+" ") // Formatted errors carry their own icon
+			line := fmt.Sprintf("%s  %s %s", event.Time.Format("2006-01-02 15:04:05"), icon, summary)
+			line = runewidth.Truncate(line, lineWidth-2, "…")
+			if i == cursor {
+				rows = append(rows, lipgloss.NewStyle().Foreground(SelectedColor).Bold(true).Render("▶ "+line))
+			} else {
+				rows = append(rows, StatsLabelStyle.Render("  "+line))
+			}
+		}
+		content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, rows...), m.Width, 1))
+		content = append(content, Center(MutedStyle.Render(fmt.Sprintf("%d of %d", cursor+1, len(events))), m.Width, 1))
+		content = append(content, Spacer(1))
+
+		// The selected event in full, with any suggestions
+		selected := events[cursor]
+		style := SuccessStyle
+		switch selected.Kind {
+		case eventlog.KindError:
+			style = ErrorStyle
+		case eventlog.KindAchievement:
+			style = lipgloss.NewStyle().Foreground(CarColor).Bold(true)
+		}
+		detail := style.Width(min(lineWidth, 72)).Render(selected.Message)
+		content = append(content, Center(detail, m.Width, 1))
+	}
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+
+	bindings := []KeyBinding{{"↑↓", "Navigate"}}
+	if len(events) > 0 {
+		bindings = append(bindings, KeyBinding{"c", "Clear"})
+	}
+	content = append(content, RenderFooter(append(bindings, KeyBinding{"ESC/q", "Main menu"})))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
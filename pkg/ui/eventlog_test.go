@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestEventLogRecordsMessages(t *testing.T) {
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+
+	model.Update(SuccessMsg{Message: "Statistics exported"})
+	model.Update(ErrorMsg{Error: "Failed to save"})
+	model.Update(ErrorMsg{Error: "Failed to save"}) // Still on screen, so not logged again
+
+	events := model.Events.Events()
+	if len(events) != 2 || events[0].Kind != eventlog.KindError || events[1].Kind != eventlog.KindSuccess {
+		t.Fatalf("Expected the success and error newest first, got %+v", events)
+	}
+
+	// A key press clears the messages, so showing one again is a new event
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(ErrorMsg{Error: "Failed to save"})
+	if model.Events.Len() != 3 {
+		t.Errorf("Expected a repeated error to be logged, got %d events", model.Events.Len())
+	}
+
+	// The log is kept beside the statistics
+	reloaded := eventlog.NewLog(filepath.Join(dir, eventlog.DefaultFileName))
+	if err := reloaded.Load(); err != nil || reloaded.Len() != 3 {
+		t.Errorf("Expected 3 persisted events, got %d (%v)", reloaded.Len(), err)
+	}
+
+	model.openEventLog()
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if view := plainText(model.View()); !strings.Contains(view, "▶ "+now().Format("2006-01-02 15:04:05")+"  ❌ Failed to save") {
+		t.Errorf("Expected the selected event with its time, got:\n%s", view)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if model.Events.Len() != 0 {
+		t.Errorf("Expected confirming to clear the log, got %d events", model.Events.Len())
+	}
+}
@@ -0,0 +1,249 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// exportPreviewLines is how many lines of a CSV or text export the overwrite
+// dialog previews
+const exportPreviewLines = 6
+
+// exportStats opens the export dialog, starting from the export settings
+func (m *Model) exportStats() (tea.Model, tea.Cmd) {
+	options := stats.DefaultExportOptions()
+	if m.ConfigManager != nil {
+		cfg := m.ConfigManager.Get()
+		options.Format = cfg.Stats.ExportFormat
+		options.Locale = cfg.Stats.ExportLocale()
+	}
+	m.ExportDraft = NewExportDraft(options)
+	if m.StatsRange < len(rangePresets) {
+		// Export the games the statistics view is showing
+		m.ExportDraft.Range = m.StatsRange
+	}
+	return m, nil
+}
+
+// exportWithOptions exports the statistics, asking first when the file exists
+func (m *Model) exportWithOptions(options stats.ExportOptions) (tea.Model, tea.Cmd) {
+	if _, err := os.Stat(options.Filename); err == nil {
+		m.confirmExportOverwrite(options)
+		return m, nil
+	}
+
+	m.writeExport(options)
+	return m, nil
+}
+
+// writeExport writes the export and reports where it went
+func (m *Model) writeExport(options stats.ExportOptions) {
+	err := m.StatsManager.ExportStats(options)
+	if err != nil {
+		enhancedErr := WrapError(err, "export statistics")
+		m.ErrorMessage = FormatErrorForDisplay(enhancedErr)
+	} else {
+		m.SuccessMessage = fmt.Sprintf("Statistics exported to: %s", options.Filename)
+		m.offerOpenFolder(options.Filename)
+	}
+}
+
+// confirmExportOverwrite asks whether to overwrite an existing export file,
+// write beside it under a free name, or cancel, previewing what CSV and text
+// exports will contain
+func (m *Model) confirmExportOverwrite(options stats.ExportOptions) {
+	lines := []string{
+		fmt.Sprintf("%s already exists.", options.Filename),
+		fmt.Sprintf("Rename writes %s instead.", filepath.Base(stats.AvailableFilename(options.Filename))),
+	}
+
+	preview, err := m.StatsManager.ExportPreview(options, exportPreviewLines)
+	if err == nil && len(preview) > 0 {
+		lines = append(lines, "", fmt.Sprintf("The %s export begins:", options.Format))
+		for _, line := range preview {
+			lines = append(lines, MutedStyle.Render(runewidth.Truncate(line, 56, "…")))
+		}
+	}
+
+	dialog := NewConfirmDialog(fmt.Sprintf("Overwrite %s?", filepath.Base(options.Filename)), lines, func() tea.Cmd {
+		m.writeExport(options)
+		return nil
+	})
+	dialog.ConfirmLabel = "Overwrite"
+	dialog.AltLabel = "Rename"
+	dialog.AltKey = "r"
+	dialog.OnAlt = func() tea.Cmd {
+		options.Filename = stats.AvailableFilename(options.Filename)
+		m.writeExport(options)
+		return nil
+	}
+	m.Dialog = dialog
+}
+
+// Fields of the export dialog, in the order they are shown
+const (
+	exportFieldFormat = iota
+	exportFieldRange
+	exportFieldHistory
+	exportFieldDaily
+	exportFieldFilename
+	exportFieldCount
+)
+
+// ExportDraft holds the export being set up in the stats view's export dialog
+type ExportDraft struct {
+	Options  stats.ExportOptions // Format, contents and locale of the export
+	Range    int                 // Index into rangePresets
+	Filename string              // Filename without the format's extension
+	Cursor   int
+}
+
+// NewExportDraft creates a draft starting from the options, named with a
+// fresh timestamp unless the options name a file
+func NewExportDraft(options stats.ExportOptions) *ExportDraft {
+	return &ExportDraft{
+		Options:  options,
+		Filename: strings.TrimSuffix(options.ResolvedFilename(), options.Format.GetFileExtension()),
+	}
+}
+
+// ExportOptions returns the options the draft exports with, resolving the
+// time range against now
+func (d *ExportDraft) ExportOptions(now time.Time) stats.ExportOptions {
+	options := d.Options
+	options.Filename = strings.TrimSpace(d.Filename)
+	options.Filename = options.ResolvedFilename()
+	options.TimeRange = nil
+	if since := rangePresets[d.Range].Since; since != nil {
+		options.TimeRange = &stats.TimeRange{Start: since(now), End: now}
+	}
+	return options
+}
+
+// applies reports whether a field changes exports in the draft's format: CSV
+// lists every game in the range, and only JSON includes daily statistics
+func (d *ExportDraft) applies(field int) bool {
+	switch field {
+	case exportFieldHistory:
+		return d.Options.Format != stats.ExportCSV
+	case exportFieldDaily:
+		return d.Options.Format == stats.ExportJSON
+	}
+	return true
+}
+
+// change cycles or toggles the value of the field under the cursor
+func (d *ExportDraft) change(delta int) {
+	switch d.Cursor {
+	case exportFieldFormat:
+		formats := stats.GetExportFormats()
+		index := max(slices.Index(formats, d.Options.Format), 0)
+		d.Options.Format = formats[(index+delta+len(formats))%len(formats)]
+	case exportFieldRange:
+		d.Range = (d.Range + delta + len(rangePresets)) % len(rangePresets)
+	case exportFieldHistory:
+		d.Options.IncludeHistory = !d.Options.IncludeHistory
+	case exportFieldDaily:
+		d.Options.IncludeDailyStats = !d.Options.IncludeDailyStats
+	}
+}
+
+// handleExportDraftKeys processes input while the export dialog is open
+func (m *Model) handleExportDraftKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	draft := m.ExportDraft
+	editingFilename := draft.Cursor == exportFieldFilename
+
+	switch key := msg.String(); {
+	case key == "ctrl+c":
+		return m, tea.Quit
+
+	case key == KeyEscape:
+		m.ExportDraft = nil
+
+	case key == KeyEnter:
+		m.ExportDraft = nil
+		return m.exportWithOptions(draft.ExportOptions(time.Now()))
+
+	case key == KeyUp || key == "shift+tab" || (key == "k" && !editingFilename):
+		draft.Cursor = (draft.Cursor - 1 + exportFieldCount) % exportFieldCount
+
+	case key == KeyDown || key == KeyTab || (key == "j" && !editingFilename):
+		draft.Cursor = (draft.Cursor + 1) % exportFieldCount
+
+	case editingFilename:
+		switch msg.Type {
+		case tea.KeyBackspace:
+			if draft.Filename != "" {
+				runes := []rune(draft.Filename)
+				draft.Filename = string(runes[:len(runes)-1])
+			}
+		case tea.KeySpace:
+			draft.Filename += " "
+		case tea.KeyRunes:
+			draft.Filename += string(msg.Runes)
+		}
+
+	case key == KeyLeft || key == "h":
+		draft.change(-1)
+
+	case key == KeyRight || key == "l" || key == KeySpace || key == " " || key == "x":
+		draft.change(1)
+	}
+
+	return m, nil
+}
+
+// Render renders the export dialog
+func (d *ExportDraft) Render() string {
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(SecondaryColor).
+		Padding(0, 2)
+
+	check := func(on bool) string {
+		if on {
+			return "[x]"
+		}
+		return "[ ]"
+	}
+	filename := d.Filename
+	if d.Cursor == exportFieldFilename {
+		filename += "█"
+	}
+	values := []string{
+		fmt.Sprintf("◀ %s ▶", d.Options.Format),
+		fmt.Sprintf("◀ %s ▶", rangePresets[d.Range].Name),
+		check(d.Options.IncludeHistory),
+		check(d.Options.IncludeDailyStats),
+		filename + MutedStyle.Render(d.Options.Format.GetFileExtension()),
+	}
+	labels := []string{"Format", "Time range", "Game history", "Daily stats", "Filename"}
+
+	lines := []string{TitleStyle.Render("EXPORT STATISTICS")}
+	for i, label := range labels {
+		value := values[i]
+		style := StatsLabelStyle
+		marker := "  "
+		if i == d.Cursor {
+			style = lipgloss.NewStyle().Foreground(SelectedColor).Bold(true)
+			marker = "▶ "
+		}
+		if !d.applies(i) {
+			style = MutedStyle
+			value += " (not in " + d.Options.Format.String() + " exports)"
+		}
+		lines = append(lines, style.Render(fmt.Sprintf("%s%-13s", marker, label))+" "+value)
+	}
+	lines = append(lines, "", MutedStyle.Render("↑↓ choose • ←→ change • Enter export • ESC cancel"))
+
+	return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// fairnessBarWidth is the width of the longest bar on the fairness page
+const fairnessBarWidth = 30
+
+// fairnessMaxBars is the most doors the fairness page draws a bar for; games
+// with more doors are summarized by their least and most frequent doors
+const fairnessMaxBars = 10
+
+// renderFairnessPage renders the statistics page auditing where the car was
+// placed, so players can check the draws are random for themselves
+func (m *Model) renderFairnessPage(content []string) []string {
+	reports := m.StatsManager.Fairness()
+	games := 0
+	for _, report := range reports {
+		games += report.Games
+	}
+
+	content = append(content, Center(StatsHeaderStyle.Render("🎲 FAIRNESS AUDIT"), m.Width, 1))
+	content = append(content, Center(MutedStyle.Render(fmt.Sprintf("Where the car was placed in your last %d games", games)), m.Width, 1))
+
+	for _, report := range reports {
+		content = append(content, Spacer(1))
+		content = append(content, Center(NewFairnessChart(report).Render(), m.Width, 1))
+	}
+
+	content = append(content, Spacer(1))
+	content = append(content, Center(MutedStyle.Render("A fair draw puts the car behind every door equally often, give or take chance."), m.Width, 1))
+	content = append(content, Center(MutedStyle.Render(fmt.Sprintf("The audit keeps your last %d games, even when the game history is reset.", stats.FairnessWindow)), m.Width, 1))
+
+	content = append(content, m.statsFooter(false))
+
+	return content
+}
+
+// FairnessChart component shows how often the car was behind each door
+// against an even spread, with the verdict of the chi-square test
+type FairnessChart struct {
+	Report stats.FairnessReport
+}
+
+// NewFairnessChart creates a new fairness chart component
+func NewFairnessChart(report stats.FairnessReport) *FairnessChart {
+	return &FairnessChart{Report: report}
+}
+
+// Render renders the fairness chart
+func (f *FairnessChart) Render() string {
+	report := f.Report
+	lines := []string{StatsLabelStyle.Render(fmt.Sprintf("%d doors, %d games, %.1f expected per door", report.NumDoors, report.Games, report.Expected))}
+
+	if report.NumDoors <= fairnessMaxBars {
+		most := max(report.Expected, 1)
+		for _, count := range report.Counts {
+			most = max(most, float64(count))
+		}
+		for door, count := range report.Counts {
+			filled := int(float64(count) / most * fairnessBarWidth)
+			bar := strings.Repeat("█", filled) + strings.Repeat("░", fairnessBarWidth-filled)
+			lines = append(lines, fmt.Sprintf("Door %-3d %s %5d (%4.1f%%)",
+				door+1, ProgressBarStyle.Render(bar), count, float64(count)/float64(report.Games)*100))
+		}
+	} else {
+		fewest, most := 0, 0
+		for door, count := range report.Counts {
+			if count < report.Counts[fewest] {
+				fewest = door
+			}
+			if count > report.Counts[most] {
+				most = door
+			}
+		}
+		lines = append(lines, fmt.Sprintf("Least often: door %d (%d)   Most often: door %d (%d)",
+			fewest+1, report.Counts[fewest], most+1, report.Counts[most]))
+	}
+
+	verdict := fmt.Sprintf("✅ Consistent with a fair draw (chi-square %.2f, p = %.2f)", report.ChiSquare, report.PValue)
+	style := SuccessStyle
+	if report.Suspicious() {
+		verdict = fmt.Sprintf("⚠️  Unusually uneven (chi-square %.2f, p = %.3f); fewer than 1 in %d fair draws are this uneven",
+			report.ChiSquare, report.PValue, int(1/stats.FairnessSignificance))
+		style = ErrorStyle
+	}
+	if report.Expected < 5 {
+		verdict = "Too few games for the test to say much yet"
+		style = MutedStyle
+	}
+	lines = append(lines, style.Render(verdict))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
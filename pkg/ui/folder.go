@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/platform"
+)
+
+// KeyOpenFolder opens the folder of the last export or backup
+const KeyOpenFolder = "o"
+
+// openFolder shows a folder in the file manager; tests replace it
+var openFolder = platform.OpenFolder
+
+// offerOpenFolder remembers the file an export or backup just wrote, so its
+// folder can be opened with KeyOpenFolder, and says so in the success message
+func (m *Model) offerOpenFolder(path string) {
+	m.RevealPath = path
+	m.SuccessMessage += fmt.Sprintf(" (%s opens the folder)", KeyOpenFolder)
+}
+
+// openRevealFolder opens the folder of the last export or backup, or names it
+// when there is no desktop to open it on
+func (m *Model) openRevealFolder() {
+	folder, err := filepath.Abs(platform.FolderOf(m.RevealPath))
+	if err != nil {
+		folder = platform.FolderOf(m.RevealPath)
+	}
+
+	err = openFolder(m.RevealPath)
+	switch {
+	case errors.Is(err, platform.ErrNoGUI):
+		m.SuccessMessage = fmt.Sprintf("There is no file manager to open here; the files are in %s", folder)
+	case err != nil:
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "open folder"))
+	default:
+		m.showToast(fmt.Sprintf("📂 Opened %s", folder))
+		m.logEvent(eventlog.KindSuccess, fmt.Sprintf("Opened %s in the file manager", folder))
+	}
+}
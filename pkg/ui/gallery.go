@@ -0,0 +1,218 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// galleryCardWidth is the width of a theme preview, border included
+const galleryCardWidth = 26
+
+// ThemeGallery is the state of the theme gallery, which previews every
+// theme side by side before switching
+type ThemeGallery struct {
+	Themes []Theme
+	Cursor int
+	Return ViewState // View the gallery was opened from
+}
+
+// galleryThemes returns the built-in themes followed by the custom ones
+func (m *Model) galleryThemes() []Theme {
+	themes := Themes()
+	if m.ConfigManager != nil {
+		for _, def := range m.ConfigManager.Themes() {
+			themes = append(themes, CustomTheme(def))
+		}
+	}
+	return themes
+}
+
+// openThemeGallery shows the theme gallery with the active theme selected
+func (m *Model) openThemeGallery() {
+	themes := m.galleryThemes()
+	cursor := 0
+	for i, theme := range themes {
+		if theme.Name == ActiveTheme().Name {
+			cursor = i
+		}
+	}
+	m.Gallery = &ThemeGallery{Themes: themes, Cursor: cursor, Return: m.CurrentView}
+	m.CurrentView = ThemeGalleryView
+}
+
+// applyGalleryTheme makes the selected theme the color scheme. Picking any
+// theme but high contrast turns off the high contrast option, which would
+// otherwise override it
+func (m *Model) applyGalleryTheme() tea.Cmd {
+	if m.ConfigManager == nil {
+		m.ErrorMessage = "Settings are unavailable without a config file"
+		return nil
+	}
+
+	theme := m.Gallery.Themes[m.Gallery.Cursor]
+	cfg := m.ConfigManager.Get()
+	cfg.UI.ColorScheme = theme.Name
+	if theme.Name != HighContrastTheme.Name {
+		cfg.UI.HighContrast = false
+	}
+	if err := m.ConfigManager.Validate(cfg); err != nil {
+		m.ErrorMessage = fmt.Sprintf("Color scheme: %v", err)
+		return nil
+	}
+
+	cmd := m.applyConfig(cfg)
+	m.applySettings(m.ConfigManager.Get())
+	m.SuccessMessage = fmt.Sprintf("Switched to the %s theme", theme.Name)
+	return cmd
+}
+
+// handleThemeGalleryKeys processes theme gallery input
+func (m *Model) handleThemeGalleryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	g := m.Gallery
+	if g == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeyLeft:
+		if g.Cursor > 0 {
+			g.Cursor--
+		}
+
+	case KeyRight:
+		if g.Cursor < len(g.Themes)-1 {
+			g.Cursor++
+		}
+
+	case KeyEnter, KeySpace, " ":
+		return m, m.applyGalleryTheme()
+
+	case "b", "backspace":
+		if g.Return == MainMenuView {
+			m.returnToMainMenu()
+		} else {
+			m.CurrentView = g.Return
+		}
+	}
+
+	return m, nil
+}
+
+// renderThemeGallery renders the theme gallery
+func (m *Model) renderThemeGallery() string {
+	g := m.Gallery
+	if g == nil {
+		return ""
+	}
+
+	// Show as many cards as fit, keeping the selected one in view
+	visible := max(1, min(len(g.Themes), (m.Width+1)/(galleryCardWidth+1)))
+	start := min(max(0, g.Cursor-visible/2), len(g.Themes)-visible)
+
+	active := ActiveTheme()
+	var cards []string
+	for i := start; i < start+visible; i++ {
+		cards = append(cards, renderThemePreview(g.Themes[i], i == g.Cursor, g.Themes[i].Name == active.Name))
+		if i < start+visible-1 {
+			cards = append(cards, " ")
+		}
+	}
+	ApplyTheme(active)
+
+	var content []string
+	content = append(content, HeaderStyle.Render("THEME GALLERY"))
+	content = append(content, Center(lipgloss.JoinHorizontal(lipgloss.Top, cards...), m.Width, 1))
+
+	selected := g.Themes[g.Cursor]
+	terminal := "dark"
+	if luminance, err := relativeLuminance(selected.Background); err == nil && luminance > 0.5 {
+		terminal = "light"
+	}
+	position := fmt.Sprintf("%d of %d  •  %s is designed for %s terminals", g.Cursor+1, len(g.Themes), selected.Name, terminal)
+	content = append(content, Center(MutedStyle.Render(position), m.Width, 1))
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+	if m.SuccessMessage != "" {
+		content = append(content, Center(SuccessStyle.Render("✅ "+m.SuccessMessage), m.Width, 1))
+	}
+
+	content = append(content, RenderFooter([]KeyBinding{
+		{"←→", "Browse"},
+		{"Enter", "Apply"},
+		{"b", "Back"},
+		{"ESC/q", "Main menu"},
+	}))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
+
+// renderThemePreview renders miniatures of the menu, doors and statistics in
+// a theme. It applies the theme to render with the real styles, so callers
+// must restore the active theme afterwards
+func renderThemePreview(theme Theme, selected, current bool) string {
+	ApplyTheme(theme)
+	inner := galleryCardWidth - 4
+
+	name := theme.Name
+	if current {
+		name += " ✓"
+	}
+	if selected {
+		name = "▶ " + name + " ◀"
+	}
+
+	menu := []string{
+		SelectedMenuItemStyle.Width(inner).Render("▶ Play Game"),
+		MenuItemStyle.Foreground(TextColor).Width(inner).Render("  Statistics"),
+		MenuItemStyle.Foreground(MutedColor).Width(inner).Render("  Settings"),
+	}
+
+	door := func(label string, fg, bg lipgloss.Color) string {
+		return lipgloss.NewStyle().Foreground(fg).Background(bg).Bold(true).Render(label)
+	}
+	doors := strings.Join([]string{
+		door(" 1 ", DoorColor, DoorFillColor),
+		door("[2]", SelectedColor, SelectedFillColor),
+		door(" 🐐 ", GoatColor, OpenFillColor),
+		door(" 🚗 ", CarColor, RevealFillColor),
+	}, " ")
+
+	bar := func(label string, rate float64, color lipgloss.Color) string {
+		const width = 10
+		filled := int(rate*width + 0.5)
+		return StatsLabelStyle.Render(label) +
+			lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", filled)) +
+			lipgloss.NewStyle().Foreground(TrackColor).Render(strings.Repeat("░", width-filled)) +
+			StatsValueStyle.Render(fmt.Sprintf(" %2.0f%%", rate*100))
+	}
+	stats := []string{
+		bar("Switch ", 2.0/3, SecondaryColor),
+		bar("Stay   ", 1.0/3, AccentColor),
+	}
+
+	body := lipgloss.JoinVertical(lipgloss.Center,
+		lipgloss.NewStyle().Foreground(PrimaryColor).Bold(true).Render(name),
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, menu...),
+		"",
+		doors,
+		"",
+		lipgloss.JoinVertical(lipgloss.Left, stats...),
+	)
+
+	border, borderColor := lipgloss.RoundedBorder(), BorderColor
+	if selected {
+		border, borderColor = lipgloss.ThickBorder(), SelectedColor
+	}
+	return lipgloss.NewStyle().
+		Width(galleryCardWidth-2).
+		BorderStyle(border).
+		BorderForeground(borderColor).
+		Padding(0, 1).
+		Render(body)
+}
@@ -0,0 +1,429 @@
+package ui
+
+import (
+	"embed"
+	"io/fs"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+//go:embed glossary/*.md
+var embeddedGlossary embed.FS
+
+// glossaryListWidth is the width of the term list beside the entry
+const glossaryListWidth = 28
+
+// glossaryInline matches the Markdown the entries use: links to other entries
+// by slug, bold, italics and code
+var glossaryInline = regexp.MustCompile(`\[([^\]]+)\]\(([a-z0-9-]+)\)|\*\*([^*]+)\*\*|\*([^*]+)\*|` + "`([^`]+)`")
+
+// GlossaryEntry is a probability term explained in a short Markdown entry
+type GlossaryEntry struct {
+	Slug string // File name without extension; links refer to entries by slug
+	Term string
+	Body string // Markdown after the title
+}
+
+// glossaryEntries returns the embedded entries in alphabetical order
+func glossaryEntries() []GlossaryEntry {
+	paths, _ := fs.Glob(embeddedGlossary, "glossary/*.md")
+
+	var entries []GlossaryEntry
+	for _, path := range paths {
+		data, err := embeddedGlossary.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		title, body, _ := strings.Cut(string(data), "\n")
+		entries = append(entries, GlossaryEntry{
+			Slug: strings.TrimSuffix(strings.TrimPrefix(path, "glossary/"), ".md"),
+			Term: strings.TrimSpace(strings.TrimPrefix(title, "# ")),
+			Body: strings.TrimSpace(body),
+		})
+	}
+
+	slices.SortFunc(entries, func(a, b GlossaryEntry) int {
+		return strings.Compare(strings.ToLower(a.Term), strings.ToLower(b.Term))
+	})
+	return entries
+}
+
+// Links returns the slugs the entry links to, in order of appearance
+func (e GlossaryEntry) Links() []string {
+	var links []string
+	for _, match := range glossaryInline.FindAllStringSubmatch(e.Body, -1) {
+		if match[2] != "" {
+			links = append(links, match[2])
+		}
+	}
+	return links
+}
+
+// Glossary is the state of the glossary view
+type Glossary struct {
+	Entries   []GlossaryEntry // Entries matching the search, shown in the list
+	Query     string
+	Searching bool // Typing goes to the search box
+	Cursor    int  // Selected entry in Entries
+	Link      int  // Selected link in the entry, -1 for none
+	History   []string
+	Return    ViewState // View the glossary was opened from
+
+	all []GlossaryEntry
+}
+
+// glossaryTopic is a glossary entry offered from another view
+type glossaryTopic struct {
+	slug     string
+	question string
+}
+
+// glossaryTopic returns the entry explaining the current view, if any
+func (m *Model) glossaryTopic() (glossaryTopic, bool) {
+	switch m.CurrentView {
+	case TutorialView:
+		if m.Tutorial == nil {
+			break
+		}
+		switch m.Tutorial.Step {
+		case TutorialWelcome:
+			return glossaryTopic{"monty-hall-problem", "Why is this famous?"}, true
+		case TutorialPick:
+			return glossaryTopic{"prior-probability", "What's a prior probability?"}, true
+		case TutorialReveal:
+			return glossaryTopic{"conditional-probability", "What's conditional probability?"}, true
+		case TutorialProbability:
+			return glossaryTopic{"bayes-theorem", "What's Bayes' theorem?"}, true
+		case TutorialResult:
+			return glossaryTopic{"law-of-large-numbers", "What's the law of large numbers?"}, true
+		}
+	case StatsView:
+		if m.StatsPage == StatsPageConvergence {
+			return glossaryTopic{"law-of-large-numbers", "What's the law of large numbers?"}, true
+		}
+	case WhatIfView:
+		return glossaryTopic{"expected-value", "What's an expected value?"}, true
+	case EducationView:
+		return glossaryTopic{"bayes-theorem", "What's Bayes' theorem?"}, true
+	}
+	return glossaryTopic{}, false
+}
+
+// renderGlossaryHint renders the key that opens the current view's glossary
+// entry, or "" when there is none
+func (m *Model) renderGlossaryHint() string {
+	topic, ok := m.glossaryTopic()
+	if !ok {
+		return ""
+	}
+	return Center(MutedStyle.Render("? "+topic.question), m.Width, 1)
+}
+
+// openGlossary shows the glossary at the entry with the given slug, or at the
+// first entry if slug is empty
+func (m *Model) openGlossary(slug string) {
+	all := glossaryEntries()
+	m.Glossary = &Glossary{Entries: all, Link: -1, Return: m.CurrentView, all: all}
+	m.Glossary.show(slug)
+	m.CurrentView = GlossaryView
+}
+
+// Selected returns the entry being shown
+func (g *Glossary) Selected() (GlossaryEntry, bool) {
+	if g.Cursor < 0 || g.Cursor >= len(g.Entries) {
+		return GlossaryEntry{}, false
+	}
+	return g.Entries[g.Cursor], true
+}
+
+// show clears the search and selects the entry with the given slug
+func (g *Glossary) show(slug string) {
+	g.Query = ""
+	g.Entries = g.all
+	g.Cursor = 0
+	g.Link = -1
+	for i, entry := range g.Entries {
+		if entry.Slug == slug {
+			g.Cursor = i
+		}
+	}
+}
+
+// search filters the entries: terms containing the query first, then entries
+// mentioning it
+func (g *Glossary) search() {
+	query := strings.ToLower(g.Query)
+
+	var terms, mentions []GlossaryEntry
+	for _, entry := range g.all {
+		switch {
+		case strings.Contains(strings.ToLower(entry.Term), query):
+			terms = append(terms, entry)
+		case strings.Contains(strings.ToLower(entry.Body), query):
+			mentions = append(mentions, entry)
+		}
+	}
+
+	g.Entries = append(terms, mentions...)
+	g.Cursor = 0
+	g.Link = -1
+}
+
+// follow shows the selected link's entry, remembering the current one
+func (g *Glossary) follow() {
+	entry, ok := g.Selected()
+	links := entry.Links()
+	if !ok || g.Link < 0 || g.Link >= len(links) {
+		return
+	}
+	g.History = append(g.History, entry.Slug)
+	g.show(links[g.Link])
+}
+
+// handleGlossarySearchKeys processes input typed into the search box
+func (m *Model) handleGlossarySearchKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	g := m.Glossary
+
+	switch msg.Type {
+	case tea.KeyEsc:
+		g.Searching = false
+		g.Query = ""
+		g.search()
+	case tea.KeyEnter:
+		g.Searching = false
+	case tea.KeyBackspace:
+		if g.Query != "" {
+			runes := []rune(g.Query)
+			g.Query = string(runes[:len(runes)-1])
+			g.search()
+		}
+	case tea.KeyUp:
+		g.Cursor = max(g.Cursor-1, 0)
+		g.Link = -1
+	case tea.KeyDown:
+		g.Cursor = min(g.Cursor+1, max(len(g.Entries)-1, 0))
+		g.Link = -1
+	case tea.KeySpace:
+		g.Query += " "
+		g.search()
+	case tea.KeyRunes:
+		g.Query += string(msg.Runes)
+		g.search()
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// handleGlossaryKeys processes glossary view input
+func (m *Model) handleGlossaryKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	g := m.Glossary
+	if g == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeyUp, "k":
+		g.Cursor = max(g.Cursor-1, 0)
+		g.Link = -1
+
+	case KeyDown, "j":
+		g.Cursor = min(g.Cursor+1, max(len(g.Entries)-1, 0))
+		g.Link = -1
+
+	case "/":
+		g.Searching = true
+
+	case KeyTab, "shift+tab":
+		entry, ok := g.Selected()
+		if links := entry.Links(); ok && len(links) > 0 {
+			if msg.String() == KeyTab {
+				g.Link = (g.Link + 1) % len(links)
+			} else {
+				g.Link = (max(g.Link, 0) + len(links) - 1) % len(links)
+			}
+		}
+
+	case KeyEnter:
+		g.follow()
+
+	case "b", "backspace":
+		if len(g.History) > 0 {
+			g.show(g.History[len(g.History)-1])
+			g.History = g.History[:len(g.History)-1]
+			break
+		}
+		if g.Return == MainMenuView {
+			m.returnToMainMenu()
+		} else {
+			m.CurrentView = g.Return
+		}
+	}
+
+	return m, nil
+}
+
+// glossaryWord is a word of an entry with its inline formatting
+type glossaryWord struct {
+	text  string
+	style lipgloss.Style
+	link  int  // Index of the link the word belongs to, -1 for none
+	glued bool // No space before the word, e.g. punctuation after a link
+}
+
+// glossaryWords splits a paragraph into formatted words, numbering its links
+// from firstLink
+func glossaryWords(paragraph string, firstLink int) ([]glossaryWord, int) {
+	var words []glossaryWord
+	link := firstLink
+	spaced := true // Whether the text so far ends with a space
+
+	addSpan := func(text string, style lipgloss.Style, link int) {
+		if text == "" {
+			return
+		}
+		glued := !spaced && strings.TrimLeft(text, " \n") == text
+		for i, field := range strings.Fields(text) {
+			words = append(words, glossaryWord{text: field, style: style, link: link, glued: glued && i == 0})
+		}
+		spaced = strings.TrimRight(text, " \n") != text
+	}
+
+	plain := lipgloss.NewStyle()
+	last := 0
+	for _, match := range glossaryInline.FindAllStringSubmatchIndex(paragraph, -1) {
+		addSpan(paragraph[last:match[0]], plain, -1)
+		last = match[1]
+
+		switch {
+		case match[2] >= 0:
+			addSpan(paragraph[match[2]:match[3]], lipgloss.NewStyle().Foreground(SecondaryColor).Underline(true), link)
+			link++
+		case match[6] >= 0:
+			addSpan(paragraph[match[6]:match[7]], lipgloss.NewStyle().Bold(true), -1)
+		case match[8] >= 0:
+			addSpan(paragraph[match[8]:match[9]], lipgloss.NewStyle().Italic(true), -1)
+		default:
+			addSpan(paragraph[match[10]:match[11]], lipgloss.NewStyle().Foreground(AccentColor), -1)
+		}
+	}
+	addSpan(paragraph[last:], plain, -1)
+
+	return words, link
+}
+
+// renderGlossaryBody renders an entry's Markdown wrapped to width, highlighting
+// the selected link
+func renderGlossaryBody(body string, width, selectedLink int) string {
+	selected := lipgloss.NewStyle().Foreground(BackgroundColor).Background(SelectedColor).Bold(true)
+
+	var lines []string
+	link := 0
+	for i, paragraph := range strings.Split(body, "\n\n") {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+
+		var words []glossaryWord
+		words, link = glossaryWords(paragraph, link)
+
+		var line strings.Builder
+		lineWidth := 0
+		for _, word := range words {
+			wordWidth := runewidth.StringWidth(word.text)
+			if lineWidth > 0 && !word.glued && lineWidth+1+wordWidth > width {
+				lines = append(lines, line.String())
+				line.Reset()
+				lineWidth = 0
+			}
+			if lineWidth > 0 && !word.glued {
+				line.WriteString(" ")
+				lineWidth++
+			}
+
+			style := word.style
+			if word.link >= 0 && word.link == selectedLink {
+				style = selected
+			}
+			line.WriteString(style.Render(word.text))
+			lineWidth += wordWidth
+		}
+		lines = append(lines, line.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// renderGlossary renders the glossary view
+func (m *Model) renderGlossary() string {
+	g := m.Glossary
+	if g == nil {
+		return ""
+	}
+
+	var content []string
+	content = append(content, HeaderStyle.Render("GLOSSARY"))
+
+	search := MutedStyle.Render("Press / to search")
+	switch {
+	case g.Searching:
+		search = StatsLabelStyle.Render("🔍 " + g.Query + "█")
+	case g.Query != "":
+		search = StatsLabelStyle.Render("🔍 " + g.Query)
+	}
+	content = append(content, Center(search, m.Width, 1))
+	content = append(content, Spacer(1))
+
+	var list []string
+	for i, entry := range g.Entries {
+		term := runewidth.Truncate(entry.Term, glossaryListWidth-3, "…")
+		if i == g.Cursor {
+			list = append(list, lipgloss.NewStyle().Foreground(SelectedColor).Bold(true).Render("▸ "+term))
+		} else {
+			list = append(list, "  "+term)
+		}
+	}
+	if len(list) == 0 {
+		list = append(list, MutedStyle.Render("No matching terms"))
+	}
+	listPane := lipgloss.NewStyle().Width(glossaryListWidth).Render(lipgloss.JoinVertical(lipgloss.Left, list...))
+
+	entryPane := ""
+	if entry, ok := g.Selected(); ok {
+		bodyWidth := max(min(m.Width-glossaryListWidth-8, 60), 30)
+		entryPane = lipgloss.JoinVertical(lipgloss.Left,
+			StatsHeaderStyle.Render(entry.Term),
+			"",
+			renderGlossaryBody(entry.Body, bodyWidth, g.Link),
+		)
+	}
+	content = append(content, Center(lipgloss.JoinHorizontal(lipgloss.Top, listPane, "  ", entryPane), m.Width, 1))
+
+	var bindings []KeyBinding
+	if g.Searching {
+		bindings = []KeyBinding{
+			{"Type", "Search"},
+			{"↑↓", "Browse"},
+			{"Enter", "Done"},
+			{"ESC", "Clear"},
+		}
+	} else {
+		bindings = []KeyBinding{
+			{"↑↓", "Browse"},
+			{"/", "Search"},
+			{"Tab", "Select link"},
+			{"Enter", "Follow"},
+			{"b", "Back"},
+			{"ESC/q", "Main menu"},
+		}
+	}
+	content = append(content, RenderFooter(bindings))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
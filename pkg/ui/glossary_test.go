@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+)
+
+func TestGlossaryEntriesLinkToEntries(t *testing.T) {
+	entries := glossaryEntries()
+	if len(entries) == 0 {
+		t.Fatal("Expected embedded glossary entries")
+	}
+
+	slugs := make(map[string]bool)
+	for _, entry := range entries {
+		slugs[entry.Slug] = true
+		if entry.Term == "" || entry.Body == "" || strings.HasPrefix(entry.Term, "#") {
+			t.Errorf("%s: entry should have a title and a body", entry.Slug)
+		}
+	}
+
+	for _, entry := range entries {
+		for _, link := range entry.Links() {
+			if !slugs[link] {
+				t.Errorf("%s links to missing entry %q", entry.Slug, link)
+			}
+		}
+	}
+
+	// Every topic offered from another view must exist
+	model := NewModel()
+	for _, view := range []ViewState{TutorialView, StatsView, WhatIfView} {
+		model.startTutorial()
+		model.CurrentView = view
+		model.StatsPage = StatsPageConvergence
+		for step := range tutorialSteps {
+			model.Tutorial.Step = TutorialStep(step)
+			if topic, ok := model.glossaryTopic(); ok && !slugs[topic.slug] {
+				t.Errorf("View %v offers missing entry %q", view, topic.slug)
+			}
+		}
+	}
+}
+
+func TestGlossarySearch(t *testing.T) {
+	model := NewModel()
+	model.openGlossary("")
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range "quantity" {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if model.CurrentView != GlossaryView || model.Glossary.Query != "quantity" {
+		t.Fatalf("Typing in the search box should not trigger shortcuts, got view %v query %q", model.CurrentView, model.Glossary.Query)
+	}
+	if len(model.Glossary.Entries) != 0 || !strings.Contains(model.View(), "No matching terms") {
+		t.Errorf("Expected no matches, got %d", len(model.Glossary.Entries))
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.Glossary.Searching || len(model.Glossary.Entries) != len(glossaryEntries()) {
+		t.Fatal("Esc should clear the search")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range "total" {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	entry, ok := model.Glossary.Selected()
+	if !ok || entry.Slug != "law-of-total-probability" {
+		t.Errorf("Expected the matching term first, got %+v", entry)
+	}
+	if model.Glossary.Searching || model.Glossary.Query != "total" {
+		t.Error("Enter should close the search box and keep the results")
+	}
+}
+
+func TestGlossaryCrossLinks(t *testing.T) {
+	model := NewModel()
+	model.startTutorial()
+	model.Tutorial.Step = TutorialProbability
+
+	if !strings.Contains(model.View(), "? What's Bayes' theorem?") {
+		t.Fatal("The tutorial's math step should link to the glossary")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("?")})
+	entry, ok := model.Glossary.Selected()
+	if model.CurrentView != GlossaryView || !ok || entry.Slug != "bayes-theorem" {
+		t.Fatalf("Expected ? to open the Bayes' theorem entry, got view %v entry %q", model.CurrentView, entry.Slug)
+	}
+
+	// The first link is prior probability, the second the law of total probability
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if entry, _ := model.Glossary.Selected(); entry.Slug != "law-of-total-probability" {
+		t.Fatalf("Expected Enter to follow the selected link, got %q", entry.Slug)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if entry, _ := model.Glossary.Selected(); entry.Slug != "bayes-theorem" {
+		t.Errorf("Expected b to go back to the previous entry, got %q", entry.Slug)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if model.CurrentView != TutorialView || model.Tutorial.Step != TutorialProbability {
+		t.Error("Expected b to return to the tutorial where it was left")
+	}
+}
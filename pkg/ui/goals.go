@@ -0,0 +1,296 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// GoalDraft holds the goal being composed in the goals view
+type GoalDraft struct {
+	KindIndex int
+	Target    float64
+}
+
+// goalPreset describes the default target and adjustment step for a goal kind
+type goalPreset struct {
+	Default float64
+	Step    float64
+	Min     float64
+	Max     float64
+}
+
+// goalPresets maps each goal kind to its editing limits
+var goalPresets = map[stats.GoalKind]goalPreset{
+	stats.GoalGamesPlayed:    {Default: 200, Step: 10, Min: 10, Max: 10000},
+	stats.GoalSwitchUsage:    {Default: 60, Step: 5, Min: 5, Max: 100},
+	stats.GoalSwitchWinRate:  {Default: 60, Step: 1, Min: 1, Max: 100},
+	stats.GoalOverallWinRate: {Default: 50, Step: 1, Min: 1, Max: 100},
+	stats.GoalWinStreak:      {Default: 5, Step: 1, Min: 2, Max: 100},
+}
+
+// NewGoalDraft creates a draft for the first goal kind with its default target
+func NewGoalDraft() *GoalDraft {
+	kind := stats.GetGoalKinds()[0]
+	return &GoalDraft{
+		KindIndex: 0,
+		Target:    goalPresets[kind].Default,
+	}
+}
+
+// Kind returns the goal kind selected in the draft
+func (d *GoalDraft) Kind() stats.GoalKind {
+	return stats.GetGoalKinds()[d.KindIndex]
+}
+
+// cycleKind moves the draft to the next or previous goal kind
+func (d *GoalDraft) cycleKind(delta int) {
+	kinds := stats.GetGoalKinds()
+	d.KindIndex = (d.KindIndex + delta + len(kinds)) % len(kinds)
+	d.Target = goalPresets[d.Kind()].Default
+}
+
+// adjustTarget changes the draft target by the preset step, staying within limits
+func (d *GoalDraft) adjustTarget(direction int) {
+	preset := goalPresets[d.Kind()]
+	d.Target += preset.Step * float64(direction)
+	if d.Target < preset.Min {
+		d.Target = preset.Min
+	}
+	if d.Target > preset.Max {
+		d.Target = preset.Max
+	}
+}
+
+// Preview returns the goal the draft would create
+func (d *GoalDraft) Preview() stats.Goal {
+	return stats.Goal{Kind: d.Kind(), Target: d.Target}
+}
+
+// handleGoalsKeys processes goals view input
+func (m *Model) handleGoalsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	goals := m.StatsManager.Goals().Goals()
+
+	switch msg.String() {
+	case KeyUp, "k":
+		if m.GoalCursor > 0 {
+			m.GoalCursor--
+		}
+
+	case KeyDown, "j":
+		if m.GoalCursor < len(goals)-1 {
+			m.GoalCursor++
+		}
+
+	case "a", "n":
+		m.GoalDraft = NewGoalDraft()
+
+	case "d", "x", "delete":
+		if m.GoalCursor < len(goals) {
+			if err := m.StatsManager.Goals().Remove(goals[m.GoalCursor].ID); err != nil {
+				m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "remove goal"))
+				return m, nil
+			}
+			m.SuccessMessage = "Goal removed"
+			if m.GoalCursor > 0 && m.GoalCursor >= len(goals)-1 {
+				m.GoalCursor--
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// handleGoalDraftKeys processes input while a new goal is being composed
+func (m *Model) handleGoalDraftKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case KeyEscape, KeyQ:
+		m.GoalDraft = nil
+
+	case KeyLeft, "h":
+		m.GoalDraft.cycleKind(-1)
+
+	case KeyRight, "l":
+		m.GoalDraft.cycleKind(1)
+
+	case KeyUp, "k":
+		m.GoalDraft.adjustTarget(1)
+
+	case KeyDown, "j":
+		m.GoalDraft.adjustTarget(-1)
+
+	case KeyEnter:
+		goal, err := m.StatsManager.Goals().Add(m.GoalDraft.Kind(), m.GoalDraft.Target)
+		if err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "add goal"))
+			return m, nil
+		}
+		m.GoalDraft = nil
+		m.GoalCursor = len(m.StatsManager.Goals().Goals()) - 1
+		m.SuccessMessage = fmt.Sprintf("New goal: %s", goal.Description())
+
+		// A goal may already be satisfied by existing statistics
+		m.checkCompletedGoals()
+	}
+
+	return m, nil
+}
+
+// checkCompletedGoals evaluates goals and announces any that were just achieved
+func (m *Model) checkCompletedGoals() {
+	completed, err := m.StatsManager.Goals().Evaluate(m.StatsManager.GetStats())
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "save goals"))
+	}
+	m.announceGoals(completed)
+}
+
+// announceCompletedGoals announces goals completed while recording games
+func (m *Model) announceCompletedGoals() {
+	m.announceGoals(m.StatsManager.TakeCompletedGoals())
+}
+
+// announceGoals shows an achievement notification for newly completed goals
+func (m *Model) announceGoals(completed []stats.Goal) {
+	if len(completed) == 0 {
+		return
+	}
+
+	if len(completed) == 1 {
+		m.SuccessMessage = fmt.Sprintf("🏆 Achievement unlocked: %s", completed[0].Description())
+	} else {
+		m.SuccessMessage = fmt.Sprintf("🏆 %d goals achieved! Latest: %s", len(completed), completed[len(completed)-1].Description())
+	}
+}
+
+// renderGoals renders the goals view
+func (m *Model) renderGoals() string {
+	gameStats := m.StatsManager.GetStats()
+	goals := m.StatsManager.Goals().Goals()
+
+	var content []string
+	content = append(content, HeaderStyle.Render("GOALS"))
+	content = append(content, Spacer(1))
+
+	if len(goals) == 0 {
+		content = append(content, Center(SubtitleStyle.Render("No goals yet. Press 'a' to set your first goal!"), m.Width, 1))
+	}
+
+	for i, goal := range goals {
+		content = append(content, Center(NewGoalProgress(goal, gameStats, i == m.GoalCursor).Render(), m.Width, 1))
+	}
+
+	if m.GoalDraft != nil {
+		content = append(content, Spacer(1))
+		content = append(content, Center(m.renderGoalDraft(), m.Width, 1))
+	}
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+	if m.SuccessMessage != "" {
+		content = append(content, Center(SuccessStyle.Render("✅ "+m.SuccessMessage), m.Width, 1))
+	}
+
+	var footer string
+	if m.GoalDraft != nil {
+		footer = RenderFooter([]KeyBinding{
+			{"←→", "Goal type"},
+			{"↑↓", "Target"},
+			{"Enter", "Add goal"},
+			{"ESC", "Cancel"},
+		})
+	} else {
+		footer = RenderFooter([]KeyBinding{
+			{"a", "Add goal"},
+			{"d", "Delete"},
+			{"↑↓", "Navigate"},
+			{"ESC/q", "Return"},
+		})
+	}
+	content = append(content, footer)
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
+
+// renderGoalDraft renders the new-goal editor
+func (m *Model) renderGoalDraft() string {
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(SecondaryColor).
+		Padding(0, 2)
+
+	kindLine := fmt.Sprintf("◀ %s ▶", m.GoalDraft.Kind())
+	targetLine := m.GoalDraft.Preview().Description()
+
+	return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Center,
+		TitleStyle.Render("NEW GOAL"),
+		SubtitleStyle.Render(kindLine),
+		StatsLabelStyle.Render(targetLine),
+	))
+}
+
+// GoalProgress component shows a goal with its progress bar
+type GoalProgress struct {
+	Goal     stats.Goal
+	Stats    *stats.GameStats
+	Selected bool
+}
+
+// NewGoalProgress creates a new goal progress component
+func NewGoalProgress(goal stats.Goal, gameStats *stats.GameStats, selected bool) *GoalProgress {
+	return &GoalProgress{
+		Goal:     goal,
+		Stats:    gameStats,
+		Selected: selected,
+	}
+}
+
+// Render renders the goal progress
+func (g *GoalProgress) Render() string {
+	const barWidth = 40
+
+	marker := "  "
+	if g.Selected {
+		marker = "▶ "
+	}
+
+	status := ""
+	if g.Goal.IsComplete() {
+		status = " 🏆"
+	}
+
+	titleStyle := StatsLabelStyle
+	if g.Selected {
+		titleStyle = lipgloss.NewStyle().Foreground(SelectedColor).Bold(true)
+	}
+	title := titleStyle.Render(marker + g.Goal.Description() + status)
+
+	filled := int(g.Goal.Progress(g.Stats) * barWidth)
+	bar := ProgressBarStyle.Width(barWidth).Render(
+		lipgloss.NewStyle().Foreground(SecondaryColor).Render(strings.Repeat("█", filled)) + strings.Repeat("░", barWidth-filled),
+	)
+
+	current := g.Goal.Current(g.Stats)
+	var detail string
+	if g.Goal.Kind.IsRate() {
+		detail = fmt.Sprintf("%.1f%% / %.0f%%", current, g.Goal.Target)
+		if g.Stats != nil && g.Goal.RatedGames(g.Stats) < g.Goal.MinGames && !g.Goal.IsComplete() {
+			games := "games"
+			if g.Goal.Kind == stats.GoalSwitchWinRate {
+				games = "switch games"
+			}
+			detail += fmt.Sprintf(" (needs %d+ %s)", g.Goal.MinGames, games)
+		}
+	} else {
+		detail = fmt.Sprintf("%.0f / %.0f", current, g.Goal.Target)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, title, "  "+bar, "  "+MutedStyle.Render(detail))
+}
@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/stats/analysis"
+)
+
+const (
+	// KeyDismissHint hides the hint for the current game
+	KeyDismissHint = "d"
+	// KeyDisableHints turns hints off, saving the setting
+	KeyDisableHints = "D"
+)
+
+// hintMinGames is how many games a strategy needs before hints quote its win rate
+const hintMinGames = 5
+
+// strategyHint suggests a strategy for the final choice from the player's own
+// results, falling back on theory until both strategies have been played
+// enough. stay and switchDoors are the win rates theory predicts for the game
+func strategyHint(gameStats *stats.GameStats, stay, switchDoors float64) string {
+	switched, stayed := gameStats.SwitchStats, gameStats.StayStats
+	rate := func(s stats.StrategyStats) float64 {
+		return float64(s.Wins) / float64(s.GamesPlayed)
+	}
+
+	switch {
+	case switched.GamesPlayed >= hintMinGames && stayed.GamesPlayed >= hintMinGames:
+		switchRate, stayRate := rate(switched), rate(stayed)
+		switch {
+		case switchRate > stayRate && stayRate > 0:
+			return fmt.Sprintf("Historically you win %.1fx more often when switching (%.0f%% vs %.0f%%)",
+				switchRate/stayRate, switchRate*100, stayRate*100)
+		case switchRate > stayRate:
+			return fmt.Sprintf("Historically you've won %d of %d games switching, and none staying",
+				switched.Wins, switched.GamesPlayed)
+		case stayRate > switchRate && switchDoors > stay:
+			return fmt.Sprintf("Staying is ahead for you so far (%.0f%% vs %.0f%%); in theory switching wins %s",
+				stayRate*100, switchRate*100, analysis.Fraction(switchDoors))
+		}
+
+	case stayed.GamesPlayed >= hintMinGames && switched.GamesPlayed == 0:
+		return fmt.Sprintf("You've always stayed, winning %.0f%% of %d games; switching wins %s in theory",
+			rate(stayed)*100, stayed.GamesPlayed, analysis.Fraction(switchDoors))
+
+	case switched.GamesPlayed >= hintMinGames && stayed.GamesPlayed == 0:
+		return fmt.Sprintf("You've always switched, winning %.0f%% of %d games; staying wins %s in theory",
+			rate(switched)*100, switched.GamesPlayed, analysis.Fraction(stay))
+	}
+
+	if switchDoors == stay {
+		return fmt.Sprintf("With this host, staying and switching both win %s of the time", analysis.Fraction(stay))
+	}
+	return fmt.Sprintf("Switching wins %s of the time in theory, staying %s", analysis.Fraction(switchDoors), analysis.Fraction(stay))
+}
+
+// renderHintStatus shows a strategy hint while the player decides whether to
+// switch, unless hints are off or dismissed for this game. Practice and
+// worksheet games go without, as they test the player's own judgment
+func (m *Model) renderHintStatus() string {
+	if !m.ShowHints || m.HintDismissedFor == m.Game || m.Practice != nil || m.Worksheet != nil || m.Game.CarRevealed() {
+		return ""
+	}
+
+	stay, switchDoors := m.Game.Host.Behavior.ExpectedWinRatesOpening(m.Game.NumDoors(), len(m.Game.HostOpenedDoors))
+	hint := "💡 " + strategyHint(m.StatsManager.GetStats(), stay, switchDoors)
+	keys := fmt.Sprintf("%s dismiss  •  %s turn off hints", KeyDismissHint, KeyDisableHints)
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		Center(lipgloss.NewStyle().Foreground(SecondaryColor).Render(hint), m.Width, 1),
+		Center(MutedStyle.Render(keys), m.Width, 1),
+	)
+}
+
+// handleHintKeys dismisses the hint for the current game or turns hints off
+func (m *Model) handleHintKeys(key string) tea.Cmd {
+	if !m.ShowHints || m.Game == nil || m.Game.Phase != game.FinalChoice {
+		return nil
+	}
+
+	if key == KeyDismissHint || m.ConfigManager == nil {
+		m.HintDismissedFor = m.Game
+		return nil
+	}
+
+	cfg := m.ConfigManager.Get()
+	cfg.Game.ShowHints = false
+	cmd := m.applyConfig(cfg)
+	m.applySettings(m.ConfigManager.Get())
+	m.SuccessMessage = "Hints turned off; turn them back on under Settings → Game"
+	return cmd
+}
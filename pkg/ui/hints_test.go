@@ -0,0 +1,71 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestStrategyHint(t *testing.T) {
+	record := func(games, wins int) stats.StrategyStats {
+		return stats.StrategyStats{GamesPlayed: games, Wins: wins, Losses: games - wins}
+	}
+	tests := []struct {
+		name        string
+		switched    stats.StrategyStats
+		stayed      stats.StrategyStats
+		stay, swtch float64
+		want        string
+	}{
+		{"no games", record(0, 0), record(0, 0), 1.0 / 3, 2.0 / 3, "Switching wins 2/3 of the time in theory, staying 1/3"},
+		{"switching ahead", record(20, 12), record(10, 3), 1.0 / 3, 2.0 / 3, "Historically you win 2.0x more often when switching (60% vs 30%)"},
+		{"never won staying", record(6, 4), record(5, 0), 1.0 / 3, 2.0 / 3, "won 4 of 6 games switching, and none staying"},
+		{"staying ahead", record(10, 4), record(10, 5), 1.0 / 3, 2.0 / 3, "Staying is ahead for you so far (50% vs 40%)"},
+		{"always stayed", record(0, 0), record(12, 4), 1.0 / 3, 2.0 / 3, "You've always stayed, winning 33% of 12 games"},
+		{"too few games", record(2, 2), record(1, 0), 1.0 / 3, 2.0 / 3, "in theory"},
+		{"falling host", record(0, 0), record(0, 0), 0.5, 0.5, "staying and switching both win 1/2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gameStats := &stats.GameStats{SwitchStats: tt.switched, StayStats: tt.stayed}
+			if hint := strategyHint(gameStats, tt.stay, tt.swtch); !strings.Contains(hint, tt.want) {
+				t.Errorf("Expected a hint containing %q, got %q", tt.want, hint)
+			}
+		})
+	}
+}
+
+func TestHintKeys(t *testing.T) {
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json")))
+	model.Game = game.NewSeededGame(1)
+	model.CurrentView = GameView
+	if err := model.Game.MakeInitialChoice(0); err != nil {
+		t.Fatal(err)
+	}
+
+	if model.renderHintStatus() == "" {
+		t.Fatal("Expected a hint at the final choice with hints on by default")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyDismissHint)})
+	if model.renderHintStatus() != "" || !model.ShowHints {
+		t.Error("x should hide the hint for this game only")
+	}
+
+	model.startNewGame()
+	if err := model.Game.MakeInitialChoice(0); err != nil {
+		t.Fatal(err)
+	}
+	if model.renderHintStatus() == "" {
+		t.Error("The next game should have a hint again")
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyDisableHints)})
+	if model.ShowHints || configManager.Get().Game.ShowHints || model.renderHintStatus() != "" {
+		t.Error("X should turn hints off in the settings")
+	}
+}
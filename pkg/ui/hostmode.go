@@ -0,0 +1,267 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	mathrand "math/rand"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// HostMode reverses the game: the computer is the contestant and the player
+// is Monty, who knows where the car is and must open a goat door. Its games
+// are never recorded in the statistics, as the player isn't the contestant
+type HostMode struct {
+	Game   *game.Game
+	Cursor int
+
+	// Tally of the games played as the host
+	Played     int
+	Forced     int // Games where only one goat door could be opened
+	Refused    int // Doors the rules did not allow to be opened
+	SwitchWins int
+	Switched   int
+	StayWins   int
+	Stayed     int
+
+	Contestant game.Player
+	rng        *mathrand.Rand
+	refusal    string // Why the last door could not be opened
+}
+
+// startHostMode opens the reversed game with a contestant who switches half
+// the time
+func (m *Model) startHostMode() {
+	contestant, _ := game.NewPlayer("random")
+	m.HostMode = &HostMode{
+		Contestant: contestant,
+		rng:        mathrand.New(mathrand.NewSource(now().UnixNano())),
+	}
+	m.HostMode.newGame()
+	m.stopAnimations()
+	m.CurrentView = HostModeView
+}
+
+// newGame starts a classic three-door game in which the contestant has
+// already picked a door, waiting for the host
+func (h *HostMode) newGame() {
+	h.Game = game.NewGame()
+	h.Game.MakeInitialChoiceForHost(h.Contestant.ChooseInitialDoor(h.Game, h.rng))
+	h.refusal = ""
+
+	h.Cursor = 0
+	if h.Cursor == h.Game.PlayerInitialChoice {
+		h.Cursor++
+	}
+}
+
+// openDoor opens the door under the cursor, unless the host's rules forbid it
+func (h *HostMode) openDoor() {
+	g := h.Game
+	forced := g.Doors[g.PlayerInitialChoice].HasGoat()
+
+	err := g.OpenDoorAsHost(h.Cursor)
+	switch {
+	case errors.Is(err, game.ErrHostRevealsCar):
+		h.Refused++
+		h.refusal = fmt.Sprintf("Door %d hides the car! The host never reveals it, or the game would be given away", h.Cursor+1)
+		return
+	case errors.Is(err, game.ErrHostOpensChoice):
+		h.Refused++
+		h.refusal = "That's the contestant's door. The host never opens it, or there'd be nothing left to decide"
+		return
+	case err != nil:
+		h.refusal = err.Error()
+		return
+	}
+
+	h.refusal = ""
+	h.Played++
+	if forced {
+		h.Forced++
+	}
+}
+
+// letContestantDecide has the contestant stay or switch and tallies the result
+func (h *HostMode) letContestantDecide() error {
+	if err := game.FinishGame(h.Game, h.Contestant, h.rng); err != nil {
+		return err
+	}
+
+	result := h.Game.Result
+	if result.Strategy == game.Switch {
+		h.Switched++
+		if result.Won {
+			h.SwitchWins++
+		}
+	} else {
+		h.Stayed++
+		if result.Won {
+			h.StayWins++
+		}
+	}
+	return nil
+}
+
+// handleHostModeKeys processes input while playing as the host
+func (m *Model) handleHostModeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	h := m.HostMode
+	if h == nil {
+		return m, nil
+	}
+
+	switch h.Game.Phase {
+	case game.HostReveal:
+		switch msg.String() {
+		case KeyLeft, "h":
+			h.Cursor = max(h.Cursor-1, 0)
+		case KeyRight, "l":
+			h.Cursor = min(h.Cursor+1, h.Game.NumDoors()-1)
+		case Key1, Key2, Key3:
+			h.Cursor = int(msg.String()[0] - '1')
+		case KeyEnter, KeySpace, " ":
+			h.openDoor()
+		}
+
+	case game.FinalChoice:
+		switch msg.String() {
+		case KeyEnter, KeySpace, " ":
+			if err := h.letContestantDecide(); err != nil {
+				m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "finish the game"))
+			}
+		}
+
+	case game.GameOver:
+		switch msg.String() {
+		case KeyEnter, KeySpace, " ":
+			h.newGame()
+		}
+	}
+
+	return m, nil
+}
+
+// hostModeLines explains the current step to the player playing the host
+func (h *HostMode) hostModeLines() []string {
+	g := h.Game
+	pick := g.PlayerInitialChoice + 1
+	car := g.CarPosition + 1
+
+	switch g.Phase {
+	case game.HostReveal:
+		lines := []string{
+			fmt.Sprintf("The contestant picked door %d. Only you know where the car is.", pick),
+			"Open one of the other doors, and make sure it hides a goat.",
+		}
+		if h.refusal != "" {
+			lines = append(lines, "", lipgloss.NewStyle().Foreground(WarningColor).Render("✋ "+h.refusal))
+		}
+		return lines
+
+	case game.FinalChoice:
+		opened := g.HostOpenedDoor + 1
+		if pick == car {
+			return []string{
+				fmt.Sprintf("You opened door %d. The contestant picked the car, so either goat", opened),
+				"door would have done: your choice was free. This happens 1 game in 3.",
+				"Now the contestant decides whether to stay or switch.",
+			}
+		}
+		return []string{
+			fmt.Sprintf("You opened door %d. The contestant picked a goat, so you had no choice:", opened),
+			fmt.Sprintf("the car had to stay behind door %d. This happens 2 games in 3, and it's", car),
+			"why switching wins 2/3: your forced move points the way to the car.",
+			"Now the contestant decides whether to stay or switch.",
+		}
+
+	case game.GameOver:
+		verb := "stayed with"
+		if g.Result.Strategy == game.Switch {
+			verb = "switched to"
+		}
+		outcome := "and got a goat"
+		if g.Result.Won {
+			outcome = "and won the car!"
+		}
+		return []string{fmt.Sprintf("The contestant %s door %d %s", verb, g.PlayerFinalChoice+1, outcome)}
+	}
+	return nil
+}
+
+// renderHostModeTally summarizes the games played as the host
+func (h *HostMode) renderHostModeTally() []string {
+	if h.Played == 0 {
+		return nil
+	}
+
+	rate := func(wins, games int) string {
+		if games == 0 {
+			return "no games"
+		}
+		return fmt.Sprintf("won %d of %d (%.0f%%)", wins, games, float64(wins)/float64(games)*100)
+	}
+	return []string{
+		fmt.Sprintf("Your hand was forced in %d of %d games (%.0f%%)", h.Forced, h.Played, float64(h.Forced)/float64(h.Played)*100),
+		fmt.Sprintf("Switching contestants %s  •  staying contestants %s", rate(h.SwitchWins, h.Switched), rate(h.StayWins, h.Stayed)),
+	}
+}
+
+// renderHostMode renders the reversed game, with every door's contents
+// visible to the host
+func (m *Model) renderHostMode() string {
+	h := m.HostMode
+	if h == nil {
+		return ""
+	}
+	g := h.Game
+
+	var content []string
+	content = append(content, HeaderStyle.Render("PLAY AS THE HOST"))
+	content = append(content, Center(SubtitleStyle.Render("You're Monty: the computer is the contestant"), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	text := lipgloss.JoinVertical(lipgloss.Left, h.hostModeLines()...)
+	content = append(content, Center(StatsLabelStyle.Render(text), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	var doors string
+	switch g.Phase {
+	case game.HostReveal:
+		doors = RenderDoorsRow(g.Doors, g.PlayerInitialChoice, -1, h.Cursor, false)
+	case game.FinalChoice:
+		doors = RenderDoorsRow(g.Doors, g.PlayerInitialChoice, g.HostOpenedDoor, -1, false)
+	default:
+		doors = RenderDoorsRow(g.Doors, g.PlayerFinalChoice, g.HostOpenedDoor, -1, true)
+	}
+	content = append(content, SafeCenter(doors, m.Width))
+	if g.Phase != game.GameOver {
+		content = append(content, Center(MutedStyle.Render(fmt.Sprintf("🚗 behind door %d (hidden from the contestant)", g.CarPosition+1)), m.Width, 1))
+	}
+
+	if tally := h.renderHostModeTally(); tally != nil {
+		content = append(content, Spacer(1))
+		for _, line := range tally {
+			content = append(content, Center(MutedStyle.Render(line), m.Width, 1))
+		}
+	}
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+
+	var bindings []KeyBinding
+	switch g.Phase {
+	case game.HostReveal:
+		bindings = append(bindings, KeyBinding{"←→/1-3", "Choose door"}, KeyBinding{"Enter", "Open door"})
+	case game.FinalChoice:
+		bindings = append(bindings, KeyBinding{"Enter", "Contestant decides"})
+	case game.GameOver:
+		bindings = append(bindings, KeyBinding{"Enter", "Next contestant"})
+	}
+	bindings = append(bindings, KeyBinding{"ESC/q", "Main menu"})
+	content = append(content, RenderFooter(bindings))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
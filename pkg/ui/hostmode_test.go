@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestHostMode(t *testing.T) {
+	model := NewModel()
+	model.startHostMode()
+	h := model.HostMode
+	if model.CurrentView != HostModeView || h.Game.Phase != game.HostReveal {
+		t.Fatal("Expected the contestant to have picked, waiting for the host")
+	}
+	if h.Cursor == h.Game.PlayerInitialChoice {
+		t.Error("Expected the cursor to start away from the contestant's door")
+	}
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	for games := 1; games <= 20; games++ {
+		g := h.Game
+		h.Cursor = g.PlayerInitialChoice
+		model.Update(enter)
+		h.Cursor = g.CarPosition
+		model.Update(enter)
+		if g.Phase != game.HostReveal || len(g.HostOpenedDoors) != 0 {
+			t.Fatal("Expected the contestant's door and the car to stay closed")
+		}
+
+		for door := range g.Doors {
+			if door != g.PlayerInitialChoice && door != g.CarPosition {
+				h.Cursor = door
+				break
+			}
+		}
+		model.Update(enter)
+		if g.Phase != game.FinalChoice {
+			t.Fatalf("Expected a goat door to open, got phase %v", g.Phase)
+		}
+		if view := model.View(); g.PlayerInitialChoice != g.CarPosition && !strings.Contains(view, "you had no choice") {
+			t.Errorf("Expected the forced move explained, got:\n%s", view)
+		}
+
+		model.Update(enter)
+		if g.Phase != game.GameOver {
+			t.Fatalf("Expected the contestant to decide, got phase %v", g.Phase)
+		}
+		model.Update(enter)
+	}
+
+	if h.Played != 20 || h.Refused != 40 || h.Switched+h.Stayed != 20 {
+		t.Errorf("Expected 20 games with 40 refused doors, got %+v", h)
+	}
+	if !strings.Contains(model.View(), "Your hand was forced in") {
+		t.Error("Expected the tally of forced reveals")
+	}
+}
@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// MaxHotseatNameLength is the longest player name in hotseat mode
+const MaxHotseatNameLength = 20
+
+// HotseatPlayer is one of the players taking turns and their tally
+type HotseatPlayer struct {
+	Name     string
+	Games    int
+	Wins     int
+	Switched int
+}
+
+// HotseatSession tracks two players alternating games on the same terminal
+type HotseatSession struct {
+	Players [2]HotseatPlayer
+	Turn    int // Index of the player whose game it is
+
+	turnOver bool // The player's game has been recorded; the other is next
+}
+
+// Current returns the player whose game it is
+func (s *HotseatSession) Current() *HotseatPlayer {
+	return &s.Players[s.Turn]
+}
+
+// Next returns the player who plays the next game
+func (s *HotseatSession) Next() *HotseatPlayer {
+	if s.turnOver {
+		return &s.Players[1-s.Turn]
+	}
+	return s.Current()
+}
+
+// nextTurn passes the turn to the other player once a game has been recorded
+func (s *HotseatSession) nextTurn() {
+	if s.turnOver {
+		s.Turn = 1 - s.Turn
+		s.turnOver = false
+	}
+}
+
+// HotseatSetup holds the player names being entered in HotseatView
+type HotseatSetup struct {
+	Names [2]string
+	Field int // Name being typed
+	Err   string
+}
+
+// openHotseat shows the player names for a hotseat session, filled in with
+// the players of the last one
+func (m *Model) openHotseat() {
+	m.HotseatSetup = &HotseatSetup{}
+	if m.Hotseat != nil {
+		m.HotseatSetup.Names = [2]string{m.Hotseat.Players[0].Name, m.Hotseat.Players[1].Name}
+	}
+	m.stopAnimations()
+	m.CurrentView = HotseatView
+}
+
+// startHotseat starts alternating games between the players entered. The
+// tally carries on when the same players start again
+func (m *Model) startHotseat() {
+	setup := m.HotseatSetup
+	var names [2]string
+	for i, name := range setup.Names {
+		names[i] = strings.TrimSpace(name)
+		if names[i] == "" {
+			setup.Err = "Enter a name for both players"
+			return
+		}
+	}
+	if strings.EqualFold(names[0], names[1]) {
+		setup.Err = "The players need different names"
+		return
+	}
+
+	if m.Hotseat == nil || m.Hotseat.Players[0].Name != names[0] || m.Hotseat.Players[1].Name != names[1] {
+		m.Hotseat = &HotseatSession{Players: [2]HotseatPlayer{{Name: names[0]}, {Name: names[1]}}}
+	}
+	m.HotseatSetup = nil
+	m.Daily = nil
+	m.Practice = nil
+	m.Worksheet = nil
+	m.Ladder = nil
+	m.startNewGame()
+	m.CurrentView = GameView
+}
+
+// handleHotseatSetupKeys processes input while the player names are typed
+func (m *Model) handleHotseatSetupKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	setup := m.HotseatSetup
+	name := &setup.Names[setup.Field]
+
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case tea.KeyEsc:
+		m.HotseatSetup = nil
+		m.returnToMainMenu()
+
+	case tea.KeyTab, tea.KeyShiftTab, tea.KeyUp, tea.KeyDown:
+		setup.Field = 1 - setup.Field
+
+	case tea.KeyBackspace:
+		if runes := []rune(*name); len(runes) > 0 {
+			*name = string(runes[:len(runes)-1])
+		}
+
+	case tea.KeyEnter:
+		if setup.Field == 0 {
+			setup.Field = 1
+			return m, nil
+		}
+		m.startHotseat()
+
+	case tea.KeySpace, tea.KeyRunes:
+		typed := string(msg.Runes)
+		if msg.Type == tea.KeySpace {
+			typed = " "
+		}
+		if len([]rune(*name))+len([]rune(typed)) <= MaxHotseatNameLength {
+			*name += typed
+		}
+		setup.Err = ""
+	}
+
+	return m, nil
+}
+
+// recordHotseatGame adds the finished game to the tally of the player whose
+// turn it was
+func (m *Model) recordHotseatGame() {
+	if m.Hotseat == nil || m.Hotseat.turnOver {
+		return
+	}
+
+	player := m.Hotseat.Current()
+	player.Games++
+	if m.Game.Result.Won {
+		player.Wins++
+	}
+	if m.Game.Result.Strategy == game.Switch {
+		player.Switched++
+	}
+	m.Hotseat.turnOver = true
+}
+
+// renderHotseatStatus renders the hotseat banner with whose turn it is and the score
+func (m *Model) renderHotseatStatus() string {
+	s := m.Hotseat
+	if s == nil {
+		return ""
+	}
+
+	status := fmt.Sprintf("👥 Hotseat  •  %s's turn", s.Current().Name)
+	if s.turnOver {
+		status = fmt.Sprintf("👥 Hotseat  •  %s played, %s is next", s.Current().Name, s.Next().Name)
+	}
+	score := fmt.Sprintf("%s %d – %d %s", s.Players[0].Name, s.Players[0].Wins, s.Players[1].Wins, s.Players[1].Name)
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		Center(lipgloss.NewStyle().Foreground(AccentColor).Bold(true).Render(status), m.Width, 1),
+		Center(MutedStyle.Render(score), m.Width, 1),
+	)
+}
+
+// renderHotseatScoreboard renders the head-to-head tally shown after each game
+func (m *Model) renderHotseatScoreboard() string {
+	s := m.Hotseat
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(AccentColor).
+		Padding(0, 2)
+
+	nameWidth := len("Player")
+	for _, player := range s.Players {
+		nameWidth = max(nameWidth, runewidth.StringWidth(player.Name))
+	}
+
+	rows := []string{
+		TitleStyle.Render("HEAD TO HEAD"),
+		StatsHeaderStyle.Render(fmt.Sprintf("%s %6s %5s %6s %9s", runewidth.FillRight("Player", nameWidth), "Games", "Won", "Rate", "Switched")),
+	}
+	for i, player := range s.Players {
+		rate, switched := "-", "-"
+		if player.Games > 0 {
+			rate = fmt.Sprintf("%.0f%%", float64(player.Wins)/float64(player.Games)*100)
+			switched = fmt.Sprintf("%.0f%%", float64(player.Switched)/float64(player.Games)*100)
+		}
+		line := fmt.Sprintf("%s %6d %5d %6s %9s", runewidth.FillRight(player.Name, nameWidth), player.Games, player.Wins, rate, switched)
+		style := StatsLabelStyle
+		if i == s.Turn {
+			style = lipgloss.NewStyle().Foreground(SelectedColor).Bold(true)
+		}
+		rows = append(rows, style.Render(line))
+	}
+
+	first, second := s.Players[0], s.Players[1]
+	leader := fmt.Sprintf("All square at %d–%d", first.Wins, second.Wins)
+	if first.Wins != second.Wins {
+		if second.Wins > first.Wins {
+			first, second = second, first
+		}
+		leader = fmt.Sprintf("%s leads %d–%d", first.Name, first.Wins, second.Wins)
+	}
+	rows = append(rows, SubtitleStyle.Render(leader))
+	rows = append(rows, MutedStyle.Render(fmt.Sprintf("Next up: %s, take the keyboard", s.Next().Name)))
+
+	return Center(boxStyle.Render(lipgloss.JoinVertical(lipgloss.Center, rows...)), m.Width, 1)
+}
+
+// renderHotseatSetup renders the view where the two players enter their names
+func (m *Model) renderHotseatSetup() string {
+	setup := m.HotseatSetup
+	if setup == nil {
+		setup = &HotseatSetup{}
+	}
+
+	var content []string
+	content = append(content, HeaderStyle.Render("HOTSEAT"))
+	content = append(content, Center(MutedStyle.Render("Two players take turns on this terminal, each with their own tally."), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	var fields []string
+	for i, name := range setup.Names {
+		label := fmt.Sprintf("Player %d: ", i+1)
+		if i == setup.Field {
+			fields = append(fields, TitleStyle.Render(label)+name+"█")
+		} else {
+			fields = append(fields, StatsLabelStyle.Render(label+name))
+		}
+	}
+	content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, fields...), m.Width, 1))
+
+	if setup.Err != "" {
+		content = append(content, Spacer(1))
+		content = append(content, Center(ErrorStyle.Render("❌ "+setup.Err), m.Width, 1))
+	}
+
+	enter := KeyBinding{"Enter", "Next player"}
+	if setup.Field == 1 {
+		enter = KeyBinding{"Enter", "Start playing"}
+	}
+	content = append(content, RenderFooter([]KeyBinding{enter, {"Tab", "Other player"}, {"ESC", "Main menu"}}))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
+
+// renderPlayerStats renders the hotseat players section of the stats view
+func (m *Model) renderPlayerStats(gameStats *stats.GameStats) []string {
+	byPlayer := stats.StatsByPlayer(gameStats)
+	if len(byPlayer) == 0 {
+		return nil
+	}
+
+	var content []string
+	content = append(content, Spacer(1))
+	content = append(content, Center(StatsHeaderStyle.Render("👥 HOTSEAT PLAYERS"), m.Width, 1))
+	for _, player := range byPlayer {
+		name := runewidth.FillRight(runewidth.Truncate(player.Name, MaxHotseatNameLength, "…"), MaxHotseatNameLength)
+		line := fmt.Sprintf("%s %4d games, won %3.0f%%  •  switch %s  •  stay %s", name, player.Games, player.WinRate()*100,
+			strategyRate(player.SwitchStats), strategyRate(player.StayStats))
+		content = append(content, Center(StatsLabelStyle.Render(line), m.Width, 1))
+	}
+	return content
+}
+
+// strategyRate describes a player's win rate with one strategy
+func strategyRate(s stats.StrategyStats) string {
+	if s.GamesPlayed == 0 {
+		return "no games"
+	}
+	return fmt.Sprintf("%.0f%% of %d", s.WinRate*100, s.GamesPlayed)
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestHotseatSetup(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+
+	model.openHotseat()
+	for _, msg := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("Ann")},
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyRunes, Runes: []rune("ann")},
+		{Type: tea.KeyEnter},
+	} {
+		model.Update(msg)
+	}
+	if model.Hotseat != nil || !strings.Contains(model.View(), "The players need different names") {
+		t.Fatal("Expected the players to need different names")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Ben")})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.Hotseat == nil || model.CurrentView != GameView {
+		t.Fatal("Expected a hotseat session in the game view")
+	}
+	if names := [2]string{model.Hotseat.Players[0].Name, model.Hotseat.Players[1].Name}; names != [2]string{"Ann", "Ben"} {
+		t.Errorf("Expected Ann and Ben to play, got %v", names)
+	}
+	if !strings.Contains(model.View(), "Ann's turn") {
+		t.Error("Expected the banner to say whose turn it is")
+	}
+}
+
+func TestHotseatPlayersAlternate(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.openHotseat()
+	model.HotseatSetup.Names = [2]string{"Ann", "Ben"}
+	model.startHotseat()
+
+	for i := 0; i < 3; i++ {
+		finishGame(t, model, 0, i != 1)
+		model.recordResult()
+		model.startNewGame()
+	}
+
+	ann, ben := model.Hotseat.Players[0], model.Hotseat.Players[1]
+	if ann.Games != 2 || ben.Games != 1 || ann.Switched != 2 || ben.Switched != 0 {
+		t.Errorf("Expected Ann to play games 1 and 3 switching and Ben game 2 staying, got %+v and %+v", ann, ben)
+	}
+	if model.Hotseat.Current().Name != "Ben" {
+		t.Errorf("Expected Ben's turn, got %s's", model.Hotseat.Current().Name)
+	}
+
+	// The games are the players', not the profile owner's
+	if games := model.StatsManager.GetStats().TotalGames; games != 0 {
+		t.Errorf("Expected hotseat games kept out of the profile's totals, got %d games", games)
+	}
+	var players []string
+	for _, player := range model.StatsManager.StatsByPlayer() {
+		players = append(players, fmt.Sprintf("%s %d", player.Name, player.Games))
+	}
+	if strings.Join(players, ",") != "Ann 2,Ben 1" {
+		t.Errorf("Expected each game recorded for its player, got %v", players)
+	}
+
+	// The scoreboard follows each game until the next player starts
+	finishGame(t, model, 0, true)
+	model.recordResult()
+	model.recordResult()
+	model.ShowResult = true
+	view := model.View()
+	for _, want := range []string{"HEAD TO HEAD", "Next up: Ann", "Ann's turn"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Expected %q after the game, got:\n%s", want, view)
+		}
+	}
+	if model.Hotseat.Players[1].Games != 2 {
+		t.Errorf("Expected the game counted once, got %d", model.Hotseat.Players[1].Games)
+	}
+
+	model.CurrentView = StatsView
+	if !strings.Contains(model.View(), "HOTSEAT PLAYERS") {
+		t.Error("Expected the players in the statistics")
+	}
+}
@@ -0,0 +1,40 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/stats/analysis"
+)
+
+// maxInsights is how many strategy tests the INSIGHTS section shows, most
+// played first
+const maxInsights = 4
+
+// renderInsights renders the INSIGHTS section, testing each strategy's win
+// rate against theory
+func (m *Model) renderInsights(gameStats *stats.GameStats) []string {
+	var content []string
+	content = append(content, Spacer(1))
+	content = append(content, Center(StatsHeaderStyle.Render("📈 INSIGHTS"), m.Width, 1))
+
+	tests := analysis.Analyze(gameStats.GameHistory, analysis.DefaultConfidence)
+	surprising := false
+	for _, test := range tests[:min(len(tests), maxInsights)] {
+		var line string
+		switch {
+		case !test.Enough():
+			line = MutedStyle.Render("📊 " + test.Conclusion())
+		case test.Consistent():
+			line = SuccessStyle.Render("✅ " + test.Conclusion())
+		default:
+			line = lipgloss.NewStyle().Foreground(WarningColor).Render("🔍 " + test.Conclusion())
+			surprising = true
+		}
+		content = append(content, Center(line, m.Width, 1))
+	}
+	if surprising {
+		content = append(content, Center(MutedStyle.Render("At 95% confidence, 1 test in 20 misses by chance; keep playing to see."), m.Width, 1))
+	}
+
+	return content
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestInsightsTestWinRates(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.CurrentView = StatsView
+	model.Width = 100
+	model.Height = 60
+
+	record := func(strategy game.PlayerStrategy, won bool) {
+		t.Helper()
+		finalChoice, carPosition := 0, 0
+		if strategy == game.Switch {
+			finalChoice = 1
+		}
+		if !won {
+			carPosition = 1 - finalChoice
+		}
+		err := model.StatsManager.RecordGame(&game.GameResult{
+			Won:            won,
+			Strategy:       strategy,
+			FinalChoice:    finalChoice,
+			CarPosition:    carPosition,
+			HostOpenedDoor: 2,
+			Timestamp:      time.Now(),
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+	for i := range 30 {
+		record(game.Switch, i%3 != 0)
+	}
+	for i := range 6 {
+		record(game.Stay, i < 5)
+	}
+
+	view := plainText(model.View())
+	for _, want := range []string{
+		"📈 INSIGHTS",
+		"Your switch win rate of 66.7% is consistent with 2/3 at 95% confidence",
+		"Your stay win rate can be tested after 4 more games",
+	} {
+		if !strings.Contains(view, want) {
+			t.Errorf("Statistics should contain %q:\n%s", want, view)
+		}
+	}
+
+	for range 14 {
+		record(game.Stay, true)
+	}
+	view = plainText(model.View())
+	if !strings.Contains(view, "Your stay win rate of 95.0% is above 1/3 at 95% confidence") || !strings.Contains(view, "misses by chance") {
+		t.Errorf("A stay rate far above 1/3 should be flagged:\n%s", view)
+	}
+}
@@ -42,6 +42,14 @@ func TestCompleteGameFlow(t *testing.T) {
 	updatedModel, _ = model.Update(keyMsg)
 	model = updatedModel.(*Model)
 
+	if model.Game.Phase != game.HostReveal || model.Game.HostOpenedDoor != -1 {
+		t.Errorf("Expected the host to pause before opening a door, got %v", model.Game.Phase)
+	}
+
+	// Test 3.5: Process the host's pause before opening doors
+	updatedModel, _ = model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+	model = updatedModel.(*Model)
+
 	if model.Game.Phase != game.FinalChoice {
 		t.Errorf("Expected FinalChoice phase after initial choice, got %v", model.Game.Phase)
 	}
@@ -120,6 +128,8 @@ func TestGameFlowWithStay(t *testing.T) {
 	keyMsg = tea.KeyMsg{Type: tea.KeyEnter}
 	updatedModel, _ = model.Update(keyMsg)
 	model = updatedModel.(*Model)
+	updatedModel, _ = model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+	model = updatedModel.(*Model)
 
 	// Stay with original choice
 	keyMsg = tea.KeyMsg{Type: tea.KeyEnter}
@@ -157,6 +167,8 @@ func TestMultipleGames(t *testing.T) {
 		keyMsg = tea.KeyMsg{Type: tea.KeyEnter}
 		updatedModel, _ = model.Update(keyMsg)
 		model = updatedModel.(*Model)
+		updatedModel, _ = model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+		model = updatedModel.(*Model)
 
 		// Make final choice (alternate between switch and stay)
 		if i%2 == 0 {
@@ -348,6 +360,8 @@ func TestDoorNavigationBugFix(t *testing.T) {
 	keyMsg = tea.KeyMsg{Type: tea.KeyEnter}
 	updatedModel, _ = model.Update(keyMsg)
 	model = updatedModel.(*Model)
+	updatedModel, _ = model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+	model = updatedModel.(*Model)
 
 	// Verify we're in final choice phase
 	if model.Game.Phase != game.FinalChoice {
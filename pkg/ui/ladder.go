@@ -0,0 +1,190 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// LadderTopDoors is the door count of the ladder's last rung
+const LadderTopDoors = 10
+
+// LadderRung is one game played on the ladder
+type LadderRung struct {
+	Doors    int
+	Switched bool
+	Won      bool
+}
+
+// LadderSession is a climb of the door-count ladder: it starts at three doors
+// and every game won adds a door, with the host opening all but one goat, so
+// switching wins (N-1)/N of the time. A game lost ends the climb
+type LadderSession struct {
+	Doors int // Doors on the current rung
+	Score int
+	Rungs []LadderRung
+
+	played bool // The current rung's game has been recorded
+}
+
+// newLadderSession starts a climb from the bottom rung
+func newLadderSession() *LadderSession {
+	return &LadderSession{Doors: stats.LadderStartDoors}
+}
+
+// Over reports whether the climb has ended, lost or won at the top rung
+func (l *LadderSession) Over() bool {
+	if !l.played || len(l.Rungs) == 0 {
+		return false
+	}
+	last := l.Rungs[len(l.Rungs)-1]
+	return !last.Won || last.Doors >= LadderTopDoors
+}
+
+// Completed reports whether the climb won every rung up to the top
+func (l *LadderSession) Completed() bool {
+	return l.Over() && l.Rungs[len(l.Rungs)-1].Won
+}
+
+// nextRung climbs a rung once the game has been won, or starts the ladder
+// again once the climb is over
+func (l *LadderSession) nextRung() {
+	if !l.played {
+		return
+	}
+	if l.Over() {
+		*l = *newLadderSession()
+		return
+	}
+	l.Doors++
+	l.played = false
+}
+
+// newGame deals the game for the current rung, with a classic host opening
+// every other door but one
+func (l *LadderSession) newGame() *game.Game {
+	g, err := game.NewGameWithDoors(l.Doors)
+	if err != nil {
+		return game.NewGame()
+	}
+	return g
+}
+
+// startLadder begins a climb of the door-count ladder from three doors
+func (m *Model) startLadder() {
+	m.Ladder = newLadderSession()
+	m.Daily = nil
+	m.Practice = nil
+	m.Worksheet = nil
+	m.Hotseat = nil
+	m.startNewGame()
+	m.CurrentView = GameView
+}
+
+// recordLadderRung adds the finished game to the climb and scores a win
+func (m *Model) recordLadderRung() {
+	l := m.Ladder
+	if l == nil || l.played {
+		return
+	}
+
+	result := m.Game.Result
+	l.Rungs = append(l.Rungs, LadderRung{Doors: l.Doors, Switched: result.Strategy == game.Switch, Won: result.Won})
+	if result.Won {
+		l.Score += stats.LadderPoints(l.Doors)
+	}
+	l.played = true
+}
+
+// ladderOdds describes the odds of each strategy with the given number of doors
+func ladderOdds(doors int) string {
+	return fmt.Sprintf("With %d doors switching wins %d/%d (%.0f%%), staying 1/%d",
+		doors, doors-1, doors, float64(doors-1)/float64(doors)*100, doors)
+}
+
+// renderLadderStatus renders the ladder banner with the rung, the score and
+// the odds of switching on it
+func (m *Model) renderLadderStatus() string {
+	l := m.Ladder
+	if l == nil {
+		return ""
+	}
+
+	status := fmt.Sprintf("🪜 Door Ladder  •  Rung %d/%d: %d doors  •  Score %d",
+		l.Doors-stats.LadderStartDoors+1, LadderTopDoors-stats.LadderStartDoors+1, l.Doors, l.Score)
+	if best := m.StatsManager.Ladder().BestScore; best > 0 {
+		status += fmt.Sprintf("  •  Best %d", best)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		Center(lipgloss.NewStyle().Foreground(AccentColor).Bold(true).Render(status), m.Width, 1),
+		Center(MutedStyle.Render(ladderOdds(l.Doors)), m.Width, 1),
+	)
+}
+
+// renderLadderSummary renders the climb so far after each ladder game
+func (m *Model) renderLadderSummary() string {
+	l := m.Ladder
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(AccentColor).
+		Padding(0, 2)
+
+	var rows []string
+	switch {
+	case l.Completed():
+		rows = append(rows, TitleStyle.Render("LADDER COMPLETE"))
+		rows = append(rows, SubtitleStyle.Render(fmt.Sprintf("You climbed all the way to %d doors, scoring %d", l.Doors, l.Score)))
+	case l.Over():
+		rows = append(rows, TitleStyle.Render("CLIMB OVER"))
+		rows = append(rows, SubtitleStyle.Render(fmt.Sprintf("You reached %d doors and scored %d", l.Doors, l.Score)))
+	default:
+		rows = append(rows, TitleStyle.Render("RUNG CLEARED"))
+		rows = append(rows, SubtitleStyle.Render(fmt.Sprintf("+%d points, next up %d doors", stats.LadderPoints(l.Doors), l.Doors+1)))
+	}
+
+	for _, rung := range l.Rungs {
+		choice := "stayed"
+		if rung.Switched {
+			choice = "switched"
+		}
+		outcome := "goat"
+		if rung.Won {
+			outcome = "car"
+		}
+		line := fmt.Sprintf("%2d doors: %-8s → %-4s  switching wins %.0f%%", rung.Doors, choice, outcome,
+			float64(rung.Doors-1)/float64(rung.Doors)*100)
+		rows = append(rows, StatsLabelStyle.Render(line))
+	}
+
+	if l.Over() {
+		rows = append(rows, MutedStyle.Render("Each door added brings switching closer to a sure win: (N-1)/N"))
+	} else {
+		rows = append(rows, MutedStyle.Render(ladderOdds(l.Doors+1)))
+	}
+
+	return Center(boxStyle.Render(lipgloss.JoinVertical(lipgloss.Center, rows...)), m.Width, 1)
+}
+
+// renderLadderStats renders the door ladder section of the stats view
+func (m *Model) renderLadderStats(gameStats *stats.GameStats) []string {
+	ladder := stats.LadderOf(gameStats.GameHistory)
+	if ladder.Climbs == 0 {
+		return nil
+	}
+
+	var content []string
+	content = append(content, Spacer(1))
+	content = append(content, Center(StatsHeaderStyle.Render("🪜 DOOR LADDER"), m.Width, 1))
+	summary := fmt.Sprintf("%d climbs  •  best score %d", ladder.Climbs, ladder.BestScore)
+	if ladder.TopDoors > 0 {
+		summary += fmt.Sprintf("  •  won with up to %d doors", ladder.TopDoors)
+	}
+	content = append(content, Center(StatsLabelStyle.Render(summary), m.Width, 1))
+	for _, rung := range ladder.Rungs {
+		content = append(content, Center(MutedStyle.Render(doorCountTheoryLine(rung)), m.Width, 1))
+	}
+	return content
+}
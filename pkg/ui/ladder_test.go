@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestLadderClimbs(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.startLadder()
+
+	// Staying on the car wins each rung
+	for doors := 3; doors <= 5; doors++ {
+		if model.Game.NumDoors() != doors {
+			t.Fatalf("Expected rung with %d doors, got %d", doors, model.Game.NumDoors())
+		}
+		finishGame(t, model, model.Game.CarPosition, false)
+		model.recordResult()
+		model.ShowResult = true
+		if doors == 5 && !strings.Contains(model.View(), "RUNG CLEARED") {
+			t.Errorf("Expected the rung cleared after a win, got:\n%s", model.View())
+		}
+		model.startNewGame()
+	}
+	if model.Ladder.Score != 12 || len(model.Ladder.Rungs) != 3 {
+		t.Errorf("Expected 3+4+5 points from 3 rungs, got %d from %d", model.Ladder.Score, len(model.Ladder.Rungs))
+	}
+	if !strings.Contains(model.View(), "With 6 doors switching wins 5/6") {
+		t.Error("Expected the banner to teach the odds of the rung")
+	}
+
+	// Switching away from the car loses, ending the climb
+	finishGame(t, model, model.Game.CarPosition, true)
+	model.recordResult()
+	model.ShowResult = true
+	if !model.Ladder.Over() || !strings.Contains(model.View(), "CLIMB OVER") {
+		t.Fatalf("Expected the climb over after a loss, got:\n%s", model.View())
+	}
+
+	ladder := model.StatsManager.Ladder()
+	if ladder.Climbs != 1 || ladder.BestScore != 12 || ladder.TopDoors != 5 {
+		t.Errorf("Expected the climb recorded, got %+v", ladder)
+	}
+
+	model.startNewGame()
+	if model.Game.NumDoors() != 3 || model.Ladder.Score != 0 {
+		t.Errorf("Expected a new climb from 3 doors, got %d doors and score %d", model.Game.NumDoors(), model.Ladder.Score)
+	}
+
+	model.CurrentView = StatsView
+	if !strings.Contains(model.View(), "DOOR LADDER") {
+		t.Error("Expected the ladder in the statistics")
+	}
+}
+
+func TestLadderTopRung(t *testing.T) {
+	ladder := &LadderSession{Doors: LadderTopDoors, Rungs: []LadderRung{{Doors: LadderTopDoors, Won: true}}, played: true}
+	if !ladder.Completed() {
+		t.Fatal("Expected winning the top rung to complete the ladder")
+	}
+	ladder.nextRung()
+	if ladder.Doors != stats.LadderStartDoors || len(ladder.Rungs) != 0 {
+		t.Errorf("Expected the ladder to start again after the top rung, got %+v", ladder)
+	}
+}
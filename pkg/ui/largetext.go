@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+)
+
+// largeFooterKeys is how many key bindings a large-text footer shows
+const largeFooterKeys = 3
+
+// bigDigits letters door numbers three rows tall in large-text mode
+var bigDigits = map[rune][3]string{
+	'0': {"█▀█", "█ █", "█▄█"},
+	'1': {"▀█ ", " █ ", "▄█▄"},
+	'2': {"▀▀█", "█▀▀", "█▄▄"},
+	'3': {"▀▀█", " ▀█", "▄▄█"},
+	'4': {"█ █", "▀▀█", "  █"},
+	'5': {"█▀▀", "▀▀█", "▄▄█"},
+	'6': {"█▀▀", "█▀█", "█▄█"},
+	'7': {"▀▀█", "  █", "  █"},
+	'8': {"█▀█", "█▀█", "█▄█"},
+	'9': {"█▀█", "▀▀█", "▄▄█"},
+}
+
+// bigNumber letters a number in bigDigits
+func bigNumber(n int) []string {
+	lines := make([]string, 3)
+	for i, digit := range strconv.Itoa(n) {
+		for row := range lines {
+			if i > 0 {
+				lines[row] += " "
+			}
+			lines[row] += bigDigits[digit][row]
+		}
+	}
+	return lines
+}
+
+// renderLargeDoor draws a door twice as tall as usual, with its number in
+// block digits. body is the art shown behind the door, up to five lines, and
+// label names what is behind it
+func (d *DoorComponent) renderLargeDoor(body []string, label string) string {
+	inner := d.Width - 2
+	blank := d.centerText("", inner)
+
+	lines := []string{"┌" + strings.Repeat("─", inner) + "┐"}
+	add := func(text string) {
+		lines = append(lines, "│"+d.centerText(text, inner)+"│")
+	}
+
+	add("")
+	add("DOOR")
+	add("")
+	for _, line := range bigNumber(d.Number) {
+		add(line)
+	}
+	add("")
+	for i := range 5 {
+		if i < len(body) {
+			// Body lines are already fitted to the door
+			lines = append(lines, "│"+body[i]+"│")
+		} else {
+			lines = append(lines, "│"+blank+"│")
+		}
+	}
+	add("")
+	add(label)
+	add("")
+	lines = append(lines, "└"+strings.Repeat("─", inner)+"┘")
+
+	return strings.Join(lines, "\n")
+}
+
+// shortenFooter keeps the first key bindings and the last, which is usually
+// the way back, so a large-text footer fits on one line
+func shortenFooter(bindings []KeyBinding) []KeyBinding {
+	if len(bindings) <= largeFooterKeys {
+		return bindings
+	}
+	shortened := append([]KeyBinding{}, bindings[:largeFooterKeys-1]...)
+	return append(shortened, bindings[len(bindings)-1])
+}
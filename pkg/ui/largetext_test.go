@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestLargeText(t *testing.T) {
+	t.Cleanup(func() { LargeText = false })
+	door := NewResponsiveDoorComponent(7, game.NewDoor(6, 6, game.Goat), false, false, 100)
+	bindings := []KeyBinding{{"←→", "Choose"}, {"Enter", "Select"}, {"s", "Statistics"}, {"q", "Main menu"}}
+	normalDoor, normalBanner := door.Render(), CreateASCIIBanner(90)
+
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	cfg := configManager.Get().Clone()
+	cfg.UI.LargeText = true
+	if err := configManager.Update(cfg); err != nil {
+		t.Fatal(err)
+	}
+	newModelWithStats(configManager, stats.NewReadOnlyStatsManager())
+	if !LargeText {
+		t.Fatal("Expected ui.large_text to turn on large text")
+	}
+
+	large := door.Render()
+	// The inside of the door, without its frames and status line, doubles
+	if inside := func(s string) int { return lipgloss.Height(s) - 5 }; inside(large) < 2*inside(normalDoor) ||
+		lipgloss.Width(large) != lipgloss.Width(normalDoor) {
+		t.Errorf("Expected a door twice as tall and as wide, got %dx%d from %dx%d", lipgloss.Width(large),
+			lipgloss.Height(large), lipgloss.Width(normalDoor), lipgloss.Height(normalDoor))
+	}
+	if !strings.Contains(large, "▀▀█") || !strings.Contains(large, "CLOSED") {
+		t.Errorf("Expected a block-digit door number and the closed label, got:\n%s", large)
+	}
+	for _, content := range []game.DoorContent{game.Car, game.Goat} {
+		opened := game.NewDoor(9, 9, content)
+		opened.State = game.Opened
+		if height := lipgloss.Height(NewResponsiveDoorComponent(10, opened, false, false, 100).Render()); height != lipgloss.Height(large) {
+			t.Errorf("Expected every door to be %d rows tall, got %d", lipgloss.Height(large), height)
+		}
+	}
+
+	if banner := CreateASCIIBanner(90); lipgloss.Width(banner) <= lipgloss.Width(normalBanner) {
+		t.Error("Expected the biggest banner that fits")
+	}
+	footer := plainText(RenderFooter(bindings))
+	if strings.Contains(footer, "Statistics") || !strings.Contains(footer, "Main menu") {
+		t.Errorf("Expected the footer to keep the first keys and the way back, got %q", footer)
+	}
+}
+
+func TestBigNumber(t *testing.T) {
+	lines := bigNumber(10)
+	if len(lines) != 3 || lines[0] != "▀█  █▀█" {
+		t.Errorf("Unexpected block digits for 10: %q", lines)
+	}
+}
@@ -0,0 +1,28 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// TestMain runs the tests in a temporary home, so models made with NewModel
+// keep their statistics, saved game and other state files out of the
+// developer's ~/.monty-hall and config directory
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "monty-hall-ui-test")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to create a test home: %v\n", err)
+		os.Exit(1)
+	}
+	os.Setenv("HOME", home)
+	os.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+	os.Unsetenv(stats.DataDirEnvVar)
+
+	code := m.Run()
+	os.RemoveAll(home)
+	os.Exit(code)
+}
@@ -2,13 +2,17 @@ package ui
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/chaos"
 	"github.com/westhuis/monty-hall/pkg/config"
 	"github.com/westhuis/monty-hall/pkg/game"
 	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/transcript"
 )
 
 // NewModel creates a new TUI model
@@ -30,19 +34,61 @@ func NewModel() *Model {
 		GamePhase:             game.Setup,
 		ShowResult:            false,
 		StatsPage:             0,
-		MaxStatsPages:         1,
+		MaxStatsPages:         statsPageCount,
 		AnimationManager:      NewAnimationManager(),
 		DoorAnimations:        make(map[int]*DoorOpenAnimation),
 		ShowAnimations:        true,
+		AnimationSpeed:        SpeedNormal,
+		AnimationPacks:        BuiltinAnimationPacks(),
+		RememberDoor:          true,
+		NumDoors:              game.NumDoors,
 		IsRevealing:           false,
 		ShowResetConfirmation: false,
 		CurrentInputIndex:     0,
+		Achievements:          newAchievementTracker(statsManager),
+		Events:                newEventLog(statsManager),
+		Transcript:            transcript.New(now()),
+		UIState:               newUIState(statsManager),
 	}
 }
 
 // NewModelWithConfig creates a new TUI model with configuration support
 func NewModelWithConfig(configManager *config.Manager) *Model {
-	statsManager := stats.NewStatsManager()
+	if configManager.Get().Stats.NoPersist {
+		return NewNoPersistModel(configManager)
+	}
+
+	store, err := configManager.OpenStatsStore()
+	if err != nil {
+		m := newModelWithStats(configManager, stats.NewStatsManager())
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "open stats store"))
+		return m
+	}
+	m := newModelWithStats(configManager, stats.NewStatsManagerWithStore(store))
+	m.setupProfiles()
+	return m
+}
+
+// NewSafeModeModel creates a model for troubleshooting: animations off, ASCII-only
+// output and statistics that are never written to disk
+func NewSafeModeModel(configManager *config.Manager) *Model {
+	m := newModelWithStats(configManager, stats.NewReadOnlyStatsManager())
+	m.SafeMode = true
+	m.ASCIIOnly = true
+	m.ShowAnimations = false
+	return m
+}
+
+// NewNoPersistModel creates a model for public kiosks: games play normally,
+// but statistics start empty and nothing is saved, exported or reset
+func NewNoPersistModel(configManager *config.Manager) *Model {
+	m := newModelWithStats(configManager, stats.NewSessionStatsManager())
+	m.NoPersist = true
+	return m
+}
+
+// newModelWithStats creates a configured model using the given stats manager
+func newModelWithStats(configManager *config.Manager, statsManager *stats.StatsManager) *Model {
 	cfg := configManager.Get()
 
 	// Apply configuration settings
@@ -55,7 +101,7 @@ func NewModelWithConfig(configManager *config.Manager) *Model {
 		height = cfg.UI.TerminalHeight
 	}
 
-	return &Model{
+	m := &Model{
 		CurrentView:           MainMenuView,
 		Width:                 width,
 		Height:                height,
@@ -70,23 +116,85 @@ func NewModelWithConfig(configManager *config.Manager) *Model {
 		GamePhase:             game.Setup,
 		ShowResult:            false,
 		StatsPage:             0,
-		MaxStatsPages:         1,
+		MaxStatsPages:         statsPageCount,
 		AnimationManager:      NewAnimationManager(),
 		DoorAnimations:        make(map[int]*DoorOpenAnimation),
-		ShowAnimations:        cfg.UI.ShowAnimations && !cfg.UI.ReducedMotion,
 		IsRevealing:           false,
 		ShowResetConfirmation: false,
 		CurrentInputIndex:     0,
+		Achievements:          newAchievementTracker(statsManager),
+		Events:                newEventLog(statsManager),
+		Transcript:            transcript.New(now()),
+		UIState:               newUIState(statsManager),
 	}
+	statsManager.AddRecordHook(m.countAutoExportGame)
+	statsManager.AddRecordHook(m.queueClassResult)
+	m.applySettings(cfg)
+	m.loadAnimationPacks()
+	m.loadResumableGame()
+	m.announceStatsRecovery()
+	if err := configManager.ThemesError(); err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "load themes"))
+	}
+	return m
 }
 
 // Init initializes the model
 func (m *Model) Init() tea.Cmd {
-	return nil
+	return tea.Batch(m.watchThemes(), m.updateWindowTitle())
 }
 
 // Update handles messages and updates the model
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	shown := m.ErrorMessage
+	model, cmd := m.update(msg)
+
+	// Chaos mode summarizes how the injected failures surfaced
+	if chaos.Enabled() && m.ErrorMessage != "" && m.ErrorMessage != shown {
+		chaos.ObserveError(strings.Contains(m.ErrorMessage, "Suggestions:"))
+	}
+
+	// Achievements can be unlocked by any recorded game, so they are
+	// announced here rather than by each view
+	m.announceAchievements()
+	if export := m.autoExport(); export != nil {
+		cmd = tea.Batch(cmd, export)
+	}
+	if report := m.reportClassResults(); report != nil {
+		cmd = tea.Batch(cmd, report)
+	}
+	m.logMessages()
+	m.recordTranscript()
+	m.saveSession()
+	if toast := m.scheduleToast(); toast != nil {
+		cmd = tea.Batch(cmd, toast)
+	}
+	if advance := m.scheduleAutoAdvance(); advance != nil {
+		cmd = tea.Batch(cmd, advance)
+	}
+	m.markViewsSeen()
+	m.syncGameClock()
+	if title := m.updateWindowTitle(); title != nil {
+		cmd = tea.Batch(cmd, title)
+	}
+	return model, cmd
+}
+
+// syncGameClock stops the game's clock while the game is out of sight, behind
+// help or in another view, so the recorded duration is time spent playing
+func (m *Model) syncGameClock() {
+	if m.Game == nil || m.Game.IsGameOver() {
+		return
+	}
+	if m.CurrentView == GameView && !m.ShowHelp {
+		m.Game.Resume()
+	} else {
+		m.Game.Pause()
+	}
+}
+
+// update dispatches a message to its handler
+func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.Width = msg.Width
@@ -100,6 +208,9 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeyPress(msg)
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case GameUpdateMsg:
 		m.Game = msg.Game
 		if m.Game != nil {
@@ -120,19 +231,60 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case AnimationTickMsg:
 		// Update animations
-		return m, m.AnimationManager.Update()
+		cmd := m.AnimationManager.HandleTick()
+		m.pruneDoorAnimations()
+		m.advanceCelebration()
+		return m, cmd
+
+	case AutoPlayTickMsg:
+		return m.handleAutoPlayTick(msg)
+	case AutoPlayRunMsg:
+		return m.handleAutoPlayRun(msg)
+
+	case ToastExpiredMsg:
+		m.expireToast()
+		return m, nil
 
-	case RevealDelayMsg:
-		// End the revealing state and show results
-		m.IsRevealing = false
-		m.ShowResult = true
+	case DefaultStrategyMsg:
+		return m, m.applyDefaultStrategy(msg)
 
-		// Record the game result
-		if m.Game.Result != nil {
-			if err := m.StatsManager.RecordGame(m.Game.Result); err != nil {
-				m.ErrorMessage = fmt.Sprintf("Failed to save statistics: %v", err)
-			}
+	case AutoAdvanceTickMsg:
+		m.handleAutoAdvanceTick(msg)
+		return m, nil
+
+	case AutoExportMsg:
+		m.finishAutoExport(msg)
+		return m, nil
+
+	case ClassroomReportMsg:
+		m.finishClassReport(msg)
+		return m, nil
+
+	case ThemeCheckMsg:
+		return m, m.reloadThemes()
+
+	case ConfigSaveMsg:
+		// Only the latest change in a burst triggers a save
+		if msg.Seq == m.ConfigSaveSeq {
+			m.flushConfig()
 		}
+		return m, nil
+
+	case HostRevealMsg:
+		// Ignore pauses for a pick that was taken back or a game left behind
+		if msg.Seq != m.HostRevealSeq || m.Game == nil || m.Game.Phase != game.HostReveal {
+			return m, nil
+		}
+		return m, m.finishHostReveal()
+
+	case RevealDelayMsg:
+		// Ignore stale timers from a reveal that was already finished
+		if !m.IsRevealing {
+			return m, nil
+		}
+
+		// End the revealing state and show results
+		m.finishReveal()
 
 		// Start winning animation if player won
 		if m.ShowAnimations && m.Game.Result != nil && m.Game.Result.Won {
@@ -151,11 +303,57 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	m.ErrorMessage = ""
 	m.SuccessMessage = ""
 
+	// Any key cancels a pending auto-advance, and does nothing else
+	if !m.AutoAdvanceAt.IsZero() && msg.String() != "ctrl+c" {
+		m.cancelAutoAdvance()
+		return m, nil
+	}
+
 	// Handle reset confirmation input first (highest priority)
 	if m.ShowResetConfirmation {
 		return m.handleResetConfirmationKeys(msg)
 	}
 
+	// An open dialog captures all input until it is dismissed
+	if m.Dialog != nil {
+		return m.handleDialogKeys(msg)
+	}
+
+	// The play-again options capture input while open
+	if m.CurrentView == GameView && m.NewGamePrompt != nil {
+		return m.handleNewGamePromptKeys(msg)
+	}
+
+	// The goal editor captures navigation keys while open
+	if m.CurrentView == GoalsView && m.GoalDraft != nil {
+		return m.handleGoalDraftKeys(msg)
+	}
+
+	// The export dialog captures typing while open
+	if m.CurrentView == StatsView && m.ExportDraft != nil {
+		return m.handleExportDraftKeys(msg)
+	}
+
+	// The custom time range editor captures typing while open
+	if m.CurrentView == StatsView && m.RangeDraft != nil {
+		return m.handleRangeDraftKeys(msg)
+	}
+
+	// The glossary search box captures typing while open
+	if m.CurrentView == GlossaryView && m.Glossary != nil && m.Glossary.Searching {
+		return m.handleGlossarySearchKeys(msg)
+	}
+
+	// The hotseat player names capture typing while they are entered
+	if m.CurrentView == HotseatView && m.HotseatSetup != nil {
+		return m.handleHotseatSetupKeys(msg)
+	}
+
+	// A new player profile's name captures typing while it is entered
+	if m.CurrentView == ProfilesView && m.ProfilePicker != nil && m.ProfilePicker.Naming {
+		return m.handleProfileNameKeys(msg)
+	}
+
 	// Global key bindings
 	switch msg.String() {
 	case "ctrl+c":
@@ -172,8 +370,7 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, tea.Quit
 		} else {
 			// Return to main menu from other screens
-			m.CurrentView = MainMenuView
-			m.MenuCursor = 0
+			m.returnToMainMenu()
 			return m, nil
 		}
 
@@ -187,8 +384,20 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		if m.CurrentView != MainMenuView {
-			m.CurrentView = MainMenuView
-			m.MenuCursor = 0
+			m.returnToMainMenu()
+			return m, nil
+		}
+
+	case KeyOpenFolder:
+		if m.RevealPath != "" {
+			m.openRevealFolder()
+			return m, nil
+		}
+
+	case "?":
+		// Jump to the glossary entry explaining the current view
+		if topic, ok := m.glossaryTopic(); ok {
+			m.openGlossary(topic.slug)
 			return m, nil
 		}
 	}
@@ -201,11 +410,148 @@ func (m *Model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleGameKeys(msg)
 	case StatsView:
 		return m.handleStatsKeys(msg)
+	case GoalsView:
+		return m.handleGoalsKeys(msg)
+	case WhatIfView:
+		return m.handleWhatIfKeys(msg)
+	case SettingsView:
+		return m.handleSettingsKeys(msg)
+	case TutorialView:
+		return m.handleTutorialKeys(msg)
+	case HostModeView:
+		return m.handleHostModeKeys(msg)
+	case SimulationView:
+		return m.handleAutoPlayKeys(msg)
+	case GlossaryView:
+		return m.handleGlossaryKeys(msg)
+	case WorksheetView:
+		return m.handleWorksheetKeys(msg)
+	case ThemeGalleryView:
+		return m.handleThemeGalleryKeys(msg)
+	case EventLogView:
+		return m.handleEventLogKeys(msg)
+	case TranscriptView:
+		return m.handleTranscriptKeys(msg)
+	case ProfilesView:
+		return m.handleProfilesKeys(msg)
+	case EducationView:
+		return m.handleEducationKeys(msg)
 	}
 
 	return m, nil
 }
 
+// returnToMainMenu leaves the current view, saving any pending settings changes
+func (m *Model) returnToMainMenu() {
+	m.flushConfig()
+	m.stopAnimations()
+	m.CurrentView = MainMenuView
+	m.MenuCursor = 0
+}
+
+// menuOptions returns the main menu entries in display order
+func (m *Model) menuOptions() []MenuOption {
+	options := []MenuOption{
+		{Label: "Play Game", Description: "Start a new game", Action: func() tea.Cmd {
+			m.Daily = nil
+			m.Practice = nil
+			m.Worksheet = nil
+			m.Hotseat = nil
+			m.Ladder = nil
+			m.startNewGame()
+			m.CurrentView = GameView
+			return nil
+		}},
+		{Label: "View Statistics", Badge: m.hasNewStatistics(), Description: "See how your strategies perform", Action: func() tea.Cmd {
+			m.CurrentView = StatsView
+			m.StatsPage = 0
+			m.StatsScroll = 0
+			return nil
+		}},
+		{Label: "Daily Challenge", Description: "Play today's shared games", Action: func() tea.Cmd {
+			m.startDailyChallenge()
+			return nil
+		}},
+		{Label: "Practice", Description: "Train your choices after losing streaks", Action: func() tea.Cmd {
+			m.startPractice()
+			return nil
+		}},
+		{Label: "Run Simulation", Description: "Watch games play themselves as the odds converge", Action: func() tea.Cmd {
+			return m.startAutoPlay()
+		}},
+		{Label: "Tutorial", Description: "Play a guided game that explains the odds", Action: func() tea.Cmd {
+			m.startTutorial()
+			return nil
+		}},
+		{Label: "Play as Host", Description: "Be Monty: open a goat door for a computer contestant", Action: func() tea.Cmd {
+			m.startHostMode()
+			return nil
+		}},
+		{Label: "Worksheet", Description: "Work through a classroom experiment and export the results", Action: func() tea.Cmd {
+			m.openWorksheet()
+			return nil
+		}},
+		{Label: "Hotseat", Description: "Two players take turns on this terminal and keep score", Action: func() tea.Cmd {
+			m.openHotseat()
+			return nil
+		}},
+		{Label: "Door Ladder", Description: "Climb from 3 doors up, one more door for every car won", Action: func() tea.Cmd {
+			m.startLadder()
+			return nil
+		}},
+		{Label: "Glossary", Description: "Look up the probability terms behind the game", Action: func() tea.Cmd {
+			m.openGlossary("")
+			return nil
+		}},
+		{Label: "Goals", Badge: m.hasNewGoals(), Description: "Set and track personal goals", Action: func() tea.Cmd {
+			m.CurrentView = GoalsView
+			m.GoalCursor = 0
+			m.GoalDraft = nil
+			return nil
+		}},
+		{Label: "Event Log", Description: "Review recent exports, errors and achievements", Action: func() tea.Cmd {
+			m.openEventLog()
+			return nil
+		}},
+		{Label: "Transcript", Description: "Review and save this session's games, choices and timings", Action: func() tea.Cmd {
+			m.openTranscript()
+			return nil
+		}},
+		{Label: "Profiles", Description: "Switch players, so each keeps their own statistics", Action: func() tea.Cmd {
+			m.openProfiles()
+			return nil
+		}},
+		{Label: "Settings", Description: "Adjust game, display and statistics options", Action: func() tea.Cmd {
+			m.openSettings()
+			return nil
+		}},
+		{Label: "Help", Badge: m.hasNewHelp(), Description: "Learn the rules and controls", Action: func() tea.Cmd {
+			m.ShowHelp = true
+			return nil
+		}},
+		{Label: "Quit", Description: "Exit the application", Action: func() tea.Cmd {
+			return tea.Quit
+		}},
+	}
+
+	// A game left unfinished when the app last exited comes first
+	if m.Resumable != nil {
+		options = slices.Insert(options, 0, MenuOption{Label: "Resume Last Game", Description: "Continue the game left unfinished when the app closed", Action: func() tea.Cmd {
+			m.resumeGame()
+			return nil
+		}})
+	}
+
+	// Kiosk visitors shouldn't change the settings, nor switch config profiles
+	if m.NoPersist {
+		options = slices.DeleteFunc(options, func(option MenuOption) bool { return option.Label == "Settings" })
+	}
+	if !m.profilesAvailable() {
+		options = slices.DeleteFunc(options, func(option MenuOption) bool { return option.Label == "Profiles" })
+	}
+	return options
+}
+
 // handleMainMenuKeys processes main menu navigation
 func (m *Model) handleMainMenuKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
@@ -215,13 +561,17 @@ func (m *Model) handleMainMenuKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 
 	case KeyDown, "j":
-		maxOptions := 4 // Play, Stats, Help, Exit
-		if m.MenuCursor < maxOptions-1 {
+		if m.MenuCursor < len(m.menuOptions())-1 {
 			m.MenuCursor++
 		}
 
 	case KeyEnter, KeySpace:
 		return m.executeMenuAction()
+
+	case KeyDemoData:
+		if !m.NoPersist {
+			m.confirmDemoData()
+		}
 	}
 
 	return m, nil
@@ -229,28 +579,132 @@ func (m *Model) handleMainMenuKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 // executeMenuAction performs the selected menu action
 func (m *Model) executeMenuAction() (tea.Model, tea.Cmd) {
-	switch m.MenuCursor {
-	case 0: // Play Game
-		m.Game = game.NewGame()
-		m.CurrentView = GameView
-		m.DoorCursor = 0
-		m.ShowResult = false
+	options := m.menuOptions()
+	if m.MenuCursor < 0 || m.MenuCursor >= len(options) {
 		return m, nil
+	}
 
-	case 1: // View Statistics
-		m.CurrentView = StatsView
-		m.StatsPage = 0
-		return m, nil
+	return m, options[m.MenuCursor].Action()
+}
 
-	case 2: // Help
-		m.ShowHelp = true
-		return m, nil
+// startNewGame begins a fresh game, first finishing any reveal still in progress
+func (m *Model) startNewGame() {
+	if m.IsRevealing {
+		m.finishReveal()
+	}
+	m.stopAnimations()
 
-	case 3: // Exit
-		return m, tea.Quit
+	previous := m.Game
+	if previous != nil && previous.Result != nil {
+		m.LastGame = previous
 	}
+	m.Rematch = false
 
-	return m, nil
+	// A finished practice session gives way to regular games
+	if m.Practice != nil && m.Practice.IsComplete() {
+		m.Practice = nil
+	}
+
+	// Hotseat players take turns, one game each
+	if m.Hotseat != nil {
+		m.Hotseat.nextTurn()
+	}
+
+	// Continue the daily challenge until all of its rounds are played
+	if m.Daily != nil && !m.Daily.IsComplete() {
+		m.Game = m.Daily.NextGame()
+	} else if m.Ladder != nil {
+		// Each rung of the ladder has its own number of doors
+		m.Daily = nil
+		m.Ladder.nextRung()
+		m.Game = m.Ladder.newGame()
+	} else if m.worksheetPlaying() {
+		// Worksheet experiments always use the classic three-door game
+		m.Daily = nil
+		m.Game = game.NewGame()
+	} else if m.NewGameOptions.SameDoors && m.LastGame != nil {
+		m.Daily = nil
+		m.Game = m.LastGame.Rematch()
+		m.Rematch = true
+	} else {
+		m.Daily = nil
+		m.Game = m.newGame()
+	}
+	m.NewGameOptions.SameDoors = false // Replays apply to one game only
+
+	m.DoorCursor = 0
+	if m.RememberDoor && m.LastGame != nil && m.LastGame.PlayerInitialChoice < m.Game.NumDoors() {
+		m.DoorCursor = m.LastGame.PlayerInitialChoice
+	}
+	m.ShowResult = false
+	m.pickHostLines()
+}
+
+// SeedGames plays regular games from a fixed seed, for reproducible demos:
+// the first game uses the seed and each game after it the next one, so every
+// game can be replayed on its own from the seed it records
+func (m *Model) SeedGames(seed int64) {
+	m.GameSeed = &seed
+}
+
+// newGame creates a regular game with the configured number of doors and host
+func (m *Model) newGame() *game.Game {
+	var g *game.Game
+	var err error
+	if m.GameSeed != nil {
+		g, err = game.NewSeededGameWithDoors(*m.GameSeed, m.NumDoors)
+		*m.GameSeed++
+	} else {
+		g, err = game.NewGameWithDoors(m.NumDoors)
+	}
+	if err != nil {
+		g = game.NewGame()
+	}
+	g.Host.Behavior = m.HostBehavior
+	if game.ValidateHostOpens(g.NumDoors(), m.HostOpens) == nil {
+		g.Host.Opens = m.HostOpens
+	}
+	return g
+}
+
+// finishReveal ends the dramatic reveal and shows the result
+func (m *Model) finishReveal() {
+	m.IsRevealing = false
+	m.ShowResult = true
+	m.Narrative, m.NarrativeFor = m.pickNarrative(), m.Game
+	m.startAutoAdvance()
+
+	// Goal notifications wait for the reveal so they don't spoil the outcome
+	m.announceCompletedGoals()
+}
+
+// recordResult saves the finished game to statistics
+func (m *Model) recordResult() {
+	if m.Game == nil || m.Game.Result == nil {
+		return
+	}
+
+	// A rematch is played knowing where the car is, so it is only practice
+	record := m.StatsManager.RecordGame
+	if m.Practice != nil || m.Rematch {
+		record = m.StatsManager.RecordPracticeGame
+	} else if m.Ladder != nil {
+		record = m.StatsManager.RecordLadderGame
+	} else if m.Hotseat != nil {
+		player := m.Hotseat.Current().Name
+		record = func(result *game.GameResult) error {
+			return m.StatsManager.RecordPlayerGame(result, player)
+		}
+	}
+	if err := record(m.Game.Result); err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "save statistics"))
+	}
+	m.recordDailyRound()
+	m.recordPracticeRound()
+	m.recordWorksheetGame()
+	m.recordHotseatGame()
+	m.recordLadderRung()
+	m.recordOnboardingGame()
 }
 
 // handleGameKeys processes game view input with door selection restrictions
@@ -266,27 +720,27 @@ func (m *Model) handleGameKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case KeyRight, "l":
 		m.moveCursorRight()
 
-	case Key1:
-		if m.isDoorSelectable(0) {
-			m.DoorCursor = 0
-		}
+	case KeyUp, "k":
+		m.moveCursorRows(-1)
 
-	case Key2:
-		if m.isDoorSelectable(1) {
-			m.DoorCursor = 1
-		}
+	case KeyDown, "j":
+		m.moveCursorRows(1)
 
-	case Key3:
-		if m.isDoorSelectable(2) {
-			m.DoorCursor = 2
+	case Key1, Key2, Key3, "4", "5", "6", "7", "8", Key9:
+		door := int(msg.String()[0] - '1')
+		if m.isDoorSelectable(door) {
+			m.DoorCursor = door
 		}
 
 	case KeyEnter, KeySpace:
 		if m.Game.IsGameOver() {
+			// A worksheet continues in its view once its games are played
+			if m.Worksheet != nil && !m.worksheetPlaying() {
+				m.CurrentView = WorksheetView
+				return m, nil
+			}
 			// Play again
-			m.Game = game.NewGame()
-			m.DoorCursor = 0
-			m.ShowResult = false
+			m.startNewGame()
 			return m, nil
 		}
 		return m.selectDoor()
@@ -296,17 +750,36 @@ func (m *Model) handleGameKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m.switchChoice()
 		} else {
 			// View statistics (available in all phases except FinalChoice)
+			m.stopAnimations()
 			m.CurrentView = StatsView
 			return m, nil
 		}
 
 	case KeyR:
 		if m.Game.IsGameOver() {
-			m.Game = game.NewGame()
-			m.DoorCursor = 0
-			m.ShowResult = false
+			m.openNewGamePrompt()
 			return m, nil
 		}
+
+	case KeyI:
+		if !m.NoPersist {
+			return m.saveResultImage()
+		}
+
+	case KeyBayes:
+		m.openEducation()
+
+	case KeyProbability:
+		return m, m.toggleProbabilityPanel()
+
+	case KeyDismissHint, KeyDisableHints:
+		return m, m.handleHintKeys(msg.String())
+
+	case KeyAbort:
+		return m.confirmAbort()
+
+	case KeyUndo:
+		return m.undoInitialChoice()
 	}
 
 	return m, nil
@@ -324,26 +797,36 @@ func (m *Model) selectDoor() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	door := m.DoorCursor
+	title := fmt.Sprintf("Lock in door %d?", door+1)
+	if m.Game.Phase == game.FinalChoice && door == m.Game.PlayerInitialChoice {
+		title = fmt.Sprintf("Stay with door %d?", door+1)
+	}
+	return m.confirmChoice(title, func() tea.Cmd { return m.chooseDoor(door) })
+}
+
+// chooseDoor makes door the player's choice for the current phase
+func (m *Model) chooseDoor(door int) tea.Cmd {
 	switch m.Game.Phase {
 	case game.InitialChoice:
-		err := m.Game.MakeInitialChoice(m.DoorCursor)
+		err := m.Game.MakeInitialChoiceForHost(door)
 		if err != nil {
 			m.ErrorMessage = err.Error()
+		} else {
+			return m.startHostReveal()
 		}
-		return m, nil
 
 	case game.FinalChoice:
-		err := m.Game.MakeFinalChoice(m.DoorCursor)
+		err := m.Game.MakeFinalChoice(door)
 		if err != nil {
 			m.ErrorMessage = err.Error()
 		} else {
 			// Start dramatic reveal delay before showing results
-			return m, m.startRevealDelay()
+			return m.startRevealDelay()
 		}
-		return m, nil
 	}
 
-	return m, nil
+	return nil
 }
 
 // switchChoice handles the switch action
@@ -352,14 +835,41 @@ func (m *Model) switchChoice() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	err := m.Game.SwitchChoice()
-	if err != nil {
-		m.ErrorMessage = err.Error()
-	} else {
+	var others []int
+	for i, door := range m.Game.Doors {
+		if !door.IsOpen() && i != m.Game.PlayerInitialChoice {
+			others = append(others, i)
+		}
+	}
+	title := "Switch to another door?"
+	if len(others) == 1 {
+		title = fmt.Sprintf("Switch to door %d?", others[0]+1)
+	}
+
+	return m.confirmChoice(title, func() tea.Cmd {
+		err := m.Game.SwitchChoice()
+		if err != nil {
+			m.ErrorMessage = err.Error()
+			return nil
+		}
 		// Start dramatic reveal delay before showing results
-		return m, m.startRevealDelay()
+		return m.startRevealDelay()
+	})
+}
+
+// confirmChoice makes a choice straight away, or in confirm-choices mode asks
+// the player to lock it in first, so a stray Enter doesn't commit a door. The
+// prompt starts on Back, the safe choice
+func (m *Model) confirmChoice(title string, choose func() tea.Cmd) (tea.Model, tea.Cmd) {
+	if !m.ConfirmChoices {
+		return m, choose()
 	}
 
+	dialog := NewConfirmDialog(title, nil, choose)
+	dialog.ConfirmLabel = "Lock in"
+	dialog.CancelLabel = "Back"
+	dialog.Width = 44
+	m.Dialog = dialog
 	return m, nil
 }
 
@@ -370,28 +880,40 @@ func (m *Model) handleStatsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case KeyLeft, "h":
 		if m.StatsPage > 0 {
 			m.StatsPage--
+			m.StatsScroll = 0
 		}
 
 	case KeyRight, "l":
 		if m.StatsPage < m.MaxStatsPages-1 {
 			m.StatsPage++
+			m.StatsScroll = 0
 		}
 
 	case KeyEnter, KeySpace:
 		// Start a new game
-		m.Game = game.NewGame()
+		m.startNewGame()
 		m.CurrentView = GameView
-		m.DoorCursor = 0
-		m.ShowResult = false
 		return m, nil
 
 	case KeyR:
 		// Reset statistics with confirmation
-		return m.confirmResetStats()
+		if !m.NoPersist {
+			return m.confirmResetStats()
+		}
 
 	case KeyE:
 		// Export statistics
-		return m.exportStats()
+		if !m.NoPersist {
+			return m.exportStats()
+		}
+
+	case "f":
+		m.cycleStatsRange()
+		return m, nil
+
+	case KeyW:
+		m.openWhatIf()
+		return m, nil
 
 	case KeyQ:
 		// Return to main menu (same as ESC)
@@ -414,18 +936,25 @@ func (m *Model) confirmResetStats() (tea.Model, tea.Cmd) {
 	m.UserInputNumbers = [4]int{0, 0, 0, 0}
 	m.CurrentInputIndex = 0
 
-	// Show the confirmation dialog
+	// Show the confirmation dialog, starting with what to reset
 	m.ShowResetConfirmation = true
+	m.ChoosingResetScope = true
+	m.ResetScope = stats.ResetAll
 
 	return m, nil
 }
 
 // handleResetConfirmationKeys processes input during reset confirmation
 func (m *Model) handleResetConfirmationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.ChoosingResetScope {
+		return m.handleResetScopeKeys(msg)
+	}
+
 	switch msg.String() {
 	case KeyEscape:
 		// Cancel reset confirmation
 		m.ShowResetConfirmation = false
+		m.ResetScope = stats.ResetAll
 		m.CurrentInputIndex = 0
 		m.UserInputNumbers = [4]int{0, 0, 0, 0}
 		return m, nil
@@ -463,6 +992,30 @@ func (m *Model) handleResetConfirmationKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd)
 	return m, nil
 }
 
+// handleResetScopeKeys processes input while choosing what to reset
+func (m *Model) handleResetScopeKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	scopes := stats.ResetScopes()
+	current := slices.Index(scopes, m.ResetScope)
+
+	switch msg.String() {
+	case KeyEscape:
+		m.ShowResetConfirmation = false
+		m.ChoosingResetScope = false
+		m.ResetScope = stats.ResetAll
+
+	case KeyUp, "k":
+		m.ResetScope = scopes[(current+len(scopes)-1)%len(scopes)]
+
+	case KeyDown, "j":
+		m.ResetScope = scopes[(current+1)%len(scopes)]
+
+	case KeyEnter, KeySpace, " ":
+		m.ChoosingResetScope = false
+	}
+
+	return m, nil
+}
+
 // validateAndResetStats validates the confirmation numbers and resets stats if correct
 func (m *Model) validateAndResetStats() (tea.Model, tea.Cmd) {
 	// Check if all numbers match
@@ -481,40 +1034,47 @@ func (m *Model) validateAndResetStats() (tea.Model, tea.Cmd) {
 	}
 
 	// Numbers match - reset statistics
-	err := m.StatsManager.Reset()
+	backup, err := m.StatsManager.ResetWithBackup(m.ResetScope)
 	if err != nil {
 		enhancedErr := WrapError(err, "reset statistics")
 		m.ErrorMessage = FormatErrorForDisplay(enhancedErr)
 	} else {
 		m.SuccessMessage = "Statistics reset successfully!"
+		if m.ResetScope != stats.ResetAll {
+			m.SuccessMessage = fmt.Sprintf("%s reset successfully!", m.ResetScope)
+		}
+		if backup != "" {
+			m.SuccessMessage += fmt.Sprintf(" Backup saved to: %s", backup)
+			m.offerOpenFolder(backup)
+		}
 	}
 
 	// Hide confirmation dialog
 	m.ShowResetConfirmation = false
+	m.ResetScope = stats.ResetAll
 	m.CurrentInputIndex = 0
 	m.UserInputNumbers = [4]int{0, 0, 0, 0}
 
 	return m, nil
 }
 
-// exportStats handles statistics export
-func (m *Model) exportStats() (tea.Model, tea.Cmd) {
-	// Use default export options (JSON format)
-	options := stats.DefaultExportOptions()
-
-	err := m.StatsManager.ExportStats(options)
-	if err != nil {
-		enhancedErr := WrapError(err, "export statistics")
-		m.ErrorMessage = FormatErrorForDisplay(enhancedErr)
-	} else {
-		m.SuccessMessage = fmt.Sprintf("Statistics exported to: %s", options.Filename)
+// View renders the current view
+func (m *Model) View() string {
+	view := m.withToast(m.renderView())
+	if m.ASCIIOnly {
+		view = ToASCII(view)
 	}
-
-	return m, nil
+	view, m.zones = scanZones(view, m.Height)
+	return m.withCelebration(view)
 }
 
-// View renders the current view
-func (m *Model) View() string {
+// renderView renders the active view
+func (m *Model) renderView() string {
+	// Dialogs are modal and replace the underlying view
+	if m.Dialog != nil {
+		return lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, m.Dialog.Render())
+	}
+
 	if m.ShowHelp {
 		return m.renderHelp()
 	}
@@ -525,7 +1085,35 @@ func (m *Model) View() string {
 	case GameView:
 		return m.renderGame()
 	case StatsView:
-		return m.renderStats()
+		return m.statsWindow(m.renderStats())
+	case GoalsView:
+		return m.renderGoals()
+	case WhatIfView:
+		return m.renderWhatIf()
+	case SettingsView:
+		return m.renderSettings()
+	case TutorialView:
+		return m.renderTutorial()
+	case HostModeView:
+		return m.renderHostMode()
+	case SimulationView:
+		return m.renderAutoPlay()
+	case GlossaryView:
+		return m.renderGlossary()
+	case WorksheetView:
+		return m.renderWorksheet()
+	case ThemeGalleryView:
+		return m.renderThemeGallery()
+	case EventLogView:
+		return m.renderEventLog()
+	case TranscriptView:
+		return m.renderTranscript()
+	case ProfilesView:
+		return m.renderProfiles()
+	case HotseatView:
+		return m.renderHotseatSetup()
+	case EducationView:
+		return m.renderEducation()
 	default:
 		return "Unknown view"
 	}
@@ -536,7 +1124,7 @@ func (m *Model) renderHelp() string {
 	helpContent := []string{
 		"",
 		"🎯 The Monty Hall Problem:",
-		"You're on a game show with 3 doors. Behind one is a car, behind the",
+		fmt.Sprintf("You're on a game show with %d doors. Behind one is a car, behind the", m.NumDoors),
 		"others are goats. After you pick a door, the host opens a door with a",
 		"goat. You can then switch your choice or stay with your original pick.",
 		"",
@@ -545,32 +1133,44 @@ func (m *Model) renderHelp() string {
 		"• Enter / Space - Select",
 		"• q - Quit application",
 		"• h - Toggle help",
-		"• r - Reset statistics",
+	}
+	if !m.NoPersist {
+		helpContent = append(helpContent, "• r - Reset statistics")
+	}
+	helpContent = append(helpContent,
 		"• s - Switch choice (during final decision)",
+		"• b - Bayes' theorem for the doors the host opened",
+		"• p - Show or hide the chance of the car behind each door",
+		fmt.Sprintf("• %s / %s - Dismiss the strategy hint / turn hints off", KeyDismissHint, KeyDisableHints),
+		fmt.Sprintf("• %s - Take back your first pick (before the host opens a door)", KeyUndo),
+		fmt.Sprintf("• %s - Abandon the game without recording a result", KeyAbort),
 		"",
 		"🎲 Game Flow:",
-		"1. Choose a door (1, 2, or 3)",
-		"2. Host reveals a goat behind another door",
+		fmt.Sprintf("1. Choose a door (%s)", doorChoices(m.NumDoors)),
+		hostRevealStep(m.hostOpens(), m.HostBehavior),
 		"3. Decide to switch or stay",
 		"4. See the result and updated statistics",
 		"",
 		"🧮 Mathematical Insight:",
-		"Switching gives you a 2/3 chance of winning!",
-		"Staying gives you only a 1/3 chance of winning.",
+	)
+	helpContent = append(helpContent, hostInsightLines(m.HostBehavior, m.NumDoors, m.hostOpens())...)
+	helpContent = append(helpContent,
 		"",
 		"Play multiple games to see this probability in action!",
 		"",
 		"📁 Statistics File:",
-		fmt.Sprintf("Stats are saved to: %s", m.StatsManager.GetStatsFilePath()),
+	)
+	bindings := []KeyBinding{{"Enter", "Play game"}}
+	if m.NoPersist {
+		helpContent = append(helpContent, "Nothing is saved: statistics last until the app is closed")
+	} else {
+		helpContent = append(helpContent, fmt.Sprintf("Stats are saved to: %s", m.StatsManager.GetStatsFilePath()))
+		bindings = append(bindings, KeyBinding{"r", "Reset stats"})
 	}
 
 	helpBox := NewHelpBox("HELP - Monty Hall Simulator", helpContent, GetLayoutWidth(m.Width))
 
-	footer := RenderFooter([]KeyBinding{
-		{"Enter", "Play game"},
-		{"r", "Reset stats"},
-		{"q", "Main menu"},
-	})
+	footer := RenderFooter(append(bindings, KeyBinding{"q", "Main menu"}))
 
 	return lipgloss.JoinVertical(lipgloss.Center,
 		Spacer(2),
@@ -587,19 +1187,15 @@ func (m *Model) renderMainMenu() string {
 	// Subtitle
 	subtitle := SubtitleStyle.Render("Test your intuition against probability theory")
 
-	// Menu options
-	options := []string{
-		"Play Game",
-		"View Statistics",
-		"Help",
-		"Quit",
-	}
-
 	// Create flat menu items
 	var menuItems []string
-	for i, option := range options {
-		button := NewMenuButton(option, i == m.MenuCursor)
-		menuItems = append(menuItems, button.Render())
+	for i, option := range m.menuOptions() {
+		label := option.Label
+		if option.Badge {
+			label += menuBadge
+		}
+		button := NewMenuButton(label, i == m.MenuCursor)
+		menuItems = append(menuItems, markZone(menuZone(i), button.Render()))
 	}
 
 	// Arrange menu vertically
@@ -626,6 +1222,21 @@ func (m *Model) renderMainMenu() string {
 	content = append(content, banner)
 	content = append(content, Spacer(1))
 	content = append(content, subtitle)
+	if line := m.renderProfileLine(); line != "" {
+		content = append(content, line)
+	}
+	if status := m.renderClassroomStatus(); status != "" {
+		content = append(content, status)
+	}
+	if m.SafeMode {
+		content = append(content, Spacer(1))
+		content = append(content, lipgloss.NewStyle().Foreground(WarningColor).Bold(true).Render(
+			"SAFE MODE: default settings, animations off, statistics will not be saved"))
+	}
+	if m.NoPersist {
+		content = append(content, Spacer(1))
+		content = append(content, m.renderNoPersistBanner())
+	}
 	content = append(content, Spacer(2))
 	content = append(content, menu)
 
@@ -650,7 +1261,7 @@ func (m *Model) renderGame() string {
 	}
 
 	// Header (always present) - use ASCII art for larger screens
-	header := CreateGameBanner(m.Width)
+	header := m.withProfileLine(CreateGameBanner(m.Width))
 
 	// Phase indicator (always present)
 	phaseIndicator := NewGamePhaseIndicator(m.Game.Phase)
@@ -660,7 +1271,7 @@ func (m *Model) renderGame() string {
 
 	// Handle revealing state with dramatic pause
 	if m.IsRevealing {
-		contentLines = append(contentLines, Center(TitleStyle.Render("The host is opening a door..."), m.Width, 1))
+		contentLines = append(contentLines, Center(TitleStyle.Render(m.hostLine(game.DialogueReveal, "The host is opening a door...")), m.Width, 1))
 		contentLines = append(contentLines, Center(SubtitleStyle.Render("..."), m.Width, 1))
 		contentLines = append(contentLines, "") // Empty line
 		contentLines = append(contentLines, "") // Empty line
@@ -671,21 +1282,36 @@ func (m *Model) renderGame() string {
 	} else {
 		switch m.Game.Phase {
 		case game.InitialChoice:
-			contentLines = append(contentLines, Center(TitleStyle.Render("Choose a door (1, 2, or 3):"), m.Width, 1))
+			contentLines = append(contentLines, Center(TitleStyle.Render(fmt.Sprintf("Choose a door (%s):", doorChoices(m.Game.NumDoors()))), m.Width, 1))
 			contentLines = append(contentLines, Center(SubtitleStyle.Render(fmt.Sprintf("Currently highlighting: Door %d", m.DoorCursor+1)), m.Width, 1))
+			contentLines = append(contentLines, m.renderHostLine(game.DialogueGreeting))
+			contentLines = append(contentLines, "") // Empty line
 			contentLines = append(contentLines, "") // Empty line
 			contentLines = append(contentLines, "") // Empty line
 			contentLines = append(contentLines, "") // Empty line
+			contentLines = append(contentLines, m.renderAbortHint())
+
+		case game.HostReveal:
+			contentLines = append(contentLines, Center(TitleStyle.Render(fmt.Sprintf("You chose door %d.", m.Game.PlayerInitialChoice+1)), m.Width, 1))
+			contentLines = append(contentLines, Center(SubtitleStyle.Render("The host is about to open a door..."), m.Width, 1))
 			contentLines = append(contentLines, "") // Empty line
 			contentLines = append(contentLines, "") // Empty line
 			contentLines = append(contentLines, "") // Empty line
+			contentLines = append(contentLines, "") // Empty line
+			contentLines = append(contentLines, "") // Empty line
+			contentLines = append(contentLines, m.renderAbortHint())
 
 		case game.FinalChoice:
 			instruction1 := fmt.Sprintf("You initially chose door %d.", m.Game.PlayerInitialChoice+1)
-			instruction2 := fmt.Sprintf("The host opened door %d, revealing a goat!", m.Game.HostOpenedDoor+1)
+			instruction2 := hostRevealSummary(m.Game.HostOpenedDoor+1, len(m.Game.HostOpenedDoors), m.Game.CarRevealed()) + "!"
 			contentLines = append(contentLines, Center(TitleStyle.Render(instruction1), m.Width, 1))
-			contentLines = append(contentLines, Center(SubtitleStyle.Render(instruction2), m.Width, 1))
-			contentLines = append(contentLines, "") // Empty line
+			if m.Game.CarRevealed() {
+				contentLines = append(contentLines, Center(lipgloss.NewStyle().Foreground(WarningColor).Bold(true).Render(instruction2), m.Width, 1))
+				contentLines = append(contentLines, Center(MutedStyle.Render(fmt.Sprintf("%s slipped! No closed door can win this round.", m.Game.Host.Behavior.DisplayName())), m.Width, 1))
+			} else {
+				contentLines = append(contentLines, Center(SubtitleStyle.Render(instruction2), m.Width, 1))
+				contentLines = append(contentLines, m.renderHostLine(game.DialogueOffer))
+			}
 			contentLines = append(contentLines, Center(lipgloss.NewStyle().Foreground(WarningColor).Bold(true).Render("Final Decision: Do you want to switch or stay?"), m.Width, 1))
 
 			// Add clear instructions with cursor info
@@ -700,14 +1326,18 @@ func (m *Model) renderGame() string {
 			}
 			cursorInfo := fmt.Sprintf("Use ←→ to choose between: %s", lipgloss.JoinHorizontal(lipgloss.Left, doorOptions...))
 			contentLines = append(contentLines, Center(SubtitleStyle.Render(cursorInfo), m.Width, 1))
-			contentLines = append(contentLines, Center(lipgloss.NewStyle().Foreground(PrimaryColor).Render("Press 's' to SWITCH to the other door"), m.Width, 1))
+			switchHint := "Press 's' to SWITCH to the other door"
+			if len(availableDoors) > 2 {
+				switchHint = "Press 's' to SWITCH to one of the other doors at random"
+			}
+			contentLines = append(contentLines, Center(lipgloss.NewStyle().Foreground(PrimaryColor).Render(switchHint), m.Width, 1))
 			contentLines = append(contentLines, Center(lipgloss.NewStyle().Foreground(SecondaryColor).Render("Press Enter to confirm your choice"), m.Width, 1))
-			contentLines = append(contentLines, "") // Empty line
+			contentLines = append(contentLines, m.renderAbortHint())
 
 		case game.GameOver:
 			if m.Game.Result != nil {
 				summary1 := fmt.Sprintf("You initially chose door %d", m.Game.Result.InitialChoice+1)
-				summary2 := fmt.Sprintf("The host opened door %d, revealing a goat", m.Game.Result.HostOpenedDoor+1)
+				summary2 := hostRevealSummary(m.Game.Result.HostOpenedDoor+1, len(m.Game.HostOpenedDoors), m.Game.Result.CarRevealed)
 
 				var strategy string
 				if m.Game.Result.Strategy == game.Switch {
@@ -732,6 +1362,7 @@ func (m *Model) renderGame() string {
 	var content []string
 	content = append(content, header)
 	content = append(content, phaseIndicator.Render())
+	content = append(content, m.renderWidgets(SlotStatus)...)
 	content = append(content, Spacer(1))
 
 	// Add fixed-height content area (8 lines)
@@ -741,30 +1372,59 @@ func (m *Model) renderGame() string {
 	// Add doors (always in the same position)
 	var doors string
 	if m.IsRevealing {
-		doors = RenderDoorsRow(m.Game.Doors, m.Game.PlayerInitialChoice, -1, -1, false)
+		doors = m.renderDoors(m.Game.PlayerInitialChoice, -1, -1, false)
 	} else {
 		switch m.Game.Phase {
 		case game.InitialChoice:
-			doors = RenderDoorsRow(m.Game.Doors, -1, -1, m.DoorCursor, false)
+			doors = m.renderDoors(-1, -1, m.DoorCursor, false)
+		case game.HostReveal:
+			doors = m.renderDoors(m.Game.PlayerInitialChoice, -1, -1, false)
 		case game.FinalChoice:
-			doors = RenderDoorsRow(m.Game.Doors, m.Game.PlayerInitialChoice, m.Game.HostOpenedDoor, m.DoorCursor, false)
+			doors = m.renderDoors(m.Game.PlayerInitialChoice, m.Game.HostOpenedDoor, m.DoorCursor, false)
 		case game.GameOver:
-			doors = RenderDoorsRow(m.Game.Doors, m.Game.PlayerInitialChoice, m.Game.HostOpenedDoor, -1, true)
+			doors = m.renderDoors(m.Game.PlayerInitialChoice, m.Game.HostOpenedDoor, -1, true)
 		}
 	}
-	content = append(content, SafeCenter(doors, m.Width))
+	content = append(content, m.withWidgetPanel(doors))
 
 	// Add result message for GameOver phase (only after reveal delay is complete)
 	if m.Game.Phase == game.GameOver && m.Game.Result != nil && m.ShowResult && !m.IsRevealing {
 		content = append(content, Spacer(1))
 		if m.Game.Result.Won {
-			winMessage := "🎉 CONGRATULATIONS! You won the car! 🎉"
-			enhancedWinMessage := CreateWinningMessage(winMessage)
+			banner := m.animationPack().Celebration.Banner
+			narrative := m.resultNarrative("CONGRATULATIONS! You won the car!")
+			winMessage := strings.TrimSpace(fmt.Sprintf("%s %s %s", banner, narrative, banner))
+			enhancedWinMessage := CreateWinningMessage(winMessage, m.Effects)
 			content = append(content, Center(enhancedWinMessage, m.Width, 1))
 		} else {
-			loseMessage := "😔 Sorry, you got a goat. Better luck next time!"
+			loseMessage := m.resultNarrative("😔 Sorry, you got a goat. Better luck next time!")
 			content = append(content, Center(MutedStyle.Render(loseMessage), m.Width, 1))
 		}
+
+		if m.Daily != nil && m.Daily.IsComplete() {
+			content = append(content, Spacer(1))
+			content = append(content, m.renderDailyShareCode())
+		}
+
+		if m.Practice != nil && m.Practice.IsComplete() {
+			content = append(content, Spacer(1))
+			content = append(content, m.renderPracticeSummary())
+		}
+
+		if m.Hotseat != nil {
+			content = append(content, Spacer(1))
+			content = append(content, m.renderHotseatScoreboard())
+		}
+
+		if m.Ladder != nil && m.Ladder.played {
+			content = append(content, Spacer(1))
+			content = append(content, m.renderLadderSummary())
+		}
+
+		if m.NewGamePrompt != nil {
+			content = append(content, Spacer(1))
+			content = append(content, Center(m.NewGamePrompt.Render(), m.Width, 1))
+		}
 	}
 
 	// Add footer based on phase
@@ -777,19 +1437,35 @@ func (m *Model) renderGame() string {
 			{"←→", "Navigate"},
 			{"q", "Main menu"},
 		})
+	case game.HostReveal:
+		footer = RenderFooter([]KeyBinding{
+			{KeyUndo, "Take back pick"},
+			{"q", "Main menu"},
+		})
 	case game.FinalChoice:
 		footer = RenderFooter([]KeyBinding{
 			{"Enter", "Confirm choice"},
 			{"s", "Switch doors"},
 			{"←→", "Choose door"},
+			{KeyBayes, "Why?"},
 			{"q", "Main menu"},
 		})
 	case game.GameOver:
-		footer = RenderFooter([]KeyBinding{
-			{"Enter", "Play again"},
-			{"s", "Statistics"},
-			{"q", "Main menu"},
-		})
+		bindings := []KeyBinding{{"Enter", "Play again"}, {"r", "New game options"}}
+		if m.Hotseat != nil {
+			bindings[0] = KeyBinding{"Enter", m.Hotseat.Next().Name + "'s turn"}
+		}
+		if m.Ladder != nil {
+			bindings[0] = KeyBinding{"Enter", "Next rung"}
+			if m.Ladder.Over() {
+				bindings[0] = KeyBinding{"Enter", "Climb again"}
+			}
+		}
+		if !m.NoPersist {
+			bindings = append(bindings, KeyBinding{"i", "Save image"})
+		}
+		bindings = append(bindings, KeyBinding{"s", "Statistics"}, KeyBinding{"q", "Main menu"})
+		footer = RenderFooter(bindings)
 	}
 	if footer != "" {
 		content = append(content, footer)
@@ -800,6 +1476,10 @@ func (m *Model) renderGame() string {
 		content = append(content, Spacer(1))
 		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
 	}
+	if m.SuccessMessage != "" {
+		content = append(content, Spacer(1))
+		content = append(content, Center(SuccessStyle.Render(m.SuccessMessage), m.Width, 1))
+	}
 
 	// Join all content - consistent top alignment for all phases
 	gameContent := lipgloss.JoinVertical(lipgloss.Center, content...)
@@ -808,19 +1488,28 @@ func (m *Model) renderGame() string {
 
 // renderStats renders the statistics view
 func (m *Model) renderStats() string {
-	stats := m.StatsManager.GetStats()
+	gameStats := m.viewedStats()
+
+	if m.ExportDraft != nil {
+		return lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, m.ExportDraft.Render())
+	}
+	if m.RangeDraft != nil {
+		return lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, m.RangeDraft.Render())
+	}
 
 	var content []string
 
 	// Header - use ASCII art for larger screens
-	header := CreateStatsBanner(m.Width)
+	header := m.withProfileLine(CreateStatsBanner(m.Width))
 	content = append(content, header)
 	content = append(content, Spacer(1))
 
-	if stats.TotalGames == 0 {
+	if m.StatsManager.GetStats().TotalGames == 0 {
 		// No games played yet
 		noGamesMsg := "No games played yet. Start playing to see statistics!"
 		content = append(content, Center(SubtitleStyle.Render(noGamesMsg), m.Width, 1))
+		// Hotseat games are kept apart, so the players may have played
+		content = append(content, m.renderPlayerStats(m.StatsManager.GetStats())...)
 
 		footer := RenderFooter([]KeyBinding{
 			{"Enter", "Play game"},
@@ -834,25 +1523,54 @@ func (m *Model) renderStats() string {
 		return lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, horizontallyCentered)
 	}
 
+	// The reset popover replaces every page, so it is handled below
+	if m.StatsPage == StatsPageAchievements && !m.ShowResetConfirmation {
+		return lipgloss.JoinVertical(lipgloss.Center, m.renderAchievementsPage(content)...)
+	}
+	if m.StatsPage == StatsPageFairness && !m.ShowResetConfirmation {
+		return lipgloss.JoinVertical(lipgloss.Center, m.renderFairnessPage(content)...)
+	}
+
+	// The overview, convergence chart and play times cover the chosen time range
+	content = append(content, Center(m.renderStatsRangeBar(), m.Width, 1))
+	content = append(content, Spacer(1))
+	if gameStats.TotalGames == 0 && !m.ShowResetConfirmation {
+		noGamesMsg := fmt.Sprintf("No games played in %s. Press f to pick another time range.", m.statsRangeName())
+		content = append(content, Center(SubtitleStyle.Render(noGamesMsg), m.Width, 1))
+		content = append(content, RenderFooter([]KeyBinding{
+			m.statsPageBinding(),
+			{"f", "Range"},
+			{"Enter", "Play game"},
+			{"ESC/q", "Return"},
+		}))
+		return lipgloss.JoinVertical(lipgloss.Center, content...)
+	}
+	if m.StatsPage == StatsPageConvergence && !m.ShowResetConfirmation {
+		return lipgloss.JoinVertical(lipgloss.Center, m.renderConvergencePage(content)...)
+	}
+	if m.StatsPage == StatsPageAnalytics && !m.ShowResetConfirmation {
+		return lipgloss.JoinVertical(lipgloss.Center, m.renderAnalyticsPage(content)...)
+	}
+
 	// Stats cards row
 	totalCard := NewStatsCard(
 		"Total Games",
-		fmt.Sprintf("%d", stats.TotalGames),
-		fmt.Sprintf("%.1f%% win rate", float64(stats.TotalWins)/float64(stats.TotalGames)*100),
+		fmt.Sprintf("%d", gameStats.TotalGames),
+		fmt.Sprintf("%.1f%% win rate", float64(gameStats.TotalWins)/float64(gameStats.TotalGames)*100),
 		PrimaryColor,
 	)
 
 	winsCard := NewStatsCard(
 		"Total Wins",
-		fmt.Sprintf("%d", stats.TotalWins),
-		fmt.Sprintf("%d losses", stats.TotalLosses),
+		fmt.Sprintf("%d", gameStats.TotalWins),
+		fmt.Sprintf("%d losses", gameStats.TotalLosses),
 		SecondaryColor,
 	)
 
 	streakCard := NewStatsCard(
 		"Best Streak",
-		fmt.Sprintf("%d", stats.StreakStats.LongestWinStreak),
-		fmt.Sprintf("Current: %d", stats.StreakStats.CurrentWinStreak),
+		fmt.Sprintf("%d", gameStats.StreakStats.LongestWinStreak),
+		fmt.Sprintf("Current: %d", gameStats.StreakStats.CurrentWinStreak),
 		AccentColor,
 	)
 
@@ -872,22 +1590,22 @@ func (m *Model) renderStats() string {
 	content = append(content, Spacer(1))
 
 	// Progress bars for strategies
-	if stats.StayStats.GamesPlayed > 0 {
+	if gameStats.StayStats.GamesPlayed > 0 {
 		stayBar := NewProgressBar(
-			stats.StayStats.Wins,
-			stats.StayStats.GamesPlayed,
+			gameStats.StayStats.Wins,
+			gameStats.StayStats.GamesPlayed,
 			40,
-			fmt.Sprintf("Stay Strategy (%.1f%%)", stats.StayStats.WinRate*100),
+			fmt.Sprintf("Stay Strategy (%.1f%%)", gameStats.StayStats.WinRate*100),
 		)
 		content = append(content, Center(stayBar.Render(), m.Width, 1))
 	}
 
-	if stats.SwitchStats.GamesPlayed > 0 {
+	if gameStats.SwitchStats.GamesPlayed > 0 {
 		switchBar := NewProgressBar(
-			stats.SwitchStats.Wins,
-			stats.SwitchStats.GamesPlayed,
+			gameStats.SwitchStats.Wins,
+			gameStats.SwitchStats.GamesPlayed,
 			40,
-			fmt.Sprintf("Switch Strategy (%.1f%%)", stats.SwitchStats.WinRate*100),
+			fmt.Sprintf("Switch Strategy (%.1f%%)", gameStats.SwitchStats.WinRate*100),
 		)
 		content = append(content, Center(switchBar.Render(), m.Width, 1))
 	}
@@ -902,36 +1620,34 @@ func (m *Model) renderStats() string {
 		"Stay should win:   33.3% (1/3 probability)",
 		"Switch should win: 66.7% (2/3 probability)",
 	}
+	if byDoors := stats.StatsByDoors(gameStats.GameHistory); len(byDoors) > 1 || (len(byDoors) == 1 && byDoors[0].NumDoors != game.NumDoors) {
+		theoryLines = nil
+		for _, doorStats := range byDoors {
+			theoryLines = append(theoryLines, doorCountTheoryLine(doorStats))
+		}
+	}
+	if byHost := stats.StatsByHost(gameStats.GameHistory); len(byHost) > 1 || (len(byHost) == 1 && byHost[0].IsVariant()) {
+		theoryLines = []string{"Games where only goats were revealed:"}
+		for _, hostStats := range byHost {
+			theoryLines = append(theoryLines, hostTheoryLine(hostStats))
+		}
+	}
 
 	for _, line := range theoryLines {
 		content = append(content, Center(MutedStyle.Render(line), m.Width, 1))
 	}
 
 	// Insights
-	if stats.TotalGames >= 10 {
-		content = append(content, Spacer(1))
-		insightTitle := StatsHeaderStyle.Render("📈 INSIGHTS")
-		content = append(content, Center(insightTitle, m.Width, 1))
-
-		var insight string
-		if stats.SwitchStats.WinRate > 0.6 {
-			insight = "✅ Switching is proving more successful!"
-		} else if stats.StayStats.WinRate > 0.4 {
-			insight = "🎲 Results are still converging to theory."
-		} else {
-			insight = "📊 Play more games to see clearer patterns."
-		}
-
-		content = append(content, Center(SuccessStyle.Render(insight), m.Width, 1))
+	if gameStats.TotalGames >= 10 {
+		content = append(content, m.renderInsights(gameStats)...)
 	}
 
+	content = append(content, m.renderPracticeComparison()...)
+	content = append(content, m.renderPlayerStats(gameStats)...)
+	content = append(content, m.renderLadderStats(gameStats)...)
+
 	// Footer
-	footer := RenderFooter([]KeyBinding{
-		{"e", "Export stats"},
-		{"r", "Reset stats"},
-		{"ESC/q", "Return"},
-	})
-	content = append(content, footer)
+	content = append(content, m.statsFooter(true))
 
 	statsContent := lipgloss.JoinVertical(lipgloss.Center, content...)
 
@@ -943,6 +1659,8 @@ func (m *Model) renderStats() string {
 			m.CurrentInputIndex,
 			60, // Width of the popover
 		)
+		popover.Scope = m.ResetScope
+		popover.ChoosingScope = m.ChoosingResetScope
 
 		// Overlay the popover on top of the stats content
 		return lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, popover.Render())
@@ -967,7 +1685,7 @@ func (m *Model) isDoorSelectable(doorIndex int) bool {
 	switch m.Game.Phase {
 	case game.InitialChoice:
 		// All doors are selectable during initial choice
-		return doorIndex >= 0 && doorIndex < game.NumDoors
+		return doorIndex >= 0 && doorIndex < m.Game.NumDoors()
 
 	case game.HostReveal:
 		// No doors are selectable during host reveal phase (countdown)
@@ -975,8 +1693,8 @@ func (m *Model) isDoorSelectable(doorIndex int) bool {
 
 	case game.FinalChoice:
 		// Only original choice and the other unopened door are selectable
-		// Host-opened door should not be selectable
-		return doorIndex != m.Game.HostOpenedDoor
+		// Host-opened doors should not be selectable
+		return doorIndex >= 0 && doorIndex < m.Game.NumDoors() && !m.Game.Doors[doorIndex].IsOpen()
 
 	case game.GameOver:
 		// No doors are selectable when game is over
@@ -994,7 +1712,7 @@ func (m *Model) getSelectableDoors() []int {
 	}
 
 	var selectable []int
-	for i := 0; i < game.NumDoors; i++ {
+	for i := 0; i < m.Game.NumDoors(); i++ {
 		if m.isDoorSelectable(i) {
 			selectable = append(selectable, i)
 		}
@@ -1058,6 +1776,173 @@ func (m *Model) moveCursorRight() {
 	}
 }
 
+// moveCursorRows moves the cursor up or down a row when the doors are drawn as a grid
+func (m *Model) moveCursorRows(delta int) {
+	if !m.usesDoorsGrid() {
+		return
+	}
+
+	door := m.DoorCursor + delta*DoorsGridColumns(m.Game.NumDoors(), m.Width)
+	if m.isDoorSelectable(door) {
+		m.DoorCursor = door
+	}
+}
+
+// usesDoorsGrid reports whether the game has more doors than fit side by side
+func (m *Model) usesDoorsGrid() bool {
+	if m.Game == nil || m.Game.NumDoors() <= game.NumDoors {
+		return false
+	}
+	return lipgloss.Width(RenderDoorsRow(m.Game.Doors, -1, -1, -1, false)) > m.Width
+}
+
+// renderDoors draws the doors side by side, or as a compact grid when they don't fit
+func (m *Model) renderDoors(playerChoice, hostOpened, cursor int, showAll bool) string {
+	if m.usesDoorsGrid() {
+		return RenderDoorsGrid(m.Game.Doors, playerChoice, cursor, showAll, m.Width)
+	}
+	return RenderDoorsRowWithAnimation(m.Game.Doors, playerChoice, hostOpened, cursor, showAll, m)
+}
+
+// hostRevealStep describes what the host opens in the game flow help
+func hostRevealStep(opened int, behavior game.HostBehavior) string {
+	if behavior == game.HostFall {
+		if opened == 1 {
+			return "2. Host opens another door at random - it might hide the car"
+		}
+		return fmt.Sprintf("2. Host opens %d other doors at random - one might hide the car", opened)
+	}
+	if opened == 1 {
+		return "2. Host reveals a goat behind another door"
+	}
+	return fmt.Sprintf("2. Host opens %d other doors, all hiding goats", opened)
+}
+
+// hostOpens returns how many doors the host opens in regular games
+func (m *Model) hostOpens() int {
+	host := game.Host{Opens: m.HostOpens}
+	return host.DoorsToOpen(m.NumDoors)
+}
+
+// doorCountTheoryLine compares expected and actual win rates for one door count
+func doorCountTheoryLine(doorStats stats.DoorCountStats) string {
+	actual := func(strategy stats.StrategyStats) string {
+		if strategy.GamesPlayed == 0 {
+			return "no games"
+		}
+		return fmt.Sprintf("%.1f%% actual", strategy.WinRate*100)
+	}
+
+	return fmt.Sprintf("%d doors: stay %.1f%% (%s), switch %.1f%% (%s)",
+		doorStats.NumDoors,
+		doorStats.ExpectedStayRate()*100, actual(doorStats.StayStats),
+		doorStats.ExpectedSwitchRate()*100, actual(doorStats.SwitchStats))
+}
+
+// hostRevealSummary describes what the host opened; door is the 1-indexed first door
+func hostRevealSummary(door, opened int, carRevealed bool) string {
+	switch {
+	case carRevealed && opened > 1:
+		return fmt.Sprintf("The host opened %d doors, revealing the car", opened)
+	case carRevealed:
+		return fmt.Sprintf("The host opened door %d, revealing the car", door)
+	case opened > 1:
+		return fmt.Sprintf("The host opened %d doors, revealing %d goats", opened, opened)
+	default:
+		return fmt.Sprintf("The host opened door %d, revealing a goat", door)
+	}
+}
+
+// hostInsightLines explains the odds of staying and switching with a host
+// behavior that opens opened of the numDoors doors
+func hostInsightLines(behavior game.HostBehavior, numDoors, opened int) []string {
+	if opened != numDoors-2 {
+		return partialRevealInsightLines(behavior, numDoors, opened)
+	}
+
+	switch behavior {
+	case game.HostFall:
+		return []string{
+			"Monty Fall: the host opens doors at random and may reveal the car,",
+			fmt.Sprintf("which happens in %d of every %d games.", numDoors-2, numDoors),
+			"When only goats are revealed, the car is equally likely behind",
+			"either closed door: switching and staying both win 1/2.",
+		}
+	case game.HostCrawl:
+		return []string{
+			"Monty Crawl: the host always reveals goats, but when he can choose",
+			"he opens the lowest-numbered doors first.",
+			fmt.Sprintf("Switching still wins %d/%d overall. If he left a lower goat door", numDoors-1, numDoors),
+			"closed, switching wins for sure; otherwise it wins only 1/2.",
+		}
+	default:
+		return []string{
+			fmt.Sprintf("Switching gives you a %d/%d chance of winning!", numDoors-1, numDoors),
+			fmt.Sprintf("Staying gives you only a 1/%d chance of winning.", numDoors),
+		}
+	}
+}
+
+// partialRevealInsightLines explains the odds when the host leaves several
+// doors to switch to
+func partialRevealInsightLines(behavior game.HostBehavior, numDoors, opened int) []string {
+	others := numDoors - 1 - opened
+	if behavior == game.HostFall {
+		return []string{
+			fmt.Sprintf("Monty Fall: the host opens %d doors at random and may reveal the car,", opened),
+			fmt.Sprintf("which happens in %d of every %d games.", opened, numDoors),
+			"When only goats are revealed, the car is equally likely behind",
+			fmt.Sprintf("any of the %d closed doors: each wins 1/%d.", numDoors-opened, numDoors-opened),
+		}
+	}
+
+	var lines []string
+	if behavior == game.HostCrawl {
+		lines = append(lines, "Monty Crawl: the host always reveals goats, lowest-numbered doors first.")
+	}
+	return append(lines,
+		fmt.Sprintf("The host opens %d of the other doors, leaving %d to switch to.", opened, others),
+		fmt.Sprintf("Together they hide the car %d/%d of the time, so switching to one", numDoors-1, numDoors),
+		fmt.Sprintf("of them wins %s, while staying wins only 1/%d.", fraction(numDoors-1, numDoors*others), numDoors),
+	)
+}
+
+// fraction formats a/b in lowest terms
+func fraction(a, b int) string {
+	x, y := a, b
+	for y != 0 {
+		x, y = y, x%y
+	}
+	return fmt.Sprintf("%d/%d", a/x, b/x)
+}
+
+// hostTheoryLine compares expected and actual win rates for one host behavior
+func hostTheoryLine(hostStats stats.HostStats) string {
+	actual := func(strategy stats.StrategyStats) string {
+		if strategy.GamesPlayed == 0 {
+			return "no games"
+		}
+		return fmt.Sprintf("%.1f%% actual", strategy.WinRate*100)
+	}
+
+	line := fmt.Sprintf("%s: stay %.1f%% (%s), switch %.1f%% (%s)",
+		hostStats.Name(),
+		hostStats.ExpectedStayRate()*100, actual(hostStats.StayStats),
+		hostStats.ExpectedSwitchRate()*100, actual(hostStats.SwitchStats))
+	if hostStats.CarRevealed > 0 {
+		line += fmt.Sprintf(", car revealed in %d", hostStats.CarRevealed)
+	}
+	return line
+}
+
+// doorChoices lists the door numbers a player can pick from
+func doorChoices(numDoors int) string {
+	if numDoors == game.NumDoors {
+		return "1, 2, or 3"
+	}
+	return fmt.Sprintf("1-%d", numDoors)
+}
+
 // Animation helper methods
 
 // startDoorOpenAnimation starts a door opening animation for the specified door
@@ -1067,7 +1952,8 @@ func (m *Model) startDoorOpenAnimation(doorIndex int) tea.Cmd {
 	}
 
 	// Create and start door opening animation
-	doorAnim := NewDoorOpenAnimation(doorIndex)
+	doorAnim := NewDoorOpenAnimationFromPack(doorIndex, m.animationPack())
+	doorAnim.SetSpeed(m.AnimationSpeed)
 	m.DoorAnimations[doorIndex] = doorAnim
 	m.AnimationManager.AddAnimation(doorAnim.Animation)
 	m.AnimationManager.StartAnimation(doorAnim.ID)
@@ -1089,8 +1975,11 @@ func (m *Model) startWinningAnimation() tea.Cmd {
 			WinningDoorStyle,
 			CarColor,
 		)
+		pulseAnim.Effects = m.Effects
+		pulseAnim.SetSpeed(m.AnimationSpeed)
 		m.AnimationManager.AddAnimation(pulseAnim.Animation)
 		m.AnimationManager.StartAnimation(pulseAnim.ID)
+		m.startCelebration()
 
 		// Start the animation loop
 		return m.AnimationManager.Update()
@@ -1104,7 +1993,26 @@ func (m *Model) getDoorAnimationFrame(doorIndex int) (string, lipgloss.Color) {
 	if anim, exists := m.DoorAnimations[doorIndex]; exists && anim.IsRunning() {
 		return anim.GetCurrentFrame()
 	}
-	return "🚪", DoorColor
+	frames, colors := m.animationPack().DoorFrames(doorIndex)
+	return frames[0], colors[0]
+}
+
+// stopAnimations ends all animations so none carry over into another game or view
+func (m *Model) stopAnimations() {
+	if m.AnimationManager != nil {
+		m.AnimationManager.StopAll()
+	}
+	m.DoorAnimations = make(map[int]*DoorOpenAnimation)
+	m.Celebration = nil
+}
+
+// pruneDoorAnimations drops door animations that have finished
+func (m *Model) pruneDoorAnimations() {
+	for doorIndex, anim := range m.DoorAnimations {
+		if !anim.IsRunning() {
+			delete(m.DoorAnimations, doorIndex)
+		}
+	}
 }
 
 // isAnimationRunning checks if any animations are currently running
@@ -1112,13 +2020,57 @@ func (m *Model) isAnimationRunning() bool {
 	return m.AnimationManager != nil && m.AnimationManager.HasRunningAnimations()
 }
 
+// startHostReveal pauses before the host opens doors, which is the player's
+// chance to take back their first pick. Without animations the host opens
+// them straight away
+func (m *Model) startHostReveal() tea.Cmd {
+	delay := m.hostPersonality().Pace(m.AnimationSpeed.Scale(HostRevealDelay))
+	if !m.ShowAnimations || delay == 0 {
+		return m.finishHostReveal()
+	}
+
+	m.HostRevealSeq++
+	seq := m.HostRevealSeq
+	return tea.Tick(delay, func(time.Time) tea.Msg {
+		return HostRevealMsg{Seq: seq}
+	})
+}
+
+// finishHostReveal has the host open doors and moves on to the final choice
+func (m *Model) finishHostReveal() tea.Cmd {
+	if err := m.Game.OpenHostDoors(); err != nil {
+		m.ErrorMessage = err.Error()
+		return nil
+	}
+	m.applyCarriedStrategy()
+
+	// The doors the host opens swing open, unless they only fit as a grid
+	var cmds []tea.Cmd
+	if !m.usesDoorsGrid() {
+		for _, door := range m.Game.HostOpenedDoors {
+			cmds = append(cmds, m.startDoorOpenAnimation(door))
+		}
+	}
+	return tea.Batch(append(cmds, m.scheduleDefaultStrategy())...)
+}
+
 // startRevealDelay starts the dramatic reveal delay
 func (m *Model) startRevealDelay() tea.Cmd {
+	// The outcome is final, so record it now rather than after the delay
+	m.recordResult()
+
+	// Without motion, or at instant speed, the result is shown straight away
+	delay := m.hostPersonality().Pace(m.AnimationSpeed.Scale(RevealDelay))
+	if m.ReducedMotion || delay == 0 {
+		m.finishReveal()
+		return nil
+	}
+
 	m.IsRevealing = true
-	m.RevealStartTime = time.Now()
+	m.RevealStartTime = now()
 
-	// Return a command that will send RevealDelayMsg after 2 seconds
-	return tea.Tick(time.Second*2, func(t time.Time) tea.Msg {
+	// Return a command that will send RevealDelayMsg once the delay is over
+	return tea.Tick(delay, func(t time.Time) tea.Msg {
 		return RevealDelayMsg{}
 	})
 }
@@ -150,6 +150,27 @@ func TestHelpToggle(t *testing.T) {
 	}
 }
 
+func TestGameClockPausesAwayFromGame(t *testing.T) {
+	model := NewModel()
+	model.CurrentView = GameView
+	model.startNewGame()
+	help := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}}
+
+	model.Update(help)
+	if !model.Game.Paused() {
+		t.Error("Help should pause the game's clock")
+	}
+	model.Update(help)
+	if model.Game.Paused() {
+		t.Error("Closing help should restart the game's clock")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if model.CurrentView != StatsView || !model.Game.Paused() {
+		t.Error("Statistics should pause the game's clock")
+	}
+}
+
 func TestErrorMessageHandling(t *testing.T) {
 	model := NewModel()
 
@@ -241,6 +262,8 @@ func TestGameFlow(t *testing.T) {
 	keyMsg := tea.KeyMsg{Type: tea.KeyEnter}
 	updatedModel, _ := model.Update(keyMsg)
 	m := updatedModel.(*Model)
+	updatedModel, _ = m.Update(HostRevealMsg{Seq: m.HostRevealSeq})
+	m = updatedModel.(*Model)
 
 	// After initial choice, should be in FinalChoice phase
 	if m.Game.Phase != game.FinalChoice {
@@ -403,3 +426,36 @@ func TestModernMainMenuRendering(t *testing.T) {
 		// The important thing is that the component works, not that they're visually different
 	}
 }
+
+func TestMontyFallGame(t *testing.T) {
+	model := NewModel()
+	model.HostBehavior = game.HostFall
+	model.CurrentView = GameView
+	model.startNewGame()
+
+	if model.Game.Host.Behavior != game.HostFall {
+		t.Fatalf("Expected new games to use the configured host, got %v", model.Game.Host.Behavior)
+	}
+
+	// Find a seed where the falling host reveals the car
+	for seed := int64(0); ; seed++ {
+		model.Game = game.NewSeededGame(seed)
+		model.Game.Host.Behavior = game.HostFall
+		if err := model.Game.MakeInitialChoice(0); err != nil {
+			t.Fatalf("Initial choice failed: %v", err)
+		}
+		if model.Game.CarRevealed() {
+			break
+		}
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "revealing the car") || !strings.Contains(view, "Monty Fall slipped") {
+		t.Error("Expected the final choice to say the host revealed the car")
+	}
+
+	model.ShowHelp = true
+	if help := model.View(); !strings.Contains(help, "switching and staying both win 1/2") {
+		t.Error("Expected the help to explain the Monty Fall odds")
+	}
+}
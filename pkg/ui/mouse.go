@@ -0,0 +1,253 @@
+package ui
+
+import (
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// Clickable elements are bracketed in the rendered output by zone markers:
+// CSI sequences ending in 'z', which have no width, so they pass through
+// lipgloss layout untouched. View records where each one landed and strips
+// them before the output reaches the terminal.
+
+// statsScrollLines is how far one turn of the mouse wheel scrolls statistics
+const statsScrollLines = 3
+
+// zoneSpan is the part of one screen line a zone covers, from X0 up to but
+// not including X1
+type zoneSpan struct {
+	Y, X0, X1 int
+}
+
+// mouseZones are the screen spans of each zone View last drew
+type mouseZones map[string][]zoneSpan
+
+// at returns the zone covering a screen cell
+func (z mouseZones) at(x, y int) (string, bool) {
+	for id, spans := range z {
+		for _, span := range spans {
+			if span.Y == y && x >= span.X0 && x < span.X1 {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
+
+// Zone IDs for each kind of clickable element
+func doorZone(door int) string   { return "door:" + strconv.Itoa(door) }
+func menuZone(option int) string { return "menu:" + strconv.Itoa(option) }
+func keyZone(key string) string  { return "key:" + key }
+
+// zoneMarker encodes id as the parameters of a CSI sequence, one code point
+// per parameter
+func zoneMarker(id string) string {
+	params := make([]string, 0, len(id))
+	for _, r := range id {
+		params = append(params, strconv.Itoa(int(r)))
+	}
+	return "\x1b[" + strings.Join(params, ";") + "z"
+}
+
+// markZone brackets each line of s as part of zone id, so a block several
+// lines tall is clickable anywhere in it
+func markZone(id, s string) string {
+	marker := zoneMarker(id)
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = marker + line + marker
+	}
+	return strings.Join(lines, "\n")
+}
+
+// zoneID decodes a zone marker, reporting false for any other sequence
+func zoneID(seq string) (string, bool) {
+	if len(seq) < 3 || !strings.HasPrefix(seq, "\x1b[") || seq[len(seq)-1] != 'z' {
+		return "", false
+	}
+	var id strings.Builder
+	for _, param := range strings.Split(seq[2:len(seq)-1], ";") {
+		r, err := strconv.Atoi(param)
+		if err != nil {
+			return "", false
+		}
+		id.WriteRune(rune(r))
+	}
+	return id.String(), true
+}
+
+// scanZones records the screen spans of each zone in view and returns view
+// without its markers. A view taller than the terminal loses its top lines,
+// since Bubble Tea shows the bottom of it, so spans are measured from there
+func scanZones(view string, height int) (string, mouseZones) {
+	var out strings.Builder
+	out.Grow(len(view))
+	zones := mouseZones{}
+	open := map[string]int{} // Zones started on the current line, by starting column
+
+	x, y := 0, 0
+	var state byte
+	for len(view) > 0 {
+		seq, width, n, newState := ansi.DecodeSequenceWc(view, state, nil)
+		state = newState
+		view = view[n:]
+
+		if id, ok := zoneID(seq); ok {
+			if start, started := open[id]; started {
+				zones[id] = append(zones[id], zoneSpan{Y: y, X0: start, X1: x})
+				delete(open, id)
+			} else {
+				open[id] = x
+			}
+			continue
+		}
+
+		out.WriteString(seq)
+		if seq == "\n" {
+			x, y = 0, y+1
+			clear(open)
+			continue
+		}
+		x += width
+	}
+
+	if hidden := y + 1 - height; height > 0 && hidden > 0 {
+		for id, spans := range zones {
+			var visible []zoneSpan
+			for _, span := range spans {
+				if span.Y -= hidden; span.Y >= 0 {
+					visible = append(visible, span)
+				}
+			}
+			zones[id] = visible
+		}
+	}
+	return out.String(), zones
+}
+
+// footerKey is the key press a footer binding stands for, if it stands for a
+// single key
+func footerKey(key string) (tea.KeyMsg, bool) {
+	switch key {
+	case "Enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}, true
+	case "ESC", "ESC/q":
+		return tea.KeyMsg{Type: tea.KeyEsc}, true
+	case "Tab":
+		return tea.KeyMsg{Type: tea.KeyTab}, true
+	}
+	if len(key) == 1 {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)}, true
+	}
+	return tea.KeyMsg{}, false
+}
+
+// handleMouse clicks doors, menu buttons and footer hints, highlights the
+// door under the pointer and scrolls statistics and the transcript with the
+// wheel
+func (m *Model) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		m.scrollWheel(1)
+
+	case msg.Button == tea.MouseButtonWheelDown:
+		m.scrollWheel(-1)
+
+	case msg.Action == tea.MouseActionMotion:
+		if door, ok := m.doorAt(msg.X, msg.Y); ok && m.choosingDoor() {
+			m.DoorCursor = door
+		}
+
+	case msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft:
+		return m.click(msg.X, msg.Y)
+	}
+
+	return m, nil
+}
+
+// scrollWheel scrolls the current view one turn of the wheel, up for a
+// positive direction
+func (m *Model) scrollWheel(direction int) {
+	switch {
+	case m.CurrentView == StatsView:
+		m.scrollStats(direction * statsScrollLines)
+	case m.CurrentView == TranscriptView && m.Transcript != nil:
+		m.scrollTranscript(direction * transcriptScrollLines)
+	}
+}
+
+// click acts on the element at a screen cell as its key would
+func (m *Model) click(x, y int) (tea.Model, tea.Cmd) {
+	id, ok := m.zones.at(x, y)
+	if !ok {
+		return m, nil
+	}
+	kind, value, _ := strings.Cut(id, ":")
+
+	switch kind {
+	case "door":
+		door, _ := strconv.Atoi(value)
+		if !m.choosingDoor() || !m.isDoorSelectable(door) {
+			return m, nil
+		}
+		m.DoorCursor = door
+		return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+
+	case "menu":
+		m.MenuCursor, _ = strconv.Atoi(value)
+		return m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+
+	case "key":
+		if key, ok := footerKey(value); ok {
+			return m.handleKeyPress(key)
+		}
+	}
+
+	return m, nil
+}
+
+// doorAt returns the selectable door drawn at a screen cell
+func (m *Model) doorAt(x, y int) (int, bool) {
+	id, ok := m.zones.at(x, y)
+	if !ok {
+		return 0, false
+	}
+	value, ok := strings.CutPrefix(id, "door:")
+	if !ok {
+		return 0, false
+	}
+	door, err := strconv.Atoi(value)
+	if err != nil || !m.isDoorSelectable(door) {
+		return 0, false
+	}
+	return door, true
+}
+
+// choosingDoor reports whether the game view is waiting for a door choice
+func (m *Model) choosingDoor() bool {
+	return m.CurrentView == GameView && m.Game != nil && m.Dialog == nil && !m.ShowHelp &&
+		(m.Game.Phase == game.InitialChoice || m.Game.Phase == game.FinalChoice)
+}
+
+// scrollStats scrolls a statistics page taller than the terminal by lines,
+// up for positive lines, keeping within the page
+func (m *Model) scrollStats(lines int) {
+	limit := max(0, strings.Count(m.renderStats(), "\n")+1-m.Height)
+	m.StatsScroll = min(max(m.StatsScroll+lines, 0), limit)
+}
+
+// statsWindow drops the lines below the statistics scrolled up to. Bubble
+// Tea shows the bottom of a view taller than the terminal, so an unscrolled
+// page shows its end, as it always has
+func (m *Model) statsWindow(page string) string {
+	if m.StatsScroll <= 0 {
+		return page
+	}
+	lines := strings.Split(page, "\n")
+	scroll := min(m.StatsScroll, max(0, len(lines)-m.Height))
+	return strings.Join(lines[:len(lines)-scroll], "\n")
+}
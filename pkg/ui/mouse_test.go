@@ -0,0 +1,144 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// zoneCell returns a screen cell inside the zone View last drew for id
+func zoneCell(t *testing.T, m *Model, id string) (int, int) {
+	t.Helper()
+	m.View()
+	spans := m.zones[id]
+	if len(spans) == 0 {
+		t.Fatalf("Expected zone %q on screen, got %v", id, m.zones)
+	}
+	span := spans[len(spans)/2]
+	return (span.X0 + span.X1) / 2, span.Y
+}
+
+func mousePress(x, y int) tea.MouseMsg {
+	return tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft}
+}
+
+func TestMouseDoors(t *testing.T) {
+	freezeUI(t)
+	m := newSnapshotModel(t)
+	m.Width, m.Height = 100, 50
+	m.Game = game.NewSeededGame(1)
+	m.CurrentView = GameView
+
+	// Hovering moves the highlight to the door under the pointer
+	x, y := zoneCell(t, m, doorZone(2))
+	m.Update(tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionMotion, Button: tea.MouseButtonNone})
+	if m.DoorCursor != 2 {
+		t.Errorf("Expected hovering door 3 to highlight it, cursor is on door %d", m.DoorCursor+1)
+	}
+
+	// Clicking chooses the door
+	x, y = zoneCell(t, m, doorZone(1))
+	m.Update(mousePress(x, y))
+	if m.Game.Phase != game.FinalChoice || m.Game.PlayerInitialChoice != 1 {
+		t.Fatalf("Expected clicking door 2 to choose it, phase %v, choice %d", m.Game.Phase, m.Game.PlayerInitialChoice+1)
+	}
+
+	// The host's open door cannot be clicked
+	opened := m.Game.HostOpenedDoor
+	x, y = zoneCell(t, m, doorZone(opened))
+	m.Update(mousePress(x, y))
+	if m.Game.Phase != game.FinalChoice {
+		t.Error("Expected clicking the host's open door to do nothing")
+	}
+
+	// Doors can still be clicked while the host's door swings open
+	m.ShowAnimations = true
+	m.startDoorOpenAnimation(opened)
+	for door := range m.Game.Doors {
+		zoneCell(t, m, doorZone(door))
+	}
+}
+
+func TestMouseMenuAndFooter(t *testing.T) {
+	freezeUI(t)
+	m := newSnapshotModel(t)
+	m.Width, m.Height = 100, 60
+
+	x, y := zoneCell(t, m, menuZone(1))
+	m.Update(mousePress(x, y))
+	if m.CurrentView != StatsView {
+		t.Fatalf("Expected clicking View Statistics to open them, got view %v", m.CurrentView)
+	}
+
+	x, y = zoneCell(t, m, keyZone("q"))
+	m.Update(mousePress(x, y))
+	if m.CurrentView != MainMenuView {
+		t.Errorf("Expected clicking the q hint to return to the menu, got view %v", m.CurrentView)
+	}
+
+	// Clicking empty space does nothing
+	m.Update(mousePress(0, 0))
+	if m.CurrentView != MainMenuView {
+		t.Errorf("Expected a click outside any button to do nothing, got view %v", m.CurrentView)
+	}
+}
+
+func TestMouseScrollsStats(t *testing.T) {
+	freezeUI(t)
+	dir := t.TempDir()
+	m := newModelWithStats(config.NewManagerWithDefaults(filepath.Join(dir, "config.json")),
+		stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(0)
+	g.SwitchChoice()
+	if err := m.StatsManager.RecordGame(g.Result); err != nil {
+		t.Fatal(err)
+	}
+	m.CurrentView = StatsView
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 20})
+	m.Toast = nil // The first win's achievement would add a line
+
+	bottom := m.View()
+	for range 100 {
+		m.Update(tea.MouseMsg{Action: tea.MouseActionPress, Button: tea.MouseButtonWheelUp})
+	}
+	top := m.View()
+	if m.StatsScroll == 0 || top == bottom {
+		t.Fatal("Expected the wheel to scroll up a page taller than the terminal")
+	}
+	lines := strings.Split(top, "\n")
+	if len(lines) != m.Height {
+		t.Errorf("Expected scrolling to stop at the top of the page, %d lines left for %d rows", len(lines), m.Height)
+	}
+
+	for range 100 {
+		m.Update(tea.MouseMsg{Action: tea.MouseActionPress, Button: tea.MouseButtonWheelDown})
+	}
+	if m.StatsScroll != 0 || m.View() != bottom {
+		t.Error("Expected the wheel to scroll back down to the end of the page")
+	}
+}
+
+func TestScanZones(t *testing.T) {
+	view := "title\n" + markZone("a", "ab\ncd") + " " + markZone("b", "é") + "\nfooter"
+	stripped, zones := scanZones(view, 3)
+
+	if stripped != "title\nab\ncd é\nfooter" {
+		t.Errorf("Expected the markers stripped, got %q", stripped)
+	}
+	// The title line is off screen, so the rest move up a row
+	if want := []zoneSpan{{0, 0, 2}, {1, 0, 2}}; len(zones["a"]) != 2 || zones["a"][0] != want[0] || zones["a"][1] != want[1] {
+		t.Errorf("Expected zone a at %v, got %v", want, zones["a"])
+	}
+	if id, ok := zones.at(3, 1); !ok || id != "b" {
+		t.Errorf("Expected zone b after the gap, got %q", id)
+	}
+	if _, ok := zones.at(2, 1); ok {
+		t.Error("Expected the gap between zones to be empty")
+	}
+}
@@ -0,0 +1,187 @@
+package ui
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// narrativeStreak is how long a streak must be for the streak lines to apply
+const narrativeStreak = 3
+
+// NarrativeContext is what a result narrative can draw on
+type NarrativeContext struct {
+	Won      bool
+	Strategy game.PlayerStrategy
+	Streak   int // Games in a row with this outcome, including this one
+	Door     int // The player's final door, from 1
+}
+
+// Keys returns the keys of the lines that suit the result, such as "win",
+// "switch_win" and, three games or more into a streak, "win_streak"
+func (c NarrativeContext) Keys() []string {
+	outcome := "loss"
+	if c.Won {
+		outcome = "win"
+	}
+	strategy := "stay"
+	if c.Strategy == game.Switch {
+		strategy = "switch"
+	}
+	keys := []string{outcome, strategy + "_" + outcome}
+	if c.Streak >= narrativeStreak {
+		keys = append(keys, outcome+"_streak")
+	}
+	return keys
+}
+
+// NarrativeProvider supplies lines announcing a result. Lines may contain
+// {door} and {streak}, which are replaced by the final door and the streak
+type NarrativeProvider interface {
+	Lines(key string) []string
+}
+
+// NarrativeLines is a provider of fixed lines by key
+type NarrativeLines map[string][]string
+
+// Lines returns the lines for key
+func (n NarrativeLines) Lines(key string) []string {
+	return n[key]
+}
+
+var (
+	narrativesMu sync.RWMutex
+	narratives   = map[string]NarrativeProvider{}
+)
+
+// builtinNarratives are the lines the game ships with
+var builtinNarratives = NarrativeLines{
+	"win": {
+		"CONGRATULATIONS! You won the car!",
+		"The car is yours! Enjoy the drive home!",
+		"Door {door} hid the car, and you found it!",
+	},
+	"switch_win": {
+		"Switching paid off: you won the car!",
+		"You switched and drove away with the car!",
+	},
+	"stay_win": {
+		"You stood your ground and won the car!",
+		"Loyalty rewarded: door {door} had the car!",
+	},
+	"win_streak": {
+		"{streak} wins in a row! The car lot is running out!",
+	},
+	"loss": {
+		"😔 Sorry, you got a goat. Better luck next time!",
+		"🐐 A goat! At least it's good company.",
+		"😔 Door {door} hid a goat this time.",
+	},
+	"switch_loss": {
+		"🐐 You switched, but the goat followed you.",
+	},
+	"stay_loss": {
+		"🐐 You stayed, and so did the goat.",
+	},
+	"loss_streak": {
+		"🐐 {streak} goats in a row. You're starting a farm!",
+	},
+}
+
+func init() {
+	RegisterNarrative("builtin", builtinNarratives)
+}
+
+// RegisterNarrative adds a provider of result lines, replacing any provider
+// already registered with its name. The lines of every provider are pooled
+func RegisterNarrative(name string, provider NarrativeProvider) {
+	narrativesMu.Lock()
+	defer narrativesMu.Unlock()
+	narratives[name] = provider
+}
+
+// UnregisterNarrative removes the provider with the given name, if any
+func UnregisterNarrative(name string) {
+	narrativesMu.Lock()
+	defer narrativesMu.Unlock()
+	delete(narratives, name)
+}
+
+// narrativeLines returns every line for the result, from the registered
+// providers and the lines in the config, in a stable order
+func (m *Model) narrativeLines(ctx NarrativeContext) []string {
+	narrativesMu.RLock()
+	names := make([]string, 0, len(narratives))
+	for name := range narratives {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	providers := make([]NarrativeProvider, 0, len(names)+1)
+	for _, name := range names {
+		providers = append(providers, narratives[name])
+	}
+	narrativesMu.RUnlock()
+
+	// A personality that announces results speaks for the host instead
+	if personality := m.hostPersonality(); personality.Narrates() {
+		providers = []NarrativeProvider{personality}
+	}
+
+	if m.ConfigManager != nil {
+		providers = append(providers, NarrativeLines(m.ConfigManager.Get().Game.Narratives))
+	}
+
+	var lines []string
+	for _, key := range ctx.Keys() {
+		for _, provider := range providers {
+			lines = append(lines, provider.Lines(key)...)
+		}
+	}
+	return lines
+}
+
+// pickNarrative chooses the line announcing the finished game, avoiding the
+// line shown for the previous game when there is another
+func (m *Model) pickNarrative() string {
+	if m.Game == nil || m.Game.Result == nil {
+		return ""
+	}
+
+	result := m.Game.Result
+	ctx := NarrativeContext{Won: result.Won, Strategy: result.Strategy, Door: result.FinalChoice}
+	streaks := m.StatsManager.GetStats().StreakStats
+	if result.Won {
+		ctx.Streak = streaks.CurrentWinStreak
+	} else {
+		ctx.Streak = streaks.CurrentLossStreak
+	}
+
+	lines := slices.DeleteFunc(m.narrativeLines(ctx), func(line string) bool {
+		return strings.TrimSpace(line) == ""
+	})
+	if len(lines) == 0 {
+		return ""
+	}
+	if fresh := slices.DeleteFunc(slices.Clone(lines), func(line string) bool { return line == m.lastNarrative }); len(fresh) > 0 {
+		lines = fresh
+	}
+
+	line := lines[game.SecureIntn(len(lines))]
+	m.lastNarrative = line
+	return strings.NewReplacer(
+		"{door}", strconv.Itoa(ctx.Door),
+		"{streak}", strconv.Itoa(ctx.Streak),
+	).Replace(line)
+}
+
+// resultNarrative returns the line announcing the current game's result, or
+// fallback when none was picked for it
+func (m *Model) resultNarrative(fallback string) string {
+	if m.NarrativeFor != m.Game || m.Narrative == "" {
+		return m.hostPersonality().Voice(fallback)
+	}
+	return m.hostPersonality().Voice(m.Narrative)
+}
@@ -0,0 +1,83 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestNarrativeKeys(t *testing.T) {
+	keys := NarrativeContext{Won: true, Strategy: game.Switch, Streak: 1}.Keys()
+	if !slices.Equal(keys, []string{"win", "switch_win"}) {
+		t.Errorf("Expected win and switch_win, got %v", keys)
+	}
+	keys = NarrativeContext{Strategy: game.Stay, Streak: narrativeStreak}.Keys()
+	if !slices.Equal(keys, []string{"loss", "stay_loss", "loss_streak"}) {
+		t.Errorf("Expected the streak lines on a losing streak, got %v", keys)
+	}
+
+	for _, key := range config.GetNarrativeKeys() {
+		if len(builtinNarratives[key]) == 0 {
+			t.Errorf("Expected built-in lines for %q", key)
+		}
+	}
+}
+
+func TestResultNarrative(t *testing.T) {
+	UnregisterNarrative("builtin")
+	t.Cleanup(func() { RegisterNarrative("builtin", builtinNarratives) })
+
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	cfg := configManager.Get()
+	cfg.Game.Narratives = map[string][]string{
+		"stay_loss": {"Door {door} again? The goat thanks you."},
+		"win":       {"First line", "Second line"},
+	}
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+	model.CurrentView = GameView
+
+	// A config line is picked, with its door filled in
+	model.Game = game.NewSeededGame(1)
+	model.Game.MakeInitialChoice((model.Game.CarPosition + 1) % 3)
+	model.Game.StayWithChoice()
+	model.startRevealDelay()
+	model.finishReveal()
+	want := fmt.Sprintf("Door %d again? The goat thanks you.", model.Game.PlayerFinalChoice+1)
+	if view := plainText(model.renderGame()); !strings.Contains(view, want) {
+		t.Errorf("Expected %q in the result, got:\n%s", want, view)
+	}
+
+	// Wins alternate between the lines rather than repeating one
+	var shown []string
+	for seed := range int64(4) {
+		model.Game = game.NewSeededGame(seed)
+		model.Game.MakeInitialChoice(model.Game.CarPosition)
+		model.Game.StayWithChoice()
+		model.startRevealDelay()
+		model.finishReveal()
+		shown = append(shown, model.Narrative)
+	}
+	for i := 1; i < len(shown); i++ {
+		if shown[i] == shown[i-1] {
+			t.Errorf("Expected consecutive wins to differ, got %v", shown)
+		}
+	}
+
+	// A game without a picked line falls back on the classic one
+	model.Game = game.NewSeededGame(2)
+	model.Game.MakeInitialChoice(model.Game.CarPosition)
+	model.Game.StayWithChoice()
+	if got := model.resultNarrative("CONGRATULATIONS! You won the car!"); got != "CONGRATULATIONS! You won the car!" {
+		t.Errorf("Expected the fallback line, got %q", got)
+	}
+}
@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// NewGameOptions controls how the next game is set up
+type NewGameOptions struct {
+	SameDoors     bool // Replay the previous car placement as practice (applies to the next game only)
+	CarryStrategy bool // Pre-select the previous stay/switch decision at the final choice
+	AutoPlay      bool // Watch the simulation instead of playing (applies to this prompt only)
+}
+
+// NewGamePrompt is the play-again micro-prompt shown after a game
+type NewGamePrompt struct {
+	Options NewGameOptions
+	Cursor  int
+}
+
+// newGamePromptItems are the toggles shown in the prompt, in order
+var newGamePromptItems = []string{
+	"Replay the same doors (practice)",
+	"Carry over my stay/switch choice",
+	"Jump straight to auto-play",
+}
+
+// openNewGamePrompt shows the play-again options, starting from the current ones
+func (m *Model) openNewGamePrompt() {
+	m.NewGamePrompt = &NewGamePrompt{Options: m.NewGameOptions}
+}
+
+// handleNewGamePromptKeys processes input while the play-again options are shown
+func (m *Model) handleNewGamePromptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	prompt := m.NewGamePrompt
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case KeyEscape, KeyQ:
+		m.NewGamePrompt = nil
+
+	case KeyUp, "k":
+		if prompt.Cursor > 0 {
+			prompt.Cursor--
+		}
+
+	case KeyDown, "j":
+		if prompt.Cursor < len(newGamePromptItems)-1 {
+			prompt.Cursor++
+		}
+
+	case KeySpace, " ", "x":
+		prompt.toggle()
+
+	case KeyEnter:
+		m.NewGameOptions = prompt.Options
+		m.NewGamePrompt = nil
+		if m.NewGameOptions.AutoPlay {
+			m.NewGameOptions.AutoPlay = false
+			return m, m.startAutoPlay()
+		}
+		m.startNewGame()
+	}
+
+	return m, nil
+}
+
+// toggle flips the option under the cursor
+func (p *NewGamePrompt) toggle() {
+	switch p.Cursor {
+	case 0:
+		p.Options.SameDoors = !p.Options.SameDoors
+	case 1:
+		p.Options.CarryStrategy = !p.Options.CarryStrategy
+	case 2:
+		p.Options.AutoPlay = !p.Options.AutoPlay
+	}
+}
+
+// applyCarriedStrategy moves the cursor to the door matching the previous game's
+// stay/switch decision when that option is on
+func (m *Model) applyCarriedStrategy() {
+	if !m.NewGameOptions.CarryStrategy || m.LastGame == nil || m.LastGame.Result == nil {
+		return
+	}
+
+	m.DoorCursor = m.Game.PlayerInitialChoice
+	if m.LastGame.Result.Strategy == game.Switch {
+		for _, door := range m.getSelectableDoors() {
+			if door != m.Game.PlayerInitialChoice {
+				m.DoorCursor = door
+			}
+		}
+	}
+}
+
+// Render renders the play-again options
+func (p *NewGamePrompt) Render() string {
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(SecondaryColor).
+		Padding(0, 2)
+
+	values := []bool{p.Options.SameDoors, p.Options.CarryStrategy, p.Options.AutoPlay}
+
+	lines := []string{TitleStyle.Render("NEW GAME OPTIONS")}
+	for i, item := range newGamePromptItems {
+		check := "[ ]"
+		if values[i] {
+			check = "[x]"
+		}
+
+		style := StatsLabelStyle
+		marker := "  "
+		if i == p.Cursor {
+			style = lipgloss.NewStyle().Foreground(SelectedColor).Bold(true)
+			marker = "▶ "
+		}
+		lines = append(lines, style.Render(marker+check+" "+item))
+	}
+	lines = append(lines, MutedStyle.Render("Space toggle • Enter start • ESC cancel"))
+
+	return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
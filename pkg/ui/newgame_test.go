@@ -0,0 +1,197 @@
+package ui
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// finishGame plays the current game to the end with the given strategy
+func finishGame(t *testing.T, model *Model, door int, switchDoors bool) {
+	t.Helper()
+
+	if err := model.Game.MakeInitialChoice(door); err != nil {
+		t.Fatalf("Unexpected error making initial choice: %v", err)
+	}
+
+	var err error
+	if switchDoors {
+		err = model.Game.SwitchChoice()
+	} else {
+		err = model.Game.StayWithChoice()
+	}
+	if err != nil {
+		t.Fatalf("Unexpected error making final choice: %v", err)
+	}
+}
+
+func TestNewGameRemembersDoor(t *testing.T) {
+	model := NewModel()
+	model.CurrentView = GameView
+	model.startNewGame()
+
+	finishGame(t, model, 2, false)
+	model.startNewGame()
+
+	if model.DoorCursor != 2 {
+		t.Errorf("Expected cursor on the previous door 2, got %d", model.DoorCursor)
+	}
+
+	model.RememberDoor = false
+	finishGame(t, model, 1, false)
+	model.startNewGame()
+
+	if model.DoorCursor != 0 {
+		t.Errorf("Expected cursor on the first door when not remembering, got %d", model.DoorCursor)
+	}
+}
+
+func TestNewGamePromptReplaysSameDoors(t *testing.T) {
+	dir := t.TempDir()
+	model := newModelWithStats(config.NewManagerWithDefaults(filepath.Join(dir, "config.json")), stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+	model.CurrentView = GameView
+	model.startNewGame()
+
+	finishGame(t, model, 0, true)
+	carPosition := model.Game.CarPosition
+
+	model.handleGameKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if model.NewGamePrompt == nil {
+		t.Fatal("Expected r to open the new game options")
+	}
+
+	// The space bar arrives as " ", not "space"
+	model.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if !model.NewGamePrompt.Options.SameDoors {
+		t.Fatal("Expected space to turn on replaying the same doors")
+	}
+	model.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if model.NewGamePrompt != nil {
+		t.Error("Expected the prompt to close after starting a game")
+	}
+	if model.Game.Phase != game.InitialChoice || !model.Rematch {
+		t.Errorf("Expected a fresh rematch, got phase %v", model.Game.Phase)
+	}
+	if model.Game.CarPosition != carPosition {
+		t.Errorf("Expected car at %d in the replay, got %d", carPosition, model.Game.CarPosition)
+	}
+	if model.NewGameOptions.SameDoors {
+		t.Error("Replaying the same doors should only apply to one game")
+	}
+
+	// Knowing where the car is, the rematch only counts as practice
+	finishGame(t, model, carPosition, false)
+	model.recordResult()
+	history := model.StatsManager.GetStats().GameHistory
+	if len(history) != 1 || !history[0].Practice {
+		t.Errorf("Expected the rematch recorded as practice, got %+v", history)
+	}
+
+	model.startNewGame()
+	if model.Rematch {
+		t.Error("Expected the game after a rematch to be a regular one")
+	}
+}
+
+func TestNewGamePromptStartsAutoPlay(t *testing.T) {
+	model := NewModel()
+	model.CurrentView = GameView
+	model.startNewGame()
+	finishGame(t, model, 0, true)
+
+	model.handleGameKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	model.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	model.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	model.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if view := plainText(model.NewGamePrompt.Render()); !strings.Contains(view, "[x] Jump straight to auto-play") {
+		t.Fatalf("Expected auto-play checked, got:\n%s", view)
+	}
+
+	_, cmd := model.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.CurrentView != SimulationView || model.AutoPlay == nil || cmd == nil {
+		t.Fatalf("Expected Enter to start auto-play, got view %v", model.CurrentView)
+	}
+	if model.NewGameOptions.AutoPlay {
+		t.Error("Expected auto-play to apply to this prompt only")
+	}
+}
+
+func TestCarryStrategyPreselectsSwitch(t *testing.T) {
+	model := NewModel()
+	model.CurrentView = GameView
+	model.startNewGame()
+
+	finishGame(t, model, 0, true)
+	model.NewGameOptions.CarryStrategy = true
+	model.startNewGame()
+
+	model.DoorCursor = 1
+	model.selectDoor()
+	model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+
+	if model.DoorCursor == model.Game.PlayerInitialChoice || model.DoorCursor == model.Game.HostOpenedDoor {
+		t.Errorf("Expected cursor on the switch door, got %d (chosen %d, opened %d)",
+			model.DoorCursor, model.Game.PlayerInitialChoice, model.Game.HostOpenedDoor)
+	}
+}
+
+func TestManyDoorsGame(t *testing.T) {
+	model := NewModel()
+	model.NumDoors = 20
+	model.CurrentView = GameView
+	model.startNewGame()
+
+	if model.Game.NumDoors() != 20 {
+		t.Fatalf("Expected a 20-door game, got %d doors", model.Game.NumDoors())
+	}
+	if !model.usesDoorsGrid() {
+		t.Fatal("Expected 20 doors to be drawn as a grid at 80 columns")
+	}
+
+	columns := DoorsGridColumns(20, model.Width)
+	model.handleGameKeys(tea.KeyMsg{Type: tea.KeyDown})
+	if model.DoorCursor != columns {
+		t.Errorf("Expected down to move the cursor a row to door %d, got %d", columns, model.DoorCursor)
+	}
+
+	model.handleGameKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("9")})
+	if model.DoorCursor != 8 {
+		t.Errorf("Expected 9 to select door index 8, got %d", model.DoorCursor)
+	}
+
+	model.selectDoor()
+	model.Update(HostRevealMsg{Seq: model.HostRevealSeq})
+	if selectable := model.getSelectableDoors(); len(selectable) != 2 {
+		t.Errorf("Expected 2 selectable doors after the host opens 18, got %v", selectable)
+	}
+	if view := model.View(); !strings.Contains(view, "The host opened 18 doors") {
+		t.Error("Expected the final choice to say how many doors the host opened")
+	}
+}
+
+func TestSeedGamesReplays(t *testing.T) {
+	play := func() []int {
+		model := NewModel()
+		model.SeedGames(42)
+		var cars []int
+		for i := 0; i < 5; i++ {
+			model.startNewGame()
+			cars = append(cars, model.Game.CarPosition)
+			if seed, ok := model.Game.Seed(); !ok || seed != int64(42+i) {
+				t.Fatalf("Expected game %d to use seed %d, got %d", i+1, 42+i, seed)
+			}
+		}
+		return cars
+	}
+
+	if first, second := play(), play(); !slices.Equal(first, second) {
+		t.Errorf("Expected the same seed to place the cars the same way, got %v and %v", first, second)
+	}
+}
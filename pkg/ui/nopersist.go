@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sessionSummary counts the games played this session, which in no-persist
+// mode are all the statistics there are
+func (m *Model) sessionSummary() string {
+	gameStats := m.StatsManager.GetStats()
+	games := "games"
+	if gameStats.TotalGames == 1 {
+		games = "game"
+	}
+	return fmt.Sprintf("This session: %d %s, %d won", gameStats.TotalGames, games, gameStats.TotalWins)
+}
+
+// renderSessionStatus reminds kiosk players that nothing they play is saved
+func (m *Model) renderSessionStatus() string {
+	if !m.NoPersist {
+		return ""
+	}
+	status := "🔒 Nothing is saved  •  " + m.sessionSummary()
+	return Center(MutedStyle.Render(status), m.Width, 1)
+}
+
+// renderNoPersistBanner is the main menu notice of no-persist mode
+func (m *Model) renderNoPersistBanner() string {
+	return lipgloss.NewStyle().Foreground(WarningColor).Bold(true).Render(
+		"KIOSK MODE: nothing is saved  •  " + m.sessionSummary())
+}
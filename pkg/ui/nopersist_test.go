@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+func TestNoPersistMode(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "config.json")
+	configManager := config.NewManagerWithDefaults(configPath)
+	cfg := configManager.Get()
+	cfg.Stats.NoPersist = true
+	cfg.Stats.ExportDirectory = dir
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	model := NewModelWithConfig(configManager)
+	if !model.NoPersist || !model.StatsManager.IsReadOnly() {
+		t.Fatal("The no_persist setting should start a model that saves nothing")
+	}
+	if model.StatsManager.GetStats().TotalGames != 0 {
+		t.Error("Statistics should start empty")
+	}
+	for _, option := range model.menuOptions() {
+		if option.Label == "Settings" {
+			t.Error("The settings menu should be hidden")
+		}
+	}
+	if !strings.Contains(plainText(model.View()), "KIOSK MODE") {
+		t.Error("The main menu should say that nothing is saved")
+	}
+
+	// Games play normally and are counted for the session
+	model.startNewGame()
+	model.CurrentView = GameView
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(0)
+	g.StayWithChoice()
+	model.Game = g
+	if err := model.StatsManager.RecordGame(g.Result); err != nil {
+		t.Fatal(err)
+	}
+	view := plainText(model.renderGame())
+	if !strings.Contains(view, "Nothing is saved") || !strings.Contains(view, "This session: 1 game,") {
+		t.Errorf("The game view should count the session's games, got:\n%s", view)
+	}
+	if strings.Contains(view, "Save image") {
+		t.Error("Saving images should be hidden")
+	}
+
+	// Export and reset are hidden and do nothing
+	model.CurrentView = StatsView
+	footer := plainText(model.statsFooter(true))
+	if strings.Contains(footer, "Export") || strings.Contains(footer, "Reset") {
+		t.Errorf("Export and reset should be hidden, got %q", footer)
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r")})
+	if model.ExportDraft != nil || model.ShowResetConfirmation {
+		t.Error("Export and reset keys should do nothing")
+	}
+
+	model.flushConfig()
+	model.autoExport()
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Nothing should be written, found %d files", len(entries))
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/uistate"
+)
+
+// OnboardingGames is how many of a new player's first games show tips
+// explaining the keys and the host
+const OnboardingGames = 3
+
+// startOnboarding begins onboarding for players without statistics. Players
+// who played before onboarding existed are treated as having finished it
+func startOnboarding(state *uistate.State, statsManager *stats.StatsManager) {
+	if _, ok := state.Onboarding(); ok {
+		return
+	}
+	progress := uistate.Onboarding{}
+	if statsManager.GetStats().TotalGames > 0 {
+		progress.GamesPlayed = OnboardingGames
+	}
+	// Like the badges, onboarding is a convenience, so failing to save it is not reported
+	_ = state.SetOnboarding(progress)
+}
+
+// onboardingGame returns which of the first games (1 to OnboardingGames) is
+// on screen, or 0 once onboarding is over. A finished game has already been
+// counted, so its result still shows the tips of that game
+func (m *Model) onboardingGame() int {
+	if m.UIState == nil || m.Game == nil {
+		return 0
+	}
+	progress, ok := m.UIState.Onboarding()
+	if !ok {
+		return 0
+	}
+
+	played := progress.GamesPlayed + 1
+	if m.Game.IsGameOver() {
+		played--
+	}
+	if played < 1 || played > OnboardingGames {
+		return 0
+	}
+	return played
+}
+
+// recordOnboardingGame counts a finished game towards onboarding. Practice
+// and worksheet games show no tips, so they don't count
+func (m *Model) recordOnboardingGame() {
+	if m.UIState == nil || m.Practice != nil || m.Worksheet != nil {
+		return
+	}
+	progress, ok := m.UIState.Onboarding()
+	if !ok || progress.GamesPlayed >= OnboardingGames {
+		return
+	}
+	progress.GamesPlayed++
+	_ = m.UIState.SetOnboarding(progress)
+}
+
+// onboardingTips explains why the host opened their doors, once they have,
+// and ends with what the keys do in the current phase
+func (m *Model) onboardingTips() []string {
+	g := m.Game
+	switch g.Phase {
+	case game.InitialChoice:
+		return []string{fmt.Sprintf("Move with ←→ or %s; Enter picks a door", doorChoices(g.NumDoors()))}
+
+	case game.FinalChoice:
+		var why string
+		switch {
+		case g.CarRevealed():
+			why = "This host opens doors at random, and this time found the car"
+		case g.Host.Behavior == game.HostFall:
+			why = "This host opens doors at random; this time they found only goats"
+		case len(g.HostOpenedDoors) > 1:
+			why = "The host knew the car's door and opened only goat doors, not yours"
+		default:
+			why = fmt.Sprintf("The host knew the car's door and opened goat door %d, not yours", g.HostOpenedDoor+1)
+		}
+		return []string{why, "Enter keeps the door under the cursor; s switches"}
+
+	case game.GameOver:
+		return []string{"Enter plays again, r shows game options, Esc the menu"}
+	}
+	return nil
+}
+
+// renderOnboardingStatus shows tips during a new player's first games. They
+// fade with each game and stop after OnboardingGames. Like strategy hints,
+// they follow the hints setting and stay out of practice and worksheet games
+func (m *Model) renderOnboardingStatus() string {
+	played := m.onboardingGame()
+	if played == 0 || !m.ShowHints || m.Practice != nil || m.Worksheet != nil {
+		return ""
+	}
+
+	style := lipgloss.NewStyle().Foreground(SecondaryColor)
+	if played > 1 {
+		style = MutedStyle
+	}
+	if played == OnboardingGames && !NoDim {
+		style = style.Faint(true)
+	}
+
+	tips := m.onboardingTips()
+	if len(tips) == 0 {
+		return ""
+	}
+	tips[len(tips)-1] = fmt.Sprintf("🧭 Tip %d/%d: %s", played, OnboardingGames, tips[len(tips)-1])
+	lines := make([]string, len(tips))
+	for i, tip := range tips {
+		lines[i] = Center(style.Render(tip), m.Width, 1)
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, lines...)
+}
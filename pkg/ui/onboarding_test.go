@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestOnboarding(t *testing.T) {
+	freezeUI(t)
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	newModel := func() *Model {
+		model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+		model.Width, model.Height = 100, 50
+		model.CurrentView = GameView
+		return model
+	}
+	play := func(model *Model) {
+		model.Game = game.NewSeededGame(1)
+		if status := plainText(model.renderOnboardingStatus()); !strings.Contains(status, "Enter picks a door") {
+			t.Fatalf("Expected a tip for picking a door, got %q", status)
+		}
+		model.Game.MakeInitialChoice(0)
+		status := plainText(model.renderOnboardingStatus())
+		if !strings.Contains(status, "not yours") || !strings.Contains(status, "s switches") {
+			t.Fatalf("Expected the host's door and the keys explained, got %q", status)
+		}
+		model.Game.StayWithChoice()
+		model.recordResult()
+	}
+
+	// The first games are counted between runs, and each result keeps its game's tips
+	model := newModel()
+	play(model)
+	if status := plainText(model.renderOnboardingStatus()); !strings.Contains(status, "Tip 1/3") {
+		t.Errorf("Expected the first result to show the first game's tip, got %q", status)
+	}
+	model = newModel()
+	play(model)
+	play(model)
+	if status := model.renderOnboardingStatus(); !strings.Contains(plainText(status), "Tip 3/3") {
+		t.Errorf("Expected the third result to show the last tip, got %q", plainText(status))
+	}
+
+	model.Game = game.NewSeededGame(2)
+	if status := model.renderOnboardingStatus(); status != "" {
+		t.Errorf("Expected no tips after %d games, got %q", OnboardingGames, plainText(status))
+	}
+}
+
+func TestOnboardingSkipsExperiencedPlayers(t *testing.T) {
+	freezeUI(t)
+	dir := t.TempDir()
+	statsManager := stats.NewStatsManager(filepath.Join(dir, "stats.json"))
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(0)
+	g.SwitchChoice()
+	if err := statsManager.RecordGame(g.Result); err != nil {
+		t.Fatal(err)
+	}
+
+	model := newModelWithStats(config.NewManagerWithDefaults(filepath.Join(dir, "config.json")), statsManager)
+	model.Game = game.NewSeededGame(2)
+	if model.onboardingGame() != 0 {
+		t.Error("Expected players with statistics to skip onboarding")
+	}
+}
@@ -0,0 +1,44 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// hostPersonality returns the configured host personality, switching when the
+// setting changes. The standard host has no lines of its own
+func (m *Model) hostPersonality() *game.HostPersonality {
+	name := game.DefaultHostPersonality
+	if m.ConfigManager != nil {
+		name = m.ConfigManager.Get().Game.HostPersonality
+	}
+
+	personality, _ := game.FindHostPersonality(name) // Falls back to the standard host
+	return personality
+}
+
+// pickHostLines chooses which of the personality's lines the host says in
+// the new game, so a line stays put while it is on screen
+func (m *Model) pickHostLines() {
+	m.hostLinePick = game.SecureIntn(math.MaxInt32)
+}
+
+// hostLine returns what the host says at key in the current game, or
+// fallback when the personality leaves it to the standard line
+func (m *Model) hostLine(key, fallback string) string {
+	if line := m.hostPersonality().Say(key, m.Game, m.hostLinePick); line != "" {
+		return line
+	}
+	return fallback
+}
+
+// renderHostLine renders what the host says at key, or an empty line for the
+// standard host, who leaves the game to speak for itself
+func (m *Model) renderHostLine(key string) string {
+	line := m.hostLine(key, "")
+	if line == "" {
+		return ""
+	}
+	return Center(MutedStyle.Render(line), m.Width, 1)
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestHostPersonality(t *testing.T) {
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	setPersonality := func(name string) *Model {
+		cfg := configManager.Get().Clone()
+		cfg.Game.HostPersonality = name
+		if err := configManager.Update(cfg); err != nil {
+			t.Fatal(err)
+		}
+		model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, name+".json")))
+		model.CurrentView = GameView
+		model.startNewGame()
+		model.hostLinePick = 0
+		return model
+	}
+
+	// The statistician quotes the odds once the host has opened a door
+	model := setPersonality("statistician")
+	if view := plainText(model.View()); !strings.Contains(view, "any pick has a 33% chance") {
+		t.Errorf("Expected the statistician's greeting, got:\n%s", view)
+	}
+	model.Game.MakeInitialChoice(0)
+	if view := plainText(model.View()); !strings.Contains(view, "switching wins 67%") {
+		t.Errorf("Expected the statistician to quote the odds of switching, got:\n%s", view)
+	}
+
+	// The deadpan host announces results in its own words, without emoji
+	model = setPersonality("deadpan")
+	model.Game.MakeInitialChoice((model.Game.CarPosition + 1) % 3)
+	model.Game.StayWithChoice()
+	model.recordResult()
+	model.finishReveal()
+	if model.Narrative != "Goat. It happens." && model.Narrative != "A goat. Try not to cry." {
+		t.Errorf("Expected a deadpan result line, got %q", model.Narrative)
+	}
+	if got := model.resultNarrative("😔 Sorry, you got a goat."); game.StripEmoji(got) != got {
+		t.Errorf("Expected the deadpan host to drop emoji, got %q", got)
+	}
+
+	// The standard host keeps the standard lines
+	model = setPersonality(game.DefaultHostPersonality)
+	model.Game.MakeInitialChoice(0)
+	model.IsRevealing = true
+	if view := plainText(model.View()); !strings.Contains(view, "The host is opening a door...") {
+		t.Errorf("Expected the standard reveal line, got:\n%s", view)
+	}
+}
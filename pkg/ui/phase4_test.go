@@ -72,7 +72,7 @@ func TestPhase4EnhancedStyling(t *testing.T) {
 	}
 
 	// Test winning message
-	winMessage := CreateWinningMessage("YOU WIN!")
+	winMessage := CreateWinningMessage("YOU WIN!", EffectsFull)
 	if winMessage == "" {
 		t.Error("Winning message should not be empty")
 	}
@@ -233,13 +233,13 @@ func TestPhase4VisualEffects(t *testing.T) {
 	}
 
 	// Test glow effect
-	glowText := CreateGlowEffect("TEST", 0.8)
+	glowText := CreateGlowEffect("TEST", 0.8, EffectsFull)
 	if glowText == "" {
 		t.Error("Glow effect should not be empty")
 	}
 
 	// Test pulse effect
-	pulseText := CreatePulseEffect("TEST", 0.5)
+	pulseText := CreatePulseEffect("TEST", 0.5, EffectsFull)
 	if pulseText == "" {
 		t.Error("Pulse effect should not be empty")
 	}
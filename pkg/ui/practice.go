@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// PracticeRounds is the number of games in a practice session
+const PracticeRounds = 10
+
+// PracticeSession tracks a run of practice games played after a simulated losing streak
+type PracticeSession struct {
+	Weakness stats.Weakness // Behavior before the session started
+	Targeted bool           // A weakness was detected in the player's history
+	Played   int
+	Switched int
+}
+
+// IsComplete returns true once every practice round has been played
+func (p *PracticeSession) IsComplete() bool {
+	return p.Played >= PracticeRounds
+}
+
+// startPractice begins a practice session aimed at the player's weakest situation
+func (m *Model) startPractice() {
+	weakness, targeted := m.StatsManager.Weakness()
+	m.Practice = &PracticeSession{Weakness: weakness, Targeted: targeted}
+	m.Daily = nil
+	m.Worksheet = nil
+	m.Hotseat = nil
+	m.Ladder = nil
+	m.startNewGame()
+	m.CurrentView = GameView
+}
+
+// recordPracticeRound counts the finished game towards the practice session
+func (m *Model) recordPracticeRound() {
+	if m.Practice == nil || m.Practice.IsComplete() {
+		return
+	}
+
+	m.Practice.Played++
+	if m.Game.Result.Strategy == game.Switch {
+		m.Practice.Switched++
+	}
+}
+
+// renderPracticeStatus renders the practice banner with the simulated losing streak
+func (m *Model) renderPracticeStatus() string {
+	if m.Practice == nil {
+		return ""
+	}
+	round := m.Practice.Played
+	if m.Game.Phase != game.GameOver {
+		round++
+	}
+
+	streak := strings.TrimSpace(strings.Repeat("✗ ", stats.LosingStreakLength))
+	scenario := fmt.Sprintf("Scenario: you just lost %d games in a row %s", stats.LosingStreakLength, streak)
+	status := fmt.Sprintf("🎯 Practice  •  Round %d/%d  •  Switched %d", round, PracticeRounds, m.Practice.Switched)
+
+	return lipgloss.JoinVertical(lipgloss.Center,
+		Center(lipgloss.NewStyle().Foreground(AccentColor).Bold(true).Render(status), m.Width, 1),
+		Center(MutedStyle.Render(scenario), m.Width, 1),
+	)
+}
+
+// renderPracticeSummary compares the session with the player's earlier behavior
+func (m *Model) renderPracticeSummary() string {
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(AccentColor).
+		Padding(0, 2)
+
+	session := stats.SwitchRate{Switched: m.Practice.Switched, Games: m.Practice.Played}
+	lines := []string{
+		TitleStyle.Render("PRACTICE COMPLETE"),
+		SubtitleStyle.Render(fmt.Sprintf("You switched in %d of %d games (%.0f%%)",
+			session.Switched, session.Games, session.Rate()*100)),
+	}
+
+	if before := m.Practice.Weakness.AfterLosses; before.Games > 0 {
+		lines = append(lines, StatsLabelStyle.Render(fmt.Sprintf(
+			"Before practice you switched %.0f%% of the time after losing streaks", before.Rate()*100)))
+	}
+	lines = append(lines, MutedStyle.Render(fmt.Sprintf("Switching wins %d/%d of the time, whatever happened in earlier games",
+		m.NumDoors-1, m.NumDoors)))
+
+	return Center(boxStyle.Render(lipgloss.JoinVertical(lipgloss.Center, lines...)), m.Width, 1)
+}
+
+// renderPracticeComparison renders the before/after practice section of the stats view
+func (m *Model) renderPracticeComparison() []string {
+	comparison, practiced := m.StatsManager.PracticeComparison()
+	weakness, weak := m.StatsManager.Weakness()
+	if !practiced && !weak {
+		return nil
+	}
+
+	var content []string
+	content = append(content, Spacer(1))
+	content = append(content, Center(StatsHeaderStyle.Render("🎯 AFTER LOSING STREAKS"), m.Width, 1))
+
+	if !practiced {
+		advice := fmt.Sprintf("You switch only %.0f%% of the time after %d losses in a row — try Practice from the main menu",
+			weakness.AfterLosses.Rate()*100, stats.LosingStreakLength)
+		content = append(content, Center(lipgloss.NewStyle().Foreground(WarningColor).Render(advice), m.Width, 1))
+		return content
+	}
+
+	rows := []struct {
+		label string
+		rate  stats.SwitchRate
+	}{
+		{"Before practice", comparison.Before},
+		{"During practice", comparison.Practice},
+		{"After practice", comparison.After},
+	}
+	for _, row := range rows {
+		line := fmt.Sprintf("%-16s %s", row.label+":", "no games yet")
+		if row.rate.Games > 0 {
+			line = fmt.Sprintf("%-16s switched %.0f%% (%d of %d)", row.label+":",
+				row.rate.Rate()*100, row.rate.Switched, row.rate.Games)
+		}
+		content = append(content, Center(StatsLabelStyle.Render(line), m.Width, 1))
+	}
+
+	if comparison.Before.Games > 0 && comparison.After.Games > 0 {
+		change := comparison.Improvement() * 100
+		if change > 0 {
+			content = append(content, Center(SuccessStyle.Render(fmt.Sprintf("▲ Switching %.0f points more since practicing", change)), m.Width, 1))
+		} else {
+			content = append(content, Center(MutedStyle.Render(fmt.Sprintf("▼ Switching %.0f points less since practicing", -change)), m.Width, 1))
+		}
+	}
+
+	return content
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestPracticeSession(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+
+	model.startPractice()
+	if model.Practice == nil || model.CurrentView != GameView {
+		t.Fatal("Expected a practice session in the game view")
+	}
+	if !strings.Contains(model.View(), "Scenario: you just lost") {
+		t.Error("Expected the simulated losing streak to be shown")
+	}
+
+	for i := 0; i < PracticeRounds; i++ {
+		finishGame(t, model, 0, i%2 == 0)
+		model.recordResult()
+		if i < PracticeRounds-1 {
+			model.startNewGame()
+		}
+	}
+
+	if !model.Practice.IsComplete() || model.Practice.Switched != PracticeRounds/2 {
+		t.Errorf("Expected a complete session with %d switches, got %+v", PracticeRounds/2, model.Practice)
+	}
+	for _, record := range model.StatsManager.GetStats().GameHistory {
+		if !record.Practice {
+			t.Error("Expected practice games to be recorded as practice")
+		}
+	}
+
+	model.ShowResult = true
+	if !strings.Contains(model.View(), "PRACTICE COMPLETE") {
+		t.Error("Expected the practice summary after the last round")
+	}
+
+	model.startNewGame()
+	if model.Practice != nil {
+		t.Error("Expected regular games after the session is complete")
+	}
+}
@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// KeyProbability shows or hides the probability panel in the game view
+const KeyProbability = "p"
+
+// probabilityBarWidth is the width of each door's bar in the probability panel
+const probabilityBarWidth = 4
+
+// doorProbabilities returns the chance the car is behind each door given what
+// the player has seen: even before the host acts, and the Bayesian posterior
+// after the host opens doors
+func doorProbabilities(g *game.Game) []float64 {
+	chances := make([]float64, g.NumDoors())
+	if beliefs := g.BayesianUpdate(); beliefs != nil {
+		for door, belief := range beliefs {
+			chances[door] = belief.Posterior
+		}
+		return chances
+	}
+
+	for door := range chances {
+		chances[door] = 1 / float64(len(chances))
+	}
+	return chances
+}
+
+// renderProbabilityPanel draws the chance of the car being behind each
+// unopened door beside the doors while the player is choosing
+func (m *Model) renderProbabilityPanel() string {
+	if !m.ShowProbability {
+		return ""
+	}
+
+	numberWidth := len(fmt.Sprint(m.Game.NumDoors()))
+	lines := []string{StatsHeaderStyle.Render("Car chances")}
+	for door, chance := range doorProbabilities(m.Game) {
+		if m.Game.Doors[door].IsOpen() {
+			continue
+		}
+		style := StatsLabelStyle
+		if m.Game.Phase == game.FinalChoice && door == m.Game.PlayerInitialChoice {
+			style = lipgloss.NewStyle().Foreground(AccentColor)
+		}
+
+		filled := int(chance*probabilityBarWidth + 0.5)
+		bar := strings.Repeat("█", filled) + strings.Repeat("░", probabilityBarWidth-filled)
+		lines = append(lines, style.Render(fmt.Sprintf("Door %-*d %s %3.0f%%", numberWidth, door+1, bar, chance*100)))
+	}
+	lines = append(lines, MutedStyle.Render(KeyProbability+" hides"))
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// toggleProbabilityPanel shows or hides the probability panel, saving the
+// choice like any other setting
+func (m *Model) toggleProbabilityPanel() tea.Cmd {
+	if m.ConfigManager == nil {
+		m.ShowProbability = !m.ShowProbability
+		return nil
+	}
+
+	cfg := m.ConfigManager.Get()
+	cfg.Game.ShowProbability = !cfg.Game.ShowProbability
+	cmd := m.applyConfig(cfg)
+	m.applySettings(m.ConfigManager.Get())
+	return cmd
+}
@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"math"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestProbabilityPanel(t *testing.T) {
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json")))
+	model.Game = game.NewSeededGame(1)
+	model.CurrentView = GameView
+
+	if !model.ShowProbability {
+		t.Fatal("The panel should follow game.show_probability, which is on by default")
+	}
+	for door, chance := range doorProbabilities(model.Game) {
+		if math.Abs(chance-1.0/3) > 1e-9 {
+			t.Errorf("Door %d should start at 1/3, got %.3f", door+1, chance)
+		}
+	}
+
+	if err := model.Game.MakeInitialChoice(0); err != nil {
+		t.Fatal(err)
+	}
+	opened := model.Game.HostOpenedDoors[0]
+	chances := doorProbabilities(model.Game)
+	if math.Abs(chances[0]-1.0/3) > 1e-9 || chances[opened] != 0 || math.Abs(chances[3-opened]-2.0/3) > 1e-9 {
+		t.Errorf("Expected 1/3 to stay and 2/3 to switch after the reveal, got %v", chances)
+	}
+
+	panel := plainText(model.renderProbabilityPanel())
+	if !strings.Contains(panel, "Car chances") || !strings.Contains(panel, "67%") {
+		t.Errorf("Expected the updated chances in the panel, got:\n%s", panel)
+	}
+	if strings.Contains(panel, "Door "+string(rune('1'+opened))) {
+		t.Errorf("Opened doors should be left out of the panel, got:\n%s", panel)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyProbability)})
+	if model.ShowProbability || configManager.Get().Game.ShowProbability || model.renderProbabilityPanel() != "" {
+		t.Error("p should hide the panel and remember the setting")
+	}
+	if strings.Contains(plainText(model.View()), "Car chances") {
+		t.Error("A hidden panel should not be drawn")
+	}
+}
@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// ProfilePicker lists the player profiles in ProfilesView, or takes the name
+// of a new one
+type ProfilePicker struct {
+	Cursor int
+	Games  map[string]int // Games played by each profile when the picker opened
+	Naming bool           // A new profile's name is being typed
+	Name   string
+	Err    string
+}
+
+// setupProfiles keeps a profile for each player beside the stats file and
+// switches to the one last played. Statistics kept elsewhere have a single
+// profile
+func (m *Model) setupProfiles() {
+	file, ok := m.StatsManager.Store().(stats.FileStore)
+	if !ok {
+		return
+	}
+
+	m.Profiles = stats.NewProfileManager(file.GetFilePath())
+	m.Profile = stats.DefaultProfile
+	m.refreshProfileNames()
+	if active := m.Profiles.Active(); active != stats.DefaultProfile {
+		if err := m.switchProfile(active); err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "switch profile"))
+		}
+	}
+}
+
+// refreshProfileNames rereads the profiles that can be switched to
+func (m *Model) refreshProfileNames() {
+	names, err := m.Profiles.Profiles()
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "list profiles"))
+		return
+	}
+	m.ProfileNames = names
+}
+
+// profilesAvailable reports whether player profiles can be switched. The demo
+// profile stands in for them until the app is closed
+func (m *Model) profilesAvailable() bool {
+	return m.Profiles != nil && m.DemoDir == "" && !m.NoPersist && !m.SafeMode
+}
+
+// showsProfile reports whether the player is named in the headers, which
+// only matters once more than one person plays
+func (m *Model) showsProfile() bool {
+	return m.profilesAvailable() && len(m.ProfileNames) > 1
+}
+
+// renderProfileLine names the player whose statistics games count towards
+func (m *Model) renderProfileLine() string {
+	if !m.showsProfile() {
+		return ""
+	}
+	return Center(MutedStyle.Render("Player: "+m.Profile), m.Width, 1)
+}
+
+// withProfileLine puts the player's name under a header
+func (m *Model) withProfileLine(header string) string {
+	if line := m.renderProfileLine(); line != "" {
+		return lipgloss.JoinVertical(lipgloss.Center, header, line)
+	}
+	return header
+}
+
+// switchProfile plays on with another player's statistics, which are loaded
+// at the next launch too
+func (m *Model) switchProfile(name string) error {
+	statsManager, err := m.Profiles.Open(name)
+	if err != nil {
+		return err
+	}
+	if err := m.Profiles.SetActive(name); err != nil {
+		return err
+	}
+
+	m.useStatsManager(statsManager)
+	m.announceStatsRecovery()
+	m.Profile = name
+	m.LastGame = nil
+	m.SyncStats()
+
+	// The report on exit compares the statistics of the player playing last
+	if m.SessionStart != nil {
+		m.StartSession()
+	}
+	return nil
+}
+
+// OfferProfiles opens the profile picker at launch when more than one person
+// plays, reporting whether it did
+func (m *Model) OfferProfiles() bool {
+	if !m.profilesAvailable() || len(m.ProfileNames) < 2 {
+		return false
+	}
+	m.openProfiles()
+	return true
+}
+
+// openProfiles shows the profile picker with the player playing selected
+func (m *Model) openProfiles() {
+	m.refreshProfileNames()
+
+	games := make(map[string]int, len(m.ProfileNames))
+	for _, name := range m.ProfileNames {
+		if name == m.Profile {
+			games[name] = m.StatsManager.GetStats().TotalGames
+		} else if profileStats, err := stats.ReadStatsFile(m.Profiles.Path(name)); err == nil {
+			games[name] = profileStats.TotalGames
+		}
+	}
+
+	m.ProfilePicker = &ProfilePicker{
+		Cursor: max(0, slices.Index(m.ProfileNames, m.Profile)),
+		Games:  games,
+	}
+	m.CurrentView = ProfilesView
+}
+
+// pickProfile switches to a profile and returns to the main menu
+func (m *Model) pickProfile(name string) {
+	if name != m.Profile {
+		if err := m.switchProfile(name); err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "switch profile"))
+			return
+		}
+		m.showToast("👤 Now playing as " + name)
+		m.logEvent(eventlog.KindSuccess, "Switched to the player profile "+name)
+	}
+	m.ProfilePicker = nil
+	m.returnToMainMenu()
+}
+
+// handleProfilesKeys processes profile picker input
+func (m *Model) handleProfilesKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker := m.ProfilePicker
+	if picker == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeyUp, "k":
+		if picker.Cursor > 0 {
+			picker.Cursor--
+		}
+
+	case KeyDown, "j":
+		if picker.Cursor < len(m.ProfileNames)-1 {
+			picker.Cursor++
+		}
+
+	case KeyEnter, KeySpace, " ":
+		if picker.Cursor < len(m.ProfileNames) {
+			m.pickProfile(m.ProfileNames[picker.Cursor])
+		}
+
+	case "n", "a":
+		picker.Naming = true
+		picker.Name = ""
+		picker.Err = ""
+	}
+
+	return m, nil
+}
+
+// handleProfileNameKeys processes input while a new profile's name is typed
+func (m *Model) handleProfileNameKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	picker := m.ProfilePicker
+
+	switch msg.Type {
+	case tea.KeyCtrlC:
+		return m, tea.Quit
+
+	case tea.KeyEsc:
+		picker.Naming = false
+
+	case tea.KeyBackspace:
+		if picker.Name != "" {
+			picker.Name = picker.Name[:len(picker.Name)-1]
+		}
+
+	case tea.KeySpace:
+		picker.Name += "-"
+
+	case tea.KeyEnter:
+		if err := m.Profiles.Create(picker.Name); err != nil {
+			picker.Err = err.Error()
+			return m, nil
+		}
+		m.refreshProfileNames()
+		m.pickProfile(picker.Name)
+
+	case tea.KeyRunes:
+		// Names become folder names, so they are kept to lowercase
+		picker.Name += strings.ToLower(string(msg.Runes))
+		picker.Err = ""
+	}
+
+	return m, nil
+}
+
+// renderProfiles renders the profile picker: each player with the games they
+// have played, or the name of a new player being typed
+func (m *Model) renderProfiles() string {
+	var content []string
+	content = append(content, HeaderStyle.Render("PLAYER PROFILES"))
+	content = append(content, Center(MutedStyle.Render("Each player keeps their own statistics, goals and achievements."), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	picker := m.ProfilePicker
+	if picker == nil {
+		picker = &ProfilePicker{}
+	}
+
+	var rows []string
+	for i, name := range m.ProfileNames {
+		games := fmt.Sprintf("%d games", picker.Games[name])
+		if picker.Games[name] == 1 {
+			games = "1 game"
+		}
+		line := fmt.Sprintf("%-32s %10s", name, games)
+		if name == m.Profile {
+			line += "  (playing)"
+		} else {
+			line += "           "
+		}
+		if i == picker.Cursor && !picker.Naming {
+			rows = append(rows, lipgloss.NewStyle().Foreground(SelectedColor).Bold(true).Render("▶ "+line))
+		} else {
+			rows = append(rows, StatsLabelStyle.Render("  "+line))
+		}
+	}
+	content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, rows...), m.Width, 1))
+
+	var bindings []KeyBinding
+	if picker.Naming {
+		content = append(content, Spacer(1))
+		content = append(content, Center(TitleStyle.Render("New player: ")+picker.Name+"█", m.Width, 1))
+		content = append(content, Center(MutedStyle.Render("Lowercase letters, digits, - and _"), m.Width, 1))
+		if picker.Err != "" {
+			content = append(content, Center(ErrorStyle.Render("❌ "+picker.Err), m.Width, 1))
+		}
+		bindings = []KeyBinding{{"Enter", "Create and play"}, {"ESC", "Cancel"}}
+	} else {
+		bindings = []KeyBinding{{"Enter", "Play as"}, {"↑↓", "Navigate"}, {"n", "New player"}, {"ESC/q", "Main menu"}}
+	}
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+	content = append(content, RenderFooter(bindings))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// newProfilesModel creates a model keeping its statistics and player
+// profiles in dir
+func newProfilesModel(dir string) *Model {
+	m := newModelWithStats(config.NewManagerWithDefaults(filepath.Join(dir, "config.json")),
+		stats.NewStatsManager(filepath.Join(dir, stats.DefaultStatsFileName)))
+	m.setupProfiles()
+	return m
+}
+
+func TestPlayerProfiles(t *testing.T) {
+	freezeUI(t)
+	dir := t.TempDir()
+	m := newProfilesModel(dir)
+	if m.OfferProfiles() || m.renderProfileLine() != "" {
+		t.Fatal("Expected a single player to go without the picker and the player line")
+	}
+
+	g := game.NewSeededGame(1)
+	g.MakeInitialChoice(0)
+	g.StayWithChoice()
+	if err := m.StatsManager.RecordGame(g.Result); err != nil {
+		t.Fatal(err)
+	}
+
+	m.openProfiles()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	for _, r := range "Sam" {
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Profile != "sam" || m.CurrentView != MainMenuView {
+		t.Fatalf("Expected the new player to start playing, got %q in view %v", m.Profile, m.CurrentView)
+	}
+	if m.StatsManager.GetStats().TotalGames != 0 {
+		t.Error("Expected the new player to start without games")
+	}
+	if !strings.Contains(plainText(m.View()), "Player: sam") {
+		t.Error("Expected the main menu to name the player")
+	}
+
+	// The player playing last is restored at launch, with the picker offered
+	m = newProfilesModel(dir)
+	if m.Profile != "sam" || !slices.Equal(m.ProfileNames, []string{stats.DefaultProfile, "sam"}) {
+		t.Fatalf("Expected sam restored at launch, got %q of %v", m.Profile, m.ProfileNames)
+	}
+	if !m.OfferProfiles() || m.CurrentView != ProfilesView {
+		t.Fatal("Expected the picker at launch once several people play")
+	}
+	if view := plainText(m.View()); !strings.Contains(view, "1 game") || !strings.Contains(view, "(playing)") {
+		t.Errorf("Expected each player's games listed, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Profile != stats.DefaultProfile || m.StatsManager.GetStats().TotalGames != 1 {
+		t.Errorf("Expected switching back to the default player's statistics, got %q", m.Profile)
+	}
+
+	// The space bar, which arrives as " ", picks a player too
+	m.openProfiles()
+	m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if m.Profile != "sam" {
+		t.Errorf("Expected space to pick sam, got %q", m.Profile)
+	}
+}
+
+func TestPlayerProfileNameRejected(t *testing.T) {
+	freezeUI(t)
+	m := newProfilesModel(t.TempDir())
+	m.openProfiles()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.ProfilePicker == nil || m.ProfilePicker.Err == "" || m.Profile != stats.DefaultProfile {
+		t.Error("Expected an invalid name to be reported without switching")
+	}
+
+	// q is typed into the name rather than leaving the view
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	if m.CurrentView != ProfilesView || m.ProfilePicker.Name != "-q" {
+		t.Errorf("Expected q typed into the name, got %q in view %v", m.ProfilePicker.Name, m.CurrentView)
+	}
+}
@@ -0,0 +1,18 @@
+package ui
+
+import "fmt"
+
+// announceStatsRecovery tells the player when their statistics were loaded
+// from the backup because the stats file could not be read
+func (m *Model) announceStatsRecovery() {
+	recovery := m.StatsManager.Recovery()
+	if recovery == nil {
+		return
+	}
+
+	text := "⚠️ Your statistics file was damaged, so the backup was loaded"
+	if !recovery.SavedAt.IsZero() {
+		text = fmt.Sprintf("⚠️ Your statistics file was damaged, so the backup from %s was loaded", recovery.SavedAt.Format("Jan 2 15:04"))
+	}
+	m.showToast(text)
+}
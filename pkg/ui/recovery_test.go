@@ -0,0 +1,32 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestStatsRecoveryNotification(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, stats.DefaultStatsFileName)
+	sm := stats.NewStatsManager(path)
+	if err := sm.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if err := sm.Reset(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	model := newModelWithStats(configManager, stats.NewStatsManager(path))
+	if model.Toast == nil || !strings.Contains(model.Toast.Text, "statistics file was damaged") {
+		t.Errorf("Expected a notification that the backup was loaded, got %+v", model.Toast)
+	}
+}
@@ -1,10 +1,13 @@
 package ui
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/charmbracelet/bubbletea"
 	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
 )
 
 // TestResetConfirmationInitiation tests that reset confirmation is properly initiated
@@ -451,3 +454,48 @@ func TestResetConfirmationStateIsolation(t *testing.T) {
 		t.Error("Reset confirmation should not automatically reappear when returning to stats view")
 	}
 }
+
+// TestResetScopeSelection tests choosing what to reset before confirming
+func TestResetScopeSelection(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.CurrentView = StatsView
+	model.StatsManager.RecordGame(&game.GameResult{Won: true, Strategy: game.Switch, InitialChoice: 1, FinalChoice: 2, CarPosition: 2})
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if !model.ChoosingResetScope || model.ResetScope != stats.ResetAll {
+		t.Fatal("Expected the reset to start by choosing what to reset")
+	}
+	if view := model.View(); !strings.Contains(view, "Game history") || !strings.Contains(view, "Daily statistics") {
+		t.Errorf("Expected the reset choices to be listed, got:\n%s", view)
+	}
+
+	// Number keys do nothing until a choice is made
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}})
+	if model.CurrentInputIndex != 0 {
+		t.Error("Expected numbers to be ignored while choosing")
+	}
+
+	// The space bar, which arrives as " ", chooses like Enter
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if model.ChoosingResetScope || model.ResetScope != stats.ResetHistory {
+		t.Fatalf("Expected the game history to be chosen, got %v", model.ResetScope)
+	}
+
+	model.ResetConfirmationNumbers = [4]int{1, 2, 3, 4}
+	for _, r := range "1234" {
+		model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	gameStats := model.StatsManager.GetStats()
+	if len(gameStats.GameHistory) != 0 || gameStats.TotalGames != 1 {
+		t.Errorf("Expected the history cleared and the totals kept, got %+v", gameStats)
+	}
+	if !strings.Contains(model.SuccessMessage, "Game history reset") || !strings.Contains(model.SuccessMessage, "Backup saved") {
+		t.Errorf("Expected the success message to name the scope and backup, got %q", model.SuccessMessage)
+	}
+	if model.ShowResetConfirmation || model.ResetScope != stats.ResetAll {
+		t.Error("Expected the reset dialog closed and the scope cleared")
+	}
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"path/filepath"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/uistate"
+)
+
+// sessionPath returns the file the regular game in progress is saved to,
+// beside the statistics, or "" when statistics aren't written to a file
+func (m *Model) sessionPath() string {
+	if m.NoPersist || m.StatsManager == nil || m.StatsManager.IsReadOnly() {
+		return ""
+	}
+	file, ok := m.StatsManager.Store().(stats.FileStore)
+	if !ok {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(file.GetFilePath()), uistate.SessionFileName)
+}
+
+// loadResumableGame looks for a game left unfinished when the app last
+// exited, to offer it on the main menu. A session that can't be read or
+// restored is not offered; the next game overwrites it
+func (m *Model) loadResumableGame() {
+	m.Resumable = nil
+	path := m.sessionPath()
+	if path == "" {
+		return
+	}
+
+	session, err := uistate.LoadSession(path)
+	if err != nil || session == nil {
+		return
+	}
+	if _, err := session.Restore(now()); err != nil {
+		return
+	}
+	m.Resumable = session
+}
+
+// regularGame reports whether the game is a regular one, rather than part of
+// a daily challenge, practice, worksheet, hotseat, ladder or rematch
+func (m *Model) regularGame() bool {
+	return m.Daily == nil && m.Practice == nil && m.Worksheet == nil && m.Hotseat == nil && m.Ladder == nil && !m.Rematch
+}
+
+// saveSession saves the regular game in progress each time its phase
+// changes, so it can be resumed if the app exits before it is over. The save
+// is cleared once the game is over or abandoned. Watching the game, like
+// recordTranscript, saves it however the phase changed
+func (m *Model) saveSession() {
+	g := m.Game
+	if g == nil || g == m.sessionGame && g.Phase == m.sessionPhase {
+		return
+	}
+	m.sessionGame, m.sessionPhase = g, g.Phase
+
+	path := m.sessionPath()
+	if path == "" || !m.regularGame() {
+		return
+	}
+
+	// The game being played replaces the one left unfinished
+	m.Resumable = nil
+
+	// Resuming is a convenience, so failing to save the game is not reported
+	switch g.Phase {
+	case game.InitialChoice, game.HostReveal, game.FinalChoice:
+		_ = uistate.SaveSession(path, &uistate.Session{Game: g.Save(), Cursor: m.DoorCursor, SavedAt: now()})
+	default:
+		_ = uistate.ClearSession(path)
+	}
+}
+
+// resumeGame continues the game left unfinished when the app last exited,
+// with its doors, phase and cursor as they were saved
+func (m *Model) resumeGame() {
+	session := m.Resumable
+	m.Resumable = nil
+	if session == nil {
+		return
+	}
+
+	g, err := session.Restore(now())
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "resume game"))
+		return
+	}
+
+	if m.IsRevealing {
+		m.finishReveal()
+	}
+	m.stopAnimations()
+	m.Daily = nil
+	m.Practice = nil
+	m.Worksheet = nil
+	m.Hotseat = nil
+	m.Ladder = nil
+	m.Rematch = false
+
+	// The host's pause before opening doors ended with the last session
+	if g.Phase == game.HostReveal && len(g.HostOpenedDoors) == 0 {
+		if err := g.OpenHostDoors(); err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "resume game"))
+			return
+		}
+	}
+
+	m.Game = g
+	m.sessionGame, m.sessionPhase = g, g.Phase
+	m.DoorCursor = 0
+	if session.Cursor >= 0 && session.Cursor < g.NumDoors() {
+		m.DoorCursor = session.Cursor
+	}
+	m.ShowResult = false
+	m.pickHostLines()
+	m.CurrentView = GameView
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/uistate"
+)
+
+func TestResumeLastGame(t *testing.T) {
+	clock := freezeUI(t)
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	newResumeModel := func() *Model {
+		return newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, stats.DefaultStatsFileName)))
+	}
+
+	model := newResumeModel()
+	if model.Resumable != nil || model.menuOptions()[0].Label == "Resume Last Game" {
+		t.Fatal("Expected nothing to resume before a game is played")
+	}
+
+	// The game is saved as its phase changes
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.CurrentView != GameView {
+		t.Fatalf("Expected a new game, got view %v", model.CurrentView)
+	}
+	if err := model.Game.MakeInitialChoice(1); err != nil {
+		t.Fatal(err)
+	}
+	model.DoorCursor = 2
+	model.Update(StatsUpdateMsg{})
+	played := model.Game
+
+	// The app exits mid-game and is started an hour later
+	clock.Advance(time.Hour)
+	model = newResumeModel()
+	options := model.menuOptions()
+	if model.Resumable == nil || options[0].Label != "Resume Last Game" {
+		t.Fatalf("Expected the unfinished game offered first, got %q", options[0].Label)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	g := model.Game
+	if model.CurrentView != GameView || g == nil || g.Phase != game.FinalChoice {
+		t.Fatalf("Expected the game resumed at the final choice, got view %v", model.CurrentView)
+	}
+	if g.CarPosition != played.CarPosition || g.PlayerInitialChoice != 1 || g.HostOpenedDoor != played.HostOpenedDoor || model.DoorCursor != 2 {
+		t.Errorf("Expected the doors and cursor restored, got car %d, pick %d, opened %d, cursor %d",
+			g.CarPosition, g.PlayerInitialChoice, g.HostOpenedDoor, model.DoorCursor)
+	}
+	if model.menuOptions()[0].Label == "Resume Last Game" {
+		t.Error("Expected the resumed game no longer offered")
+	}
+
+	// Finishing the game clears the save
+	if err := g.StayWithChoice(); err != nil {
+		t.Fatal(err)
+	}
+	model.Update(StatsUpdateMsg{})
+	if _, err := os.Stat(filepath.Join(dir, uistate.SessionFileName)); !os.IsNotExist(err) {
+		t.Errorf("Expected the save cleared once the game is over, got %v", err)
+	}
+	if newResumeModel().Resumable != nil {
+		t.Error("Expected nothing to resume after the game is over")
+	}
+}
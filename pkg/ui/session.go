@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// StartSession takes the snapshot of lifetime statistics the session is
+// compared with on exit. Call it once statistics from other devices are merged
+func (m *Model) StartSession() {
+	start := m.StatsManager.GetStats().Aggregates()
+	m.SessionStart = &start
+}
+
+// SessionDiff returns how the session moved the lifetime statistics, or false
+// if the session was never started
+func (m *Model) SessionDiff() (stats.SessionDiff, bool) {
+	if m.SessionStart == nil {
+		return stats.SessionDiff{}, false
+	}
+	return stats.SessionDiff{Before: *m.SessionStart, After: m.StatsManager.GetStats().Aggregates()}, true
+}
+
+// SessionReport describes the session's changes for printing on exit, or
+// returns "" if no games were played
+func (m *Model) SessionReport() string {
+	diff, ok := m.SessionDiff()
+	if !ok {
+		return ""
+	}
+	lines := diff.Lines()
+	if len(lines) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("This session:\n")
+	for _, line := range lines {
+		b.WriteString("  " + line + "\n")
+	}
+	return b.String()
+}
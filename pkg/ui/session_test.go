@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestSessionReport(t *testing.T) {
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+
+	play := func(seed int64, win bool) {
+		g := game.NewSeededGame(seed)
+		choice := g.CarPosition
+		if !win {
+			choice = (choice + 1) % 3
+		}
+		g.MakeInitialChoice(choice)
+		g.StayWithChoice()
+		if err := model.StatsManager.RecordGame(g.Result); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Games before the session started are its baseline
+	play(1, true)
+	play(2, false)
+	if model.SessionReport() != "" {
+		t.Error("Expected no report before the session started")
+	}
+	model.StartSession()
+	if model.SessionReport() != "" {
+		t.Error("Expected no report for a session without games")
+	}
+
+	play(3, true)
+	play(4, true)
+	report := model.SessionReport()
+	for _, want := range []string{"This session:", "+2 games (4 in all)", "Win rate 50.0% → 75.0% (+25.0%)", "New longest win streak: 2 (was 1)"} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected %q in the report, got:\n%s", want, report)
+		}
+	}
+}
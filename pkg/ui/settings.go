@@ -0,0 +1,517 @@
+package ui
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// ConfigSaveDelay is how long settings must stay unchanged before they are saved
+const ConfigSaveDelay = 500 * time.Millisecond
+
+// applyConfig applies a settings change immediately and schedules a debounced save,
+// so rapid toggling only writes the final state to disk
+func (m *Model) applyConfig(cfg *config.Config) tea.Cmd {
+	if m.ConfigManager == nil {
+		return nil
+	}
+
+	if err := m.ConfigManager.Apply(cfg); err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "update settings"))
+		return nil
+	}
+
+	m.ConfigSaveSeq++
+	seq := m.ConfigSaveSeq
+	return tea.Tick(ConfigSaveDelay, func(time.Time) tea.Msg {
+		return ConfigSaveMsg{Seq: seq}
+	})
+}
+
+// flushConfig writes pending settings changes to disk now. In no-persist mode
+// changes only last for the session
+func (m *Model) flushConfig() {
+	if m.ConfigManager == nil || m.NoPersist {
+		return
+	}
+
+	if err := m.ConfigManager.Flush(); err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "save settings"))
+	}
+}
+
+// SettingsSection is a tab of the settings view, matching a section of the config file
+type SettingsSection int
+
+const (
+	SettingsUI SettingsSection = iota
+	SettingsGame
+	SettingsStats
+	SettingsEducation
+)
+
+// settingsSections lists the tabs in display order
+var settingsSections = []SettingsSection{SettingsUI, SettingsGame, SettingsStats, SettingsEducation}
+
+// String returns the tab title
+func (s SettingsSection) String() string {
+	switch s {
+	case SettingsUI:
+		return "UI"
+	case SettingsGame:
+		return "Game"
+	case SettingsStats:
+		return "Stats"
+	case SettingsEducation:
+		return "Education"
+	default:
+		return "Unknown"
+	}
+}
+
+// setting is one editable option in the settings view
+type setting struct {
+	label       string
+	description string
+	value       func(cfg *config.Config) string
+	change      func(cfg *config.Config, delta int) // delta is -1 or 1; toggles ignore the sign
+}
+
+// toggleSetting edits a boolean option
+func toggleSetting(label, description string, field func(cfg *config.Config) *bool) setting {
+	return setting{
+		label:       label,
+		description: description,
+		value: func(cfg *config.Config) string {
+			if *field(cfg) {
+				return "On"
+			}
+			return "Off"
+		},
+		change: func(cfg *config.Config, delta int) {
+			*field(cfg) = !*field(cfg)
+		},
+	}
+}
+
+// choiceSetting cycles a string option through its allowed values
+func choiceSetting(label, description string, choices []string, field func(cfg *config.Config) *string) setting {
+	return setting{
+		label:       label,
+		description: description,
+		value:       func(cfg *config.Config) string { return *field(cfg) },
+		change: func(cfg *config.Config, delta int) {
+			current := slices.Index(choices, *field(cfg))
+			*field(cfg) = choices[(current+delta+len(choices))%len(choices)]
+		},
+	}
+}
+
+// numberSetting steps an integer option. Values are not clamped here, so
+// Config.Validate reports when a step goes out of range
+func numberSetting(label, description string, step int, format func(int) string, field func(cfg *config.Config) *int) setting {
+	return setting{
+		label:       label,
+		description: description,
+		value:       func(cfg *config.Config) string { return format(*field(cfg)) },
+		change: func(cfg *config.Config, delta int) {
+			*field(cfg) += delta * step
+		},
+	}
+}
+
+// delimiterNames are how CSV delimiters are shown in settings
+var delimiterNames = map[string]string{",": "comma", ";": "semicolon", "\t": "tab"}
+
+// settingsFor returns the options shown on a settings tab
+func (m *Model) settingsFor(section SettingsSection) []setting {
+	switch section {
+	case SettingsUI:
+		packs := make([]string, 0, len(m.AnimationPacks))
+		for _, pack := range m.AnimationPacks {
+			packs = append(packs, pack.Name)
+		}
+		schemes := config.GetColorSchemes()
+		if m.ConfigManager != nil {
+			schemes = m.ConfigManager.ColorSchemes()
+		}
+
+		return []setting{
+			choiceSetting("Color scheme", "Theme colors", schemes,
+				func(cfg *config.Config) *string { return &cfg.UI.ColorScheme }),
+			toggleSetting("Animations", "Animate doors and celebrations",
+				func(cfg *config.Config) *bool { return &cfg.UI.ShowAnimations }),
+			numberSetting("Animation speed", "How fast doors open and the result is revealed", 1,
+				func(speed int) string { return config.GetAnimationSpeeds()[speed] },
+				func(cfg *config.Config) *int { return &cfg.UI.AnimationSpeed }),
+			toggleSetting("Reduced motion", "Show results at once, without animations, pauses or blinking",
+				func(cfg *config.Config) *bool { return &cfg.UI.ReducedMotion }),
+			choiceSetting("Animation pack", "How doors open and wins are celebrated", packs,
+				func(cfg *config.Config) *string { return &cfg.UI.AnimationPack }),
+			choiceSetting("Effects", "Rainbow, glow and pulsing on the win message", config.GetEffectsIntensities(),
+				func(cfg *config.Config) *string { return &cfg.UI.EffectsIntensity }),
+			numberSetting("Max FPS", "Cap on animation frames per second", 10,
+				func(fps int) string { return strconv.Itoa(fps) },
+				func(cfg *config.Config) *int { return &cfg.UI.MaxFPS }),
+			toggleSetting("High contrast", "White, yellow and black with thick borders",
+				func(cfg *config.Config) *bool { return &cfg.UI.HighContrast }),
+			toggleSetting("Large text", "Taller doors, block-digit door numbers and shorter footers",
+				func(cfg *config.Config) *bool { return &cfg.UI.LargeText }),
+			toggleSetting("Terminal title", "Show the win rate and game number in the window title",
+				func(cfg *config.Config) *bool { return &cfg.UI.TerminalTitle }),
+		}
+
+	case SettingsGame:
+		behaviors := make([]string, 0, len(game.HostBehaviors()))
+		for _, behavior := range game.HostBehaviors() {
+			behaviors = append(behaviors, behavior.String())
+		}
+
+		return []setting{
+			numberSetting("Doors", "Doors per game; the host opens all but one of the others", 1,
+				func(doors int) string { return strconv.Itoa(doors) },
+				func(cfg *config.Config) *int { return &cfg.Game.NumDoors }),
+			choiceSetting("Host", "classic reveals goats, fall opens doors at random, crawl prefers low doors", behaviors,
+				func(cfg *config.Config) *string { return &cfg.Game.HostBehavior }),
+			choiceSetting("Host personality", "How the host talks, and how long he pauses before the result", game.HostPersonalityNames(),
+				func(cfg *config.Config) *string { return &cfg.Game.HostPersonality }),
+			numberSetting("Host opens", "Doors the host opens; fewer leave several doors to switch to", 1,
+				func(opens int) string {
+					if opens == 0 {
+						return "All but one"
+					}
+					return strconv.Itoa(opens)
+				},
+				func(cfg *config.Config) *int { return &cfg.Game.HostOpens }),
+			choiceSetting("Default strategy", "switch or stay makes the final choice for you after the reveal", []string{"ask", "switch", "stay"},
+				func(cfg *config.Config) *string { return &cfg.Game.DefaultStrategy }),
+			toggleSetting("Remember door", "Start new games on the previously chosen door",
+				func(cfg *config.Config) *bool { return &cfg.Game.RememberDoor }),
+			toggleSetting("Show hints", "Suggest a strategy from your results at the final choice",
+				func(cfg *config.Config) *bool { return &cfg.Game.ShowHints }),
+			toggleSetting("Show probability", "Chance of the car behind each door, beside the doors",
+				func(cfg *config.Config) *bool { return &cfg.Game.ShowProbability }),
+			toggleSetting("Confirm choices", "Ask before locking in each door choice",
+				func(cfg *config.Config) *bool { return &cfg.Game.ConfirmChoices }),
+			toggleSetting("Auto advance", "Start the next game automatically after the result",
+				func(cfg *config.Config) *bool { return &cfg.Game.AutoAdvance }),
+			numberSetting("Advance after", "Seconds the result is shown before the next game", 1,
+				func(seconds int) string { return fmt.Sprintf("%ds", seconds) },
+				func(cfg *config.Config) *int { return &cfg.Game.AutoAdvanceDelay }),
+		}
+
+	case SettingsStats:
+		formats := stats.GetExportFormats()
+
+		return []setting{
+			{
+				label:       "Export format",
+				description: "Format of exported statistics",
+				value:       func(cfg *config.Config) string { return cfg.Stats.ExportFormat.String() },
+				change: func(cfg *config.Config, delta int) {
+					current := slices.Index(formats, cfg.Stats.ExportFormat)
+					cfg.Stats.ExportFormat = formats[(current+delta+len(formats))%len(formats)]
+				},
+			},
+			choiceSetting("Date format", "Dates in exports; eu is 31.12.2006, uk 31/12/2006", stats.DateFormats(),
+				func(cfg *config.Config) *string { return &cfg.Stats.DateFormat }),
+			choiceSetting("Decimal separator", "Decimal separator of numbers in exports", stats.DecimalSeparators(),
+				func(cfg *config.Config) *string { return &cfg.Stats.DecimalSeparator }),
+			{
+				label:       "CSV delimiter",
+				description: "Field separator in CSV exports; semicolon suits European Excel",
+				value:       func(cfg *config.Config) string { return delimiterNames[cfg.Stats.CSVDelimiter] },
+				change: func(cfg *config.Config, delta int) {
+					delimiters := stats.CSVDelimiters()
+					current := slices.Index(delimiters, cfg.Stats.CSVDelimiter)
+					cfg.Stats.CSVDelimiter = delimiters[(current+delta+len(delimiters))%len(delimiters)]
+				},
+			},
+			numberSetting("History size", "Most games kept in the history", 1000,
+				func(size int) string { return strconv.Itoa(size) },
+				func(cfg *config.Config) *int { return &cfg.Stats.MaxHistorySize }),
+			toggleSetting("Daily stats", "Show the daily statistics breakdown",
+				func(cfg *config.Config) *bool { return &cfg.Stats.ShowDailyStats }),
+			toggleSetting("Streaks", "Show win and loss streaks",
+				func(cfg *config.Config) *bool { return &cfg.Stats.ShowStreaks }),
+			toggleSetting("Advanced stats", "Show advanced statistics",
+				func(cfg *config.Config) *bool { return &cfg.Stats.ShowAdvanced }),
+			toggleSetting("Auto export", "Export to the export directory every few games and on exit",
+				func(cfg *config.Config) *bool { return &cfg.Stats.AutoExport }),
+			numberSetting("Export every", "Games between automatic exports", 5,
+				func(games int) string { return strconv.Itoa(games) },
+				func(cfg *config.Config) *int { return &cfg.Stats.AutoExportEvery }),
+			numberSetting("Exports kept", "Older automatic exports are removed", 1,
+				func(keep int) string { return strconv.Itoa(keep) },
+				func(cfg *config.Config) *int { return &cfg.Stats.AutoExportKeep }),
+		}
+
+	case SettingsEducation:
+		return []setting{
+			toggleSetting("Explanations", "Show probability explanations",
+				func(cfg *config.Config) *bool { return &cfg.Education.ShowExplanations }),
+			toggleSetting("Math", "Show mathematical details",
+				func(cfg *config.Config) *bool { return &cfg.Education.ShowMath }),
+			toggleSetting("Interactive mode", "Enable interactive tutorials",
+				func(cfg *config.Config) *bool { return &cfg.Education.InteractiveMode }),
+			toggleSetting("Skip tutorial", "Skip the tutorial on startup",
+				func(cfg *config.Config) *bool { return &cfg.Education.SkipTutorial }),
+		}
+
+	default:
+		return nil
+	}
+}
+
+// openSettings shows the settings view on its first tab
+func (m *Model) openSettings() {
+	m.CurrentView = SettingsView
+	m.SettingsSection = SettingsUI
+	m.SettingsCursor = 0
+}
+
+// changeSetting changes the selected option, validating the whole config
+// before applying it so invalid values never take effect
+func (m *Model) changeSetting(delta int) tea.Cmd {
+	if m.ConfigManager == nil {
+		m.ErrorMessage = "Settings are unavailable without a config file"
+		return nil
+	}
+
+	settings := m.settingsFor(m.SettingsSection)
+	if m.SettingsCursor >= len(settings) {
+		return nil
+	}
+	selected := settings[m.SettingsCursor]
+
+	cfg := m.ConfigManager.Get()
+	selected.change(cfg, delta)
+	if err := m.ConfigManager.Validate(cfg); err != nil {
+		m.ErrorMessage = fmt.Sprintf("%s: %v", selected.label, err)
+		return nil
+	}
+
+	cmd := m.applyConfig(cfg)
+	m.applySettings(m.ConfigManager.Get())
+	return cmd
+}
+
+// applySettings copies the settings the model caches from the config
+func (m *Model) applySettings(cfg *config.Config) {
+	m.ReducedMotion = cfg.UI.ReducedMotion
+	m.AnimationSpeed = AnimationSpeed(cfg.UI.AnimationSpeed)
+	m.ShowAnimations = cfg.UI.ShowAnimations && m.AnimationSpeed != SpeedInstant && !m.ReducedMotion && !m.SafeMode && !m.NoAnimations
+	if !m.ShowAnimations {
+		m.stopAnimations()
+	}
+	if m.AnimationManager != nil {
+		m.AnimationManager.SetMaxFPS(cfg.UI.MaxFPS)
+	}
+
+	m.RememberDoor = cfg.Game.RememberDoor
+	m.ShowProbability = cfg.Game.ShowProbability
+	m.ShowHints = cfg.Game.ShowHints
+	m.ConfirmChoices = cfg.Game.ConfirmChoices
+	m.AutoAdvance = cfg.Game.AutoAdvance
+	m.DefaultStrategy = cfg.Game.DefaultStrategy
+	m.AutoAdvanceDelay = time.Duration(cfg.Game.AutoAdvanceDelay) * time.Second
+	if m.AutoAdvanceDelay == 0 {
+		m.AutoAdvanceDelay = defaultAutoAdvanceDelay
+	}
+	if !m.AutoAdvance {
+		m.cancelAutoAdvance()
+	}
+	m.TerminalTitle = cfg.UI.TerminalTitle
+	LargeText = cfg.UI.LargeText
+	if m.StatsManager != nil {
+		m.StatsManager.SetRecordDraws(cfg.Stats.RecordDraws)
+	}
+
+	m.NumDoors = cfg.Game.NumDoors
+	if game.ValidateNumDoors(m.NumDoors) != nil {
+		m.NumDoors = game.NumDoors
+	}
+
+	hostBehavior, err := game.ParseHostBehavior(cfg.Game.HostBehavior)
+	if err != nil {
+		hostBehavior = game.HostClassic
+	}
+	m.HostBehavior = hostBehavior
+
+	m.HostOpens = cfg.Game.HostOpens
+	if game.ValidateHostOpens(m.NumDoors, m.HostOpens) != nil {
+		m.HostOpens = 0
+	}
+
+	effects, err := ParseEffectsIntensity(cfg.UI.EffectsIntensity)
+	if err != nil {
+		effects = EffectsFull
+	}
+	if m.ReducedMotion && effects > EffectsSubtle {
+		// Full effects pulse and blink
+		effects = EffectsSubtle
+	}
+	m.Effects = effects
+
+	var custom []config.ThemeDefinition
+	if m.ConfigManager != nil {
+		custom = m.ConfigManager.Themes()
+	}
+	ApplyTheme(themeFor(cfg.UI, custom))
+}
+
+// KeyConfigProfile switches to the next config profile in settings
+const KeyConfigProfile = "c"
+
+// nextConfigProfile switches to the next saved config profile and makes it
+// the one loaded at launch
+func (m *Model) nextConfigProfile() {
+	if m.ConfigManager == nil || m.ConfigManager.Profile() == "" {
+		return
+	}
+
+	profiles, err := m.ConfigManager.Profiles()
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "switch profile"))
+		return
+	}
+	if len(profiles) < 2 {
+		m.SuccessMessage = "There are no other profiles yet; start with --config-profile NAME to create one"
+		return
+	}
+
+	next := profiles[(slices.Index(profiles, m.ConfigManager.Profile())+1)%len(profiles)]
+	if err := m.ConfigManager.SwitchProfile(next); err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "switch profile"))
+		return
+	}
+	m.applySettings(m.ConfigManager.Get())
+	m.showToast(fmt.Sprintf("⚙️ Switched to the %s settings profile", next))
+	m.logEvent(eventlog.KindSuccess, fmt.Sprintf("Switched to the %s settings profile", next))
+}
+
+// handleSettingsKeys processes settings view input
+func (m *Model) handleSettingsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	settings := m.settingsFor(m.SettingsSection)
+
+	switch msg.String() {
+	case KeyTab:
+		m.SettingsSection = settingsSections[(int(m.SettingsSection)+1)%len(settingsSections)]
+		m.SettingsCursor = 0
+
+	case "shift+tab":
+		m.SettingsSection = settingsSections[(int(m.SettingsSection)+len(settingsSections)-1)%len(settingsSections)]
+		m.SettingsCursor = 0
+
+	case KeyUp, "k":
+		if m.SettingsCursor > 0 {
+			m.SettingsCursor--
+		}
+
+	case KeyDown, "j":
+		if m.SettingsCursor < len(settings)-1 {
+			m.SettingsCursor++
+		}
+
+	case KeyLeft:
+		return m, m.changeSetting(-1)
+
+	case KeyRight, "l", KeyEnter, KeySpace, " ":
+		return m, m.changeSetting(1)
+
+	case "p":
+		if m.SettingsSection == SettingsUI {
+			m.openThemeGallery()
+		}
+
+	case KeyConfigProfile:
+		m.nextConfigProfile()
+	}
+
+	return m, nil
+}
+
+// renderSettings renders the settings view
+func (m *Model) renderSettings() string {
+	var content []string
+	content = append(content, HeaderStyle.Render("SETTINGS"))
+	content = append(content, Spacer(1))
+
+	// Tabs; the active one is bracketed so it is visible without color
+	var tabs []string
+	for _, section := range settingsSections {
+		if section == m.SettingsSection {
+			tabs = append(tabs, lipgloss.NewStyle().Foreground(SelectedColor).Bold(true).Render("[ "+section.String()+" ]"))
+		} else {
+			tabs = append(tabs, MutedStyle.Render("  "+section.String()+"  "))
+		}
+	}
+	content = append(content, Center(strings.Join(tabs, " "), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	profile := ""
+	if m.ConfigManager != nil {
+		profile = m.ConfigManager.Profile()
+	}
+	if profile != "" {
+		content = append(content, Center(MutedStyle.Render("Profile: ")+StatsLabelStyle.Render(profile)+
+			MutedStyle.Render(fmt.Sprintf(" (%s switches)", KeyConfigProfile)), m.Width, 1))
+		content = append(content, Spacer(1))
+	}
+
+	settings := m.settingsFor(m.SettingsSection)
+	if m.ConfigManager == nil {
+		content = append(content, Center(MutedStyle.Render("Settings are unavailable without a config file"), m.Width, 1))
+		settings = nil
+	}
+
+	labelWidth := 0
+	for _, s := range settings {
+		labelWidth = max(labelWidth, runewidth.StringWidth(s.label))
+	}
+
+	var rows []string
+	for i, s := range settings {
+		label := s.label + strings.Repeat(" ", labelWidth-runewidth.StringWidth(s.label))
+		value := s.value(m.ConfigManager.Get())
+		if i == m.SettingsCursor {
+			rows = append(rows, lipgloss.NewStyle().Foreground(SelectedColor).Bold(true).Render(fmt.Sprintf("▶ %s  ◀ %s ▶", label, value)))
+		} else {
+			rows = append(rows, StatsLabelStyle.Render(fmt.Sprintf("  %s    %s", label, value)))
+		}
+	}
+	if len(rows) > 0 {
+		content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, rows...), m.Width, 1))
+		content = append(content, Spacer(1))
+		content = append(content, Center(MutedStyle.Render(settings[min(m.SettingsCursor, len(settings)-1)].description), m.Width, 1))
+	}
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+	if m.SuccessMessage != "" {
+		content = append(content, Center(SuccessStyle.Render("✅ "+m.SuccessMessage), m.Width, 1))
+	}
+
+	bindings := []KeyBinding{
+		{"Tab", "Section"},
+		{"↑↓", "Navigate"},
+		{"←→", "Change"},
+	}
+	if m.SettingsSection == SettingsUI {
+		bindings = append(bindings, KeyBinding{"p", "Preview themes"})
+	}
+	content = append(content, RenderFooter(append(bindings, KeyBinding{"ESC/q", "Save & return"})))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// newSettingsTestModel creates a model whose configuration is stored in a temp directory
+func newSettingsTestModel(t *testing.T) *Model {
+	t.Helper()
+
+	configManager, err := config.NewManagerWithPath(filepath.Join(t.TempDir(), "config.json"))
+	if err != nil {
+		t.Fatalf("Failed to create config manager: %v", err)
+	}
+
+	model := NewModel()
+	model.ConfigManager = configManager
+	return model
+}
+
+func TestApplyConfigDebouncesSaves(t *testing.T) {
+	model := newSettingsTestModel(t)
+
+	for _, showHints := range []bool{false, true, false} {
+		cfg := model.ConfigManager.Get()
+		cfg.Game.ShowHints = showHints
+		cmd := model.applyConfig(cfg)
+		if cmd == nil {
+			t.Fatal("applyConfig should schedule a save")
+		}
+	}
+
+	if model.ConfigManager.Get().Game.ShowHints {
+		t.Error("Config change should apply immediately")
+	}
+
+	// Timers from earlier changes are ignored
+	model.Update(ConfigSaveMsg{Seq: 1})
+	if !model.ConfigManager.IsDirty() {
+		t.Error("Stale save timer should not write the config")
+	}
+
+	model.Update(ConfigSaveMsg{Seq: model.ConfigSaveSeq})
+	if model.ConfigManager.IsDirty() {
+		t.Error("Latest save timer should write the config")
+	}
+}
+
+func TestReturnToMainMenuFlushesConfig(t *testing.T) {
+	model := newSettingsTestModel(t)
+	model.CurrentView = StatsView
+
+	cfg := model.ConfigManager.Get()
+	cfg.Game.ShowHints = false
+	model.applyConfig(cfg)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if model.CurrentView != MainMenuView {
+		t.Errorf("Expected main menu view, got %v", model.CurrentView)
+	}
+
+	if model.ConfigManager.IsDirty() {
+		t.Error("Leaving a view should save pending config changes")
+	}
+}
+
+func TestSettingsViewNavigation(t *testing.T) {
+	model := newSettingsTestModel(t)
+	model.openSettings()
+
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if model.SettingsCursor != 1 {
+		t.Errorf("Expected cursor 1, got %d", model.SettingsCursor)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if model.SettingsSection != SettingsGame || model.SettingsCursor != 0 {
+		t.Errorf("Tab should open the Game section at the top, got %v cursor %d", model.SettingsSection, model.SettingsCursor)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	model.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if model.SettingsSection != SettingsEducation {
+		t.Errorf("Shift+Tab should wrap to the Education section, got %v", model.SettingsSection)
+	}
+
+	for range 10 {
+		model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if want := len(model.settingsFor(SettingsEducation)) - 1; model.SettingsCursor != want {
+		t.Errorf("Cursor should stop at the last setting %d, got %d", want, model.SettingsCursor)
+	}
+}
+
+func TestSettingsChangeAppliesConfig(t *testing.T) {
+	model := newSettingsTestModel(t)
+	model.openSettings()
+	model.SettingsSection = SettingsGame
+
+	// Doors
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if got := model.ConfigManager.Get().Game.NumDoors; got != 4 {
+		t.Errorf("Expected 4 doors in the config, got %d", got)
+	}
+	if model.NumDoors != 4 {
+		t.Errorf("Model should follow the config, got %d doors", model.NumDoors)
+	}
+
+	// Host behavior
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.HostBehavior != game.HostFall {
+		t.Errorf("Expected Monty Fall host, got %v", model.HostBehavior)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if model.ConfigManager.IsDirty() {
+		t.Error("Leaving settings should save the changes")
+	}
+
+	reloaded, err := config.NewManagerWithPath(model.ConfigManager.GetConfigPath())
+	if err != nil {
+		t.Fatalf("Failed to reload config: %v", err)
+	}
+	if got := reloaded.Get().Game.HostBehavior; got != "fall" {
+		t.Errorf("Expected saved host behavior fall, got %q", got)
+	}
+}
+
+func TestSettingsRejectsInvalidValues(t *testing.T) {
+	model := newSettingsTestModel(t)
+	model.openSettings()
+	model.SettingsSection = SettingsGame
+
+	cfg := model.ConfigManager.Get()
+	cfg.Game.NumDoors = game.MaxDoors
+	model.applyConfig(cfg)
+	model.applySettings(model.ConfigManager.Get())
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if model.ErrorMessage == "" {
+		t.Error("Expected a validation error for too many doors")
+	}
+	if got := model.ConfigManager.Get().Game.NumDoors; got != game.MaxDoors {
+		t.Errorf("Invalid value should not be applied, got %d doors", got)
+	}
+	if model.NumDoors != game.MaxDoors {
+		t.Errorf("Model should keep the valid door count, got %d", model.NumDoors)
+	}
+}
+
+func TestNoAnimationsOutlastsSettings(t *testing.T) {
+	model := newSettingsTestModel(t)
+	model.NoAnimations = true
+	model.ShowAnimations = false
+
+	// Any change to the settings applies them all again
+	cfg := model.ConfigManager.Get()
+	cfg.UI.ShowAnimations = true
+	cfg.Game.ShowHints = !cfg.Game.ShowHints
+	model.applyConfig(cfg)
+	model.applySettings(model.ConfigManager.Get())
+	if model.ShowAnimations {
+		t.Error("Expected animations to stay off for the session")
+	}
+	if !model.ConfigManager.Get().UI.ShowAnimations {
+		t.Error("Expected the animations setting left as saved")
+	}
+}
+
+func TestSettingsSwitchConfigProfile(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	if _, err := config.NewManagerForProfile("classroom"); err != nil {
+		t.Fatalf("Failed to create profile: %v", err)
+	}
+	configManager, err := config.NewManager()
+	if err != nil {
+		t.Fatalf("Failed to create config manager: %v", err)
+	}
+
+	model := NewModel()
+	model.ConfigManager = configManager
+	model.Width = 80
+	model.openSettings()
+
+	// Pending changes are saved to the profile they were made in
+	cfg := model.ConfigManager.Get()
+	cfg.Game.NumDoors = 5
+	model.applyConfig(cfg)
+	model.applySettings(model.ConfigManager.Get())
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyConfigProfile)})
+	if configManager.Profile() != "classroom" {
+		t.Fatalf("Expected c to switch to the classroom profile, got %s", configManager.Profile())
+	}
+	if model.NumDoors != game.NumDoors {
+		t.Errorf("Expected the classroom profile's %d doors, got %d", game.NumDoors, model.NumDoors)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(KeyConfigProfile)})
+	if configManager.Profile() != config.DefaultProfile || model.NumDoors != 5 {
+		t.Errorf("Expected to return to the default profile with 5 doors, got %s with %d", configManager.Profile(), model.NumDoors)
+	}
+}
@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/westhuis/monty-hall/pkg/termimage"
+)
+
+// shareImagePadding is the margin around the screen in saved images, in pixels
+const shareImagePadding = 24
+
+// captureResultScreen renders the game screen with true colors, independent
+// of what the current terminal supports, and parses it into cells
+func (m *Model) captureResultScreen() *termimage.Screen {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(profile)
+
+	view := m.renderGame()
+	if m.ASCIIOnly {
+		view = ToASCII(view)
+	}
+
+	foreground, _ := termimage.ParseHexColor(ColorText)
+	background, _ := termimage.ParseHexColor(string(BackgroundColor))
+	return termimage.Parse(view, foreground, background)
+}
+
+// saveResultImage writes the finished game screen as PNG and SVG images to
+// the current directory
+func (m *Model) saveResultImage() (tea.Model, tea.Cmd) {
+	if m.Game == nil || !m.Game.IsGameOver() {
+		return m, nil
+	}
+
+	// Messages would be captured with the screen
+	m.ErrorMessage = ""
+	m.SuccessMessage = ""
+
+	screen := m.captureResultScreen()
+	background, _ := termimage.ParseHexColor(string(BackgroundColor))
+	options := termimage.Options{Padding: shareImagePadding, Fallback: ToASCII}
+
+	base := fmt.Sprintf("monty-hall-result_%s", time.Now().Format("2006-01-02_15-04-05"))
+	renderers := []struct {
+		ext    string
+		render func(*os.File) error
+	}{
+		{".png", func(f *os.File) error { return termimage.RenderPNG(f, screen, background, options) }},
+		{".svg", func(f *os.File) error { return termimage.RenderSVG(f, screen, background, options) }},
+	}
+
+	for _, r := range renderers {
+		if err := writeImageFile(base+r.ext, r.render); err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "save result image"))
+			return m, nil
+		}
+	}
+
+	m.SuccessMessage = fmt.Sprintf("Result saved to: %s.png and %s.svg", base, base)
+	return m, nil
+}
+
+// writeImageFile creates filename and renders into it
+func writeImageFile(filename string, render func(*os.File) error) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create file %s: %w", filename, err)
+	}
+	if err := render(file); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to render %s: %w", filename, err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestSaveResultImage(t *testing.T) {
+	t.Chdir(t.TempDir())
+
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.CurrentView = GameView
+	model.Game = game.NewSeededGame(1)
+
+	// Not available before the game is over
+	model.handleGameKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	if matches, _ := filepath.Glob("monty-hall-result_*"); len(matches) != 0 {
+		t.Fatalf("Expected no image before the game is over, got %v", matches)
+	}
+
+	if err := model.Game.MakeInitialChoice(0); err != nil {
+		t.Fatalf("Initial choice failed: %v", err)
+	}
+	if err := model.Game.SwitchChoice(); err != nil {
+		t.Fatalf("Switch failed: %v", err)
+	}
+
+	model.handleGameKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+	if model.ErrorMessage != "" {
+		t.Fatalf("Expected the image to save, got error: %s", model.ErrorMessage)
+	}
+	if !strings.Contains(model.SuccessMessage, ".png") {
+		t.Errorf("Expected a success message naming the files, got %q", model.SuccessMessage)
+	}
+
+	for _, pattern := range []string{"monty-hall-result_*.png", "monty-hall-result_*.svg"} {
+		matches, _ := filepath.Glob(pattern)
+		if len(matches) != 1 {
+			t.Fatalf("Expected one file matching %s, got %v", pattern, matches)
+		}
+		if info, err := os.Stat(matches[0]); err != nil || info.Size() == 0 {
+			t.Errorf("Expected %s to be written", matches[0])
+		}
+	}
+
+	svg, _ := filepath.Glob("monty-hall-result_*.svg")
+	content, _ := os.ReadFile(svg[0])
+	if !strings.Contains(string(content), "<text") {
+		t.Error("Expected the SVG to contain the screen text")
+	}
+}
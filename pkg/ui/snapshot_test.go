@@ -0,0 +1,183 @@
+package ui
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+var updateSnapshots = flag.Bool("update", false, "rewrite the golden snapshot files in testdata/snapshots")
+
+// Fixed environment for snapshot tests
+const (
+	snapshotWidth     = 80
+	snapshotHeight    = 24
+	snapshotStatsPath = "testdata/snapshots/stats.json" // Never created; statistics start empty
+)
+
+// snapshotTime is the frozen wall clock, which also fixes the daily challenge
+var snapshotTime = time.Date(2025, time.March, 14, 12, 0, 0, 0, time.UTC)
+
+// fakeClock is a clock that only moves when told to
+type fakeClock struct {
+	current time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.current
+}
+
+// Advance moves the clock forward, as if d had passed
+func (c *fakeClock) Advance(d time.Duration) {
+	c.current = c.current.Add(d)
+}
+
+// freezeUI fixes the clock and theme for the rest of the test: true color on a
+// dark background, whatever terminal runs the tests
+func freezeUI(t *testing.T) *fakeClock {
+	t.Helper()
+
+	clock := &fakeClock{current: snapshotTime}
+	previousNow := now
+	now = clock.Now
+
+	profile := lipgloss.ColorProfile()
+	darkBackground := lipgloss.HasDarkBackground()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	lipgloss.SetHasDarkBackground(true)
+
+	t.Cleanup(func() {
+		now = previousNow
+		lipgloss.SetColorProfile(profile)
+		lipgloss.SetHasDarkBackground(darkBackground)
+	})
+	return clock
+}
+
+// newSnapshotModel creates a model with default settings, empty statistics
+// and a fixed terminal size
+func newSnapshotModel(t *testing.T) *Model {
+	t.Helper()
+
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	m := newModelWithStats(configManager, stats.NewReadOnlyStatsManager(snapshotStatsPath))
+	m.Width = snapshotWidth
+	m.Height = snapshotHeight
+	m.ShowAnimations = false
+	return m
+}
+
+// snapshotName turns a state name such as "Game: switch or stay" into a file name
+func snapshotName(name string) string {
+	return strings.Trim(regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// assertSnapshot compares output with testdata/snapshots/<name>.golden, or
+// rewrites the file when the tests run with -update
+func assertSnapshot(t *testing.T, name, output string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "snapshots", snapshotName(name)+".golden")
+	if *updateSnapshots {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create snapshot directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(output), 0644); err != nil {
+			t.Fatalf("Failed to write snapshot: %v", err)
+		}
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Missing snapshot %s; run go test ./pkg/ui -run Snapshot -update to create it: %v", path, err)
+	}
+	if string(golden) == output {
+		return
+	}
+
+	// Styling is hard to read in a diff, so point at the first line that differs
+	want := strings.Split(string(golden), "\n")
+	got := strings.Split(output, "\n")
+	for i := 0; i < max(len(want), len(got)); i++ {
+		var wantLine, gotLine string
+		if i < len(want) {
+			wantLine = want[i]
+		}
+		if i < len(got) {
+			gotLine = got[i]
+		}
+		if wantLine != gotLine {
+			t.Errorf("View differs from %s at line %d (run with -update if the change is intended)\nwant: %q\n got: %q\nplain want: %s\nplain  got: %s",
+				path, i+1, wantLine, gotLine, ansi.Strip(wantLine), ansi.Strip(gotLine))
+			return
+		}
+	}
+}
+
+func TestViewSnapshots(t *testing.T) {
+	freezeUI(t)
+
+	states := append(auditStates(),
+		auditState{"Game: revealing", func(m *Model) {
+			m.Game = game.NewSeededGame(auditSeed)
+			m.CurrentView = GameView
+			m.Game.MakeInitialChoice(0)
+			m.Game.SwitchChoice()
+			m.IsRevealing = true
+		}},
+		auditState{"Game: ten doors", func(m *Model) {
+			m.Game, _ = game.NewSeededGameWithDoors(auditSeed, 10)
+			m.CurrentView = GameView
+			m.Game.MakeInitialChoice(0)
+		}},
+	)
+
+	for _, state := range states {
+		t.Run(snapshotName(state.name), func(t *testing.T) {
+			m := newSnapshotModel(t)
+			state.setup(m)
+
+			view := m.View()
+			if again := m.View(); again != view {
+				t.Fatal("View output changed between two renders of the same state")
+			}
+			assertSnapshot(t, state.name, view)
+		})
+	}
+}
+
+func TestDoorAnimationSnapshots(t *testing.T) {
+	clock := freezeUI(t)
+
+	m := newSnapshotModel(t)
+	m.ShowAnimations = true
+	m.Game = game.NewSeededGame(auditSeed)
+	m.CurrentView = GameView
+	m.chooseDoor(0)
+	m.Update(HostRevealMsg{Seq: m.HostRevealSeq})
+	if _, running := m.DoorAnimations[m.Game.HostOpenedDoor]; !running {
+		t.Fatal("Expected the door the host opens to swing open")
+	}
+
+	// The same clock readings always give the same frames
+	var frames []string
+	for range 4 {
+		m.AnimationManager.Update()
+		frames = append(frames, m.View())
+		clock.Advance(200 * time.Millisecond)
+	}
+
+	assertSnapshot(t, "Door opening animation", strings.Join(frames, "\n\n"))
+}
@@ -0,0 +1,224 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// rangeDateFormat is how dates of a custom time range are typed and shown
+const rangeDateFormat = "2006-01-02"
+
+// rangePreset is a time range offered in the statistics view and export dialog
+type rangePreset struct {
+	Name  string
+	Since func(now time.Time) time.Time // nil covers every game
+}
+
+// rangePresets are the time ranges offered, in order
+var rangePresets = []rangePreset{
+	{Name: "All time"},
+	{Name: "Today", Since: func(now time.Time) time.Time {
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}},
+	{Name: "Last 7 days", Since: func(now time.Time) time.Time { return now.AddDate(0, 0, -7) }},
+	{Name: "Last 30 days", Since: func(now time.Time) time.Time { return now.AddDate(0, 0, -30) }},
+}
+
+// customStatsRange reports whether the statistics view covers a custom range
+func (m *Model) customStatsRange() bool {
+	return m.StatsRange == len(rangePresets) && m.StatsCustomRange != nil
+}
+
+// statsTimeRange returns the time range the statistics view covers, or nil
+// for all time
+func (m *Model) statsTimeRange(now time.Time) *stats.TimeRange {
+	if m.customStatsRange() {
+		return m.StatsCustomRange
+	}
+	if m.StatsRange >= len(rangePresets) || rangePresets[m.StatsRange].Since == nil {
+		return nil
+	}
+	return &stats.TimeRange{Start: rangePresets[m.StatsRange].Since(now), End: now}
+}
+
+// viewedStats returns the statistics of the games in the chosen time range,
+// recalculated so win rates, streaks and charts cover only those games
+func (m *Model) viewedStats() *stats.GameStats {
+	timeRange := m.statsTimeRange(time.Now())
+	if timeRange == nil {
+		return m.StatsManager.GetStats()
+	}
+	return m.StatsManager.GetFilteredStats(stats.StatsFilter{TimeRange: timeRange})
+}
+
+// statsRangeName describes the chosen time range
+func (m *Model) statsRangeName() string {
+	if m.customStatsRange() {
+		return fmt.Sprintf("%s to %s", m.StatsCustomRange.Start.Format(rangeDateFormat), m.StatsCustomRange.End.Format(rangeDateFormat))
+	}
+	if m.StatsRange >= len(rangePresets) {
+		return rangePresets[0].Name
+	}
+	return rangePresets[m.StatsRange].Name
+}
+
+// cycleStatsRange moves the statistics view to the next time range, opening
+// the editor when the custom range comes up
+func (m *Model) cycleStatsRange() {
+	next := (m.StatsRange + 1) % (len(rangePresets) + 1)
+	if next == len(rangePresets) {
+		m.RangeDraft = newRangeDraft(m.StatsCustomRange, time.Now())
+		return
+	}
+	m.StatsRange = next
+}
+
+// renderStatsRangeBar renders the time ranges, highlighting the chosen one
+func (m *Model) renderStatsRangeBar() string {
+	names := make([]string, 0, len(rangePresets)+1)
+	for _, preset := range rangePresets {
+		names = append(names, preset.Name)
+	}
+	names = append(names, "Custom")
+
+	selected := min(m.StatsRange, len(rangePresets))
+	if m.StatsRange == len(rangePresets) && !m.customStatsRange() {
+		selected = 0
+	}
+	if m.customStatsRange() {
+		names[len(rangePresets)] = m.statsRangeName()
+	}
+
+	var items []string
+	for i, name := range names {
+		if i == selected {
+			items = append(items, lipgloss.NewStyle().Foreground(SelectedColor).Bold(true).Render("["+name+"]"))
+		} else {
+			items = append(items, MutedStyle.Render(" "+name+" "))
+		}
+	}
+	return strings.Join(items, " ")
+}
+
+// RangeDraft holds the custom time range being typed in the statistics view
+type RangeDraft struct {
+	From   string // First day, as YYYY-MM-DD
+	To     string // Last day, as YYYY-MM-DD
+	Cursor int    // 0 edits From, 1 edits To
+	Err    string // Why the range could not be applied
+}
+
+// newRangeDraft starts a draft from the current custom range, or the last 30
+// days when there is none
+func newRangeDraft(current *stats.TimeRange, now time.Time) *RangeDraft {
+	if current != nil {
+		return &RangeDraft{From: current.Start.Format(rangeDateFormat), To: current.End.Format(rangeDateFormat)}
+	}
+	return &RangeDraft{From: now.AddDate(0, 0, -30).Format(rangeDateFormat), To: now.Format(rangeDateFormat)}
+}
+
+// field returns the date under the cursor
+func (d *RangeDraft) field() *string {
+	if d.Cursor == 0 {
+		return &d.From
+	}
+	return &d.To
+}
+
+// TimeRange parses the draft into a range from the start of the first day to
+// the end of the last
+func (d *RangeDraft) TimeRange() (*stats.TimeRange, error) {
+	from, err := time.ParseInLocation(rangeDateFormat, d.From, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("the first day should be written as YYYY-MM-DD")
+	}
+	to, err := time.ParseInLocation(rangeDateFormat, d.To, time.Local)
+	if err != nil {
+		return nil, fmt.Errorf("the last day should be written as YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return nil, fmt.Errorf("the range ends before it starts")
+	}
+	return &stats.TimeRange{Start: from, End: to.AddDate(0, 0, 1).Add(-time.Nanosecond)}, nil
+}
+
+// handleRangeDraftKeys processes input while a custom time range is typed
+func (m *Model) handleRangeDraftKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	draft := m.RangeDraft
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case KeyEscape:
+		m.RangeDraft = nil
+
+	case "f":
+		// Skip the custom range and carry on cycling
+		m.RangeDraft = nil
+		m.StatsRange = 0
+
+	case KeyUp, KeyDown, KeyTab, "shift+tab":
+		draft.Cursor = 1 - draft.Cursor
+
+	case "backspace":
+		if field := draft.field(); *field != "" {
+			*field = (*field)[:len(*field)-1]
+		}
+
+	case KeyEnter:
+		timeRange, err := draft.TimeRange()
+		if err != nil {
+			draft.Err = err.Error()
+			return m, nil
+		}
+		m.StatsCustomRange = timeRange
+		m.StatsRange = len(rangePresets)
+		m.RangeDraft = nil
+
+	default:
+		if msg.Type != tea.KeyRunes {
+			break
+		}
+		for _, r := range msg.Runes {
+			if field := draft.field(); (r == '-' || r >= '0' && r <= '9') && len(*field) < len(rangeDateFormat) {
+				*field += string(r)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Render renders the custom time range editor
+func (d *RangeDraft) Render() string {
+	boxStyle := lipgloss.NewStyle().
+		BorderStyle(FrameBorder).
+		BorderForeground(SecondaryColor).
+		Padding(0, 2)
+
+	lines := []string{TitleStyle.Render("CUSTOM TIME RANGE")}
+	for i, field := range []struct{ label, value string }{{"From", d.From}, {"To", d.To}} {
+		style := StatsLabelStyle
+		marker := "  "
+		value := field.value
+		if i == d.Cursor {
+			style = lipgloss.NewStyle().Foreground(SelectedColor).Bold(true)
+			marker = "▶ "
+			value += "█"
+		}
+		lines = append(lines, style.Render(fmt.Sprintf("%s%-5s", marker, field.label))+" "+value)
+	}
+	if d.Err != "" {
+		lines = append(lines, ErrorStyle.Render("❌ "+strings.ToUpper(d.Err[:1])+d.Err[1:]))
+	}
+	lines = append(lines, "", MutedStyle.Render("YYYY-MM-DD, both days included"))
+	lines = append(lines, MutedStyle.Render("↑↓ switch • Enter apply • f skip • ESC cancel"))
+
+	return boxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestStatsTimeRange(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.CurrentView = StatsView
+	model.Width = 100
+	model.Height = 50
+
+	// Three wins 20 days ago, then a loss 3 days ago
+	now := time.Now()
+	for i, won := range []bool{true, true, true, false} {
+		timestamp := now.AddDate(0, 0, -20).Add(time.Duration(i) * time.Minute)
+		if !won {
+			timestamp = now.AddDate(0, 0, -3)
+		}
+		err := model.StatsManager.RecordGame(&game.GameResult{
+			Won:            won,
+			Strategy:       game.Switch,
+			InitialChoice:  1,
+			FinalChoice:    2,
+			CarPosition:    2,
+			HostOpenedDoor: 3,
+			Timestamp:      timestamp,
+		})
+		if err != nil {
+			t.Fatalf("Failed to record game: %v", err)
+		}
+	}
+
+	if view := model.View(); !strings.Contains(view, "[All time]") || !strings.Contains(view, "75.0% win rate") {
+		t.Fatalf("Statistics should open on all time:\n%s", view)
+	}
+
+	// f: today, which has no games
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if view := model.View(); !strings.Contains(view, "[Today]") || !strings.Contains(view, "No games played in Today") {
+		t.Errorf("Expected an empty today:\n%s", view)
+	}
+
+	// f: the last 7 days, with the loss only
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if got := model.viewedStats(); got.TotalGames != 1 || got.StreakStats.LongestWinStreak != 0 {
+		t.Errorf("Expected the loss alone in the last 7 days, got %d games", got.TotalGames)
+	}
+	if view := model.View(); !strings.Contains(view, "0.0% win rate") {
+		t.Errorf("The overview should cover the last 7 days:\n%s", view)
+	}
+
+	// f twice: the custom range editor, for the day of the wins
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if model.RangeDraft == nil {
+		t.Fatal("The custom range should open the editor")
+	}
+	day := now.AddDate(0, 0, -20).Format(rangeDateFormat)
+	model.RangeDraft.From = "2099-01-01"
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.RangeDraft == nil || !strings.Contains(model.View(), "The range ends before it starts") {
+		t.Fatal("A backwards range should be refused")
+	}
+	model.RangeDraft.From = ""
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(day + "x")})
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	for range len(model.RangeDraft.To) {
+		model.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(day)})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.RangeDraft != nil {
+		t.Fatalf("Expected the range to apply, got %q", model.RangeDraft.Err)
+	}
+	if got := model.viewedStats(); got.TotalGames != 3 || got.StreakStats.CurrentWinStreak != 3 {
+		t.Errorf("Expected the 3 wins of %s, got %d games", day, got.TotalGames)
+	}
+	if view := model.View(); !strings.Contains(view, "["+day+" to "+day+"]") {
+		t.Errorf("The bar should show the custom range:\n%s", view)
+	}
+
+	// The convergence chart covers the range too
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if view := model.View(); !strings.Contains(view, "last 3 games") {
+		t.Errorf("The convergence chart should cover the custom range:\n%s", view)
+	}
+
+	// f: back to all time
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}})
+	if model.statsTimeRange(now) != nil || model.viewedStats().TotalGames != 4 {
+		t.Error("Expected to cycle back to all time")
+	}
+}
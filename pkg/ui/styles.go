@@ -9,202 +9,244 @@ import (
 	"github.com/mattn/go-runewidth"
 )
 
-// Enhanced color palette with gradients and effects
+// Colors of the active theme, set by ApplyTheme
 var (
 	// Primary colors
-	PrimaryColor   = lipgloss.Color("#00ADD8") // Go blue
-	SecondaryColor = lipgloss.Color("#00D084") // Success green
-	AccentColor    = lipgloss.Color("#FF6B6B") // Attention red
-	WarningColor   = lipgloss.Color("#FFA726") // Warning orange
+	PrimaryColor   lipgloss.Color
+	SecondaryColor lipgloss.Color
+	AccentColor    lipgloss.Color
+	WarningColor   lipgloss.Color
 
 	// Neutral colors
-	TextColor       = lipgloss.Color("#FFFFFF") // White text
-	MutedColor      = lipgloss.Color("#888888") // Muted gray
-	BorderColor     = lipgloss.Color("#444444") // Border gray
-	BackgroundColor = lipgloss.Color("#1A1A1A") // Dark background
+	TextColor       lipgloss.Color
+	MutedColor      lipgloss.Color
+	BorderColor     lipgloss.Color
+	BackgroundColor lipgloss.Color
 
 	// Game-specific colors
-	CarColor      = lipgloss.Color("#FFD700") // Gold for car
-	GoatColor     = lipgloss.Color("#8B4513") // Brown for goat
-	DoorColor     = lipgloss.Color("#8B4513") // Wood brown for doors
-	SelectedColor = lipgloss.Color("#00ADD8") // Highlight color
+	CarColor      lipgloss.Color
+	GoatColor     lipgloss.Color
+	DoorColor     lipgloss.Color
+	SelectedColor lipgloss.Color
 
 	// Enhanced visual colors
-	GlowColor      = lipgloss.Color("#00FFFF") // Cyan glow
-	SparkleColor   = lipgloss.Color("#FFFF00") // Yellow sparkle
-	ShadowColor    = lipgloss.Color("#000000") // Black shadow
-	HighlightColor = lipgloss.Color("#FFFFFF") // White highlight
+	GlowColor      lipgloss.Color
+	SparkleColor   lipgloss.Color
+	ShadowColor    lipgloss.Color
+	HighlightColor lipgloss.Color
+
+	// Fills behind highlighted content
+	SurfaceColor      lipgloss.Color
+	DoorFillColor     lipgloss.Color
+	SelectedFillColor lipgloss.Color
+	OpenFillColor     lipgloss.Color
+	RevealFillColor   lipgloss.Color
+	WinningFillColor  lipgloss.Color
+	TrackColor        lipgloss.Color
 
 	// Gradient color sets
-	WinGradient = []lipgloss.Color{
-		lipgloss.Color("#FFD700"), // Gold
-		lipgloss.Color("#FFA500"), // Orange
-		lipgloss.Color("#FF6347"), // Tomato
-	}
+	WinGradient  []lipgloss.Color
+	DoorGradient []lipgloss.Color
+	MenuGradient []lipgloss.Color
+
+	// Border of separators, such as the rule above footers
+	RuleBorder lipgloss.Border
+	// Border of boxes, dialogs and doors
+	FrameBorder lipgloss.Border
+	// Set by themes whose text must never be drawn faint
+	NoDim bool
+)
 
-	DoorGradient = []lipgloss.Color{
-		lipgloss.Color("#8B4513"), // Saddle brown
-		lipgloss.Color("#A0522D"), // Sienna
-		lipgloss.Color("#CD853F"), // Peru
-	}
+// LargeText draws taller doors with block-digit numbers, the biggest banner
+// that fits and shorter footers, for players with low vision. It follows
+// ui.large_text
+var LargeText bool
 
-	MenuGradient = []lipgloss.Color{
-		lipgloss.Color("#1A1A1A"), // Dark
-		lipgloss.Color("#2A2A2A"), // Medium
-		lipgloss.Color("#3A3A3A"), // Light
-	}
+// Base styles, rebuilt by ApplyTheme
+var (
+	// Container styles
+	HeaderStyle, BoxStyle lipgloss.Style
+
+	// Text styles
+	TitleStyle, SubtitleStyle, ErrorStyle, SuccessStyle, MutedStyle, ToastStyle lipgloss.Style
+
+	// Interactive styles
+	MenuItemStyle, SelectedMenuItemStyle, MenuButtonStyle, SelectedMenuButtonStyle lipgloss.Style
+
+	// Door styles
+	DoorStyle, SelectedDoorStyle, OpenDoorStyle, WinningDoorStyle lipgloss.Style
+
+	// Statistics styles
+	StatsHeaderStyle, StatsValueStyle, StatsLabelStyle lipgloss.Style
+
+	// Progress bar styles
+	ProgressBarStyle, ProgressFillStyle lipgloss.Style
+
+	// Enhanced visual effect styles
+	GlowStyle, SparkleStyle, WinningStyle lipgloss.Style
+
+	// Animation-ready door styles
+	DoorClosedStyle, DoorOpeningStyle, DoorRevealedStyle lipgloss.Style
+
+	// Particle, typewriter and pulse effect styles
+	ParticleStyle, TypewriterStyle, PulseBaseStyle, PulseActiveStyle lipgloss.Style
 )
 
-// Base styles
-var (
+// buildStyles derives the styles from the active colors
+func buildStyles() {
 	// Container styles
 	HeaderStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(PrimaryColor).
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(PrimaryColor).
-			Padding(1, 2).
-			Margin(1, 0)
+		Bold(true).
+		Foreground(PrimaryColor).
+		BorderStyle(FrameBorder).
+		BorderForeground(PrimaryColor).
+		Padding(1, 2).
+		Margin(1, 0)
 
 	BoxStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(BorderColor).
-			Padding(1, 2).
-			Margin(1, 0)
+		BorderStyle(FrameBorder).
+		BorderForeground(BorderColor).
+		Padding(1, 2).
+		Margin(1, 0)
 
 	// Text styles
 	TitleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(PrimaryColor).
-			Align(lipgloss.Center)
+		Bold(true).
+		Foreground(PrimaryColor).
+		Align(lipgloss.Center)
 
 	SubtitleStyle = lipgloss.NewStyle().
-			Foreground(SecondaryColor).
-			Align(lipgloss.Center)
+		Foreground(SecondaryColor).
+		Align(lipgloss.Center)
 
 	ErrorStyle = lipgloss.NewStyle().
-			Foreground(AccentColor).
-			Bold(true)
+		Foreground(AccentColor).
+		Bold(true)
 
 	SuccessStyle = lipgloss.NewStyle().
-			Foreground(SecondaryColor).
-			Bold(true)
+		Foreground(SecondaryColor).
+		Bold(true)
 
 	MutedStyle = lipgloss.NewStyle().
-			Foreground(MutedColor)
+		Foreground(MutedColor)
+
+	ToastStyle = lipgloss.NewStyle().
+		Foreground(CarColor).
+		Background(WinningFillColor).
+		Bold(true)
 
 	// Interactive styles
 	MenuItemStyle = lipgloss.NewStyle().
-			Padding(0, 2)
+		Padding(0, 2)
 
 	SelectedMenuItemStyle = lipgloss.NewStyle().
-				Foreground(SelectedColor).
-				Bold(true).
-				Padding(0, 2).
-				Background(lipgloss.Color("#2A2A2A"))
+		Foreground(SelectedColor).
+		Bold(true).
+		Padding(0, 2).
+		Background(SurfaceColor)
 
 	// Flat, minimalistic menu buttons for Phase 3
 	MenuButtonStyle = lipgloss.NewStyle().
-			Width(24).
-			Align(lipgloss.Center, lipgloss.Center).
-			Foreground(TextColor).
-			Margin(0, 0).
-			Padding(1, 2)
+		Width(24).
+		Align(lipgloss.Center, lipgloss.Center).
+		Foreground(TextColor).
+		Margin(0, 0).
+		Padding(1, 2)
 
 	SelectedMenuButtonStyle = MenuButtonStyle.
-				Foreground(PrimaryColor).
-				Background(lipgloss.Color("#2A2A2A")).
-				Bold(true)
+		Foreground(PrimaryColor).
+		Background(SurfaceColor).
+		Bold(true)
 
 	// Door styles - no width/height constraints to prevent Unicode collapse
 	DoorStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(DoorColor).
-			Background(lipgloss.Color("#2D1B0E")).
-			Padding(0, 1)
+		BorderStyle(FrameBorder).
+		BorderForeground(DoorColor).
+		Background(DoorFillColor).
+		Padding(0, 1)
 
 	SelectedDoorStyle = DoorStyle.
-				BorderForeground(SelectedColor).
-				Background(lipgloss.Color("#1A3A3A")).
-				Bold(true)
+		BorderForeground(SelectedColor).
+		Background(SelectedFillColor).
+		Bold(true)
 
 	OpenDoorStyle = DoorStyle.
-			BorderForeground(SecondaryColor).
-			Background(lipgloss.Color("#1A2A1A"))
+		BorderForeground(SecondaryColor).
+		Background(OpenFillColor)
 
 	WinningDoorStyle = DoorStyle.
-				BorderForeground(CarColor).
-				Background(lipgloss.Color("#2A2A1A")).
-				Bold(true)
+		BorderForeground(CarColor).
+		Background(RevealFillColor).
+		Bold(true)
 
 	// Statistics styles
 	StatsHeaderStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(PrimaryColor).
-				Underline(true)
+		Bold(true).
+		Foreground(PrimaryColor).
+		Underline(true)
 
 	StatsValueStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(SecondaryColor)
+		Bold(true).
+		Foreground(SecondaryColor)
 
 	StatsLabelStyle = lipgloss.NewStyle().
-			Foreground(TextColor)
+		Foreground(TextColor)
 
 	// Progress bar styles
 	ProgressBarStyle = lipgloss.NewStyle().
-				Width(30).
-				Height(1).
-				Background(lipgloss.Color("#333333"))
+		Width(30).
+		Height(1).
+		Background(TrackColor)
 
 	ProgressFillStyle = lipgloss.NewStyle().
-				Background(PrimaryColor)
+		Background(PrimaryColor)
 
 	// Enhanced visual effect styles
 	GlowStyle = lipgloss.NewStyle().
-			Foreground(GlowColor).
-			Bold(true)
+		Foreground(GlowColor).
+		Bold(true)
 
 	SparkleStyle = lipgloss.NewStyle().
-			Foreground(SparkleColor).
-			Bold(true)
+		Foreground(SparkleColor).
+		Bold(true)
 
 	WinningStyle = lipgloss.NewStyle().
-			Foreground(CarColor).
-			Background(lipgloss.Color("#2A1A00")).
-			Bold(true).
-			Blink(true)
+		Foreground(CarColor).
+		Background(WinningFillColor).
+		Bold(true).
+		Blink(true)
 
 	// Animation-ready door styles
 	DoorClosedStyle = DoorStyle.
-			BorderForeground(DoorColor).
-			Background(lipgloss.Color("#2D1B0E"))
+		BorderForeground(DoorColor).
+		Background(DoorFillColor)
 
 	DoorOpeningStyle = DoorStyle.
-				BorderForeground(WarningColor).
-				Background(lipgloss.Color("#2A2A1A")).
-				Bold(true)
+		BorderForeground(WarningColor).
+		Background(RevealFillColor).
+		Bold(true)
 
 	DoorRevealedStyle = DoorStyle.
-				BorderForeground(SecondaryColor).
-				Background(lipgloss.Color("#1A2A1A")).
-				Bold(true)
+		BorderForeground(SecondaryColor).
+		Background(OpenFillColor).
+		Bold(true)
 
 	// Particle effect styles
 	ParticleStyle = lipgloss.NewStyle().
-			Foreground(SparkleColor)
+		Foreground(SparkleColor)
 
 	// Typewriter effect style
 	TypewriterStyle = lipgloss.NewStyle().
-			Foreground(TextColor)
+		Foreground(TextColor)
 
 	// Pulse effect styles
 	PulseBaseStyle = lipgloss.NewStyle().
-			Foreground(TextColor)
+		Foreground(TextColor)
 
 	PulseActiveStyle = lipgloss.NewStyle().
-				Foreground(PrimaryColor).
-				Bold(true)
-)
+		Foreground(PrimaryColor).
+		Bold(true)
+}
 
 // Layout helpers
 func CenterHorizontal(content string, width int) string {
@@ -262,12 +304,12 @@ func GetLayoutHeight(terminalHeight int) int {
 }
 
 // Animation helpers
-func PulseStyle(baseStyle lipgloss.Style, intensity float64) lipgloss.Style {
-	// Simple pulse effect by adjusting brightness
-	if intensity > 0.5 {
+func PulseStyle(baseStyle lipgloss.Style, intensity float64, effects EffectsIntensity) lipgloss.Style {
+	// Simple pulse effect by adjusting brightness; below full effects the style holds steady
+	if intensity > 0.5 && effects == EffectsFull {
 		return baseStyle.Copy().Bold(true)
 	}
-	return baseStyle
+	return effects.Apply(baseStyle)
 }
 
 // Game phase styling
@@ -293,12 +335,12 @@ func Divider(width int) string {
 	return lipgloss.NewStyle().
 		Width(width).
 		Foreground(BorderColor).
-		Render(lipgloss.NewStyle().Width(width).Render("─"))
+		Render(lipgloss.NewStyle().Width(width).Render(RuleBorder.Top))
 }
 
 // Enhanced visual effect utilities
-func CreateGlowEffect(text string, intensity float64) string {
-	if intensity <= 0 {
+func CreateGlowEffect(text string, intensity float64, effects EffectsIntensity) string {
+	if intensity <= 0 || effects == EffectsOff {
 		return text
 	}
 
@@ -335,7 +377,15 @@ func InterpolateColor(color1, color2 lipgloss.Color, progress float64) lipgloss.
 	return color2
 }
 
-func CreatePulseEffect(text string, progress float64) string {
+func CreatePulseEffect(text string, progress float64, effects EffectsIntensity) string {
+	// Subtle effects hold the brightest style instead of pulsing
+	switch effects {
+	case EffectsOff:
+		return effects.Apply(PulseBaseStyle).Render(text)
+	case EffectsSubtle:
+		return effects.Apply(PulseActiveStyle).Render(text)
+	}
+
 	// Create pulsing effect based on progress (0.0 to 1.0)
 	intensity := (1 + math.Sin(progress*math.Pi*4)) / 2 // Oscillate between 0 and 1
 
@@ -373,16 +423,16 @@ func ApplyGradient(text string, colors []lipgloss.Color, progress float64) strin
 }
 
 // Animation state styling
-func GetAnimationStyle(state string, progress float64) lipgloss.Style {
+func GetAnimationStyle(state string, progress float64, effects EffectsIntensity) lipgloss.Style {
 	switch state {
 	case "opening":
 		return DoorOpeningStyle
 	case "revealed":
 		return DoorRevealedStyle
 	case "winning":
-		return WinningStyle
+		return effects.Apply(WinningStyle)
 	case "glowing":
-		return GlowStyle
+		return effects.Apply(GlowStyle)
 	default:
 		return DoorClosedStyle
 	}
@@ -471,9 +521,17 @@ func CreateEnhancedTitle(text string) string {
 	return CreateGradientText(text, PrimaryColor, SecondaryColor)
 }
 
-// Enhanced winning message with effects
-func CreateWinningMessage(text string) string {
-	return CreateGlowText(CreateRainbowText(text), CarColor)
+// Enhanced winning message with effects: rainbow and glow when full, bold
+// car color when subtle, plain car color when off
+func CreateWinningMessage(text string, effects EffectsIntensity) string {
+	switch effects {
+	case EffectsOff:
+		return lipgloss.NewStyle().Foreground(CarColor).Render(text)
+	case EffectsSubtle:
+		return CreateGlowText(text, CarColor)
+	default:
+		return CreateGlowText(CreateRainbowText(text), CarColor)
+	}
 }
 
 // Enhanced door number with glow effect
@@ -578,23 +636,23 @@ func GetResponsiveDoorStyle(size ScreenSize, isSelected, isCursor bool) lipgloss
 	// Remove width constraint to prevent Unicode collapse - door content handles its own width
 	baseStyle := lipgloss.NewStyle().
 		Align(lipgloss.Center, lipgloss.Center).
-		BorderStyle(lipgloss.RoundedBorder()).
+		BorderStyle(FrameBorder).
 		Padding(0, layout.Padding)
 
 	if isCursor {
 		return baseStyle.Copy().
 			BorderForeground(SelectedColor).
-			Background(lipgloss.Color("#1A3A3A")).
+			Background(SelectedFillColor).
 			Bold(true)
 	} else if isSelected {
 		return baseStyle.Copy().
 			BorderForeground(SecondaryColor).
-			Background(lipgloss.Color("#1A2A1A"))
+			Background(OpenFillColor)
 	}
 
 	return baseStyle.Copy().
 		BorderForeground(DoorColor).
-		Background(lipgloss.Color("#2D1B0E"))
+		Background(DoorFillColor)
 }
 
 // CreateASCIIBanner creates a large ASCII art banner for the title
@@ -633,10 +691,36 @@ func CreateASCIIBanner(width int) string {
 		"╩ ╩╚═╝╝╚╝ ╩  ╩   ╩ ╩╩ ╩╩═╝╩═╝",
 	}
 
+	// Themes may letter the banner themselves
+	if font := activeTheme.Banner; font != nil {
+		if len(font.Large) > 0 {
+			largeBanner = font.Large
+		}
+		if len(font.Medium) > 0 {
+			mediumBanner = font.Medium
+		}
+		if len(font.Small) > 0 {
+			smallBanner = font.Small
+		}
+	}
+
 	var selectedBanner []string
 	var style lipgloss.Style
 
-	if width >= 120 {
+	if LargeText {
+		// The biggest banner that fits, rather than the one the width calls for
+		selectedBanner = smallBanner
+		for _, banner := range [][]string{largeBanner, mediumBanner} {
+			if lipgloss.Width(strings.Join(banner, "\n")) <= width {
+				selectedBanner = banner
+				break
+			}
+		}
+		style = lipgloss.NewStyle().
+			Foreground(PrimaryColor).
+			Bold(true).
+			Align(lipgloss.Center)
+	} else if width >= 120 {
 		selectedBanner = largeBanner
 		style = lipgloss.NewStyle().
 			Foreground(PrimaryColor).
@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// SyncStats merges the statistics of other devices from the configured sync
+// folder and keeps publishing this device's games there. It reports the
+// outcome on the main menu and does nothing when sync is off or nothing is saved
+func (m *Model) SyncStats() {
+	if m.ConfigManager == nil || m.NoPersist {
+		return
+	}
+	// The sync folder holds one player's games, those of the default profile
+	if m.Profiles != nil && m.Profile != stats.DefaultProfile {
+		return
+	}
+
+	cfg := m.ConfigManager.Get()
+	if cfg.Stats.SyncDirectory == "" {
+		return
+	}
+
+	device := cfg.Stats.SyncDevice
+	if device == "" {
+		device, _ = os.Hostname()
+	}
+
+	vault, err := stats.NewVault(cfg.Stats.SyncDirectory, device)
+	if err == nil {
+		var result stats.SyncResult
+		result, err = m.StatsManager.EnableSync(vault)
+		if err == nil && result.Merged > 0 {
+			m.SuccessMessage = fmt.Sprintf("Merged %d games from your other devices", result.Merged)
+		}
+	}
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "sync statistics"))
+	}
+}
+
+// PromptStatsMerge previews merging the games in a stats file, such as one
+// copied from another computer, and asks before applying it
+func (m *Model) PromptStatsMerge(path string) {
+	merge, err := m.StatsManager.PrepareMerge(path, "")
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "merge statistics"))
+		return
+	}
+
+	name := filepath.Base(path)
+	if merge.Added == 0 && merge.Dropped == 0 {
+		m.SuccessMessage = fmt.Sprintf("Your statistics already have every game in %s", name)
+		return
+	}
+
+	lines := []string{
+		fmt.Sprintf("%d new games, %d games after merging.", merge.Added, merge.Games),
+		"Totals, streaks and daily statistics are recalculated",
+		"from the merged games. Your stats file is backed up first.",
+		"",
+		fmt.Sprintf("Games:      %d → %d", merge.Before.TotalGames, merge.After.TotalGames),
+		fmt.Sprintf("Switch won: %d/%d → %d/%d", merge.Before.SwitchStats.Wins, merge.Before.SwitchStats.GamesPlayed,
+			merge.After.SwitchStats.Wins, merge.After.SwitchStats.GamesPlayed),
+		fmt.Sprintf("Stay won:   %d/%d → %d/%d", merge.Before.StayStats.Wins, merge.Before.StayStats.GamesPlayed,
+			merge.After.StayStats.Wins, merge.After.StayStats.GamesPlayed),
+	}
+	if merge.Dropped > 0 {
+		lines = append(lines, "", lipgloss.NewStyle().Foreground(WarningColor).Render(
+			fmt.Sprintf("%d games are only counted in totals and are left out.", merge.Dropped)))
+	}
+
+	dialog := NewConfirmDialog(fmt.Sprintf("Merge games from %s?", name), lines, func() tea.Cmd {
+		backup, err := m.StatsManager.ApplyMerge(merge)
+		if err != nil {
+			m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "merge statistics"))
+			return nil
+		}
+		m.SuccessMessage = fmt.Sprintf("Merged %d games from %s", merge.Added, name)
+		if backup != "" {
+			m.SuccessMessage += fmt.Sprintf("; previous statistics backed up to %s", filepath.Base(backup))
+			m.offerOpenFolder(backup)
+		}
+		return nil
+	})
+	dialog.ConfirmLabel = "Merge"
+	m.Dialog = dialog
+}
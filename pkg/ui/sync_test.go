@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestSyncStats(t *testing.T) {
+	vaultDir := t.TempDir()
+
+	// Another device has already published a game
+	other := stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	other.RecordGame(&game.GameResult{Won: true, Strategy: game.Switch, InitialChoice: 1, FinalChoice: 2, CarPosition: 2, HostOpenedDoor: 3, Timestamp: time.Now()})
+	vault, err := stats.NewVault(vaultDir, "desktop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.EnableSync(vault); err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	cfg := configManager.Get()
+	cfg.Stats.SyncDirectory = vaultDir
+	cfg.Stats.SyncDevice = "laptop"
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	model := newModelWithStats(configManager, stats.NewStatsManager(filepath.Join(dir, "stats.json")))
+	model.SyncStats()
+
+	if got := model.StatsManager.GetStats().TotalGames; got != 1 {
+		t.Fatalf("Expected the other device's game to be merged, got %d games", got)
+	}
+	if !strings.Contains(model.SuccessMessage, "Merged 1 games") {
+		t.Errorf("Expected the merge to be reported, got %q", model.SuccessMessage)
+	}
+
+	model.OfferTutorial()
+	if model.CurrentView == TutorialView {
+		t.Error("Players with games on another device should not be offered the tutorial")
+	}
+}
+
+func TestPromptStatsMerge(t *testing.T) {
+	dir := t.TempDir()
+	other := stats.NewStatsManager(filepath.Join(dir, "other.json"))
+	other.RecordGame(&game.GameResult{Won: true, Strategy: game.Switch, InitialChoice: 1, FinalChoice: 2, CarPosition: 2, HostOpenedDoor: 3, Timestamp: time.Now()})
+
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(dir, "stats.json"))
+	model.PromptStatsMerge(filepath.Join(dir, "other.json"))
+	if model.Dialog == nil {
+		t.Fatalf("Expected a merge preview, got error %q", model.ErrorMessage)
+	}
+	if view := model.View(); !strings.Contains(view, "1 new games") || !strings.Contains(view, "Games:      0 → 1") {
+		t.Errorf("Expected the preview to show the new games and totals, got:\n%s", view)
+	}
+
+	model.Dialog.OnConfirm()
+	if got := model.StatsManager.GetStats().TotalGames; got != 1 {
+		t.Errorf("Expected 1 game after merging, got %d", got)
+	}
+
+	// Merging again finds nothing new
+	model.Dialog = nil
+	model.PromptStatsMerge(filepath.Join(dir, "other.json"))
+	if model.Dialog != nil || !strings.Contains(model.SuccessMessage, "already have every game") {
+		t.Errorf("Expected nothing to merge the second time, got %q", model.SuccessMessage)
+	}
+}
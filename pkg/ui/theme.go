@@ -0,0 +1,447 @@
+package ui
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/config"
+)
+
+// Theme is a complete color scheme. Applying a theme sets the package colors
+// and rebuilds every style from them, so views pick it up on their next render
+type Theme struct {
+	Name string
+
+	Primary   lipgloss.Color // Headers, titles and the menu selection
+	Secondary lipgloss.Color // Success and the switch strategy
+	Accent    lipgloss.Color // Errors and the stay strategy
+	Warning   lipgloss.Color
+
+	Text       lipgloss.Color
+	Muted      lipgloss.Color
+	Border     lipgloss.Color
+	Background lipgloss.Color // The terminal background the theme is designed for
+
+	Car      lipgloss.Color
+	Goat     lipgloss.Color
+	Door     lipgloss.Color
+	Selected lipgloss.Color
+
+	Glow      lipgloss.Color
+	Sparkle   lipgloss.Color
+	Shadow    lipgloss.Color
+	Highlight lipgloss.Color
+
+	// Fills behind highlighted content
+	Surface      lipgloss.Color // Selected menu items
+	DoorFill     lipgloss.Color // Closed doors
+	SelectedFill lipgloss.Color // The door under the cursor
+	OpenFill     lipgloss.Color // Opened doors
+	RevealFill   lipgloss.Color // Doors being opened and the winning door
+	WinningFill  lipgloss.Color // The win message
+	Track        lipgloss.Color // Empty part of progress bars
+
+	WinGradient  []lipgloss.Color
+	DoorGradient []lipgloss.Color
+	MenuGradient []lipgloss.Color
+
+	// Lettering, left zero for the defaults
+	Rule   lipgloss.Border // Separators, such as the rule above footers
+	Frame  lipgloss.Border // Boxes, dialogs and doors
+	Banner *BannerFont     // The main menu banner
+
+	NoDim bool // Never draw faint text, so every line keeps the theme's contrast
+}
+
+// BannerFont is a theme's lettering of the main menu banner, for wide (120
+// columns or more), medium (80 or more) and narrow terminals. Sizes left
+// empty use the built-in banner
+type BannerFont struct {
+	Large  []string
+	Medium []string
+	Small  []string
+}
+
+// DefaultTheme is the original palette, designed for dark terminals
+var DefaultTheme = Theme{
+	Name:         "default",
+	Primary:      "#00ADD8", // Go blue
+	Secondary:    "#00D084", // Success green
+	Accent:       "#FF6B6B", // Attention red
+	Warning:      "#FFA726", // Warning orange
+	Text:         "#FFFFFF",
+	Muted:        "#888888",
+	Border:       "#444444",
+	Background:   "#1A1A1A",
+	Car:          "#FFD700", // Gold
+	Goat:         "#8B4513", // Brown
+	Door:         "#8B4513", // Wood brown
+	Selected:     "#00ADD8",
+	Glow:         "#00FFFF",
+	Sparkle:      "#FFFF00",
+	Shadow:       "#000000",
+	Highlight:    "#FFFFFF",
+	Surface:      "#2A2A2A",
+	DoorFill:     "#2D1B0E",
+	SelectedFill: "#1A3A3A",
+	OpenFill:     "#1A2A1A",
+	RevealFill:   "#2A2A1A",
+	WinningFill:  "#2A1A00",
+	Track:        "#333333",
+	WinGradient:  []lipgloss.Color{"#FFD700", "#FFA500", "#FF6347"},
+	DoorGradient: []lipgloss.Color{"#8B4513", "#A0522D", "#CD853F"},
+	MenuGradient: []lipgloss.Color{"#1A1A1A", "#2A2A2A", "#3A3A3A"},
+}
+
+// HighContrastTheme uses only white, yellow and black, which look the same on
+// 16-color terminals, with thick borders and nothing dimmed
+var HighContrastTheme = Theme{
+	Name:         "high-contrast",
+	Primary:      "#FFFF00",
+	Secondary:    "#FFFFFF",
+	Accent:       "#FFFF00",
+	Warning:      "#FFFF00",
+	Text:         "#FFFFFF",
+	Muted:        "#FFFFFF",
+	Border:       "#FFFFFF",
+	Background:   "#000000",
+	Car:          "#FFFF00",
+	Goat:         "#FFFFFF",
+	Door:         "#FFFFFF",
+	Selected:     "#FFFF00",
+	Glow:         "#FFFF00",
+	Sparkle:      "#FFFF00",
+	Shadow:       "#000000",
+	Highlight:    "#FFFFFF",
+	Surface:      "#000000",
+	DoorFill:     "#000000",
+	SelectedFill: "#000000",
+	OpenFill:     "#000000",
+	RevealFill:   "#000000",
+	WinningFill:  "#000000",
+	Track:        "#FFFFFF",
+	WinGradient:  []lipgloss.Color{"#FFFF00", "#FFFFFF", "#FFFF00"},
+	DoorGradient: []lipgloss.Color{"#FFFFFF", "#FFFFFF", "#FFFFFF"},
+	MenuGradient: []lipgloss.Color{"#000000", "#000000", "#000000"},
+	Rule:         lipgloss.ThickBorder(),
+	Frame:        lipgloss.ThickBorder(),
+	NoDim:        true,
+}
+
+// ColorblindSafeTheme uses the Okabe-Ito palette, so the switch and stay
+// colors stay distinct with red-green color blindness
+var ColorblindSafeTheme = Theme{
+	Name:         "colorblind-safe",
+	Primary:      "#56B4E9", // Sky blue
+	Secondary:    "#0099E6", // Blue, for switching
+	Accent:       "#E69F00", // Orange, for staying and errors
+	Warning:      "#F0E442", // Yellow
+	Text:         "#FFFFFF",
+	Muted:        "#999999",
+	Border:       "#555555",
+	Background:   "#1A1A1A",
+	Car:          "#F0E442",
+	Goat:         "#CC79A7", // Reddish purple
+	Door:         "#B07A4F",
+	Selected:     "#56B4E9",
+	Glow:         "#56B4E9",
+	Sparkle:      "#F0E442",
+	Shadow:       "#000000",
+	Highlight:    "#FFFFFF",
+	Surface:      "#2A2A2A",
+	DoorFill:     "#2D1B0E",
+	SelectedFill: "#12303F",
+	OpenFill:     "#0F2533",
+	RevealFill:   "#2E2A10",
+	WinningFill:  "#2E2A10",
+	Track:        "#333333",
+	WinGradient:  []lipgloss.Color{"#F0E442", "#E69F00", "#D55E00"},
+	DoorGradient: []lipgloss.Color{"#B07A4F", "#C08C60", "#D09E72"},
+	MenuGradient: []lipgloss.Color{"#1A1A1A", "#2A2A2A", "#3A3A3A"},
+}
+
+// LightTheme is designed for terminals with a light background
+var LightTheme = Theme{
+	Name:         "light",
+	Primary:      "#005F87",
+	Secondary:    "#006B3C",
+	Accent:       "#B00020",
+	Warning:      "#8A4B00",
+	Text:         "#1A1A1A",
+	Muted:        "#5F5F5F",
+	Border:       "#9E9E9E",
+	Background:   "#FFFFFF",
+	Car:          "#7A5C00",
+	Goat:         "#6D3B12",
+	Door:         "#6D3B12",
+	Selected:     "#005F87",
+	Glow:         "#0077AA",
+	Sparkle:      "#A66F00",
+	Shadow:       "#BDBDBD",
+	Highlight:    "#000000",
+	Surface:      "#E4EEF3",
+	DoorFill:     "#F3E6D8",
+	SelectedFill: "#D8EAF2",
+	OpenFill:     "#DDEFE3",
+	RevealFill:   "#F5EDCC",
+	WinningFill:  "#F5EDCC",
+	Track:        "#DDDDDD",
+	WinGradient:  []lipgloss.Color{"#7A5C00", "#A65E00", "#B33A1B"},
+	DoorGradient: []lipgloss.Color{"#6D3B12", "#7F4A1F", "#93602F"},
+	MenuGradient: []lipgloss.Color{"#FFFFFF", "#F0F0F0", "#E0E0E0"},
+}
+
+// MatrixTheme is green phosphor on black, with the banner spelled in bits
+var MatrixTheme = Theme{
+	Name:         "matrix",
+	Primary:      "#00FF41",
+	Secondary:    "#B6FFB6", // Pale green, for switching
+	Accent:       "#7FD600", // Yellow green, for staying and errors
+	Warning:      "#D4FF5A",
+	Text:         "#33FF66",
+	Muted:        "#00A82D",
+	Border:       "#006B1C",
+	Background:   "#000000",
+	Car:          "#E6FFE6",
+	Goat:         "#00A82D",
+	Door:         "#00B32C",
+	Selected:     "#00FF41",
+	Glow:         "#00FF41",
+	Sparkle:      "#E6FFE6",
+	Shadow:       "#000000",
+	Highlight:    "#E6FFE6",
+	Surface:      "#003B0F",
+	DoorFill:     "#001A06",
+	SelectedFill: "#003B0F",
+	OpenFill:     "#00140A",
+	RevealFill:   "#0A2A0A",
+	WinningFill:  "#002B0A",
+	Track:        "#002B0A",
+	WinGradient:  []lipgloss.Color{"#E6FFE6", "#00FF41", "#00A82D"},
+	DoorGradient: []lipgloss.Color{"#006B1C", "#008F23", "#00B32C"},
+	MenuGradient: []lipgloss.Color{"#000000", "#001A06", "#002B0A"},
+	Banner: &BannerFont{
+		Large: []string{
+			"01      01    010101    01      01  0101010101  01      01          01      01    010101    01          01        ",
+			"0101  0101  01      01  0101    01      01        01  01            01      01  01      01  01          01        ",
+			"01  01  01  01      01  01  01  01      01          01              0101010101  0101010101  01          01        ",
+			"01      01  01      01  01    0101      01          01              01      01  01      01  01          01        ",
+			"01      01    010101    01      01      01          01              01      01  01      01  0101010101  0101010101",
+		},
+		Medium: []string{
+			"0   0  101  0   0 01010 0   0     0   0  101  0     0    ",
+			"01 10 0   0 01  0   0    1 1      0   0 0   0 0     0    ",
+			"0 0 0 0   0 0 0 0   0     0       01010 01010 0     0    ",
+			"0   0 0   0 0  10   0     0       0   0 0   0 0     0    ",
+			"0   0  101  0   0   0     0       0   0 0   0 01010 01010",
+		},
+		Small: []string{"> MONTY HALL_"},
+	},
+}
+
+// CRTTheme is an amber monochrome monitor: lettering drawn in half-height
+// blocks and double rules stand in for scanlines
+var CRTTheme = Theme{
+	Name:         "crt",
+	Primary:      "#FFB000",
+	Secondary:    "#FFD866", // Pale amber, for switching
+	Accent:       "#FF7A1A", // Orange, for staying and errors
+	Warning:      "#FFE9A8",
+	Text:         "#FFC23D",
+	Muted:        "#B37B00",
+	Border:       "#664600",
+	Background:   "#140C00",
+	Car:          "#FFF3C4",
+	Goat:         "#B37B00",
+	Door:         "#CC8A00",
+	Selected:     "#FFB000",
+	Glow:         "#FFB000",
+	Sparkle:      "#FFF3C4",
+	Shadow:       "#000000",
+	Highlight:    "#FFF3C4",
+	Surface:      "#3D2A00",
+	DoorFill:     "#241700",
+	SelectedFill: "#3D2A00",
+	OpenFill:     "#1C1200",
+	RevealFill:   "#332200",
+	WinningFill:  "#3D2A00",
+	Track:        "#2E1F00",
+	WinGradient:  []lipgloss.Color{"#FFF3C4", "#FFB000", "#FF7A1A"},
+	DoorGradient: []lipgloss.Color{"#996600", "#B37B00", "#CC8A00"},
+	MenuGradient: []lipgloss.Color{"#140C00", "#241700", "#332200"},
+	Rule:         lipgloss.DoubleBorder(),
+	Banner: &BannerFont{
+		Large: []string{
+			"▀▀      ▀▀    ▀▀▀▀▀▀    ▀▀      ▀▀  ▀▀▀▀▀▀▀▀▀▀  ▀▀      ▀▀          ▀▀      ▀▀    ▀▀▀▀▀▀    ▀▀          ▀▀        ",
+			"▀▀▀▀  ▀▀▀▀  ▀▀      ▀▀  ▀▀▀▀    ▀▀      ▀▀        ▀▀  ▀▀            ▀▀      ▀▀  ▀▀      ▀▀  ▀▀          ▀▀        ",
+			"▀▀  ▀▀  ▀▀  ▀▀      ▀▀  ▀▀  ▀▀  ▀▀      ▀▀          ▀▀              ▀▀▀▀▀▀▀▀▀▀  ▀▀▀▀▀▀▀▀▀▀  ▀▀          ▀▀        ",
+			"▀▀      ▀▀  ▀▀      ▀▀  ▀▀    ▀▀▀▀      ▀▀          ▀▀              ▀▀      ▀▀  ▀▀      ▀▀  ▀▀          ▀▀        ",
+			"▀▀      ▀▀    ▀▀▀▀▀▀    ▀▀      ▀▀      ▀▀          ▀▀              ▀▀      ▀▀  ▀▀      ▀▀  ▀▀▀▀▀▀▀▀▀▀  ▀▀▀▀▀▀▀▀▀▀",
+		},
+		Medium: []string{
+			"▀   ▀  ▀▀▀  ▀   ▀ ▀▀▀▀▀ ▀   ▀     ▀   ▀  ▀▀▀  ▀     ▀    ",
+			"▀▀ ▀▀ ▀   ▀ ▀▀  ▀   ▀    ▀ ▀      ▀   ▀ ▀   ▀ ▀     ▀    ",
+			"▀ ▀ ▀ ▀   ▀ ▀ ▀ ▀   ▀     ▀       ▀▀▀▀▀ ▀▀▀▀▀ ▀     ▀    ",
+			"▀   ▀ ▀   ▀ ▀  ▀▀   ▀     ▀       ▀   ▀ ▀   ▀ ▀     ▀    ",
+			"▀   ▀  ▀▀▀  ▀   ▀   ▀     ▀       ▀   ▀ ▀   ▀ ▀▀▀▀▀ ▀▀▀▀▀",
+		},
+		Small: []string{"▀▀ MONTY HALL ▀▀"},
+	},
+}
+
+// Themes returns the built-in themes, in the order settings cycles through them
+func Themes() []Theme {
+	return []Theme{DefaultTheme, HighContrastTheme, ColorblindSafeTheme, LightTheme, MatrixTheme, CRTTheme}
+}
+
+// ThemeByName returns the built-in theme with the given name
+func ThemeByName(name string) (Theme, bool) {
+	for _, theme := range Themes() {
+		if theme.Name == name {
+			return theme, true
+		}
+	}
+	return Theme{}, false
+}
+
+// CustomTheme builds a user-defined theme: its base theme with the colors
+// it sets replaced. The fills, gradients and lettering come from the base
+func CustomTheme(def config.ThemeDefinition) Theme {
+	theme, ok := ThemeByName(def.Base)
+	if !ok {
+		theme = DefaultTheme
+	}
+	theme.Name = def.Name
+
+	for _, override := range []struct {
+		value string
+		color *lipgloss.Color
+	}{
+		{def.Primary, &theme.Primary},
+		{def.Secondary, &theme.Secondary},
+		{def.Accent, &theme.Accent},
+		{def.Warning, &theme.Warning},
+		{def.Text, &theme.Text},
+		{def.Muted, &theme.Muted},
+		{def.Border, &theme.Border},
+		{def.Background, &theme.Background},
+		{def.Car, &theme.Car},
+		{def.Goat, &theme.Goat},
+		{def.Door, &theme.Door},
+		{def.Selected, &theme.Selected},
+	} {
+		if override.value != "" {
+			*override.color = lipgloss.Color(override.value)
+		}
+	}
+	return theme
+}
+
+// themeFor returns the theme the UI settings select, looking up custom
+// themes by name; the high contrast accessibility option overrides the
+// color scheme
+func themeFor(ui config.UIConfig, custom []config.ThemeDefinition) Theme {
+	if ui.HighContrast {
+		return HighContrastTheme
+	}
+	if theme, ok := ThemeByName(ui.ColorScheme); ok {
+		return theme
+	}
+	for _, def := range custom {
+		if def.Name == ui.ColorScheme {
+			return CustomTheme(def)
+		}
+	}
+	return DefaultTheme
+}
+
+// ThemeCheckInterval is how often the custom themes file is checked for changes
+const ThemeCheckInterval = 2 * time.Second
+
+// ThemeCheckMsg is sent when the custom themes file should be checked
+type ThemeCheckMsg struct{}
+
+// watchThemes schedules the next check of the custom themes file
+func (m *Model) watchThemes() tea.Cmd {
+	if m.ConfigManager == nil {
+		return nil
+	}
+	return tea.Tick(ThemeCheckInterval, func(time.Time) tea.Msg {
+		return ThemeCheckMsg{}
+	})
+}
+
+// reloadThemes applies edits to the custom themes file while the app runs
+func (m *Model) reloadThemes() tea.Cmd {
+	changed, err := m.ConfigManager.ReloadThemesIfChanged()
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "load themes"))
+	}
+	if changed {
+		m.applySettings(m.ConfigManager.Get())
+	}
+	return m.watchThemes()
+}
+
+// activeTheme is the theme the colors and styles were last built from
+var activeTheme Theme
+
+// ActiveTheme returns the theme in use
+func ActiveTheme() Theme {
+	return activeTheme
+}
+
+// ApplyTheme makes the theme active, setting every color and rebuilding the
+// styles derived from them
+func ApplyTheme(theme Theme) {
+	activeTheme = theme
+
+	PrimaryColor = theme.Primary
+	SecondaryColor = theme.Secondary
+	AccentColor = theme.Accent
+	WarningColor = theme.Warning
+
+	TextColor = theme.Text
+	MutedColor = theme.Muted
+	BorderColor = theme.Border
+	BackgroundColor = theme.Background
+
+	CarColor = theme.Car
+	GoatColor = theme.Goat
+	DoorColor = theme.Door
+	SelectedColor = theme.Selected
+
+	GlowColor = theme.Glow
+	SparkleColor = theme.Sparkle
+	ShadowColor = theme.Shadow
+	HighlightColor = theme.Highlight
+
+	SurfaceColor = theme.Surface
+	DoorFillColor = theme.DoorFill
+	SelectedFillColor = theme.SelectedFill
+	OpenFillColor = theme.OpenFill
+	RevealFillColor = theme.RevealFill
+	WinningFillColor = theme.WinningFill
+	TrackColor = theme.Track
+
+	WinGradient = theme.WinGradient
+	DoorGradient = theme.DoorGradient
+	MenuGradient = theme.MenuGradient
+
+	RuleBorder = theme.Rule
+	if RuleBorder == (lipgloss.Border{}) {
+		RuleBorder = lipgloss.NormalBorder()
+	}
+	FrameBorder = theme.Frame
+	if FrameBorder == (lipgloss.Border{}) {
+		FrameBorder = lipgloss.RoundedBorder()
+	}
+	NoDim = theme.NoDim
+
+	buildStyles()
+}
+
+func init() {
+	ApplyTheme(DefaultTheme)
+}
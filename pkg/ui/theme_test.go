@@ -0,0 +1,195 @@
+package ui
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/westhuis/monty-hall/pkg/config"
+)
+
+func TestThemesPassContrastChecks(t *testing.T) {
+	defer ApplyTheme(DefaultTheme)
+
+	// The default theme keeps its original palette, whose door border is a
+	// known contrast failure; the alternative schemes must pass every check
+	for _, theme := range Themes()[1:] {
+		ApplyTheme(theme)
+
+		report := &AccessibilityReport{}
+		report.checkContrast()
+		for _, check := range report.Checks {
+			if !check.Passed {
+				t.Errorf("%s: %s fails: %s", theme.Name, check.Subject, check.Detail)
+			}
+		}
+	}
+}
+
+func TestHighContrastOn16Colors(t *testing.T) {
+	defer ApplyTheme(DefaultTheme)
+	ApplyTheme(HighContrastTheme)
+
+	// Every color is one of the 16 ANSI colors, so a 16-color terminal shows
+	// the palette unchanged and the contrast checks still hold
+	value := reflect.ValueOf(HighContrastTheme)
+	for i := range value.NumField() {
+		var colors []lipgloss.Color
+		switch field := value.Field(i).Interface().(type) {
+		case lipgloss.Color:
+			colors = []lipgloss.Color{field}
+		case []lipgloss.Color:
+			colors = field
+		}
+		for _, color := range colors {
+			ansi := termenv.ANSI.Convert(termenv.RGBColor(color)).(termenv.ANSIColor)
+			if !strings.EqualFold(ansi.String(), string(color)) {
+				t.Errorf("%s %s becomes %s on 16-color terminals", value.Type().Field(i).Name, color, ansi)
+			}
+		}
+	}
+
+	if MutedColor != TextColor || !NoDim {
+		t.Error("Expected nothing to be muted or dimmed")
+	}
+	for name, style := range map[string]lipgloss.Style{"Door": DoorStyle, "Box": BoxStyle, "Header": HeaderStyle} {
+		if style.GetBorderStyle() != lipgloss.ThickBorder() {
+			t.Errorf("Expected %s borders to be thick", name)
+		}
+	}
+	if dialog := plainText(NewConfirmDialog("Apply settings?", nil, nil).Render()); !strings.Contains(dialog, "┏") {
+		t.Errorf("Expected a thick dialog border, got:\n%s", dialog)
+	}
+}
+
+func TestColorSchemeSetting(t *testing.T) {
+	defer ApplyTheme(DefaultTheme)
+
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	model := NewModelWithConfig(configManager)
+	if ActiveTheme().Name != DefaultTheme.Name {
+		t.Fatalf("Expected the default theme, got %s", ActiveTheme().Name)
+	}
+
+	for _, scheme := range config.GetColorSchemes() {
+		if _, ok := ThemeByName(scheme); !ok {
+			t.Errorf("Color scheme %q has no theme", scheme)
+		}
+	}
+
+	cfg := configManager.Get()
+	cfg.UI.ColorScheme = "light"
+	model.applySettings(cfg)
+	if HeaderStyle.GetForeground() != LightTheme.Primary || MutedStyle.GetForeground() != LightTheme.Muted {
+		t.Error("Expected styles to be rebuilt from the light theme")
+	}
+
+	// High contrast is an accessibility option that overrides the scheme
+	cfg.UI.HighContrast = true
+	model.applySettings(cfg)
+	if ActiveTheme().Name != HighContrastTheme.Name || DoorStyle.GetBorderBottomForeground() != HighContrastTheme.Door {
+		t.Errorf("Expected the high contrast theme, got %s", ActiveTheme().Name)
+	}
+}
+
+func TestCustomTheme(t *testing.T) {
+	defer ApplyTheme(DefaultTheme)
+
+	def := config.ThemeDefinition{Name: "ocean", Base: "light", Primary: "#0077BE", Door: "#335577"}
+	theme := CustomTheme(def)
+	if theme.Name != "ocean" || theme.Primary != "#0077BE" || theme.Door != "#335577" {
+		t.Errorf("Expected the theme's colors, got %+v", theme)
+	}
+	if theme.Text != LightTheme.Text || theme.DoorFill != LightTheme.DoorFill {
+		t.Error("Expected unset colors to come from the base theme")
+	}
+
+	ui := config.UIConfig{ColorScheme: "ocean"}
+	ApplyTheme(themeFor(ui, []config.ThemeDefinition{def}))
+	if ActiveTheme().Name != "ocean" || HeaderStyle.GetForeground() != theme.Primary {
+		t.Errorf("Expected the custom theme to be applied, got %s", ActiveTheme().Name)
+	}
+
+	if themeFor(ui, nil).Name != DefaultTheme.Name {
+		t.Error("Expected an unknown scheme to use the default theme")
+	}
+}
+
+func TestThemeGallery(t *testing.T) {
+	defer ApplyTheme(DefaultTheme)
+
+	configManager := config.NewManagerWithDefaults(filepath.Join(t.TempDir(), "config.json"))
+	model := NewModelWithConfig(configManager)
+	model.Width, model.Height = len(Themes())*(galleryCardWidth+1), 30
+	model.openSettings()
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if model.CurrentView != ThemeGalleryView || model.Gallery.Themes[model.Gallery.Cursor].Name != DefaultTheme.Name {
+		t.Fatal("Expected p to open the gallery on the active theme")
+	}
+
+	view := plainText(model.View())
+	for _, theme := range Themes() {
+		if !strings.Contains(view, theme.Name) {
+			t.Errorf("Expected a preview of %s", theme.Name)
+		}
+	}
+	if ActiveTheme().Name != DefaultTheme.Name {
+		t.Error("Rendering previews should leave the active theme unchanged")
+	}
+
+	// Picking a theme turns off high contrast, which would override it
+	cfg := configManager.Get()
+	cfg.UI.HighContrast = true
+	model.applySettings(cfg)
+	configManager.Update(cfg)
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if got := configManager.Get().UI; got.ColorScheme != ColorblindSafeTheme.Name || got.HighContrast {
+		t.Errorf("Expected the colorblind-safe scheme without high contrast, got %+v", got)
+	}
+	if ActiveTheme().Name != ColorblindSafeTheme.Name {
+		t.Errorf("Expected the selected theme to be applied, got %s", ActiveTheme().Name)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	if model.CurrentView != SettingsView {
+		t.Error("Expected b to return to settings")
+	}
+}
+
+func TestThemeLettering(t *testing.T) {
+	defer ApplyTheme(DefaultTheme)
+
+	ApplyTheme(CRTTheme)
+	for _, width := range []int{120, 80, 40} {
+		if banner := plainText(CreateASCIIBanner(width)); !strings.Contains(banner, "▀") || strings.Contains(banner, "█") {
+			t.Errorf("Expected the CRT lettering at width %d, got:\n%s", width, banner)
+		}
+	}
+	if footer := plainText(RenderFooter([]KeyBinding{{"q", "Quit"}})); !strings.Contains(footer, "═") {
+		t.Errorf("Expected a double rule above the CRT footer, got:\n%s", footer)
+	}
+
+	// Custom themes take the lettering of their base
+	ApplyTheme(CustomTheme(config.ThemeDefinition{Name: "green-crt", Base: "crt", Primary: "#33FF33"}))
+	if banner := plainText(CreateASCIIBanner(80)); !strings.Contains(banner, "▀") {
+		t.Errorf("Expected a custom theme to keep its base's lettering, got:\n%s", banner)
+	}
+
+	// Lettering a theme leaves out falls back to the built-in banner and rules
+	ApplyTheme(MatrixTheme)
+	if footer := plainText(RenderFooter([]KeyBinding{{"q", "Quit"}})); !strings.Contains(footer, "─") {
+		t.Errorf("Expected the normal rule above the matrix footer, got:\n%s", footer)
+	}
+	ApplyTheme(DefaultTheme)
+	if banner := plainText(CreateASCIIBanner(80)); !strings.Contains(banner, "███╗") {
+		t.Errorf("Expected the built-in banner, got:\n%s", banner)
+	}
+}
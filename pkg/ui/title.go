@@ -0,0 +1,51 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbletea"
+)
+
+// windowTitle describes the app's state for the terminal window title, such
+// as "Monty Hall — 62% switch win rate — game 134", so it can be followed
+// from a background tmux pane or tab
+func (m *Model) windowTitle() string {
+	parts := []string{"Monty Hall"}
+	gameStats := m.StatsManager.GetStats()
+
+	if switched := gameStats.SwitchStats; switched.GamesPlayed > 0 {
+		rate := float64(switched.Wins) / float64(switched.GamesPlayed)
+		parts = append(parts, fmt.Sprintf("%.0f%% switch win rate", rate*100))
+	}
+
+	// The game in progress, or the last one played
+	number := gameStats.TotalGames
+	if m.CurrentView == GameView && m.Game != nil && !m.Game.IsGameOver() {
+		number++
+	}
+	if number > 0 {
+		parts = append(parts, fmt.Sprintf("game %d", number))
+	}
+
+	separator := " — "
+	if m.ASCIIOnly {
+		separator = " - "
+	}
+	return strings.Join(parts, separator)
+}
+
+// updateWindowTitle returns a command setting the terminal window title when
+// it has changed, or clearing it when the setting is turned off
+func (m *Model) updateWindowTitle() tea.Cmd {
+	title := ""
+	if m.TerminalTitle {
+		title = m.windowTitle()
+	}
+	if title == m.WindowTitle {
+		return nil
+	}
+
+	m.WindowTitle = title
+	return tea.SetWindowTitle(title)
+}
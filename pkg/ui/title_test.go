@@ -0,0 +1,46 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestWindowTitle(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.TerminalTitle = true
+
+	if title := model.windowTitle(); title != "Monty Hall" {
+		t.Errorf("Expected a plain title before any games, got %q", title)
+	}
+	if model.updateWindowTitle() == nil || model.updateWindowTitle() != nil {
+		t.Error("The title should only be set when it changes")
+	}
+
+	for i, won := range []bool{true, true, false} {
+		g := game.NewSeededGame(int64(i))
+		g.MakeInitialChoice(0)
+		g.SwitchChoice()
+		g.Result.Won = won
+		if err := model.StatsManager.RecordGame(g.Result); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if title := model.windowTitle(); title != "Monty Hall — 67% switch win rate — game 3" {
+		t.Errorf("Unexpected title after three games: %q", title)
+	}
+
+	model.startNewGame()
+	model.CurrentView = GameView
+	if title := model.windowTitle(); title != "Monty Hall — 67% switch win rate — game 4" {
+		t.Errorf("The title should number the game in progress, got %q", title)
+	}
+
+	model.TerminalTitle = false
+	if model.updateWindowTitle() == nil || model.WindowTitle != "" {
+		t.Error("Turning the setting off should clear the title")
+	}
+}
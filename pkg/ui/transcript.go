@@ -0,0 +1,220 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/transcript"
+)
+
+// transcriptScrollLines is how far one turn of the mouse wheel scrolls the
+// transcript
+const transcriptScrollLines = 3
+
+// recordTranscript adds the steps the current game has taken since the last
+// update to the session transcript. Watching the game, rather than each way of
+// playing it, records choices made by key, mouse or default strategy alike
+func (m *Model) recordTranscript() {
+	if m.Transcript == nil || m.Game == nil {
+		return
+	}
+
+	g := m.Game
+	if g != m.transcriptGame {
+		m.transcriptGame, m.transcriptPhase = g, game.Setup
+		m.Transcript.StartGame(now(), m.transcriptGameStart(g))
+	}
+	if g.Phase == m.transcriptPhase {
+		return
+	}
+
+	// A restored game may already be past several steps
+	elapsed := g.Elapsed(time.Now())
+	if g.Phase == game.InitialChoice && m.transcriptPhase > game.InitialChoice {
+		m.Transcript.Add(now(), elapsed, transcript.KindChoice, "Took back the first pick")
+	}
+	// An abandoned game may never have had a door chosen or opened
+	if m.transcriptPhase < game.HostReveal && g.Phase >= game.HostReveal && g.PlayerInitialChoice >= 0 {
+		m.Transcript.Add(now(), elapsed, transcript.KindChoice, fmt.Sprintf("Chose door %d", g.PlayerInitialChoice+1))
+	}
+	if m.transcriptPhase < game.FinalChoice && g.Phase >= game.FinalChoice && len(g.HostOpenedDoors) > 0 {
+		m.Transcript.Add(now(), elapsed, transcript.KindHost, transcriptHostText(g))
+	}
+	if g.Phase == game.Aborted {
+		m.Transcript.Add(now(), elapsed, transcript.KindResult, "Abandoned without a result")
+	}
+	if g.Phase == game.GameOver && g.Result != nil {
+		result := g.Result // Results number doors from 1
+		final := fmt.Sprintf("Stayed with door %d", result.FinalChoice)
+		if result.Strategy == game.Switch {
+			final = fmt.Sprintf("Switched to door %d", result.FinalChoice)
+		}
+		if result.StrategySource == game.StrategySourceDefault {
+			final += " (default strategy)"
+		}
+		m.Transcript.Add(now(), result.GameDuration, transcript.KindChoice, final)
+
+		outcome := fmt.Sprintf("Got a goat; the car was behind door %d", result.CarPosition)
+		if result.Won {
+			outcome = "Won the car"
+		}
+		m.Transcript.Add(now(), result.GameDuration, transcript.KindResult, outcome)
+	}
+	m.transcriptPhase = g.Phase
+}
+
+// transcriptGameStart describes a new game for the transcript
+func (m *Model) transcriptGameStart(g *game.Game) string {
+	text := fmt.Sprintf("New game: %d doors, %s", g.NumDoors(), g.Host.Behavior.DisplayName())
+	if seed, ok := g.Seed(); ok {
+		text += fmt.Sprintf(", seed %d", seed)
+	}
+	switch {
+	case m.Daily != nil:
+		text += " (daily challenge)"
+	case m.Practice != nil:
+		text += " (practice)"
+	case m.Rematch:
+		text += " (same doors, practice)"
+	case m.Worksheet != nil:
+		text += " (worksheet)"
+	}
+	return text
+}
+
+// transcriptHostText says which doors the host opened and what was behind them
+func transcriptHostText(g *game.Game) string {
+	doors := make([]string, len(g.HostOpenedDoors))
+	for i, door := range g.HostOpenedDoors {
+		doors[i] = fmt.Sprintf("%d", door+1)
+	}
+
+	text := "Host opened door " + doors[0]
+	if len(doors) > 1 {
+		text = "Host opened doors " + strings.Join(doors, ", ")
+	}
+	switch {
+	case g.CarRevealed():
+		return text + ", revealing the car"
+	case len(doors) > 1:
+		return text + ": goats"
+	default:
+		return text + ": a goat"
+	}
+}
+
+// openTranscript shows the transcript with the newest entries in view
+func (m *Model) openTranscript() {
+	m.CurrentView = TranscriptView
+	m.TranscriptScroll = 0
+}
+
+// scrollTranscript scrolls the transcript by lines, up for positive lines,
+// keeping within it
+func (m *Model) scrollTranscript(lines int) {
+	limit := max(0, m.Transcript.Len()-m.transcriptRows())
+	m.TranscriptScroll = min(max(m.TranscriptScroll+lines, 0), limit)
+}
+
+// transcriptRows is how many entries fit on screen
+func (m *Model) transcriptRows() int {
+	return max(3, m.Height-12)
+}
+
+// saveTranscript writes the transcript to a text file in the current folder
+func (m *Model) saveTranscript() (tea.Model, tea.Cmd) {
+	path := fmt.Sprintf("monty-hall-transcript_%s.txt", now().Format("2006-01-02_15-04-05"))
+	if err := m.Transcript.Save(path); err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "save transcript"))
+		return m, nil
+	}
+	m.SuccessMessage = "Transcript saved to: " + path
+	m.offerOpenFolder(path)
+	return m, nil
+}
+
+// handleTranscriptKeys processes transcript input
+func (m *Model) handleTranscriptKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.Transcript == nil {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeyUp, "k":
+		m.scrollTranscript(1)
+
+	case KeyDown, "j":
+		m.scrollTranscript(-1)
+
+	case KeyE:
+		// Kiosk visitors cannot write files
+		if !m.NoPersist && m.Transcript.Len() > 0 {
+			return m.saveTranscript()
+		}
+	}
+
+	return m, nil
+}
+
+// renderTranscript renders the session transcript: one line per step, oldest
+// first, scrolled to the newest until the player scrolls back
+func (m *Model) renderTranscript() string {
+	var content []string
+	content = append(content, HeaderStyle.Render("SESSION TRANSCRIPT"))
+
+	var entries []transcript.Entry
+	if m.Transcript != nil {
+		entries = m.Transcript.Entries()
+	}
+
+	if len(entries) == 0 {
+		content = append(content, Center(MutedStyle.Render("No games yet this session. Every game, choice and its timing will be recorded here."), m.Width, 1))
+	} else {
+		games := fmt.Sprintf("%d games", m.Transcript.Games())
+		if m.Transcript.Games() == 1 {
+			games = "1 game"
+		}
+		content = append(content, Center(MutedStyle.Render(fmt.Sprintf("%s since %s", games, m.Transcript.Started.Format(time.TimeOnly))), m.Width, 1))
+		content = append(content, Spacer(1))
+
+		visible := m.transcriptRows()
+		end := len(entries) - min(m.TranscriptScroll, max(0, len(entries)-visible))
+		start := max(0, end-visible)
+
+		lineWidth := max(20, m.Width-8)
+		var rows []string
+		for _, entry := range entries[start:end] {
+			line := runewidth.Truncate(entry.String(), lineWidth, "…")
+			switch entry.Kind {
+			case transcript.KindStart:
+				rows = append(rows, lipgloss.NewStyle().Foreground(PrimaryColor).Bold(true).Render(line))
+			case transcript.KindResult:
+				rows = append(rows, lipgloss.NewStyle().Foreground(SecondaryColor).Render(line))
+			default:
+				rows = append(rows, StatsLabelStyle.Render(line))
+			}
+		}
+		content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, rows...), m.Width, 1))
+		content = append(content, Center(MutedStyle.Render(fmt.Sprintf("Lines %d-%d of %d", start+1, end, len(entries))), m.Width, 1))
+	}
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+	if m.SuccessMessage != "" {
+		content = append(content, Center(SuccessStyle.Render("✅ "+m.SuccessMessage), m.Width, 1))
+	}
+
+	bindings := []KeyBinding{{"↑↓", "Scroll"}}
+	if !m.NoPersist && len(entries) > 0 {
+		bindings = append(bindings, KeyBinding{"e", "Save as text"})
+	}
+	content = append(content, RenderFooter(append(bindings, KeyBinding{"ESC/q", "Main menu"})))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/transcript"
+)
+
+func TestTranscriptRecordsGames(t *testing.T) {
+	freezeUI(t)
+	m := newSnapshotModel(t)
+	m.CurrentView = GameView
+	m.Game = game.NewSeededGame(1)
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+
+	var kinds []transcript.Kind
+	var texts []string
+	for _, entry := range m.Transcript.Entries() {
+		if entry.Game != 1 {
+			t.Errorf("Expected every entry in game 1, got %+v", entry)
+		}
+		kinds = append(kinds, entry.Kind)
+		texts = append(texts, entry.Text)
+	}
+	want := []transcript.Kind{transcript.KindStart, transcript.KindChoice, transcript.KindHost, transcript.KindChoice, transcript.KindResult}
+	if !slices.Equal(kinds, want) {
+		t.Fatalf("Expected start, choice, host, final choice and result, got %q", texts)
+	}
+	if texts[1] != "Chose door 2" || texts[3] != fmt.Sprintf("Switched to door %d", m.Game.PlayerFinalChoice+1) {
+		t.Errorf("Expected the choices recorded, got %q", texts)
+	}
+
+	// The next game is numbered on
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	entries := m.Transcript.Entries()
+	if last := entries[len(entries)-1]; last.Game != 2 || last.Kind != transcript.KindStart {
+		t.Errorf("Expected playing again to start game 2, got %+v", last)
+	}
+}
+
+func TestTranscriptView(t *testing.T) {
+	freezeUI(t)
+	t.Chdir(t.TempDir())
+	m := newSnapshotModel(t)
+	m.Width, m.Height = 100, 16
+	m.openTranscript()
+	if view := plainText(m.View()); !strings.Contains(view, "No games yet") || strings.Contains(view, "Save as text") {
+		t.Errorf("Expected an empty transcript with nothing to save, got:\n%s", view)
+	}
+
+	for range 3 {
+		m.Transcript.StartGame(now(), "New game")
+		m.Transcript.Add(now(), 0, transcript.KindChoice, "Chose door 1")
+	}
+	view := plainText(m.View())
+	if !strings.Contains(view, "Lines 3-6 of 6") || strings.Contains(view, "Game 1") {
+		t.Errorf("Expected the newest entries in view, got:\n%s", view)
+	}
+	m.Update(tea.MouseMsg{Action: tea.MouseActionPress, Button: tea.MouseButtonWheelUp})
+	if view := plainText(m.View()); !strings.Contains(view, "Lines 1-4 of 6") {
+		t.Errorf("Expected the wheel to scroll back to the first game, got:\n%s", view)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	files, _ := filepath.Glob("monty-hall-transcript_*.txt")
+	if len(files) != 1 {
+		t.Fatalf("Expected the transcript saved to a text file, got %v (%s)", files, m.ErrorMessage)
+	}
+	data, err := os.ReadFile(files[0])
+	if err != nil || strings.Count(string(data), "Chose door 1") != 3 {
+		t.Errorf("Expected every entry in the file, got %q (%v)", data, err)
+	}
+}
@@ -0,0 +1,320 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// TutorialStep is a stage of the guided tutorial
+type TutorialStep int
+
+const (
+	TutorialWelcome TutorialStep = iota
+	TutorialPick
+	TutorialReveal
+	TutorialProbability
+	TutorialDecide
+	TutorialResult
+)
+
+// tutorialSteps is the number of tutorial stages
+const tutorialSteps = int(TutorialResult) + 1
+
+const (
+	tutorialCarDoor  = 1 // The car is behind door 2 in the scripted game
+	tutorialPickDoor = 0 // Door the scripted player picks
+)
+
+// Title returns the heading shown for the step
+func (s TutorialStep) Title() string {
+	switch s {
+	case TutorialWelcome:
+		return "The game"
+	case TutorialPick:
+		return "Pick a door"
+	case TutorialReveal:
+		return "The host opens a door"
+	case TutorialProbability:
+		return "Why switching wins 2/3"
+	case TutorialDecide:
+		return "Switch or stay?"
+	case TutorialResult:
+		return "The result"
+	default:
+		return "Unknown"
+	}
+}
+
+// Tutorial is a guided three-door game with a classic host. Its games are
+// never recorded in the statistics
+type Tutorial struct {
+	Step        TutorialStep
+	Game        *game.Game
+	Cursor      int
+	Interactive bool // The player makes the choices; otherwise they are scripted
+}
+
+// newTutorialGame creates the scripted tutorial game
+func newTutorialGame() *game.Game {
+	save := &game.SaveGame{
+		Version:       game.SaveVersion,
+		NumDoors:      game.NumDoors,
+		CarPosition:   tutorialCarDoor,
+		HostBehavior:  game.HostClassic.String(),
+		Phase:         "initial_choice",
+		InitialChoice: -1,
+		FinalChoice:   -1,
+		StartedAt:     now(),
+	}
+
+	g, err := save.Game()
+	if err != nil {
+		return game.NewGame()
+	}
+	return g
+}
+
+// startTutorial opens the tutorial at its first step
+func (m *Model) startTutorial() {
+	interactive := true
+	if m.ConfigManager != nil {
+		interactive = m.ConfigManager.Get().Education.InteractiveMode
+	}
+
+	m.stopAnimations()
+	m.Tutorial = &Tutorial{
+		Game:        newTutorialGame(),
+		Cursor:      tutorialPickDoor,
+		Interactive: interactive,
+	}
+	m.CurrentView = TutorialView
+}
+
+// OfferTutorial opens the tutorial for a first-time player, unless the
+// settings skip it. It is offered once; afterwards it is in the main menu
+func (m *Model) OfferTutorial() {
+	if m.ConfigManager == nil || m.SafeMode || m.StatsManager.GetStats().TotalGames > 0 {
+		return
+	}
+
+	cfg := m.ConfigManager.Get()
+	if cfg.Education.SkipTutorial {
+		return
+	}
+	cfg.Education.SkipTutorial = true
+	m.applyConfig(cfg) // Saved when the tutorial is left
+
+	m.startTutorial()
+}
+
+// handleTutorialKeys processes tutorial input
+func (m *Model) handleTutorialKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	t := m.Tutorial
+	if t == nil {
+		return m, nil
+	}
+
+	switch t.Step {
+	case TutorialPick:
+		if t.Interactive {
+			switch msg.String() {
+			case KeyLeft, "h":
+				t.Cursor = max(t.Cursor-1, 0)
+				return m, nil
+			case KeyRight, "l":
+				t.Cursor = min(t.Cursor+1, t.Game.NumDoors()-1)
+				return m, nil
+			case Key1, Key2, Key3:
+				t.Cursor = int(msg.String()[0] - '1')
+				return m, nil
+			}
+		}
+
+	case TutorialDecide:
+		switch msg.String() {
+		case KeyS:
+			m.tutorialFinalChoice(t.Game.SwitchChoice)
+		case "t":
+			if t.Interactive {
+				m.tutorialFinalChoice(t.Game.StayWithChoice)
+			}
+		}
+		return m, nil
+
+	case TutorialResult:
+		switch msg.String() {
+		case KeyEnter, KeySpace, " ":
+			m.Tutorial = nil
+			m.startNewGame()
+			m.CurrentView = GameView
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case KeyEnter, KeySpace, " ":
+		if t.Step == TutorialPick {
+			if err := t.Game.MakeInitialChoice(t.Cursor); err != nil {
+				m.ErrorMessage = err.Error()
+				return m, nil
+			}
+		}
+		t.Step++
+	}
+
+	return m, nil
+}
+
+// tutorialFinalChoice makes the player's final choice and shows the result
+func (m *Model) tutorialFinalChoice(choose func() error) {
+	if err := choose(); err != nil {
+		m.ErrorMessage = err.Error()
+		return
+	}
+	m.Tutorial.Step = TutorialResult
+}
+
+// tutorialLines returns the explanation for the current step
+func (t *Tutorial) tutorialLines() []string {
+	g := t.Game
+	picked := g.PlayerInitialChoice + 1
+	opened := g.HostOpenedDoor + 1
+	other := 0
+	for _, door := range g.GetAvailableChoices() {
+		if door != g.PlayerInitialChoice {
+			other = door + 1
+		}
+	}
+
+	switch t.Step {
+	case TutorialWelcome:
+		return []string{
+			"You're on a game show. Behind one of these three doors is a car,",
+			"behind the other two are goats. You want the car.",
+			"",
+			"You pick a door, then the host, who knows where the car is,",
+			"opens another door with a goat. Then you may switch doors.",
+		}
+
+	case TutorialPick:
+		if !t.Interactive {
+			return []string{
+				"Let's pick door 1. With no information, each door is equally",
+				"likely to hide the car: 1/3 each.",
+			}
+		}
+		return []string{
+			"Choose any door. With no information, each door is equally",
+			"likely to hide the car: 1/3 each.",
+		}
+
+	case TutorialReveal:
+		return []string{
+			fmt.Sprintf("You picked door %d. The host opened door %d: a goat.", picked, opened),
+			"",
+			"He never opens your door and never reveals the car.",
+			fmt.Sprintf("Your door had a 1/3 chance; doors %d and %d together had 2/3.", min(opened, other), max(opened, other)),
+			fmt.Sprintf("Opening door %d moves all of that 2/3 onto door %d.", opened, other),
+		}
+
+	case TutorialProbability:
+		row := func(car, reason, chance string) string {
+			return fmt.Sprintf("  %-26s %-24s P = %s", car, reason, chance)
+		}
+		return []string{
+			fmt.Sprintf("How likely was the host to open door %d, for each car position?", opened),
+			row(fmt.Sprintf("Car behind door %d (yours):", picked), fmt.Sprintf("he picks door %d or %d", min(opened, other), max(opened, other)), "1/2"),
+			row(fmt.Sprintf("Car behind door %d:", other), fmt.Sprintf("he must open door %d", opened), "1"),
+			row(fmt.Sprintf("Car behind door %d:", opened), "he can't open it", "0"),
+			"",
+			fmt.Sprintf("P(car behind %d | opened %d) = (1/3 × 1/2) / (1/3 × 1/2 + 1/3 × 1) = 1/3", picked, opened),
+			fmt.Sprintf("P(car behind %d | opened %d) = (1/3 × 1)   / (1/3 × 1/2 + 1/3 × 1) = 2/3", other, opened),
+		}
+
+	case TutorialDecide:
+		if !t.Interactive {
+			return []string{
+				fmt.Sprintf("Door %d wins 2/3 of the time, so let's switch to it.", other),
+			}
+		}
+		return []string{
+			fmt.Sprintf("Stay with door %d (wins 1/3) or switch to door %d (wins 2/3)?", picked, other),
+		}
+
+	case TutorialResult:
+		outcome := fmt.Sprintf("The car was behind door %d. You lost this time,", g.CarPosition+1)
+		if g.Result != nil && g.Result.Won {
+			outcome = fmt.Sprintf("The car was behind door %d. You won,", g.CarPosition+1)
+		}
+		return []string{
+			outcome,
+			"but one game proves little: over many games switching wins 2 in 3.",
+			"Check the statistics after playing a few games to see it happen.",
+		}
+
+	default:
+		return nil
+	}
+}
+
+// renderTutorial renders the tutorial view
+func (m *Model) renderTutorial() string {
+	t := m.Tutorial
+	if t == nil {
+		return ""
+	}
+
+	var content []string
+	content = append(content, HeaderStyle.Render("TUTORIAL"))
+	content = append(content, Center(SubtitleStyle.Render(fmt.Sprintf("Step %d of %d: %s", int(t.Step)+1, tutorialSteps, t.Step.Title())), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	text := lipgloss.JoinVertical(lipgloss.Left, t.tutorialLines()...)
+	content = append(content, Center(StatsLabelStyle.Render(text), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	g := t.Game
+	var doors string
+	switch t.Step {
+	case TutorialWelcome:
+		doors = RenderDoorsRow(g.Doors, -1, -1, -1, false)
+	case TutorialPick:
+		doors = RenderDoorsRow(g.Doors, -1, -1, t.Cursor, false)
+	case TutorialResult:
+		doors = RenderDoorsRow(g.Doors, g.PlayerFinalChoice, g.HostOpenedDoor, -1, true)
+	default:
+		doors = RenderDoorsRow(g.Doors, g.PlayerInitialChoice, g.HostOpenedDoor, -1, false)
+	}
+	content = append(content, SafeCenter(doors, m.Width))
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+
+	if hint := m.renderGlossaryHint(); hint != "" {
+		content = append(content, hint)
+	}
+
+	var bindings []KeyBinding
+	switch {
+	case t.Step == TutorialPick && t.Interactive:
+		bindings = append(bindings, KeyBinding{"←→/1-3", "Choose door"}, KeyBinding{"Enter", "Pick"})
+	case t.Step == TutorialPick:
+		bindings = append(bindings, KeyBinding{"Enter", "Pick door 1"})
+	case t.Step == TutorialDecide && t.Interactive:
+		bindings = append(bindings, KeyBinding{"s", "Switch"}, KeyBinding{"t", "Stay"})
+	case t.Step == TutorialDecide:
+		bindings = append(bindings, KeyBinding{"s", "Switch"})
+	case t.Step == TutorialResult:
+		bindings = append(bindings, KeyBinding{"Enter", "Play a real game"})
+	default:
+		bindings = append(bindings, KeyBinding{"Enter", "Continue"})
+	}
+	bindings = append(bindings, KeyBinding{"ESC/q", "Main menu"})
+	content = append(content, RenderFooter(bindings))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
@@ -0,0 +1,110 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// newTutorialTestModel creates a first-time player's model with the given
+// tutorial mode
+func newTutorialTestModel(t *testing.T, interactive bool) *Model {
+	t.Helper()
+
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	cfg := configManager.Get()
+	cfg.Education.InteractiveMode = interactive
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	return newModelWithStats(configManager, stats.NewReadOnlyStatsManager(filepath.Join(dir, "stats.json")))
+}
+
+func TestScriptedTutorial(t *testing.T) {
+	model := newTutorialTestModel(t, false)
+	model.startTutorial()
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	model.Update(enter) // Welcome
+	model.Update(tea.KeyMsg{Type: tea.KeyRight})
+	model.Update(enter) // Pick
+
+	tutorial := model.Tutorial
+	if tutorial.Game.PlayerInitialChoice != tutorialPickDoor {
+		t.Fatalf("Scripted tutorial should pick door %d, got %d", tutorialPickDoor+1, tutorial.Game.PlayerInitialChoice+1)
+	}
+	if tutorial.Game.HostOpenedDoor != 2 {
+		t.Errorf("Host should open door 3, got %d", tutorial.Game.HostOpenedDoor+1)
+	}
+
+	model.Update(enter) // Reveal
+	model.Update(enter) // Probability
+	if tutorial.Step != TutorialDecide {
+		t.Fatalf("Expected the decide step, got %v", tutorial.Step)
+	}
+
+	// Staying isn't part of the script
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	if tutorial.Step != TutorialDecide {
+		t.Error("Scripted tutorial should only allow switching")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if tutorial.Step != TutorialResult || !tutorial.Game.Result.Won {
+		t.Errorf("Switching should win the scripted game, got step %v result %+v", tutorial.Step, tutorial.Game.Result)
+	}
+	if got := model.StatsManager.GetStats().TotalGames; got != 0 {
+		t.Errorf("Tutorial games should not be recorded, got %d games", got)
+	}
+
+	model.Update(enter)
+	if model.CurrentView != GameView || model.Tutorial != nil {
+		t.Error("Finishing the tutorial should start a real game")
+	}
+}
+
+func TestInteractiveTutorialStay(t *testing.T) {
+	model := newTutorialTestModel(t, true)
+	model.startTutorial()
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	model.Update(enter)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("2")})
+	model.Update(enter)
+
+	tutorial := model.Tutorial
+	if tutorial.Game.PlayerInitialChoice != tutorialCarDoor {
+		t.Fatalf("Expected door 2 picked, got %d", tutorial.Game.PlayerInitialChoice+1)
+	}
+
+	model.Update(enter)
+	model.Update(enter)
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("t")})
+	if tutorial.Step != TutorialResult || !tutorial.Game.Result.Won {
+		t.Errorf("Staying on the car should win, got step %v", tutorial.Step)
+	}
+}
+
+func TestOfferTutorialOnce(t *testing.T) {
+	model := newTutorialTestModel(t, true)
+
+	model.OfferTutorial()
+	if model.CurrentView != TutorialView {
+		t.Fatal("First-time players should be offered the tutorial")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !model.ConfigManager.Get().Education.SkipTutorial || model.ConfigManager.IsDirty() {
+		t.Error("Leaving the tutorial should save that it was offered")
+	}
+
+	model.OfferTutorial()
+	if model.CurrentView != MainMenuView {
+		t.Error("The tutorial should only be offered once")
+	}
+}
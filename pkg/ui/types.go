@@ -4,9 +4,14 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/achievements"
 	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/eventlog"
 	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/simulation"
 	"github.com/westhuis/monty-hall/pkg/stats"
+	"github.com/westhuis/monty-hall/pkg/transcript"
+	"github.com/westhuis/monty-hall/pkg/uistate"
 )
 
 // ViewState represents the current view in the application
@@ -18,6 +23,20 @@ const (
 	StatsView
 	HelpView
 	ExitView
+	GoalsView
+	WhatIfView
+	SettingsView
+	TutorialView
+	SimulationView
+	GlossaryView
+	WorksheetView
+	ThemeGalleryView
+	EventLogView
+	EducationView
+	TranscriptView
+	ProfilesView
+	HotseatView
+	HostModeView
 )
 
 // Model represents the main application state
@@ -31,10 +50,18 @@ type Model struct {
 
 	// Configuration
 	ConfigManager *config.Manager
+	ConfigSaveSeq int    // Incremented on each applied change to debounce saves
+	SafeMode      bool   // Started with --safe-mode for troubleshooting
+	NoPersist     bool   // Started with --no-persist for a public kiosk: nothing is saved
+	NoAnimations  bool   // Started with --no-animations: animations stay off whatever the settings say
+	ASCIIOnly     bool   // Transliterate output to plain ASCII
+	TerminalTitle bool   // Keep the terminal window title up to date
+	WindowTitle   string // Terminal title last set, "" if none
 
 	// Game state
 	Game         *game.Game
 	StatsManager *stats.StatsManager
+	DemoDir      string // Temporary profile holding demo statistics, if loaded
 
 	// UI state
 	MenuCursor     int
@@ -42,6 +69,7 @@ type Model struct {
 	ShowHelp       bool
 	ErrorMessage   string
 	SuccessMessage string
+	RevealPath     string // File the last export or backup wrote, whose folder KeyOpenFolder opens
 
 	// Game flow state
 	GamePhase  game.GamePhase
@@ -50,21 +78,168 @@ type Model struct {
 	// Statistics view state
 	StatsPage     int
 	MaxStatsPages int
+	StatsScroll   int // Lines scrolled up from the bottom of a page taller than the terminal
+
+	// Time range the statistics view covers: an index into rangePresets, or
+	// one past the end for StatsCustomRange, which RangeDraft edits
+	StatsRange       int
+	StatsCustomRange *stats.TimeRange
+	RangeDraft       *RangeDraft
 
 	// Animation system
 	AnimationManager *AnimationManager
 	DoorAnimations   map[int]*DoorOpenAnimation
 	ShowAnimations   bool
+	ReducedMotion    bool             // Results appear at once, without pauses, pulsing or blinking
+	AnimationSpeed   AnimationSpeed   // Scales the reveal delay, animation durations and tick cadence
+	Effects          EffectsIntensity // How strongly celebration text is styled
+	AnimationPacks   []*AnimationPack // Built-in and external packs available in settings
+	Celebration      *Celebration     // Particles bursting from the car's door after a win, nil otherwise
+	AnimationPack    *AnimationPack   // Active pack, follows the configured pack name
+	animationPackFor string           // Configured pack name AnimationPack was chosen for
 
 	// Dramatic reveal system
 	IsRevealing     bool
 	RevealStartTime time.Time
+	HostRevealSeq   int // Incremented on each initial choice, to ignore stale host pauses
+
+	// Modal dialog shown over the current view, nil when closed
+	Dialog *ConfirmDialog
 
 	// Reset confirmation system
 	ShowResetConfirmation    bool
+	ChoosingResetScope       bool             // Picking what to reset, before the numbers are entered
+	ResetScope               stats.ResetScope // What the reset clears
 	ResetConfirmationNumbers [4]int
 	UserInputNumbers         [4]int
 	CurrentInputIndex        int
+
+	// Goals view state
+	GoalCursor int
+	GoalDraft  *GoalDraft
+
+	// Export dialog in the stats view, nil when closed
+	ExportDraft *ExportDraft
+
+	// Achievements unlocked by played games, and the notification shown for them
+	Achievements *achievements.Tracker
+	Toast        *Toast
+
+	// Settings view state
+	SettingsSection SettingsSection
+	SettingsCursor  int
+
+	// Replay of the game history under alternate policies, shown in WhatIfView
+	WhatIf *stats.WhatIfReport
+
+	// Daily challenge in progress, nil for regular games
+	Daily *game.DailyChallenge
+
+	// Games playing themselves in SimulationView
+	AutoPlay *AutoPlay
+
+	// Guided tutorial in progress, shown in TutorialView
+	Tutorial *Tutorial
+
+	// Games played as the host, shown in HostModeView
+	HostMode *HostMode
+
+	// Probability glossary, shown in GlossaryView
+	Glossary *Glossary
+
+	// Theme previews, shown in ThemeGalleryView
+	Gallery *ThemeGallery
+
+	// Step of the Bayesian update for the current game, shown in EducationView,
+	// or the game's decision tree in its place
+	BayesStep        BayesStep
+	ShowDecisionTree bool
+
+	// Recent events, shown in EventLogView, and the messages already logged
+	Events        *eventlog.Log
+	EventCursor   int
+	loggedError   string
+	loggedSuccess string
+
+	// Games and choices of this session, shown in TranscriptView, and the
+	// game and phase last recorded in it
+	Transcript       *transcript.Transcript
+	TranscriptScroll int // Entries scrolled up from the newest
+	transcriptGame   *game.Game
+	transcriptPhase  game.GamePhase
+
+	// Player profiles, each with their own statistics, and the one playing.
+	// Profiles is nil when statistics aren't kept in a file
+	Profiles      *stats.ProfileManager
+	Profile       string
+	ProfileNames  []string
+	ProfilePicker *ProfilePicker // Shown in ProfilesView
+
+	// When views were last seen, for the badges on the main menu
+	UIState *uistate.State
+
+	// Regular game left unfinished when the app last exited, offered on the
+	// main menu, and the game and phase last saved so it can be resumed
+	Resumable    *uistate.Session
+	sessionGame  *game.Game
+	sessionPhase game.GamePhase
+
+	// Games recorded since the last automatic export
+	autoExportGames int
+
+	// Instructor's server the games are reported to, nil outside classroom mode
+	Classroom *ClassroomLink
+
+	// Practice session in progress, nil for regular games
+	Practice *PracticeSession
+
+	// Classroom worksheet in progress, nil for regular games
+	Worksheet *stats.Worksheet
+
+	// Two players taking turns, nil for regular games, and the names being
+	// entered in HotseatView before they start
+	Hotseat      *HotseatSession
+	HotseatSetup *HotseatSetup
+
+	// Climb of the door-count ladder in progress, nil for regular games
+	Ladder *LadderSession
+
+	// Seed of the next regular game, which the one after continues from; nil
+	// draws every game securely
+	GameSeed *int64
+
+	// Play-again preferences
+	LastGame         *game.Game        // Previous finished game
+	RememberDoor     bool              // Start on the previously chosen door
+	ShowProbability  bool              // Show the chance of the car behind each door while choosing
+	ShowHints        bool              // Suggest a strategy at the final choice
+	ConfirmChoices   bool              // Ask before locking in each door choice
+	AutoAdvance      bool              // Start the next game automatically after the result
+	AutoAdvanceDelay time.Duration     // How long the result is shown before auto-advancing
+	DefaultStrategy  string            // "switch" or "stay" to make final choices automatically, "ask" to leave them to the player
+	AutoChoiceFor    *game.Game        // Game whose final choice the default strategy is about to make
+	HintDismissedFor *game.Game        // Game whose hint was dismissed
+	Narrative        string            // Line announcing the result of NarrativeFor
+	NarrativeFor     *game.Game        // Game Narrative was picked for
+	lastNarrative    string            // Unexpanded line last picked, not repeated next game
+	hostLinePick     int               // Chooses the host personality's lines for the current game
+	NumDoors         int               // Doors in regular games
+	HostBehavior     game.HostBehavior // How the host opens doors in regular games
+	HostOpens        int               // Doors the host opens in regular games; 0 for all but one
+	NewGameOptions   NewGameOptions
+	NewGamePrompt    *NewGamePrompt // Open play-again options, nil when closed
+	Rematch          bool           // The game replays the last one's doors, so it is recorded as practice
+
+	// Auto-advance countdown to the next game
+	AutoAdvanceAt        time.Time // When the next game starts; zero when no countdown is running
+	AutoAdvanceSeq       int       // Identifies the countdown, so ticks of a cancelled one are ignored
+	autoAdvanceScheduled bool      // A countdown tick is pending
+
+	// Lifetime statistics when the session started, nil until StartSession
+	SessionStart *stats.Aggregates
+
+	// Where View last drew each clickable element
+	zones mouseZones
 }
 
 // Msg represents messages that can be sent to update the model
@@ -104,6 +279,7 @@ type SuccessMsg struct {
 // MenuOption represents a menu item
 type MenuOption struct {
 	Label       string
+	Badge       bool // Something new to see
 	Description string
 	Action      func() tea.Cmd
 }
@@ -157,10 +333,41 @@ const (
 	KeyR      = "r"
 	KeyS      = "s"
 	KeyE      = "e"
+	KeyW      = "w"
+	KeyI      = "i"
 	Key1      = "1"
 	Key2      = "2"
 	Key3      = "3"
+	Key9      = "9"
 )
 
 // RevealDelayMsg is sent after the reveal delay timer
 type RevealDelayMsg struct{}
+
+// HostRevealMsg is sent when the host's pause before opening doors is over
+type HostRevealMsg struct {
+	Seq int
+}
+
+// AutoPlayTickMsg is sent when the simulation view should play its next step
+type AutoPlayTickMsg struct {
+	Seq int
+}
+
+// AutoPlayRunMsg carries the progress of the simulation view's background run
+type AutoPlayRunMsg struct {
+	Seq    int
+	Update simulation.Update
+	Closed bool // The run ended without a final update
+}
+
+// AutoExportMsg reports an automatic export written in the background
+type AutoExportMsg struct {
+	Filename string
+	Err      error
+}
+
+// ConfigSaveMsg is sent when a debounced configuration save is due
+type ConfigSaveMsg struct {
+	Seq int
+}
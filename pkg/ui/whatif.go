@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// whatIfSeed keeps the random policy's replay stable between visits
+const whatIfSeed = 1
+
+// openWhatIf replays the game history under alternate policies and shows the result
+func (m *Model) openWhatIf() {
+	m.WhatIf = m.StatsManager.WhatIf(whatIfSeed)
+	m.CurrentView = WhatIfView
+}
+
+// handleWhatIfKeys processes input in the what-if view
+func (m *Model) handleWhatIfKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case KeyS, "b":
+		m.CurrentView = StatsView
+
+	case KeyEnter, KeySpace, " ":
+		m.startNewGame()
+		m.CurrentView = GameView
+	}
+
+	return m, nil
+}
+
+// renderWhatIf renders how recorded games would have gone under each policy
+func (m *Model) renderWhatIf() string {
+	report := m.WhatIf
+
+	var content []string
+	content = append(content, HeaderStyle.Render("WHAT IF?"))
+	content = append(content, Spacer(1))
+
+	if report == nil || report.Games == 0 {
+		content = append(content, Center(SubtitleStyle.Render("No games with recorded door positions to replay yet."), m.Width, 1))
+	} else {
+		content = append(content, Center(SubtitleStyle.Render(
+			fmt.Sprintf("Your %d games replayed with the same car positions and first picks", report.Games)), m.Width, 1))
+		if report.Skipped > 0 {
+			content = append(content, Center(MutedStyle.Render(
+				fmt.Sprintf("%d older games were skipped because their doors were not recorded consistently", report.Skipped)), m.Width, 1))
+		}
+		content = append(content, Spacer(1))
+
+		actual := NewProgressBar(report.ActualWins, report.Games, 40, "Your play")
+		content = append(content, Center(actual.Render(), m.Width, 1))
+
+		for _, outcome := range report.Outcomes {
+			content = append(content, Spacer(1))
+			bar := NewProgressBar(outcome.Wins, outcome.Games, 40, outcome.Policy.String())
+			content = append(content, Center(bar.Render(), m.Width, 1))
+			content = append(content, Center(renderWinDifference(report.Difference(outcome)), m.Width, 1))
+		}
+	}
+
+	if hint := m.renderGlossaryHint(); hint != "" {
+		content = append(content, hint)
+	}
+	footer := RenderFooter([]KeyBinding{
+		{"Enter", "Play game"},
+		{"s", "Statistics"},
+		{"ESC/q", "Return"},
+	})
+	content = append(content, footer)
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
+
+// renderWinDifference describes how a policy compares to the actual results
+func renderWinDifference(diff int) string {
+	switch {
+	case diff > 0:
+		return lipgloss.NewStyle().Foreground(WarningColor).Render(fmt.Sprintf("▲ %s more than you", pluralWins(diff)))
+	case diff < 0:
+		return SuccessStyle.Render(fmt.Sprintf("▼ %s fewer than you", pluralWins(-diff)))
+	default:
+		return MutedStyle.Render("= same number of wins as you")
+	}
+}
+
+// pluralWins formats a number of wins
+func pluralWins(n int) string {
+	if n == 1 {
+		return "1 win"
+	}
+	return fmt.Sprintf("%d wins", n)
+}
@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestWhatIfView(t *testing.T) {
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.CurrentView = StatsView
+
+	err := model.StatsManager.RecordGame(&game.GameResult{
+		Won:            false,
+		Strategy:       game.Stay,
+		InitialChoice:  1,
+		FinalChoice:    1,
+		CarPosition:    2,
+		HostOpenedDoor: 3,
+		Timestamp:      time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to record game: %v", err)
+	}
+
+	model.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	if model.CurrentView != WhatIfView {
+		t.Fatalf("Expected w to open the what-if view, got %v", model.CurrentView)
+	}
+	if model.WhatIf == nil || model.WhatIf.Games != 1 {
+		t.Fatalf("Expected a report for 1 game, got %+v", model.WhatIf)
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "Always switch") || !strings.Contains(view, "1 win more than you") {
+		t.Errorf("Expected the switch policy to show one more win, got:\n%s", view)
+	}
+
+	model.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if model.CurrentView != StatsView {
+		t.Errorf("Expected s to return to statistics, got %v", model.CurrentView)
+	}
+}
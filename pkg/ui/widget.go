@@ -0,0 +1,141 @@
+package ui
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// WidgetSlot is the part of the game view a widget is drawn in
+type WidgetSlot int
+
+const (
+	// SlotStatus lines sit under the phase indicator, centered
+	SlotStatus WidgetSlot = iota
+	// SlotPanel widgets are stacked beside the doors, or under them when
+	// the terminal is too narrow
+	SlotPanel
+)
+
+// widgetPanelGap separates the side panel from the doors
+const widgetPanelGap = 3
+
+// Widget is a small piece of the game view contributed by an optional
+// feature, such as a mode's status line, so the feature does not have to edit
+// renderGame
+type Widget struct {
+	Name  string
+	Slot  WidgetSlot
+	Order int // Widgets in a slot are drawn in increasing order
+
+	// Phases lists the game phases the widget is shown in; empty shows it in
+	// every phase
+	Phases []game.GamePhase
+
+	// Render draws the widget for the current game, or returns "" to hide it.
+	// Status widgets are centered by the layout; panel widgets are drawn as is
+	Render func(m *Model) string
+}
+
+var (
+	widgetsMu sync.RWMutex
+	widgets   = map[string]Widget{}
+)
+
+func init() {
+	RegisterWidget(Widget{Name: "practice", Slot: SlotStatus, Order: 10, Render: (*Model).renderPracticeStatus})
+	RegisterWidget(Widget{Name: "hotseat", Slot: SlotStatus, Order: 15, Render: (*Model).renderHotseatStatus})
+	RegisterWidget(Widget{Name: "daily", Slot: SlotStatus, Order: 20, Render: (*Model).renderDailyStatus})
+	RegisterWidget(Widget{Name: "ladder", Slot: SlotStatus, Order: 25, Render: (*Model).renderLadderStatus})
+	RegisterWidget(Widget{Name: "worksheet", Slot: SlotStatus, Order: 30, Render: (*Model).renderWorksheetStatus})
+	RegisterWidget(Widget{Name: "session", Slot: SlotStatus, Order: 40, Render: (*Model).renderSessionStatus})
+	RegisterWidget(Widget{Name: "onboarding", Slot: SlotStatus, Order: 45, Render: (*Model).renderOnboardingStatus})
+	RegisterWidget(Widget{Name: "hint", Slot: SlotStatus, Order: 50,
+		Phases: []game.GamePhase{game.FinalChoice}, Render: (*Model).renderHintStatus})
+	RegisterWidget(Widget{Name: "defaultstrategy", Slot: SlotStatus, Order: 55,
+		Phases: []game.GamePhase{game.FinalChoice}, Render: (*Model).renderDefaultStrategyStatus})
+	RegisterWidget(Widget{Name: "autoadvance", Slot: SlotStatus, Order: 60,
+		Phases: []game.GamePhase{game.GameOver}, Render: (*Model).renderAutoAdvanceStatus})
+	RegisterWidget(Widget{Name: "probability", Slot: SlotPanel, Order: 10,
+		Phases: []game.GamePhase{game.InitialChoice, game.FinalChoice}, Render: (*Model).renderProbabilityPanel})
+}
+
+// RegisterWidget adds a widget to the game view, replacing any widget already
+// registered with its name
+func RegisterWidget(widget Widget) {
+	widgetsMu.Lock()
+	defer widgetsMu.Unlock()
+	widgets[widget.Name] = widget
+}
+
+// UnregisterWidget removes the widget with the given name, if any
+func UnregisterWidget(name string) {
+	widgetsMu.Lock()
+	defer widgetsMu.Unlock()
+	delete(widgets, name)
+}
+
+// WidgetNames returns the names of the registered widgets
+func WidgetNames() []string {
+	widgetsMu.RLock()
+	defer widgetsMu.RUnlock()
+	names := make([]string, 0, len(widgets))
+	for name := range widgets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// slotWidgets returns the widgets of a slot shown in the phase, in order
+func slotWidgets(slot WidgetSlot, phase game.GamePhase) []Widget {
+	widgetsMu.RLock()
+	defer widgetsMu.RUnlock()
+
+	var shown []Widget
+	for _, widget := range widgets {
+		if widget.Slot == slot && (len(widget.Phases) == 0 || slices.Contains(widget.Phases, phase)) {
+			shown = append(shown, widget)
+		}
+	}
+	slices.SortFunc(shown, func(a, b Widget) int {
+		if a.Order != b.Order {
+			return a.Order - b.Order
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return shown
+}
+
+// renderWidgets renders the visible widgets of a slot for the current game
+func (m *Model) renderWidgets(slot WidgetSlot) []string {
+	if m.Game == nil {
+		return nil
+	}
+
+	var rendered []string
+	for _, widget := range slotWidgets(slot, m.Game.Phase) {
+		if out := widget.Render(m); out != "" {
+			rendered = append(rendered, out)
+		}
+	}
+	return rendered
+}
+
+// withWidgetPanel places the panel widgets beside the doors when they fit,
+// and under them otherwise
+func (m *Model) withWidgetPanel(doors string) string {
+	panel := m.renderWidgets(SlotPanel)
+	if len(panel) == 0 {
+		return SafeCenter(doors, m.Width)
+	}
+
+	column := lipgloss.JoinVertical(lipgloss.Left, panel...)
+	if lipgloss.Width(doors)+widgetPanelGap+lipgloss.Width(column) <= m.Width {
+		return SafeCenter(lipgloss.JoinHorizontal(lipgloss.Top, doors, strings.Repeat(" ", widgetPanelGap), column), m.Width)
+	}
+	return lipgloss.JoinVertical(lipgloss.Center, SafeCenter(doors, m.Width), Center(column, m.Width, 1))
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestWidgets(t *testing.T) {
+	RegisterWidget(Widget{Name: "test-status", Slot: SlotStatus, Order: 5, Render: func(m *Model) string {
+		return "⏱ test pace"
+	}})
+	RegisterWidget(Widget{Name: "test-panel", Slot: SlotPanel, Phases: []game.GamePhase{game.FinalChoice}, Render: func(m *Model) string {
+		return "💰 test wallet"
+	}})
+	t.Cleanup(func() {
+		UnregisterWidget("test-status")
+		UnregisterWidget("test-panel")
+	})
+
+	if names := WidgetNames(); !slices.Contains(names, "test-panel") || !slices.Contains(names, "practice") {
+		t.Fatalf("Expected the registered widgets, got %v", names)
+	}
+
+	model := NewModel()
+	model.StatsManager = stats.NewStatsManager(filepath.Join(t.TempDir(), "stats.json"))
+	model.Width, model.Height = 120, 40
+	model.startPractice()
+
+	// Status widgets are drawn in order
+	view := model.View()
+	pace, practice := strings.Index(view, "test pace"), strings.Index(view, "Practice")
+	if pace < 0 || practice < 0 || pace > practice {
+		t.Errorf("Expected the test status before the practice status, got:\n%s", view)
+	}
+	if strings.Contains(view, "test wallet") {
+		t.Error("Expected the panel widget to be hidden before the final choice")
+	}
+
+	// Panel widgets sit beside the doors on wide terminals
+	if err := model.Game.MakeInitialChoice(1); err != nil {
+		t.Fatalf("MakeInitialChoice failed: %v", err)
+	}
+	view = model.View()
+	var beside bool
+	for _, line := range strings.Split(view, "\n") {
+		if strings.Contains(line, "test wallet") && strings.ContainsAny(line, "╭│") {
+			beside = true
+		}
+	}
+	if !beside {
+		t.Errorf("Expected the panel widget beside the doors, got:\n%s", view)
+	}
+
+	// Narrow terminals move the panel under the doors
+	model.Width = 30
+	if !strings.Contains(model.View(), "test wallet") {
+		t.Error("Expected the panel widget on a narrow terminal")
+	}
+}
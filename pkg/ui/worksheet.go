@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+// openWorksheet shows the worksheet in progress, starting one if needed
+func (m *Model) openWorksheet() {
+	if m.Worksheet == nil {
+		m.Worksheet = stats.NewWorksheet(now())
+	}
+	m.stopAnimations()
+	m.CurrentView = WorksheetView
+}
+
+// worksheetPlaying returns true while the current worksheet task is played
+// in games
+func (m *Model) worksheetPlaying() bool {
+	if m.Worksheet == nil {
+		return false
+	}
+	task, _, ok := m.Worksheet.Current()
+	if !ok {
+		return false
+	}
+	_, play := task.Strategy()
+	return play
+}
+
+// recordWorksheetGame counts the finished game towards the worksheet
+func (m *Model) recordWorksheetGame() {
+	if !m.worksheetPlaying() {
+		return
+	}
+
+	task, _, _ := m.Worksheet.Current()
+	if !m.Worksheet.Record(m.Game.Result) {
+		strategy, _ := task.Strategy()
+		m.ErrorMessage = fmt.Sprintf("This game doesn't count for the worksheet: the task is to always %s", strategyVerb(strategy))
+	}
+}
+
+// strategyVerb returns the verb for a strategy, as in "always stay"
+func strategyVerb(strategy game.PlayerStrategy) string {
+	if strategy == game.Switch {
+		return "switch"
+	}
+	return "stay"
+}
+
+// handleWorksheetKeys processes worksheet view input
+func (m *Model) handleWorksheetKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	w := m.Worksheet
+	if w == nil {
+		return m, nil
+	}
+
+	task, _, ok := w.Current()
+	switch msg.String() {
+	case KeyEnter, KeySpace, " ":
+		if m.worksheetPlaying() {
+			m.Daily = nil
+			m.Practice = nil
+			m.Hotseat = nil
+			m.Ladder = nil
+			m.startNewGame()
+			m.CurrentView = GameView
+		}
+
+	case KeyS, "t":
+		if ok && task.Kind == stats.TaskCompare {
+			answer := game.Switch
+			if msg.String() == "t" {
+				answer = game.Stay
+			}
+			if err := w.Answer(answer, now()); err != nil {
+				m.ErrorMessage = err.Error()
+			}
+		}
+
+	case KeyE, "x":
+		if !m.NoPersist {
+			m.exportWorksheet(msg.String() == KeyE)
+		}
+
+	case "n":
+		if w.IsComplete() {
+			m.Worksheet = stats.NewWorksheet(now())
+		}
+	}
+
+	return m, nil
+}
+
+// exportWorksheet saves the filled-in worksheet to the export directory
+func (m *Model) exportWorksheet(markdown bool) {
+	dir := ""
+	if m.ConfigManager != nil {
+		dir = m.ConfigManager.Get().Stats.ExportDirectory
+	}
+
+	path, err := m.Worksheet.Export(dir, markdown, now())
+	if err != nil {
+		m.ErrorMessage = FormatErrorForDisplay(WrapError(err, "export worksheet"))
+		return
+	}
+	m.SuccessMessage = fmt.Sprintf("Worksheet saved to: %s", path)
+}
+
+// renderWorksheetStatus renders the worksheet banner shown above the doors
+func (m *Model) renderWorksheetStatus() string {
+	if m.Worksheet == nil {
+		return ""
+	}
+	task, index, ok := m.Worksheet.Current()
+	if !ok {
+		return Center(lipgloss.NewStyle().Foreground(AccentColor).Bold(true).Render("📝 Worksheet complete"), m.Width, 1)
+	}
+
+	status := fmt.Sprintf("📝 Worksheet  •  Task %d/%d  •  %d of %d games", index+1, len(m.Worksheet.Tasks), len(task.Results), task.Games)
+	if !m.worksheetPlaying() {
+		status = fmt.Sprintf("📝 Worksheet  •  Task %d/%d", index+1, len(m.Worksheet.Tasks))
+	}
+	return lipgloss.JoinVertical(lipgloss.Center,
+		Center(lipgloss.NewStyle().Foreground(AccentColor).Bold(true).Render(status), m.Width, 1),
+		Center(MutedStyle.Render(task.Prompt()), m.Width, 1),
+	)
+}
+
+// renderWorksheet renders the worksheet view
+func (m *Model) renderWorksheet() string {
+	w := m.Worksheet
+	if w == nil {
+		return ""
+	}
+
+	var content []string
+	content = append(content, HeaderStyle.Render("WORKSHEET"))
+	content = append(content, Center(SubtitleStyle.Render("An experiment: does switching really win more often?"), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	current, _, _ := w.Current()
+	var lines []string
+	for i, task := range w.Tasks {
+		mark, style := "☐", StatsLabelStyle
+		switch {
+		case task.IsComplete():
+			mark, style = "☑", SuccessStyle
+		case task == current:
+			mark, style = "▸", lipgloss.NewStyle().Foreground(SelectedColor).Bold(true)
+		}
+		lines = append(lines, style.Render(fmt.Sprintf("%s %d. %s", mark, i+1, task.Prompt())))
+
+		var detail string
+		switch {
+		case task.Kind == stats.TaskCompare && task.IsComplete():
+			detail = w.Conclusion()
+		case task.Kind == stats.TaskCompare:
+			detail = "Answer once both experiments are done"
+		case len(task.Results) > 0:
+			detail = fmt.Sprintf("%d of %d games played, won %d (%.0f%%)", len(task.Results), task.Games, task.Wins(), task.WinRate()*100)
+		default:
+			detail = fmt.Sprintf("0 of %d games played", task.Games)
+		}
+		lines = append(lines, MutedStyle.Render("     "+detail))
+	}
+	content = append(content, Center(lipgloss.JoinVertical(lipgloss.Left, lines...), m.Width, 1))
+	content = append(content, Spacer(1))
+
+	if m.ErrorMessage != "" {
+		content = append(content, Center(ErrorStyle.Render("❌ "+m.ErrorMessage), m.Width, 1))
+	}
+	if m.SuccessMessage != "" {
+		content = append(content, Center(SuccessStyle.Render("✅ "+m.SuccessMessage), m.Width, 1))
+	}
+
+	var bindings []KeyBinding
+	switch {
+	case m.worksheetPlaying():
+		bindings = append(bindings, KeyBinding{"Enter", "Play next game"})
+	case current != nil && current.Kind == stats.TaskCompare:
+		bindings = append(bindings, KeyBinding{"s", "Switching"}, KeyBinding{"t", "Staying"})
+	}
+	if !m.NoPersist {
+		bindings = append(bindings, KeyBinding{"e", "Save .md"}, KeyBinding{"x", "Save .txt"})
+	}
+	if w.IsComplete() {
+		bindings = append(bindings, KeyBinding{"n", "New worksheet"})
+	}
+	bindings = append(bindings, KeyBinding{"ESC/q", "Main menu"})
+	content = append(content, RenderFooter(bindings))
+
+	return lipgloss.JoinVertical(lipgloss.Center, content...)
+}
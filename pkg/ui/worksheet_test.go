@@ -0,0 +1,75 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbletea"
+	"github.com/westhuis/monty-hall/pkg/config"
+	"github.com/westhuis/monty-hall/pkg/game"
+	"github.com/westhuis/monty-hall/pkg/stats"
+)
+
+func TestWorksheetFlow(t *testing.T) {
+	dir := t.TempDir()
+	configManager := config.NewManagerWithDefaults(filepath.Join(dir, "config.json"))
+	cfg := configManager.Get()
+	cfg.Game.NumDoors = 5
+	cfg.Stats.ExportDirectory = filepath.Join(dir, "exports")
+	if err := configManager.Apply(cfg); err != nil {
+		t.Fatal(err)
+	}
+	model := newModelWithStats(configManager, stats.NewReadOnlyStatsManager(filepath.Join(dir, "stats.json")))
+
+	model.openWorksheet()
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.CurrentView != GameView || model.Game.NumDoors() != game.NumDoors {
+		t.Fatalf("Worksheet games should use the classic three doors, got view %v with %d doors", model.CurrentView, model.Game.NumDoors())
+	}
+
+	// Switching doesn't count while the task is to stay
+	model.Game.MakeInitialChoice(0)
+	model.switchChoice()
+	if !strings.Contains(model.ErrorMessage, "always stay") || len(model.Worksheet.Tasks[0].Results) != 0 {
+		t.Errorf("Expected a switch to be rejected, got %q", model.ErrorMessage)
+	}
+
+	for _, strategy := range []game.PlayerStrategy{game.Stay, game.Switch} {
+		for range stats.WorksheetGames {
+			model.startNewGame()
+			model.Game.MakeInitialChoice(0)
+			if strategy == game.Switch {
+				model.switchChoice()
+			} else {
+				model.Game.StayWithChoice()
+				model.recordResult()
+			}
+		}
+	}
+
+	// With the games played, Enter returns to the worksheet for the last task
+	model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if model.CurrentView != WorksheetView {
+		t.Fatalf("Expected the worksheet after its games, got view %v", model.CurrentView)
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if !model.Worksheet.IsComplete() {
+		t.Fatal("Answering should complete the worksheet")
+	}
+
+	model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	if !strings.HasPrefix(model.SuccessMessage, "Worksheet saved to: "+cfg.Stats.ExportDirectory) {
+		t.Fatalf("Expected the worksheet in the export directory, got %q %q", model.SuccessMessage, model.ErrorMessage)
+	}
+	data, err := os.ReadFile(strings.TrimPrefix(model.SuccessMessage, "Worksheet saved to: "))
+	if err != nil || !strings.Contains(string(data), "My answer: switching") {
+		t.Errorf("Exported worksheet should include the answer, got %v", err)
+	}
+
+	if got := model.StatsManager.GetStats().TotalGames; got != 2*stats.WorksheetGames+1 {
+		t.Errorf("Worksheet games should also be recorded in the statistics, got %d", got)
+	}
+}
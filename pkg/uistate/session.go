@@ -0,0 +1,89 @@
+package uistate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+// SessionFileName is the name of the file keeping the game in progress
+const SessionFileName = "monty_hall_session.json"
+
+// Session is a game in progress, saved so it can be resumed if the app exits
+// before the game is over
+type Session struct {
+	Game    *game.SaveGame `json:"game"`
+	Cursor  int            `json:"cursor"`   // Door the cursor was on
+	SavedAt time.Time      `json:"saved_at"` // When the game was last saved
+}
+
+// Restore rebuilds the saved game. The time since it was saved, while the app
+// was closed, counts as paused, so it is left out of the game's duration
+func (s *Session) Restore(now time.Time) (*game.Game, error) {
+	if s.Game == nil {
+		return nil, fmt.Errorf("%w: no game", game.ErrInvalidSave)
+	}
+
+	save := *s.Game
+	if gap := now.Sub(s.SavedAt); gap > 0 && !s.SavedAt.IsZero() {
+		save.PausedFor += gap
+	}
+	return save.Game()
+}
+
+// SaveSession writes the session to path. It is written to a temporary file
+// that is renamed over path, so a crash mid-write keeps the previous session
+func SaveSession(path string, session *Session) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(session, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal game in progress: %w", err)
+	}
+
+	tempPath := path + ".tmp"
+	if err := chaos.WriteFile(tempPath, data, 0644); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to write game in progress: %w", err)
+	}
+	if err := os.Rename(tempPath, path); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("failed to write game in progress: %w", err)
+	}
+	return nil
+}
+
+// LoadSession reads the session saved at path, or returns nil when there is none
+func LoadSession(path string) (*Session, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read game in progress: %w", err)
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("failed to parse game in progress: %w", err)
+	}
+	return &session, nil
+}
+
+// ClearSession removes the session saved at path, once its game is over
+func ClearSession(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to clear game in progress: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Package uistate keeps small pieces of interface state between runs, such as
+// when each view was last seen, so the menu can point out what is new, and how
+// far a new player is through the guided first games
+package uistate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/chaos"
+)
+
+// DefaultFileName is the name of the UI state file
+const DefaultFileName = "monty_hall_ui_state.json"
+
+// Marker records when a view was last seen, and which version of its
+// content, for views whose content changes with app updates
+type Marker struct {
+	Time    time.Time `json:"time"`
+	Version int       `json:"version,omitempty"`
+}
+
+// Onboarding records a new player's progress through the guided first games
+type Onboarding struct {
+	GamesPlayed int `json:"games_played"`
+}
+
+// stateFile is the layout of the UI state file
+type stateFile struct {
+	LastSeen   map[string]Marker `json:"last_seen"`
+	Onboarding *Onboarding       `json:"onboarding,omitempty"`
+}
+
+// State holds the last-seen marker of each view and the onboarding
+// progress, persisted to a file
+type State struct {
+	filePath   string
+	markers    map[string]Marker
+	onboarding *Onboarding // Nil until onboarding starts
+	readOnly   bool        // Keep state in memory only
+}
+
+// NewState creates state backed by the given file; an empty path keeps it
+// for the session only
+func NewState(filePath string) *State {
+	return &State{
+		filePath: filePath,
+		markers:  make(map[string]Marker),
+		readOnly: filePath == "",
+	}
+}
+
+// NewReadOnlyState creates state that loads markers but never writes them
+func NewReadOnlyState(filePath string) *State {
+	s := NewState(filePath)
+	s.readOnly = true
+	return s
+}
+
+// Load reads the markers; a missing file means no view has been seen
+func (s *State) Load() error {
+	if s.filePath == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			s.markers = make(map[string]Marker)
+			return nil
+		}
+		return fmt.Errorf("failed to read UI state: %w", err)
+	}
+
+	var file stateFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("failed to unmarshal UI state: %w", err)
+	}
+
+	s.markers = file.LastSeen
+	if s.markers == nil {
+		s.markers = make(map[string]Marker)
+	}
+	s.onboarding = file.Onboarding
+	return nil
+}
+
+// Save writes the markers; read-only state keeps them in memory
+func (s *State) Save() error {
+	if s.readOnly {
+		return nil
+	}
+
+	dir := filepath.Dir(s.filePath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+
+	data, err := json.MarshalIndent(stateFile{LastSeen: s.markers, Onboarding: s.onboarding}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal UI state: %w", err)
+	}
+
+	if err := chaos.WriteFile(s.filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write UI state: %w", err)
+	}
+
+	return nil
+}
+
+// LastSeen returns the marker of a view, and false if it has never been seen
+func (s *State) LastSeen(view string) (Marker, bool) {
+	marker, ok := s.markers[view]
+	return marker, ok
+}
+
+// MarkSeen records that a view was seen at a time, showing version of its content
+func (s *State) MarkSeen(view string, at time.Time, version int) error {
+	s.markers[view] = Marker{Time: at, Version: version}
+	return s.Save()
+}
+
+// Onboarding returns the onboarding progress, and false if onboarding never started
+func (s *State) Onboarding() (Onboarding, bool) {
+	if s.onboarding == nil {
+		return Onboarding{}, false
+	}
+	return *s.onboarding, true
+}
+
+// SetOnboarding records the onboarding progress
+func (s *State) SetOnboarding(progress Onboarding) error {
+	s.onboarding = &progress
+	return s.Save()
+}
+
+// GetFilePath returns the path of the UI state file
+func (s *State) GetFilePath() string {
+	return s.filePath
+}
@@ -0,0 +1,114 @@
+package uistate
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/westhuis/monty-hall/pkg/game"
+)
+
+var seen = time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+
+func TestStatePersistsMarkers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	state := NewState(path)
+	if err := state.Load(); err != nil {
+		t.Fatalf("Expected a missing file to load as empty: %v", err)
+	}
+	if _, ok := state.LastSeen("statistics"); ok {
+		t.Error("Expected no view to have been seen")
+	}
+
+	if err := state.MarkSeen("statistics", seen, 0); err != nil {
+		t.Fatal(err)
+	}
+	state.MarkSeen("help", seen.Add(time.Hour), 3)
+
+	reloaded := NewState(path)
+	if err := reloaded.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if marker, ok := reloaded.LastSeen("statistics"); !ok || !marker.Time.Equal(seen) {
+		t.Errorf("Expected statistics to be seen at %v, got %+v", seen, marker)
+	}
+	if marker, _ := reloaded.LastSeen("help"); marker.Version != 3 {
+		t.Errorf("Expected help version 3, got %+v", marker)
+	}
+}
+
+func TestReadOnlyState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	state := NewReadOnlyState(path)
+	state.MarkSeen("statistics", seen, 0)
+	if _, ok := state.LastSeen("statistics"); !ok {
+		t.Error("Expected the marker to be kept in memory")
+	}
+
+	reloaded := NewState(path)
+	reloaded.Load()
+	if _, ok := reloaded.LastSeen("statistics"); ok {
+		t.Error("Expected nothing to be written")
+	}
+}
+
+func TestStatePersistsOnboarding(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	state := NewState(path)
+	state.MarkSeen("help", seen, 1)
+	if _, ok := state.Onboarding(); ok {
+		t.Error("Expected onboarding not to have started")
+	}
+	if err := state.SetOnboarding(Onboarding{GamesPlayed: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := NewState(path)
+	if err := reloaded.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if progress, ok := reloaded.Onboarding(); !ok || progress.GamesPlayed != 2 {
+		t.Errorf("Expected 2 onboarding games, got %+v", progress)
+	}
+	if _, ok := reloaded.LastSeen("help"); !ok {
+		t.Error("Expected the markers to be kept beside the onboarding progress")
+	}
+}
+
+func TestSessionRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), SessionFileName)
+	if session, err := LoadSession(path); err != nil || session != nil {
+		t.Fatalf("Expected no session before one is saved, got %+v, %v", session, err)
+	}
+
+	g := game.NewSeededGame(7)
+	if err := g.MakeInitialChoice(0); err != nil {
+		t.Fatal(err)
+	}
+	savedAt := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	if err := SaveSession(path, &Session{Game: g.Save(), Cursor: 2, SavedAt: savedAt}); err != nil {
+		t.Fatal(err)
+	}
+
+	session, err := LoadSession(path)
+	if err != nil || session == nil || session.Cursor != 2 {
+		t.Fatalf("Expected the session read back, got %+v, %v", session, err)
+	}
+	restored, err := session.Restore(savedAt.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Phase != game.FinalChoice || restored.CarPosition != g.CarPosition || restored.HostOpenedDoor != g.HostOpenedDoor {
+		t.Errorf("Expected the game restored at the final choice, got phase %v", restored.Phase)
+	}
+	if paused := restored.Save().PausedFor; paused < time.Hour {
+		t.Errorf("Expected the time the app was closed left out of the game, got %v paused", paused)
+	}
+
+	if err := ClearSession(path); err != nil {
+		t.Fatal(err)
+	}
+	if session, _ := LoadSession(path); session != nil {
+		t.Error("Expected no session once cleared")
+	}
+}